@@ -1,6 +1,7 @@
 package serve
 
 import (
+	"fmt"
 	"log/slog"
 	"os"
 	"os/signal"
@@ -9,7 +10,9 @@ import (
 	"github.com/DimTur/lp_api_gateway/internal/app"
 	lpgrpc "github.com/DimTur/lp_api_gateway/internal/clients/lp/grpc"
 	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
+	ssojwks "github.com/DimTur/lp_api_gateway/internal/clients/sso/jwks"
 	"github.com/DimTur/lp_api_gateway/internal/config"
+	authmiddleware "github.com/DimTur/lp_api_gateway/internal/handlers/middleware/auth"
 	"github.com/DimTur/lp_api_gateway/internal/lib/api/validation"
 	lpservice "github.com/DimTur/lp_api_gateway/internal/services/lp"
 	"github.com/DimTur/lp_api_gateway/internal/services/permissions"
@@ -70,23 +73,46 @@ func NewServeCmd() *cobra.Command {
 				return err
 			}
 
-			redisPermissions := &redis.RedisPermissions{
-				Host:     cfg.Redis.Host,
-				Port:     cfg.Redis.Port,
-				DB:       cfg.Redis.PermissionsDB,
-				Password: cfg.Redis.Password,
-			}
-			redisPerm, err := redis.NewRedisClient(*redisPermissions)
-			if err != nil {
-				log.Error("failed to close redis", slog.Any("err", err))
-			}
-
 			validate := validation.InitValidator()
 
-			permService := permissions.New(log, validate, lpClient, lpClient, lpClient, ssoClient, redisPerm)
-			ssoService := ssoservice.New(log, validate, ssoClient, ssoClient)
+			permService := permissions.New(log, validate, lpClient, lpClient, lpClient, ssoClient)
+			ssoService := ssoservice.New(log, validate, ssoClient, ssoClient, ssoClient, ssoClient, cfg.Telegram.BotName)
 			lpService := lpservice.New(log, validate, lpClient, lpClient, lpClient, lpClient, lpClient, lpClient, ssoClient, *permService)
 
+			var authChecker authmiddleware.AuthService
+			switch cfg.Auth.Mode {
+			case config.AuthModeRemote:
+				authChecker = ssoService
+			case config.AuthModeLocal:
+				jwksClient := ssojwks.New(log, cfg.Auth.JWKSURL, cfg.Clients.SSO.Timeout)
+				if err := jwksClient.Refresh(ctx); err != nil {
+					// Keys are fetched again on the next refresh or unknown kid
+					log.Error("failed to fetch jwks", slog.Any("err", err))
+				}
+				go jwksClient.Run(ctx, cfg.Auth.JWKSRefreshInterval)
+
+				redisDenylist, err := redis.NewRedisClient(redis.RedisPermissions{
+					Host:     cfg.Redis.Host,
+					Port:     cfg.Redis.Port,
+					DB:       cfg.Redis.DenylistDB,
+					Password: cfg.Redis.Password,
+				})
+				if err != nil {
+					return err
+				}
+
+				authChecker = ssoservice.NewLocalAuthChecker(
+					log,
+					validate,
+					cfg.Auth.Issuer,
+					jwksClient,
+					redisDenylist,
+					cfg.Auth.DenylistCacheTTL,
+				)
+			default:
+				return fmt.Errorf("unknown auth mode %q", cfg.Auth.Mode)
+			}
+
 			application, err := app.NewApp(
 				cfg.HTTPServer.Address,
 				cfg.HTTPServer.Timeout,
@@ -94,6 +120,7 @@ func NewServeCmd() *cobra.Command {
 				cfg.HTTPServer.IddleTimeout,
 				*ssoService,
 				*lpService,
+				authChecker,
 				log,
 				validate,
 				traceService,
@@ -3,8 +3,9 @@ module github.com/DimTur/lp_api_gateway
 go 1.23.0
 
 require (
-	github.com/DimTur/lp_protos v0.3.5
+	github.com/DimTur/lp_protos v0.3.8
 	github.com/go-chi/render v1.0.3
+	github.com/golang-jwt/jwt/v5 v5.2.1
 	github.com/grpc-ecosystem/go-grpc-middleware/v2 v2.1.0
 	github.com/ilyakaznacheev/cleanenv v1.5.0
 	github.com/prometheus/client_golang v1.20.3
@@ -73,3 +74,6 @@ require (
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 	olympos.io/encoding/edn v0.0.0-20201019073823-d3554ca0b0a3 // indirect
 )
+
+// lp_protos lives in this repository, see ../lp_protos/readme.md
+replace github.com/DimTur/lp_protos => ../lp_protos
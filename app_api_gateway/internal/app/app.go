@@ -6,6 +6,7 @@ import (
 
 	httpapp "github.com/DimTur/lp_api_gateway/internal/app/http"
 	"github.com/DimTur/lp_api_gateway/internal/handlers"
+	authmiddleware "github.com/DimTur/lp_api_gateway/internal/handlers/middleware/auth"
 	lpservice "github.com/DimTur/lp_api_gateway/internal/services/lp"
 	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
 	"github.com/go-playground/validator/v10"
@@ -24,6 +25,7 @@ func NewApp(
 	iddleTimeout time.Duration,
 	ssoService ssoservice.SsoService,
 	lpservice lpservice.LpService,
+	authChecker authmiddleware.AuthService,
 	logger *slog.Logger,
 	validator *validator.Validate,
 	traceProvider trace.TracerProvider,
@@ -32,6 +34,7 @@ func NewApp(
 	routerConfigurator := handlers.NewChiRouterConfigurator(
 		ssoService,
 		lpservice,
+		authChecker,
 		logger,
 		validator,
 		traceProvider,
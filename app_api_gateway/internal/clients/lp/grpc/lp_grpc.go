@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/DimTur/lp_api_gateway/internal/lib/clientinfo"
 	lpv1 "github.com/DimTur/lp_protos/gen/go/lp"
 	grpclog "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
@@ -59,8 +60,12 @@ func New(
 
 // InterceptorLogger adapts slog logger to iterceptor logger.
 // This code is simple enough to be copied and not imported.
+// Calls made during impersonation are logged with the admin id.
 func InterceptorLogger(l *slog.Logger) grpclog.Logger {
 	return grpclog.LoggerFunc(func(ctx context.Context, lvl grpclog.Level, msg string, fields ...any) {
+		if info, ok := clientinfo.FromContext(ctx); ok && info.ActorID != "" {
+			fields = append(fields, "actor_id", info.ActorID)
+		}
 		l.Log(ctx, slog.Level(lvl), msg, fields...)
 	})
 }
@@ -42,11 +42,13 @@ type CompleteLessonResp struct {
 	PercentageScore int64 `json:"percentage_score"`
 }
 
+// GetLessonAttempts gets attempts of the user, or of the learner if it's set.
 type GetLessonAttempts struct {
-	UserID   string `json:"user_id" validate:"required"`
-	LessonID int64  `json:"lesson_id,omitempty"`
-	Limit    int64  `json:"limit,omitempty" validate:"min=1"`
-	Offset   int64  `json:"offset,omitempty" validate:"min=0"`
+	UserID    string `json:"user_id" validate:"required"`
+	LearnerID string `json:"learner_id,omitempty"`
+	LessonID  int64  `json:"lesson_id,omitempty"`
+	Limit     int64  `json:"limit,omitempty" validate:"min=1"`
+	Offset    int64  `json:"offset,omitempty" validate:"min=0"`
 }
 
 type LessonAttempt struct {
@@ -0,0 +1,110 @@
+package ssogrpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *Client) RegisterApp(ctx context.Context, app *ssomodels.RegisterApp) (*ssomodels.RegisterAppResp, error) {
+	const op = "sso.grpc_apps.RegisterApp"
+
+	resp, err := c.api.RegisterApp(ctx, &ssov1.RegisterAppRequest{
+		UserId: app.UserID,
+		Name:   app.Name,
+		Scopes: app.Scopes,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid credentials", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case codes.NotFound:
+			c.log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case codes.PermissionDenied:
+			c.log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		case codes.AlreadyExists:
+			c.log.Error("app already exists", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrAppExists)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.RegisterAppResp{
+		ClientID:     resp.ClientId,
+		ClientSecret: resp.ClientSecret,
+	}, nil
+}
+
+func (c *Client) IssueClientToken(ctx context.Context, creds *ssomodels.IssueClientToken) (*ssomodels.IssueClientTokenResp, error) {
+	const op = "sso.grpc_apps.IssueClientToken"
+
+	resp, err := c.api.IssueClientToken(ctx, &ssov1.IssueClientTokenRequest{
+		ClientId:     creds.ClientID,
+		ClientSecret: creds.ClientSecret,
+		Scopes:       creds.Scopes,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+			c.log.Error("invalid client", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidClient)
+		case codes.InvalidArgument:
+			c.log.Error("invalid scope", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidScope)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.IssueClientTokenResp{
+		AccessToken: resp.AccessToken,
+		ExpiresIn:   resp.ExpiresIn,
+		Scope:       resp.Scope,
+	}, nil
+}
+
+func (c *Client) RegisterRelyingParty(ctx context.Context, rp *ssomodels.RegisterRelyingParty) (*ssomodels.RegisterRelyingPartyResp, error) {
+	const op = "sso.grpc_apps.RegisterRelyingParty"
+
+	resp, err := c.api.RegisterRelyingParty(ctx, &ssov1.RegisterRelyingPartyRequest{
+		UserId:       rp.UserID,
+		Name:         rp.Name,
+		RedirectUris: rp.RedirectURIs,
+		Public:       rp.Public,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid credentials", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case codes.NotFound:
+			c.log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case codes.PermissionDenied:
+			c.log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		case codes.AlreadyExists:
+			c.log.Error("relying party already exists", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrAppExists)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.RegisterRelyingPartyResp{
+		ClientID:     resp.ClientId,
+		ClientSecret: resp.ClientSecret,
+	}, nil
+}
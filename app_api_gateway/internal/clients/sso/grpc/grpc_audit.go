@@ -0,0 +1,64 @@
+package ssogrpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *Client) ListAuditEvents(ctx context.Context, list *ssomodels.ListAuditEvents) (*ssomodels.ListAuditEventsResp, error) {
+	const op = "sso.grpc_audit.ListAuditEvents"
+
+	resp, err := c.api.ListAuditEvents(ctx, &ssov1.ListAuditEventsRequest{
+		AdminId:   list.AdminID,
+		UserId:    list.UserID,
+		EventType: list.EventType,
+		From:      list.From,
+		To:        list.To,
+		Cursor:    list.Cursor,
+		Limit:     list.Limit,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid credentials", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case codes.NotFound:
+			c.log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case codes.PermissionDenied:
+			c.log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	var events []*ssomodels.AuditEvent
+	for _, event := range resp.Events {
+		events = append(events, &ssomodels.AuditEvent{
+			ID:        event.Id,
+			Type:      event.Type,
+			UserID:    event.UserId,
+			ActorID:   event.ActorId,
+			Email:     event.Email,
+			Method:    event.Method,
+			TargetID:  event.TargetId,
+			Details:   event.Details,
+			IP:        event.Ip,
+			UserAgent: event.UserAgent,
+			Created:   event.Created,
+		})
+	}
+
+	return &ssomodels.ListAuditEventsResp{
+		Events:     events,
+		NextCursor: resp.NextCursor,
+	}, nil
+}
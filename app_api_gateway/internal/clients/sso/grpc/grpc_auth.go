@@ -8,6 +8,7 @@ import (
 
 	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
 	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -22,6 +23,21 @@ var (
 	ErrInvalidRefreshToken = errors.New("invalid refresh token")
 	ErrInvalidAccessToken  = errors.New("invalid access token")
 	ErrOtpNotFound         = errors.New("otp not found")
+	ErrEmailNotVerified    = errors.New("email is not verified")
+	ErrInvalidVerifyToken  = errors.New("invalid verification token")
+	ErrInvalidMFAToken     = errors.New("invalid mfa token")
+	ErrTOTPAlreadyEnabled  = errors.New("totp is already enabled")
+	ErrTOTPNotEnabled      = errors.New("totp is not enabled")
+	ErrTooManyAttempts     = errors.New("too many attempts")
+	ErrInvalidClient       = errors.New("invalid client")
+	ErrInvalidScope        = errors.New("invalid scope")
+	ErrWeakPassword        = errors.New("password doesn't meet policy")
+	ErrSessionNotFound     = errors.New("session not found")
+	ErrUserDeactivated     = errors.New("user is deactivated")
+	ErrUserStatusConflict  = errors.New("user status doesn't allow the action")
+	ErrGDPRJobNotFound     = errors.New("gdpr job not found")
+	ErrInvalidInviteToken  = errors.New("invalid invite token")
+	ErrTelegramNotLinked   = errors.New("telegram is not linked")
 
 	ErrInternal = errors.New("internal error")
 )
@@ -42,6 +58,9 @@ func (c *Client) RegisterUser(ctx context.Context, newUser *ssomodels.RegisterUs
 		case codes.InvalidArgument:
 			c.log.Error("invalid arguments", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case codes.FailedPrecondition:
+			c.log.Error("weak password", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrWeakPassword)
 		default:
 			c.log.Error("internal error", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
@@ -71,6 +90,15 @@ func (c *Client) LoginUser(ctx context.Context, logUser *ssomodels.LogIn) (*ssom
 		case codes.InvalidArgument:
 			c.log.Error("invalid credentials", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case codes.FailedPrecondition:
+			c.log.Error("email is not verified", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrEmailNotVerified)
+		case codes.ResourceExhausted:
+			c.log.Error("too many attempts", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTooManyAttempts)
+		case codes.PermissionDenied:
+			c.log.Error("user is deactivated", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserDeactivated)
 		default:
 			c.log.Error("internal error", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
@@ -80,6 +108,8 @@ func (c *Client) LoginUser(ctx context.Context, logUser *ssomodels.LogIn) (*ssom
 	return &ssomodels.LogInResp{
 		AccessToken:  resp.AccessToken,
 		RefreshToken: resp.RefreshToken,
+		MFARequired:  resp.MfaRequired,
+		MFAToken:     resp.MfaToken,
 	}, nil
 }
 
@@ -97,6 +127,12 @@ func (c *Client) LogInViaTg(ctx context.Context, email *ssomodels.LogInViaTg) (*
 		case codes.NotFound:
 			c.log.Error("user not found", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case codes.FailedPrecondition:
+			c.log.Error("email is not verified", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrEmailNotVerified)
+		case codes.PermissionDenied:
+			c.log.Error("user is deactivated", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserDeactivated)
 		default:
 			c.log.Error("internal error", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
@@ -124,6 +160,15 @@ func (c *Client) CheckOTPAndLogIn(ctx context.Context, otp *ssomodels.CheckOTPAn
 		case codes.NotFound:
 			c.log.Error("user not found", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case codes.FailedPrecondition:
+			c.log.Error("email is not verified", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrEmailNotVerified)
+		case codes.ResourceExhausted:
+			c.log.Error("too many attempts", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTooManyAttempts)
+		case codes.PermissionDenied:
+			c.log.Error("user is deactivated", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserDeactivated)
 		default:
 			c.log.Error("internal error", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
@@ -133,6 +178,130 @@ func (c *Client) CheckOTPAndLogIn(ctx context.Context, otp *ssomodels.CheckOTPAn
 	return &ssomodels.CheckOTPAndLogInResp{
 		AccessToken:  resp.AccessToken,
 		RefreshToken: resp.RefreshToken,
+		MFARequired:  resp.MfaRequired,
+		MFAToken:     resp.MfaToken,
+	}, nil
+}
+
+func (c *Client) VerifyEmail(ctx context.Context, verify *ssomodels.VerifyEmail) (*ssomodels.VerifyEmailResp, error) {
+	const op = "sso.grpc_auth.VerifyEmail"
+
+	resp, err := c.api.VerifyEmail(ctx, &ssov1.VerifyEmailRequest{
+		Token: verify.Token,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid verification token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidVerifyToken)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.VerifyEmailResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (c *Client) AcceptInvite(ctx context.Context, accept *ssomodels.AcceptInvite) (*ssomodels.AcceptInviteResp, error) {
+	const op = "sso.grpc_auth.AcceptInvite"
+
+	resp, err := c.api.AcceptInvite(ctx, &ssov1.AcceptInviteRequest{
+		Token:    accept.Token,
+		Password: accept.Password,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid invite token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidInviteToken)
+		case codes.FailedPrecondition:
+			c.log.Error("weak password", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrWeakPassword)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.AcceptInviteResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (c *Client) ResendVerification(ctx context.Context, resend *ssomodels.ResendVerification) (*ssomodels.ResendVerificationResp, error) {
+	const op = "sso.grpc_auth.ResendVerification"
+
+	resp, err := c.api.ResendVerification(ctx, &ssov1.ResendVerificationRequest{
+		Email: resend.Email,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid email", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.ResendVerificationResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (c *Client) RequestPasswordReset(ctx context.Context, reset *ssomodels.RequestPasswordReset) (*ssomodels.RequestPasswordResetResp, error) {
+	const op = "sso.grpc_auth.RequestPasswordReset"
+
+	resp, err := c.api.RequestPasswordReset(ctx, &ssov1.RequestPasswordResetRequest{
+		Email: reset.Email,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid email", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.RequestPasswordResetResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (c *Client) ConfirmPasswordReset(ctx context.Context, reset *ssomodels.ConfirmPasswordReset) (*ssomodels.ConfirmPasswordResetResp, error) {
+	const op = "sso.grpc_auth.ConfirmPasswordReset"
+
+	resp, err := c.api.ConfirmPasswordReset(ctx, &ssov1.ConfirmPasswordResetRequest{
+		Email:       reset.Email,
+		Code:        reset.Code,
+		NewPassword: reset.NewPassword,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid credentials", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case codes.FailedPrecondition:
+			c.log.Error("weak password", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrWeakPassword)
+		case codes.ResourceExhausted:
+			c.log.Error("too many attempts", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTooManyAttempts)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.ConfirmPasswordResetResp{
+		Success: resp.Success,
 	}, nil
 }
 
@@ -140,11 +309,10 @@ func (c *Client) UpdateUserInfo(ctx context.Context, newInfo *ssomodels.UpdateUs
 	const op = "sso.grpc_auth.UpdateUserInfo"
 
 	resp, err := c.api.UpdateUserInfo(ctx, &ssov1.UpdateUserInfoRequest{
-		Id:      newInfo.ID,
-		Email:   newInfo.Email,
-		Name:    newInfo.Name,
-		TgLink:  newInfo.TgLink,
-		IsAdmin: newInfo.IsAdmin,
+		Id:     newInfo.ID,
+		Email:  newInfo.Email,
+		Name:   newInfo.Name,
+		TgLink: newInfo.TgLink,
 	})
 	if err != nil {
 		switch status.Code(err) {
@@ -165,11 +333,16 @@ func (c *Client) UpdateUserInfo(ctx context.Context, newInfo *ssomodels.UpdateUs
 func (c *Client) RefreshToken(ctx context.Context, refToken *ssomodels.RefreshToken) (*ssomodels.RefreshTokenResp, error) {
 	const op = "sso.grpc_auth.RefreshToken"
 
+	// Refresh tokens are single use: a retried call would present an already
+	// rotated token and trigger reuse detection for the whole family.
 	resp, err := c.api.RefreshToken(ctx, &ssov1.RefreshTokenRequest{
 		RefreshToken: refToken.RefreshToken,
-	})
+	}, grpcretry.Disable())
 	if err != nil {
 		switch status.Code(err) {
+		case codes.Unauthenticated:
+			c.log.Error("invalid refresh token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
 		case codes.InvalidArgument:
 			c.log.Error("invalid credentials", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
@@ -180,7 +353,64 @@ func (c *Client) RefreshToken(ctx context.Context, refToken *ssomodels.RefreshTo
 	}
 
 	return &ssomodels.RefreshTokenResp{
-		AccessToken: resp.AccessToken,
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+	}, nil
+}
+
+func (c *Client) Logout(ctx context.Context, logout *ssomodels.Logout) (*ssomodels.LogoutResp, error) {
+	const op = "sso.grpc_auth.Logout"
+
+	resp, err := c.api.Logout(ctx, &ssov1.LogoutRequest{
+		AccessToken:  logout.AccessToken,
+		RefreshToken: logout.RefreshToken,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+			c.log.Error("invalid access token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+		case codes.InvalidArgument:
+			c.log.Error("invalid refresh token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.LogoutResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (c *Client) GetUserInfo(ctx context.Context, user *ssomodels.GetUserInfo) (*ssomodels.GetUserInfoResp, error) {
+	const op = "sso.grpc_auth.GetUserInfo"
+
+	resp, err := c.api.GetUserInfo(ctx, &ssov1.GetUserInfoRequest{
+		UserId: user.UserID,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid credentials", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case codes.NotFound:
+			c.log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.GetUserInfoResp{
+		ID:       resp.Id,
+		Email:    resp.Email,
+		Name:     resp.Name,
+		TgLink:   resp.TgLink,
+		TgLinked: resp.TgLinked,
+		IsAdmin:  resp.IsAdmin,
 	}, nil
 }
 
@@ -224,7 +454,10 @@ func (c *Client) AuthCheck(ctx context.Context, authCheck *ssomodels.AuthCheck)
 	}
 
 	return &ssomodels.AuthCheckResp{
-		IsValid: resp.IsValid,
-		UserID:  resp.UserId,
+		IsValid:  resp.IsValid,
+		UserID:   resp.UserId,
+		ClientID: resp.ClientId,
+		Scopes:   resp.Scopes,
+		ActorID:  resp.ActorId,
 	}, nil
 }
@@ -0,0 +1,80 @@
+package ssogrpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *Client) RequestUserExport(ctx context.Context, req *ssomodels.ManageUser) (*ssomodels.GDPRJobResp, error) {
+	const op = "sso.grpc_gdpr.RequestUserExport"
+
+	resp, err := c.api.RequestUserExport(ctx, &ssov1.RequestUserExportRequest{
+		AdminId: req.AdminID,
+		UserId:  req.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.manageUserError(err))
+	}
+
+	return &ssomodels.GDPRJobResp{
+		JobID: resp.JobId,
+	}, nil
+}
+
+func (c *Client) RequestUserErasure(ctx context.Context, req *ssomodels.ManageUser) (*ssomodels.GDPRJobResp, error) {
+	const op = "sso.grpc_gdpr.RequestUserErasure"
+
+	resp, err := c.api.RequestUserErasure(ctx, &ssov1.RequestUserErasureRequest{
+		AdminId: req.AdminID,
+		UserId:  req.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.manageUserError(err))
+	}
+
+	return &ssomodels.GDPRJobResp{
+		JobID: resp.JobId,
+	}, nil
+}
+
+func (c *Client) GetGDPRJob(ctx context.Context, get *ssomodels.GetGDPRJob) (*ssomodels.GDPRJob, error) {
+	const op = "sso.grpc_gdpr.GetGDPRJob"
+
+	resp, err := c.api.GetGDPRJob(ctx, &ssov1.GetGDPRJobRequest{
+		AdminId: get.AdminID,
+		JobId:   get.JobID,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid credentials", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case codes.NotFound:
+			c.log.Error("gdpr job not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrGDPRJobNotFound)
+		case codes.PermissionDenied:
+			c.log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.GDPRJob{
+		JobID:   resp.JobId,
+		Type:    resp.Type,
+		UserID:  resp.UserId,
+		Status:  resp.Status,
+		Error:   resp.Error,
+		Archive: resp.Archive,
+		Created: resp.Created,
+		Updated: resp.Updated,
+	}, nil
+}
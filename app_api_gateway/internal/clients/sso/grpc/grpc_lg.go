@@ -17,6 +17,7 @@ var (
 	ErrGroupExists      = errors.New("group already exists")
 	ErrGroupNotFound    = errors.New("group not found")
 	ErrPermissionDenied = errors.New("you don't have permissions")
+	ErrVersionConflict  = errors.New("group was changed by another request")
 )
 
 func (c *Client) CreateLearningGroup(ctx context.Context, newLGroup *ssomodels.CreateLearningGroup) (*ssomodels.CreateLearningGroupResp, error) {
@@ -24,6 +25,7 @@ func (c *Client) CreateLearningGroup(ctx context.Context, newLGroup *ssomodels.C
 
 	resp, err := c.api.CreateLearningGroup(ctx, &ssov1.CreateLearningGroupRequest{
 		Name:        newLGroup.Name,
+		ParentId:    newLGroup.ParentID,
 		CreatedBy:   newLGroup.CreatedBy,
 		ModifiedBy:  newLGroup.ModifiedBy,
 		GroupAdmins: newLGroup.GroupAdmins,
@@ -37,6 +39,9 @@ func (c *Client) CreateLearningGroup(ctx context.Context, newLGroup *ssomodels.C
 		case codes.AlreadyExists:
 			c.log.Error("group alredy exists", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrGroupExists)
+		case codes.PermissionDenied:
+			c.log.Error("permissions denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
 		default:
 			c.log.Error("internal error", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
@@ -72,10 +77,12 @@ func (c *Client) GetLearningGroupByID(ctx context.Context, lgID *ssomodels.GetLg
 	lgResp := &ssomodels.GetLgByIDResp{
 		Id:          resp.Id,
 		Name:        resp.Name,
+		ParentID:    resp.ParentId,
 		CreatedBy:   resp.CreatedBy,
 		ModifiedBy:  resp.ModifiedBy,
 		GroupAdmins: make([]*ssomodels.GroupAdmins, len(resp.GroupAdmins)),
 		Learners:    make([]*ssomodels.Learner, len(resp.Learners)),
+		Version:     resp.Version,
 	}
 
 	for i, ga := range resp.GroupAdmins {
@@ -107,6 +114,7 @@ func (c *Client) UpdateLearningGroup(ctx context.Context, updFields *ssomodels.U
 		ModifiedBy:      updFields.ModifiedBy,
 		GroupAdmins:     updFields.GroupAdmins,
 		Learners:        updFields.Learners,
+		Version:         updFields.Version,
 	})
 	if err != nil {
 		switch status.Code(err) {
@@ -116,6 +124,9 @@ func (c *Client) UpdateLearningGroup(ctx context.Context, updFields *ssomodels.U
 		case codes.NotFound:
 			c.log.Error("group not found", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrGroupNotFound)
+		case codes.FailedPrecondition:
+			c.log.Error("group version conflict", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrVersionConflict)
 		case codes.InvalidArgument:
 			c.log.Error("invalid credentials", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
@@ -177,6 +188,7 @@ func (c *Client) GetLearningGroups(ctx context.Context, uID *ssomodels.GetLGroup
 		resp.LearningGroups[i] = &ssomodels.LearningGroup{
 			Id:         g.Id,
 			Name:       g.Name,
+			ParentID:   g.ParentId,
 			CreatedBy:  g.CreatedBy,
 			ModifiedBy: g.ModifiedBy,
 			Created:    g.Created,
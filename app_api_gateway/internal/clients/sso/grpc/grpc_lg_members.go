@@ -0,0 +1,72 @@
+package ssogrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrLastGroupAdmin = errors.New("group must keep a group admin")
+
+func (c *Client) AddMembers(ctx context.Context, change *ssomodels.ChangeLgMembers) (*ssomodels.ChangeLgMembersResp, error) {
+	const op = "sso.grpc_lg_members.AddMembers"
+
+	resp, err := c.api.AddMembers(ctx, &ssov1.AddMembersRequest{
+		UserId:          change.UserID,
+		LearningGroupId: change.LgID,
+		Role:            change.Role,
+		UserIds:         change.UserIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.lgMembersError(err))
+	}
+
+	return &ssomodels.ChangeLgMembersResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (c *Client) RemoveMembers(ctx context.Context, change *ssomodels.ChangeLgMembers) (*ssomodels.ChangeLgMembersResp, error) {
+	const op = "sso.grpc_lg_members.RemoveMembers"
+
+	resp, err := c.api.RemoveMembers(ctx, &ssov1.RemoveMembersRequest{
+		UserId:          change.UserID,
+		LearningGroupId: change.LgID,
+		Role:            change.Role,
+		UserIds:         change.UserIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.lgMembersError(err))
+	}
+
+	return &ssomodels.ChangeLgMembersResp{
+		Success: resp.Success,
+	}, nil
+}
+
+// lgMembersError maps grpc status of members calls to client errors.
+func (c *Client) lgMembersError(err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.log.Error("invalid credentials", slog.String("err", err.Error()))
+		return ErrInvalidCredentials
+	case codes.PermissionDenied:
+		c.log.Error("permission denied", slog.String("err", err.Error()))
+		return ErrPermissionDenied
+	case codes.NotFound:
+		c.log.Error("group not found", slog.String("err", err.Error()))
+		return ErrGroupNotFound
+	case codes.FailedPrecondition:
+		c.log.Error("group must keep a group admin", slog.String("err", err.Error()))
+		return ErrLastGroupAdmin
+	default:
+		c.log.Error("internal error", slog.String("err", err.Error()))
+		return ErrInternal
+	}
+}
@@ -0,0 +1,186 @@
+package ssogrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrRoleNotFound = errors.New("role not found")
+	ErrRoleExists   = errors.New("role already exists")
+)
+
+func (c *Client) CreateLgRole(ctx context.Context, create *ssomodels.CreateLgRole) (*ssomodels.LgRole, error) {
+	const op = "sso.grpc_lg_roles.CreateLgRole"
+
+	resp, err := c.api.CreateLgRole(ctx, &ssov1.CreateLgRoleRequest{
+		UserId:          create.UserID,
+		LearningGroupId: create.LgID,
+		Name:            create.Name,
+		Permissions:     create.Permissions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.lgRoleError(err))
+	}
+
+	return lgRoleFromProto(resp.Role), nil
+}
+
+func (c *Client) ListLgRoles(ctx context.Context, list *ssomodels.ListLgRoles) (*ssomodels.ListLgRolesResp, error) {
+	const op = "sso.grpc_lg_roles.ListLgRoles"
+
+	resp, err := c.api.ListLgRoles(ctx, &ssov1.ListLgRolesRequest{
+		UserId:          list.UserID,
+		LearningGroupId: list.LgID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.lgRoleError(err))
+	}
+
+	roles := make([]*ssomodels.LgRole, 0, len(resp.Roles))
+	for _, role := range resp.Roles {
+		roles = append(roles, lgRoleFromProto(role))
+	}
+
+	return &ssomodels.ListLgRolesResp{
+		Roles: roles,
+	}, nil
+}
+
+func (c *Client) UpdateLgRole(ctx context.Context, upd *ssomodels.UpdateLgRole) (*ssomodels.LgRoleResp, error) {
+	const op = "sso.grpc_lg_roles.UpdateLgRole"
+
+	resp, err := c.api.UpdateLgRole(ctx, &ssov1.UpdateLgRoleRequest{
+		UserId:          upd.UserID,
+		LearningGroupId: upd.LgID,
+		RoleId:          upd.RoleID,
+		Name:            upd.Name,
+		Permissions:     upd.Permissions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.lgRoleError(err))
+	}
+
+	return &ssomodels.LgRoleResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (c *Client) DeleteLgRole(ctx context.Context, del *ssomodels.DeleteLgRole) (*ssomodels.LgRoleResp, error) {
+	const op = "sso.grpc_lg_roles.DeleteLgRole"
+
+	resp, err := c.api.DeleteLgRole(ctx, &ssov1.DeleteLgRoleRequest{
+		UserId:          del.UserID,
+		LearningGroupId: del.LgID,
+		RoleId:          del.RoleID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.lgRoleError(err))
+	}
+
+	return &ssomodels.LgRoleResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (c *Client) AssignLgRole(ctx context.Context, change *ssomodels.ChangeLgRoleMembers) (*ssomodels.LgRoleResp, error) {
+	const op = "sso.grpc_lg_roles.AssignLgRole"
+
+	resp, err := c.api.AssignLgRole(ctx, &ssov1.AssignLgRoleRequest{
+		UserId:          change.UserID,
+		LearningGroupId: change.LgID,
+		RoleId:          change.RoleID,
+		UserIds:         change.UserIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.lgRoleError(err))
+	}
+
+	return &ssomodels.LgRoleResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (c *Client) UnassignLgRole(ctx context.Context, change *ssomodels.ChangeLgRoleMembers) (*ssomodels.LgRoleResp, error) {
+	const op = "sso.grpc_lg_roles.UnassignLgRole"
+
+	resp, err := c.api.UnassignLgRole(ctx, &ssov1.UnassignLgRoleRequest{
+		UserId:          change.UserID,
+		LearningGroupId: change.LgID,
+		RoleId:          change.RoleID,
+		UserIds:         change.UserIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.lgRoleError(err))
+	}
+
+	return &ssomodels.LgRoleResp{
+		Success: resp.Success,
+	}, nil
+}
+
+// GetUserPermissions returns permissions of the user in learning groups
+// granted by built-in and custom roles.
+func (c *Client) GetUserPermissions(ctx context.Context, user *ssomodels.GetUserPermissions) (*ssomodels.GetUserPermissionsResp, error) {
+	const op = "sso.grpc_lg_roles.GetUserPermissions"
+
+	resp, err := c.api.GetUserPermissions(ctx, &ssov1.GetUserPermissionsRequest{
+		UserId: user.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.lgRoleError(err))
+	}
+
+	groups := make([]*ssomodels.LgPermissions, 0, len(resp.Groups))
+	for _, group := range resp.Groups {
+		groups = append(groups, &ssomodels.LgPermissions{
+			LgID:        group.LearningGroupId,
+			Permissions: group.Permissions,
+		})
+	}
+
+	return &ssomodels.GetUserPermissionsResp{
+		Groups: groups,
+	}, nil
+}
+
+func lgRoleFromProto(role *ssov1.LgRole) *ssomodels.LgRole {
+	return &ssomodels.LgRole{
+		ID:          role.Id,
+		LgID:        role.LearningGroupId,
+		Name:        role.Name,
+		Permissions: role.Permissions,
+		Members:     role.Members,
+		CreatedBy:   role.CreatedBy,
+		Created:     role.Created,
+		Updated:     role.Updated,
+	}
+}
+
+// lgRoleError maps grpc status of roles calls to client errors.
+func (c *Client) lgRoleError(err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.log.Error("invalid credentials", slog.String("err", err.Error()))
+		return ErrInvalidCredentials
+	case codes.PermissionDenied:
+		c.log.Error("permission denied", slog.String("err", err.Error()))
+		return ErrPermissionDenied
+	case codes.NotFound:
+		c.log.Error("role not found", slog.String("err", err.Error()))
+		return ErrRoleNotFound
+	case codes.AlreadyExists:
+		c.log.Error("role already exists", slog.String("err", err.Error()))
+		return ErrRoleExists
+	default:
+		c.log.Error("internal error", slog.String("err", err.Error()))
+		return ErrInternal
+	}
+}
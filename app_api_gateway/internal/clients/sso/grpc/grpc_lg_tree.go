@@ -0,0 +1,87 @@
+package ssogrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrGroupCycle = errors.New("group can't be moved into its own subtree")
+
+func (c *Client) GetLearningGroupTree(ctx context.Context, get *ssomodels.GetLgTree) (*ssomodels.LgTreeNode, error) {
+	const op = "sso.grpc_lg_tree.GetLearningGroupTree"
+
+	resp, err := c.api.GetLearningGroupTree(ctx, &ssov1.GetLearningGroupTreeRequest{
+		UserId:          get.UserID,
+		LearningGroupId: get.LgID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.lgTreeError(err))
+	}
+
+	return lgTreeNodeFromProto(resp.Root), nil
+}
+
+func (c *Client) MoveLearningGroup(ctx context.Context, move *ssomodels.MoveLearningGroup) (*ssomodels.MoveLearningGroupResp, error) {
+	const op = "sso.grpc_lg_tree.MoveLearningGroup"
+
+	resp, err := c.api.MoveLearningGroup(ctx, &ssov1.MoveLearningGroupRequest{
+		UserId:          move.UserID,
+		LearningGroupId: move.LgID,
+		ParentId:        move.ParentID,
+		Version:         move.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.lgTreeError(err))
+	}
+
+	return &ssomodels.MoveLearningGroupResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func lgTreeNodeFromProto(node *ssov1.LearningGroupNode) *ssomodels.LgTreeNode {
+	children := make([]*ssomodels.LgTreeNode, len(node.Children))
+	for i, child := range node.Children {
+		children[i] = lgTreeNodeFromProto(child)
+	}
+
+	return &ssomodels.LgTreeNode{
+		Id:       node.Id,
+		Name:     node.Name,
+		ParentID: node.ParentId,
+		Children: children,
+	}
+}
+
+// lgTreeError maps grpc status of tree calls to client errors.
+// Cycle comes as failed precondition with its own message.
+func (c *Client) lgTreeError(err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.log.Error("invalid credentials", slog.String("err", err.Error()))
+		return ErrInvalidCredentials
+	case codes.PermissionDenied:
+		c.log.Error("permission denied", slog.String("err", err.Error()))
+		return ErrPermissionDenied
+	case codes.NotFound:
+		c.log.Error("group not found", slog.String("err", err.Error()))
+		return ErrGroupNotFound
+	case codes.FailedPrecondition:
+		if status.Convert(err).Message() == "learning group can't be moved into its own subtree" {
+			c.log.Error("group can't be moved into its own subtree", slog.String("err", err.Error()))
+			return ErrGroupCycle
+		}
+		c.log.Error("group version conflict", slog.String("err", err.Error()))
+		return ErrVersionConflict
+	default:
+		c.log.Error("internal error", slog.String("err", err.Error()))
+		return ErrInternal
+	}
+}
@@ -0,0 +1,276 @@
+package ssogrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrMemberNotFound = errors.New("member not found")
+
+func (c *Client) ScimListUsers(ctx context.Context, list *ssomodels.ScimListUsers) (*ssomodels.ScimListUsersResp, error) {
+	const op = "sso.grpc_scim.ScimListUsers"
+
+	resp, err := c.api.ScimListUsers(ctx, &ssov1.ScimListUsersRequest{
+		ClientId:   list.ClientID,
+		Email:      list.Email,
+		Active:     list.Active,
+		StartIndex: list.StartIndex,
+		Count:      list.Count,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.scimUserError(err))
+	}
+
+	users := make([]*ssomodels.ScimUser, 0, len(resp.Users))
+	for _, user := range resp.Users {
+		users = append(users, scimUserFromProto(user))
+	}
+
+	return &ssomodels.ScimListUsersResp{
+		Users: users,
+		Total: resp.Total,
+	}, nil
+}
+
+func (c *Client) ScimGetUser(ctx context.Context, get *ssomodels.ScimManageUser) (*ssomodels.ScimUser, error) {
+	const op = "sso.grpc_scim.ScimGetUser"
+
+	resp, err := c.api.ScimGetUser(ctx, &ssov1.ScimGetUserRequest{
+		ClientId: get.ClientID,
+		UserId:   get.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.scimUserError(err))
+	}
+
+	return scimUserFromProto(resp.User), nil
+}
+
+func (c *Client) ScimCreateUser(ctx context.Context, create *ssomodels.ScimCreateUser) (*ssomodels.ScimUser, error) {
+	const op = "sso.grpc_scim.ScimCreateUser"
+
+	// Retry could send the invite twice
+	resp, err := c.api.ScimCreateUser(ctx, &ssov1.ScimCreateUserRequest{
+		ClientId: create.ClientID,
+		Email:    create.Email,
+		Name:     create.Name,
+		Active:   create.Active,
+	}, grpcretry.Disable())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.scimUserError(err))
+	}
+
+	return scimUserFromProto(resp.User), nil
+}
+
+func (c *Client) ScimUpdateUser(ctx context.Context, upd *ssomodels.ScimUpdateUser) (*ssomodels.ScimUser, error) {
+	const op = "sso.grpc_scim.ScimUpdateUser"
+
+	resp, err := c.api.ScimUpdateUser(ctx, &ssov1.ScimUpdateUserRequest{
+		ClientId: upd.ClientID,
+		UserId:   upd.UserID,
+		Email:    upd.Email,
+		Name:     upd.Name,
+		Active:   upd.Active,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.scimUserError(err))
+	}
+
+	return scimUserFromProto(resp.User), nil
+}
+
+func (c *Client) ScimDeleteUser(ctx context.Context, del *ssomodels.ScimManageUser) (*ssomodels.ScimResp, error) {
+	const op = "sso.grpc_scim.ScimDeleteUser"
+
+	resp, err := c.api.ScimDeleteUser(ctx, &ssov1.ScimDeleteUserRequest{
+		ClientId: del.ClientID,
+		UserId:   del.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.scimUserError(err))
+	}
+
+	return &ssomodels.ScimResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (c *Client) ScimListGroups(ctx context.Context, list *ssomodels.ScimListGroups) (*ssomodels.ScimListGroupsResp, error) {
+	const op = "sso.grpc_scim.ScimListGroups"
+
+	resp, err := c.api.ScimListGroups(ctx, &ssov1.ScimListGroupsRequest{
+		ClientId:    list.ClientID,
+		DisplayName: list.DisplayName,
+		StartIndex:  list.StartIndex,
+		Count:       list.Count,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.scimGroupError(err))
+	}
+
+	groups := make([]*ssomodels.ScimGroup, 0, len(resp.Groups))
+	for _, group := range resp.Groups {
+		groups = append(groups, scimGroupFromProto(group))
+	}
+
+	return &ssomodels.ScimListGroupsResp{
+		Groups: groups,
+		Total:  resp.Total,
+	}, nil
+}
+
+func (c *Client) ScimGetGroup(ctx context.Context, get *ssomodels.ScimManageGroup) (*ssomodels.ScimGroup, error) {
+	const op = "sso.grpc_scim.ScimGetGroup"
+
+	resp, err := c.api.ScimGetGroup(ctx, &ssov1.ScimGetGroupRequest{
+		ClientId: get.ClientID,
+		GroupId:  get.GroupID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.scimGroupError(err))
+	}
+
+	return scimGroupFromProto(resp.Group), nil
+}
+
+func (c *Client) ScimCreateGroup(ctx context.Context, create *ssomodels.ScimCreateGroup) (*ssomodels.ScimGroup, error) {
+	const op = "sso.grpc_scim.ScimCreateGroup"
+
+	// Retry could create the group twice
+	resp, err := c.api.ScimCreateGroup(ctx, &ssov1.ScimCreateGroupRequest{
+		ClientId:    create.ClientID,
+		DisplayName: create.DisplayName,
+		Members:     create.Members,
+	}, grpcretry.Disable())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.scimGroupError(err))
+	}
+
+	return scimGroupFromProto(resp.Group), nil
+}
+
+func (c *Client) ScimUpdateGroup(ctx context.Context, upd *ssomodels.ScimUpdateGroup) (*ssomodels.ScimGroup, error) {
+	const op = "sso.grpc_scim.ScimUpdateGroup"
+
+	resp, err := c.api.ScimUpdateGroup(ctx, &ssov1.ScimUpdateGroupRequest{
+		ClientId:    upd.ClientID,
+		GroupId:     upd.GroupID,
+		DisplayName: upd.DisplayName,
+		Members:     upd.Members,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.scimGroupError(err))
+	}
+
+	return scimGroupFromProto(resp.Group), nil
+}
+
+func (c *Client) ScimChangeGroupMembers(ctx context.Context, change *ssomodels.ScimChangeGroupMembers) (*ssomodels.ScimResp, error) {
+	const op = "sso.grpc_scim.ScimChangeGroupMembers"
+
+	resp, err := c.api.ScimChangeGroupMembers(ctx, &ssov1.ScimChangeGroupMembersRequest{
+		ClientId: change.ClientID,
+		GroupId:  change.GroupID,
+		Add:      change.Add,
+		Remove:   change.Remove,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.scimGroupError(err))
+	}
+
+	return &ssomodels.ScimResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (c *Client) ScimDeleteGroup(ctx context.Context, del *ssomodels.ScimManageGroup) (*ssomodels.ScimResp, error) {
+	const op = "sso.grpc_scim.ScimDeleteGroup"
+
+	resp, err := c.api.ScimDeleteGroup(ctx, &ssov1.ScimDeleteGroupRequest{
+		ClientId: del.ClientID,
+		GroupId:  del.GroupID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.scimGroupError(err))
+	}
+
+	return &ssomodels.ScimResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func scimUserFromProto(user *ssov1.ScimUser) *ssomodels.ScimUser {
+	return &ssomodels.ScimUser{
+		ID:      user.Id,
+		Email:   user.Email,
+		Name:    user.Name,
+		Status:  user.Status,
+		Active:  user.Active,
+		Created: user.Created,
+		Updated: user.Updated,
+	}
+}
+
+func scimGroupFromProto(group *ssov1.ScimGroup) *ssomodels.ScimGroup {
+	return &ssomodels.ScimGroup{
+		ID:          group.Id,
+		DisplayName: group.DisplayName,
+		Members:     group.Members,
+		Created:     group.Created,
+		Updated:     group.Updated,
+		Version:     group.Version,
+	}
+}
+
+// scimUserError maps grpc status of SCIM users calls to client errors.
+func (c *Client) scimUserError(err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.log.Error("invalid credentials", slog.String("err", err.Error()))
+		return ErrInvalidCredentials
+	case codes.NotFound:
+		c.log.Error("user not found", slog.String("err", err.Error()))
+		return ErrUserNotFound
+	case codes.AlreadyExists:
+		c.log.Error("user already exists", slog.String("err", err.Error()))
+		return ErrUserExists
+	case codes.PermissionDenied:
+		c.log.Error("permissions denied", slog.String("err", err.Error()))
+		return ErrPermissionDenied
+	default:
+		c.log.Error("internal error", slog.String("err", err.Error()))
+		return ErrInternal
+	}
+}
+
+// scimGroupError maps grpc status of SCIM groups calls to client errors.
+// Unknown member comes as invalid argument with its own message.
+func (c *Client) scimGroupError(err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		if status.Convert(err).Message() == "member not found" {
+			c.log.Error("member not found", slog.String("err", err.Error()))
+			return ErrMemberNotFound
+		}
+		c.log.Error("invalid credentials", slog.String("err", err.Error()))
+		return ErrInvalidCredentials
+	case codes.NotFound:
+		c.log.Error("group not found", slog.String("err", err.Error()))
+		return ErrGroupNotFound
+	case codes.AlreadyExists:
+		c.log.Error("group already exists", slog.String("err", err.Error()))
+		return ErrGroupExists
+	default:
+		c.log.Error("internal error", slog.String("err", err.Error()))
+		return ErrInternal
+	}
+}
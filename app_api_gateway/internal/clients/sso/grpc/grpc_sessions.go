@@ -0,0 +1,72 @@
+package ssogrpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *Client) ListSessions(ctx context.Context, list *ssomodels.ListSessions) (*ssomodels.ListSessionsResp, error) {
+	const op = "sso.grpc_sessions.ListSessions"
+
+	resp, err := c.api.ListSessions(ctx, &ssov1.ListSessionsRequest{
+		UserId: list.UserID,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid credentials", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	var sessions []*ssomodels.Session
+	for _, session := range resp.Sessions {
+		sessions = append(sessions, &ssomodels.Session{
+			ID:        session.Id,
+			UserAgent: session.UserAgent,
+			IP:        session.Ip,
+			Created:   session.Created,
+			LastUsed:  session.LastUsed,
+			ExpiresAt: session.ExpiresAt,
+		})
+	}
+
+	return &ssomodels.ListSessionsResp{
+		Sessions: sessions,
+	}, nil
+}
+
+func (c *Client) RevokeSession(ctx context.Context, revoke *ssomodels.RevokeSession) (*ssomodels.RevokeSessionResp, error) {
+	const op = "sso.grpc_sessions.RevokeSession"
+
+	resp, err := c.api.RevokeSession(ctx, &ssov1.RevokeSessionRequest{
+		UserId:    revoke.UserID,
+		SessionId: revoke.SessionID,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid credentials", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case codes.NotFound:
+			c.log.Error("session not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrSessionNotFound)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.RevokeSessionResp{
+		Success: resp.Success,
+	}, nil
+}
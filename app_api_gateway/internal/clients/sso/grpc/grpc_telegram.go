@@ -0,0 +1,65 @@
+package ssogrpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateTgLinkToken returns one-time token of telegram linking.
+// Deep link of the bot is built by the service.
+func (c *Client) CreateTgLinkToken(ctx context.Context, create *ssomodels.CreateTgLink) (*ssomodels.CreateTgLinkResp, error) {
+	const op = "sso.grpc_telegram.CreateTgLinkToken"
+
+	resp, err := c.api.CreateTgLinkToken(ctx, &ssov1.CreateTgLinkTokenRequest{
+		UserId: create.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.telegramError(err))
+	}
+
+	return &ssomodels.CreateTgLinkResp{
+		Token:     resp.Token,
+		ExpiresAt: resp.ExpiresAt,
+	}, nil
+}
+
+func (c *Client) UnlinkTelegram(ctx context.Context, unlink *ssomodels.UnlinkTelegram) (*ssomodels.UnlinkTelegramResp, error) {
+	const op = "sso.grpc_telegram.UnlinkTelegram"
+
+	resp, err := c.api.UnlinkTelegram(ctx, &ssov1.UnlinkTelegramRequest{
+		UserId: unlink.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.telegramError(err))
+	}
+
+	return &ssomodels.UnlinkTelegramResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (c *Client) telegramError(err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.log.Error("invalid credentials", slog.String("err", err.Error()))
+		return ErrInvalidCredentials
+	case codes.NotFound:
+		c.log.Error("user not found", slog.String("err", err.Error()))
+		return ErrUserNotFound
+	case codes.FailedPrecondition:
+		c.log.Error("telegram is not linked", slog.String("err", err.Error()))
+		return ErrTelegramNotLinked
+	case codes.PermissionDenied:
+		c.log.Error("user is deactivated", slog.String("err", err.Error()))
+		return ErrUserDeactivated
+	default:
+		c.log.Error("internal error", slog.String("err", err.Error()))
+		return ErrInternal
+	}
+}
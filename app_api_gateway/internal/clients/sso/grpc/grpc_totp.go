@@ -0,0 +1,138 @@
+package ssogrpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *Client) LoginMFA(ctx context.Context, login *ssomodels.LoginMFA) (*ssomodels.LoginMFAResp, error) {
+	const op = "sso.grpc_totp.LoginMFA"
+
+	// MFA token, TOTP step and recovery code are single use:
+	// a retried call would be refused after the first one succeeded.
+	resp, err := c.api.LoginMFA(ctx, &ssov1.LoginMFARequest{
+		MfaToken: login.MFAToken,
+		Code:     login.Code,
+	}, grpcretry.Disable())
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+			c.log.Error("invalid mfa token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidMFAToken)
+		case codes.InvalidArgument:
+			c.log.Error("invalid code", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case codes.ResourceExhausted:
+			c.log.Error("too many attempts", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTooManyAttempts)
+		case codes.PermissionDenied:
+			c.log.Error("user is deactivated", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserDeactivated)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.LoginMFAResp{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+	}, nil
+}
+
+func (c *Client) EnrollTOTP(ctx context.Context, enroll *ssomodels.EnrollTOTP) (*ssomodels.EnrollTOTPResp, error) {
+	const op = "sso.grpc_totp.EnrollTOTP"
+
+	resp, err := c.api.EnrollTOTP(ctx, &ssov1.EnrollTOTPRequest{
+		UserId: enroll.UserID,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid credentials", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case codes.NotFound:
+			c.log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case codes.AlreadyExists:
+			c.log.Error("totp is already enabled", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTOTPAlreadyEnabled)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.EnrollTOTPResp{
+		URI:    resp.OtpauthUri,
+		Secret: resp.Secret,
+	}, nil
+}
+
+func (c *Client) VerifyTOTP(ctx context.Context, verify *ssomodels.VerifyTOTP) (*ssomodels.VerifyTOTPResp, error) {
+	const op = "sso.grpc_totp.VerifyTOTP"
+
+	resp, err := c.api.VerifyTOTP(ctx, &ssov1.VerifyTOTPRequest{
+		UserId: verify.UserID,
+		Code:   verify.Code,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid code", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case codes.NotFound:
+			c.log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case codes.AlreadyExists:
+			c.log.Error("totp is already enabled", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTOTPAlreadyEnabled)
+		case codes.FailedPrecondition:
+			c.log.Error("totp is not enrolled", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTOTPNotEnabled)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.VerifyTOTPResp{
+		RecoveryCodes: resp.RecoveryCodes,
+	}, nil
+}
+
+func (c *Client) DisableTOTP(ctx context.Context, disable *ssomodels.DisableTOTP) (*ssomodels.DisableTOTPResp, error) {
+	const op = "sso.grpc_totp.DisableTOTP"
+
+	resp, err := c.api.DisableTOTP(ctx, &ssov1.DisableTOTPRequest{
+		UserId: disable.UserID,
+		Code:   disable.Code,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid code", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case codes.NotFound:
+			c.log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case codes.FailedPrecondition:
+			c.log.Error("totp is not enabled", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTOTPNotEnabled)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	return &ssomodels.DisableTOTPResp{
+		Success: resp.Success,
+	}, nil
+}
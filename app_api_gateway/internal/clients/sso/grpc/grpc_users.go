@@ -0,0 +1,191 @@
+package ssogrpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *Client) ListUsers(ctx context.Context, list *ssomodels.ListUsers) (*ssomodels.ListUsersResp, error) {
+	const op = "sso.grpc_users.ListUsers"
+
+	resp, err := c.api.ListUsers(ctx, &ssov1.ListUsersRequest{
+		AdminId: list.AdminID,
+		Query:   list.Query,
+		Status:  list.Status,
+		Cursor:  list.Cursor,
+		Limit:   list.Limit,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.log.Error("invalid credentials", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case codes.NotFound:
+			c.log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case codes.PermissionDenied:
+			c.log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		default:
+			c.log.Error("internal error", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+
+	var users []*ssomodels.UserSummary
+	for _, user := range resp.Users {
+		users = append(users, &ssomodels.UserSummary{
+			ID:      user.Id,
+			Email:   user.Email,
+			Name:    user.Name,
+			TgLink:  user.TgLink,
+			IsAdmin: user.IsAdmin,
+			Status:  user.Status,
+			Created: user.Created,
+		})
+	}
+
+	return &ssomodels.ListUsersResp{
+		Users:      users,
+		NextCursor: resp.NextCursor,
+	}, nil
+}
+
+func (c *Client) SetUserAdmin(ctx context.Context, set *ssomodels.SetUserAdmin) (*ssomodels.SetUserAdminResp, error) {
+	const op = "sso.grpc_users.SetUserAdmin"
+
+	resp, err := c.api.SetUserAdmin(ctx, &ssov1.SetUserAdminRequest{
+		AdminId: set.AdminID,
+		UserId:  set.UserID,
+		IsAdmin: set.IsAdmin,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.manageUserError(err))
+	}
+
+	return &ssomodels.SetUserAdminResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (c *Client) DeactivateUser(ctx context.Context, manage *ssomodels.ManageUser) (*ssomodels.ManageUserResp, error) {
+	const op = "sso.grpc_users.DeactivateUser"
+
+	resp, err := c.api.DeactivateUser(ctx, &ssov1.DeactivateUserRequest{
+		AdminId: manage.AdminID,
+		UserId:  manage.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.manageUserError(err))
+	}
+
+	return &ssomodels.ManageUserResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (c *Client) ReactivateUser(ctx context.Context, manage *ssomodels.ManageUser) (*ssomodels.ManageUserResp, error) {
+	const op = "sso.grpc_users.ReactivateUser"
+
+	resp, err := c.api.ReactivateUser(ctx, &ssov1.ReactivateUserRequest{
+		AdminId: manage.AdminID,
+		UserId:  manage.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.manageUserError(err))
+	}
+
+	return &ssomodels.ManageUserResp{
+		Success: resp.Success,
+	}, nil
+}
+
+// ImportUsers isn't retried: retry could send invites twice, and import
+// of the big file can take longer than the timeout of one call.
+func (c *Client) ImportUsers(ctx context.Context, imp *ssomodels.ImportUsers) (*ssomodels.ImportUsersResp, error) {
+	const op = "sso.grpc_users.ImportUsers"
+
+	rows := make([]*ssov1.ImportUserRow, 0, len(imp.Rows))
+	for _, row := range imp.Rows {
+		rows = append(rows, &ssov1.ImportUserRow{
+			Row:    row.Row,
+			Email:  row.Email,
+			Name:   row.Name,
+			TgLink: row.TgLink,
+		})
+	}
+
+	resp, err := c.api.ImportUsers(ctx, &ssov1.ImportUsersRequest{
+		AdminId:           imp.AdminID,
+		Rows:              rows,
+		LearningGroupId:   imp.LearningGroupID,
+		LearningGroupName: imp.LearningGroupName,
+	}, grpcretry.Disable())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.manageUserError(err))
+	}
+
+	results := make([]*ssomodels.ImportUserResult, 0, len(resp.Results))
+	for _, res := range resp.Results {
+		results = append(results, &ssomodels.ImportUserResult{
+			Row:    res.Row,
+			Email:  res.Email,
+			Status: res.Status,
+			UserID: res.UserId,
+			Error:  res.Error,
+		})
+	}
+
+	return &ssomodels.ImportUsersResp{
+		Results:            results,
+		LearningGroupError: resp.LearningGroupError,
+	}, nil
+}
+
+// ImpersonateUser returns short-lived access token of the user
+// for the admin who impersonates them.
+func (c *Client) ImpersonateUser(ctx context.Context, imp *ssomodels.ImpersonateUser) (*ssomodels.ImpersonateUserResp, error) {
+	const op = "sso.grpc_users.ImpersonateUser"
+
+	resp, err := c.api.ImpersonateUser(ctx, &ssov1.ImpersonateUserRequest{
+		AdminId: imp.AdminID,
+		UserId:  imp.UserID,
+		Reason:  imp.Reason,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, c.manageUserError(err))
+	}
+
+	return &ssomodels.ImpersonateUserResp{
+		AccessToken: resp.AccessToken,
+		ExpiresAt:   resp.ExpiresAt,
+	}, nil
+}
+
+// manageUserError maps status of admin actions on user accounts.
+func (c *Client) manageUserError(err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.log.Error("invalid credentials", slog.String("err", err.Error()))
+		return ErrInvalidCredentials
+	case codes.NotFound:
+		c.log.Error("user not found", slog.String("err", err.Error()))
+		return ErrUserNotFound
+	case codes.PermissionDenied:
+		c.log.Error("permission denied", slog.String("err", err.Error()))
+		return ErrPermissionDenied
+	case codes.FailedPrecondition:
+		c.log.Error("user status conflict", slog.String("err", err.Error()))
+		return ErrUserStatusConflict
+	default:
+		c.log.Error("internal error", slog.String("err", err.Error()))
+		return ErrInternal
+	}
+}
@@ -6,12 +6,14 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/DimTur/lp_api_gateway/internal/lib/clientinfo"
 	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
 	grpclog "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 type Client struct {
@@ -43,6 +45,7 @@ func New(
 		addr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithChainUnaryInterceptor(
+			clientInfoInterceptor,
 			grpclog.UnaryClientInterceptor(InterceptorLogger(log), logOpts...),
 			grpcretry.UnaryClientInterceptor(retryOpts...),
 		),
@@ -59,8 +62,36 @@ func New(
 
 // InterceptorLogger adapts slog logger to iterceptor logger.
 // This code is simple enough to be copied and not imported.
+// Calls made during impersonation are logged with the admin id.
 func InterceptorLogger(l *slog.Logger) grpclog.Logger {
 	return grpclog.LoggerFunc(func(ctx context.Context, lvl grpclog.Level, msg string, fields ...any) {
+		if info, ok := clientinfo.FromContext(ctx); ok && info.ActorID != "" {
+			fields = append(fields, "actor_id", info.ActorID)
+		}
 		l.Log(ctx, slog.Level(lvl), msg, fields...)
 	})
 }
+
+// clientInfoInterceptor passes IP address and User-Agent of the HTTP client
+// to sso via gRPC metadata. They are used for brute-force protection.
+// Impersonating admin is passed too, so sso audits actions of the session.
+func clientInfoInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply any,
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if info, ok := clientinfo.FromContext(ctx); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx,
+			clientinfo.MDClientIP, info.IP,
+			clientinfo.MDUserAgent, info.UserAgent,
+		)
+		if info.ActorID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, clientinfo.MDActorID, info.ActorID)
+		}
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
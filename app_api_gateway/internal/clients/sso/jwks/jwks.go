@@ -0,0 +1,163 @@
+package ssojwks
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var (
+	ErrFetchKeys = errors.New("failed to fetch jwks")
+	ErrNoKeys    = errors.New("jwks has no usable keys")
+)
+
+// minRefreshInterval limits refreshes forced by unknown kid,
+// so tokens with random kid can't be used to flood sso.
+const minRefreshInterval = 30 * time.Second
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Kid string `json:"kid"`
+}
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+// Client keeps Ed25519 public keys which sso signs tokens with.
+type Client struct {
+	url        string
+	httpClient *http.Client
+	log        *slog.Logger
+
+	mu          sync.RWMutex
+	keys        map[string]ed25519.PublicKey
+	refreshedAt time.Time
+}
+
+func New(log *slog.Logger, url string, timeout time.Duration) *Client {
+	return &Client{
+		url:        url,
+		httpClient: &http.Client{Timeout: timeout},
+		log:        log,
+		keys:       make(map[string]ed25519.PublicKey),
+	}
+}
+
+// Refresh fetches key set from sso and replaces the current one.
+func (c *Client) Refresh(ctx context.Context) error {
+	const op = "sso.jwks.Refresh"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w: %v", op, ErrFetchKeys, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %w: status %d", op, ErrFetchKeys, resp.StatusCode)
+	}
+
+	var set jwks
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("%s: %w: %v", op, ErrFetchKeys, err)
+	}
+
+	keys := make(map[string]ed25519.PublicKey, len(set.Keys))
+	for _, key := range set.Keys {
+		if key.Kty != "OKP" || key.Crv != "Ed25519" || key.Kid == "" {
+			continue
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(key.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			c.log.Warn("skipping invalid jwk", slog.String("op", op), slog.String("kid", key.Kid))
+			continue
+		}
+
+		keys[key.Kid] = ed25519.PublicKey(x)
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("%s: %w", op, ErrNoKeys)
+	}
+
+	c.mu.Lock()
+	c.keys = keys
+	c.refreshedAt = time.Now()
+	c.mu.Unlock()
+
+	return nil
+}
+
+// Key returns public key by kid. Unknown kid may belong to a key
+// which was just rotated in, so the key set is refreshed once in a while.
+func (c *Client) Key(ctx context.Context, kid string) (ed25519.PublicKey, bool) {
+	c.mu.RLock()
+	key, ok := c.keys[kid]
+	c.mu.RUnlock()
+	if ok {
+		return key, true
+	}
+
+	// Only one forced refresh per minRefreshInterval
+	c.mu.Lock()
+	if time.Since(c.refreshedAt) < minRefreshInterval {
+		c.mu.Unlock()
+		return nil, false
+	}
+	c.refreshedAt = time.Now()
+	c.mu.Unlock()
+
+	if err := c.Refresh(ctx); err != nil {
+		c.log.Error("failed to refresh jwks", slog.String("err", err.Error()))
+		return nil, false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	key, ok = c.keys[kid]
+	return key, ok
+}
+
+// Keys returns all known public keys.
+func (c *Client) Keys() []ed25519.PublicKey {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	keys := make([]ed25519.PublicKey, 0, len(c.keys))
+	for _, key := range c.keys {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Run refreshes key set every interval until ctx is done.
+func (c *Client) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Refresh(ctx); err != nil {
+				c.log.Error("failed to refresh jwks", slog.String("err", err.Error()))
+			}
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package ssojwks
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testSSO serves key set which can be rotated by the test.
+type testSSO struct {
+	mu       sync.Mutex
+	keys     map[string]ed25519.PublicKey
+	requests atomic.Int32
+}
+
+func (s *testSSO) addKey(t *testing.T, kid string) ed25519.PublicKey {
+	t.Helper()
+
+	pubKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[kid] = pubKey
+	return pubKey
+}
+
+func (s *testSSO) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests.Add(1)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set := jwks{Keys: []jwk{
+		// Keys of unknown type are skipped
+		{Kty: "RSA", Kid: "rsa"},
+	}}
+	for kid, key := range s.keys {
+		set.Keys = append(set.Keys, jwk{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(key),
+			Kid: kid,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(set)
+}
+
+func newTestClient(t *testing.T) (*Client, *testSSO) {
+	t.Helper()
+
+	sso := &testSSO{keys: make(map[string]ed25519.PublicKey)}
+	srv := httptest.NewServer(sso)
+	t.Cleanup(srv.Close)
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return New(log, srv.URL, time.Second), sso
+}
+
+func TestRefresh(t *testing.T) {
+	c, sso := newTestClient(t)
+	ctx := context.Background()
+
+	if err := c.Refresh(ctx); !errors.Is(err, ErrNoKeys) {
+		t.Fatalf("Refresh() of empty key set error = %v, want %v", err, ErrNoKeys)
+	}
+
+	want := sso.addKey(t, "2024-12")
+	if err := c.Refresh(ctx); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	key, ok := c.Key(ctx, "2024-12")
+	if !ok || !key.Equal(want) {
+		t.Errorf("Key() = %v, %v, want refreshed key", key, ok)
+	}
+	if _, ok := c.Key(ctx, "rsa"); ok {
+		t.Error("Key() of non Ed25519 key is found")
+	}
+	if keys := c.Keys(); len(keys) != 1 {
+		t.Errorf("Keys() = %d keys, want 1", len(keys))
+	}
+}
+
+func TestRefreshFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := New(slog.New(slog.NewTextHandler(io.Discard, nil)), srv.URL, time.Second)
+	if err := c.Refresh(context.Background()); !errors.Is(err, ErrFetchKeys) {
+		t.Errorf("Refresh() error = %v, want %v", err, ErrFetchKeys)
+	}
+}
+
+func TestKeyUnknownKIDRefresh(t *testing.T) {
+	c, sso := newTestClient(t)
+	ctx := context.Background()
+
+	sso.addKey(t, "2024-12")
+	if err := c.Refresh(ctx); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	// Key rotated in after the last refresh
+	want := sso.addKey(t, "2025-01")
+
+	// Refresh isn't forced more often than minRefreshInterval
+	if _, ok := c.Key(ctx, "2025-01"); ok {
+		t.Error("Key() of unknown kid right after refresh is found")
+	}
+	if got := sso.requests.Load(); got != 1 {
+		t.Errorf("jwks requests = %d, want 1", got)
+	}
+
+	c.mu.Lock()
+	c.refreshedAt = time.Now().Add(-minRefreshInterval)
+	c.mu.Unlock()
+
+	key, ok := c.Key(ctx, "2025-01")
+	if !ok || !key.Equal(want) {
+		t.Errorf("Key() of rotated in kid = %v, %v, want refreshed key", key, ok)
+	}
+	if got := sso.requests.Load(); got != 2 {
+		t.Errorf("jwks requests = %d, want 2", got)
+	}
+
+	// Random kids can't be used to flood sso
+	for i := 0; i < 5; i++ {
+		if _, ok := c.Key(ctx, "random"); ok {
+			t.Error("Key() of random kid is found")
+		}
+	}
+	if got := sso.requests.Load(); got != 2 {
+		t.Errorf("jwks requests after random kids = %d, want 2", got)
+	}
+}
@@ -0,0 +1,36 @@
+package ssomodels
+
+type RegisterApp struct {
+	UserID string   `json:"user_id" validate:"required"`
+	Name   string   `json:"name" validate:"required,min=3,max=100"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,required"`
+}
+
+type RegisterAppResp struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+type IssueClientToken struct {
+	ClientID     string   `json:"client_id" validate:"required"`
+	ClientSecret string   `json:"client_secret" validate:"required"`
+	Scopes       []string `json:"scopes" validate:"dive,required"`
+}
+
+type IssueClientTokenResp struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope"`
+}
+
+type RegisterRelyingParty struct {
+	UserID       string   `json:"user_id" validate:"required"`
+	Name         string   `json:"name" validate:"required,min=3,max=100"`
+	RedirectURIs []string `json:"redirect_uris" validate:"required,min=1,dive,url"`
+	Public       bool     `json:"public"`
+}
+
+type RegisterRelyingPartyResp struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
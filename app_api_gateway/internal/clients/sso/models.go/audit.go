@@ -0,0 +1,32 @@
+package ssomodels
+
+// ListAuditEvents is a page request of the sso audit log.
+// From and To are RFC3339 times.
+type ListAuditEvents struct {
+	AdminID   string `json:"admin_id" validate:"required"`
+	UserID    string `json:"user_id,omitempty"`
+	EventType string `json:"event_type,omitempty"`
+	From      string `json:"from,omitempty" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	To        string `json:"to,omitempty" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Cursor    string `json:"cursor,omitempty"`
+	Limit     int32  `json:"limit,omitempty" validate:"gte=0,lte=100"`
+}
+
+type ListAuditEventsResp struct {
+	Events     []*AuditEvent `json:"events"`
+	NextCursor string        `json:"next_cursor"`
+}
+
+type AuditEvent struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	UserID    string `json:"user_id,omitempty"`
+	ActorID   string `json:"actor_id,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Method    string `json:"method,omitempty"`
+	TargetID  string `json:"target_id,omitempty"`
+	Details   string `json:"details,omitempty"`
+	IP        string `json:"ip,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+	Created   string `json:"created"`
+}
@@ -0,0 +1,23 @@
+package ssomodels
+
+type GDPRJobResp struct {
+	JobID string `json:"job_id"`
+}
+
+type GetGDPRJob struct {
+	AdminID string `json:"admin_id" validate:"required"`
+	JobID   string `json:"job_id" validate:"required"`
+}
+
+// GDPRJob is user data export or erasure job.
+// Archive is JSON of completed export.
+type GDPRJob struct {
+	JobID   string `json:"job_id"`
+	Type    string `json:"type"`
+	UserID  string `json:"user_id"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+	Archive []byte `json:"-"`
+	Created string `json:"created"`
+	Updated string `json:"updated"`
+}
@@ -2,6 +2,7 @@ package ssomodels
 
 type CreateLearningGroup struct {
 	Name        string   `json:"name" validate:"required,min=3,max=100"`
+	ParentID    string   `json:"parent_id,omitempty"`
 	CreatedBy   string   `json:"created_by" validate:"required"`
 	ModifiedBy  string   `json:"modified_by" validate:"required"`
 	GroupAdmins []string `json:"group_admins" validate:"required"`
@@ -20,10 +21,12 @@ type GetLgByID struct {
 type GetLgByIDResp struct {
 	Id          string         `json:"id"`
 	Name        string         `json:"name"`
+	ParentID    string         `json:"parent_id"`
 	CreatedBy   string         `json:"created_by"`
 	ModifiedBy  string         `json:"modified_by"`
 	Learners    []*Learner     `json:"learners"`
 	GroupAdmins []*GroupAdmins `json:"group_admins"`
+	Version     int64          `json:"version"`
 }
 
 type Learner struct {
@@ -45,6 +48,7 @@ type UpdateLearningGroup struct {
 	ModifiedBy  string   `json:"modified_by,omitempty"`
 	GroupAdmins []string `json:"group_admins,omitempty"`
 	Learners    []string `json:"learners,omitempty"`
+	Version     int64    `json:"version,omitempty" validate:"gte=0"`
 }
 
 type UpdateLearningGroupResp struct {
@@ -71,6 +75,7 @@ type GetLGroupsResp struct {
 type LearningGroup struct {
 	Id         string `json:"id"`
 	Name       string `json:"name"`
+	ParentID   string `json:"parent_id"`
 	CreatedBy  string `json:"created_by"`
 	ModifiedBy string `json:"modified_by"`
 	Created    string `json:"created"`
@@ -93,3 +98,15 @@ type UserIsGroupAdminIn struct {
 type UserIsLearnerIn struct {
 	UserID string `json:"user_id" validate:"required"`
 }
+
+// ChangeLgMembers adds or removes users with the role. Role is learner or group_admin.
+type ChangeLgMembers struct {
+	UserID  string   `json:"user_id" validate:"required"`
+	LgID    string   `json:"learning_group_id" validate:"required"`
+	Role    string   `json:"role" validate:"required,oneof=learner group_admin"`
+	UserIDs []string `json:"user_ids" validate:"required,min=1,max=1000,dive,required"`
+}
+
+type ChangeLgMembersResp struct {
+	Success bool `json:"success"`
+}
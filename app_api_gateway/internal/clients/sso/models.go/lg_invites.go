@@ -0,0 +1,47 @@
+package ssomodels
+
+// CreateLgInvite is a request of the group admin. ExpiresIn is in seconds.
+type CreateLgInvite struct {
+	UserID    string `json:"user_id" validate:"required"`
+	LgID      string `json:"learning_group_id" validate:"required"`
+	MaxUses   int64  `json:"max_uses" validate:"required,gte=1,lte=1000"`
+	ExpiresIn int64  `json:"expires_in" validate:"required,gte=60,lte=2592000"`
+}
+
+type LgInvite struct {
+	Code      string `json:"code"`
+	LgID      string `json:"learning_group_id"`
+	CreatedBy string `json:"created_by"`
+	MaxUses   int64  `json:"max_uses"`
+	Uses      int64  `json:"uses"`
+	ExpiresAt string `json:"expires_at"`
+	Created   string `json:"created"`
+}
+
+type ListLgInvites struct {
+	UserID string `json:"user_id" validate:"required"`
+	LgID   string `json:"learning_group_id" validate:"required"`
+}
+
+type ListLgInvitesResp struct {
+	Invites []*LgInvite `json:"invites"`
+}
+
+type RevokeLgInvite struct {
+	UserID string `json:"user_id" validate:"required"`
+	LgID   string `json:"learning_group_id" validate:"required"`
+	Code   string `json:"code" validate:"required"`
+}
+
+type RevokeLgInviteResp struct {
+	Success bool `json:"success"`
+}
+
+type RedeemLgInvite struct {
+	UserID string `json:"user_id" validate:"required"`
+	Code   string `json:"code" validate:"required"`
+}
+
+type RedeemLgInviteResp struct {
+	LgID string `json:"learning_group_id"`
+}
@@ -0,0 +1,71 @@
+package ssomodels
+
+// LgRole is a named set of permissions in the learning group.
+type LgRole struct {
+	ID          string   `json:"id"`
+	LgID        string   `json:"learning_group_id"`
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+	Members     []string `json:"members"`
+	CreatedBy   string   `json:"created_by"`
+	Created     string   `json:"created"`
+	Updated     string   `json:"updated"`
+}
+
+// CreateLgRole permissions are checked against the catalog by sso.
+type CreateLgRole struct {
+	UserID      string   `json:"user_id" validate:"required"`
+	LgID        string   `json:"learning_group_id" validate:"required"`
+	Name        string   `json:"name" validate:"required,min=3,max=50"`
+	Permissions []string `json:"permissions" validate:"required,min=1,max=20,dive,required"`
+}
+
+type ListLgRoles struct {
+	UserID string `json:"user_id" validate:"required"`
+	LgID   string `json:"learning_group_id" validate:"required"`
+}
+
+type ListLgRolesResp struct {
+	Roles []*LgRole `json:"roles"`
+}
+
+// UpdateLgRole replaces name and permissions of the role.
+type UpdateLgRole struct {
+	UserID      string   `json:"user_id" validate:"required"`
+	LgID        string   `json:"learning_group_id" validate:"required"`
+	RoleID      string   `json:"role_id" validate:"required"`
+	Name        string   `json:"name" validate:"required,min=3,max=50"`
+	Permissions []string `json:"permissions" validate:"required,min=1,max=20,dive,required"`
+}
+
+type DeleteLgRole struct {
+	UserID string `json:"user_id" validate:"required"`
+	LgID   string `json:"learning_group_id" validate:"required"`
+	RoleID string `json:"role_id" validate:"required"`
+}
+
+// ChangeLgRoleMembers assigns the role to the users or takes it away.
+type ChangeLgRoleMembers struct {
+	UserID  string   `json:"user_id" validate:"required"`
+	LgID    string   `json:"learning_group_id" validate:"required"`
+	RoleID  string   `json:"role_id" validate:"required"`
+	UserIDs []string `json:"user_ids" validate:"required,min=1,max=1000,dive,required"`
+}
+
+type LgRoleResp struct {
+	Success bool `json:"success"`
+}
+
+type GetUserPermissions struct {
+	UserID string `json:"user_id" validate:"required"`
+}
+
+// LgPermissions are permissions of the user in the learning group.
+type LgPermissions struct {
+	LgID        string   `json:"learning_group_id"`
+	Permissions []string `json:"permissions"`
+}
+
+type GetUserPermissionsResp struct {
+	Groups []*LgPermissions `json:"groups"`
+}
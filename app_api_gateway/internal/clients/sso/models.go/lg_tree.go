@@ -0,0 +1,26 @@
+package ssomodels
+
+type GetLgTree struct {
+	UserID string `json:"user_id" validate:"required"`
+	LgID   string `json:"learning_group_id" validate:"required"`
+}
+
+// LgTreeNode is the learning group with its child groups ordered by name.
+type LgTreeNode struct {
+	Id       string        `json:"id"`
+	Name     string        `json:"name"`
+	ParentID string        `json:"parent_id"`
+	Children []*LgTreeNode `json:"children"`
+}
+
+// MoveLearningGroup moves the group under the parent, empty ParentID makes it a root group.
+type MoveLearningGroup struct {
+	UserID   string `json:"user_id" validate:"required"`
+	LgID     string `json:"learning_group_id" validate:"required"`
+	ParentID string `json:"parent_id"`
+	Version  int64  `json:"version,omitempty" validate:"gte=0"`
+}
+
+type MoveLearningGroupResp struct {
+	Success bool `json:"success"`
+}
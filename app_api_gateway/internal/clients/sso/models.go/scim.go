@@ -0,0 +1,104 @@
+package ssomodels
+
+// Models of SCIM provisioning. ClientID is the service client which
+// provisions users and learning groups, it's the actor in the audit log.
+
+// ScimUser is a platform user, userName of SCIM user is the email.
+type ScimUser struct {
+	ID      string `json:"id"`
+	Email   string `json:"email"`
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Active  bool   `json:"active"`
+	Created string `json:"created"`
+	Updated string `json:"updated"`
+}
+
+// ScimGroup is a learning group, members are learners of the group.
+type ScimGroup struct {
+	ID          string   `json:"id"`
+	DisplayName string   `json:"display_name"`
+	Members     []string `json:"members"`
+	Created     string   `json:"created"`
+	Updated     string   `json:"updated"`
+	Version     int64    `json:"version"`
+}
+
+// ScimListUsers filters users by exact email and active state,
+// StartIndex is one-based like in SCIM.
+type ScimListUsers struct {
+	ClientID   string `json:"client_id" validate:"required"`
+	Email      string `json:"email,omitempty"`
+	Active     string `json:"active,omitempty" validate:"omitempty,oneof=true false"`
+	StartIndex int64  `json:"start_index,omitempty" validate:"gte=0"`
+	Count      int64  `json:"count,omitempty" validate:"gte=0"`
+}
+
+type ScimListUsersResp struct {
+	Users []*ScimUser `json:"users"`
+	Total int64       `json:"total"`
+}
+
+type ScimManageUser struct {
+	ClientID string `json:"client_id" validate:"required"`
+	UserID   string `json:"user_id" validate:"required"`
+}
+
+type ScimCreateUser struct {
+	ClientID string `json:"client_id" validate:"required"`
+	Email    string `json:"email" validate:"required,email"`
+	Name     string `json:"name,omitempty" validate:"max=100"`
+	Active   bool   `json:"active"`
+}
+
+// ScimUpdateUser replaces the user, empty name keeps the current one.
+type ScimUpdateUser struct {
+	ClientID string `json:"client_id" validate:"required"`
+	UserID   string `json:"user_id" validate:"required"`
+	Email    string `json:"email" validate:"required,email"`
+	Name     string `json:"name,omitempty" validate:"max=100"`
+	Active   bool   `json:"active"`
+}
+
+type ScimListGroups struct {
+	ClientID    string `json:"client_id" validate:"required"`
+	DisplayName string `json:"display_name,omitempty"`
+	StartIndex  int64  `json:"start_index,omitempty" validate:"gte=0"`
+	Count       int64  `json:"count,omitempty" validate:"gte=0"`
+}
+
+type ScimListGroupsResp struct {
+	Groups []*ScimGroup `json:"groups"`
+	Total  int64        `json:"total"`
+}
+
+type ScimManageGroup struct {
+	ClientID string `json:"client_id" validate:"required"`
+	GroupID  string `json:"group_id" validate:"required"`
+}
+
+type ScimCreateGroup struct {
+	ClientID    string   `json:"client_id" validate:"required"`
+	DisplayName string   `json:"display_name" validate:"required,min=3,max=100"`
+	Members     []string `json:"members" validate:"max=1000,dive,required"`
+}
+
+// ScimUpdateGroup replaces display name and members of the group.
+type ScimUpdateGroup struct {
+	ClientID    string   `json:"client_id" validate:"required"`
+	GroupID     string   `json:"group_id" validate:"required"`
+	DisplayName string   `json:"display_name" validate:"required,min=3,max=100"`
+	Members     []string `json:"members" validate:"max=1000,dive,required"`
+}
+
+// ScimChangeGroupMembers is built from add and remove operations of SCIM PATCH.
+type ScimChangeGroupMembers struct {
+	ClientID string   `json:"client_id" validate:"required"`
+	GroupID  string   `json:"group_id" validate:"required"`
+	Add      []string `json:"add,omitempty" validate:"max=1000,dive,required"`
+	Remove   []string `json:"remove,omitempty" validate:"max=1000,dive,required"`
+}
+
+type ScimResp struct {
+	Success bool `json:"success"`
+}
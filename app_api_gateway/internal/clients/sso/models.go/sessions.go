@@ -0,0 +1,27 @@
+package ssomodels
+
+type ListSessions struct {
+	UserID string `json:"user_id" validate:"required"`
+}
+
+type ListSessionsResp struct {
+	Sessions []*Session `json:"sessions"`
+}
+
+type Session struct {
+	ID        string `json:"id"`
+	UserAgent string `json:"user_agent"`
+	IP        string `json:"ip"`
+	Created   string `json:"created"`
+	LastUsed  string `json:"last_used"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type RevokeSession struct {
+	UserID    string `json:"user_id" validate:"required"`
+	SessionID string `json:"session_id" validate:"required"`
+}
+
+type RevokeSessionResp struct {
+	Success bool `json:"success"`
+}
@@ -0,0 +1,19 @@
+package ssomodels
+
+type CreateTgLink struct {
+	UserID string `json:"user_id" validate:"required"`
+}
+
+type CreateTgLinkResp struct {
+	Token     string `json:"token"`
+	Link      string `json:"link"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type UnlinkTelegram struct {
+	UserID string `json:"user_id" validate:"required"`
+}
+
+type UnlinkTelegramResp struct {
+	Success bool `json:"success"`
+}
@@ -0,0 +1,38 @@
+package ssomodels
+
+type LoginMFA struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	Code     string `json:"code" validate:"required"`
+}
+
+type LoginMFAResp struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type EnrollTOTP struct {
+	UserID string `json:"user_id" validate:"required"`
+}
+
+type EnrollTOTPResp struct {
+	URI    string `json:"uri"`
+	Secret string `json:"secret"`
+}
+
+type VerifyTOTP struct {
+	UserID string `json:"user_id" validate:"required"`
+	Code   string `json:"code" validate:"required"`
+}
+
+type VerifyTOTPResp struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type DisableTOTP struct {
+	UserID string `json:"user_id" validate:"required"`
+	Code   string `json:"code" validate:"required"`
+}
+
+type DisableTOTPResp struct {
+	Success bool `json:"success"`
+}
@@ -18,6 +18,8 @@ type LogIn struct {
 type LogInResp struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
+	MFARequired  bool   `json:"mfa_required"`
+	MFAToken     string `json:"mfa_token"`
 }
 
 type LogInViaTg struct {
@@ -37,14 +39,58 @@ type CheckOTPAndLogIn struct {
 type CheckOTPAndLogInResp struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
+	MFARequired  bool   `json:"mfa_required"`
+	MFAToken     string `json:"mfa_token"`
+}
+
+type VerifyEmail struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type VerifyEmailResp struct {
+	Success bool `json:"success"`
+}
+
+type ResendVerification struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResendVerificationResp struct {
+	Success bool `json:"success"`
+}
+
+type RequestPasswordReset struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type RequestPasswordResetResp struct {
+	Success bool `json:"success"`
+}
+
+type ConfirmPasswordReset struct {
+	Email       string `json:"email" validate:"required,email"`
+	Code        string `json:"code" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required,min=8,password_complexity"`
+}
+
+type ConfirmPasswordResetResp struct {
+	Success bool `json:"success"`
+}
+
+type AcceptInvite struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=8,password_complexity"`
+}
+
+type AcceptInviteResp struct {
+	Success bool `json:"success"`
 }
 
 type UpdateUserInfo struct {
-	ID      string `json:"id" validate:"required"`
-	Email   string `json:"email,omitempty"`
-	Name    string `json:"name,omitempty"`
-	TgLink  string `json:"tg_link,omitempty"`
-	IsAdmin bool   `json:"is_admin,omitempty"`
+	ID     string `json:"id" validate:"required"`
+	Email  string `json:"email,omitempty"`
+	Name   string `json:"name,omitempty"`
+	TgLink string `json:"tg_link,omitempty"`
 }
 
 type UpdateUserInfoResp struct {
@@ -56,7 +102,45 @@ type RefreshToken struct {
 }
 
 type RefreshTokenResp struct {
-	AccessToken string `json:"access_token"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type Logout struct {
+	AccessToken  string `json:"access_token" validate:"required"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+}
+
+type LogoutResp struct {
+	Success bool `json:"success"`
+}
+
+type GetUserInfo struct {
+	UserID string `json:"user_id" validate:"required"`
+}
+
+type GetUserInfoResp struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	TgLink   string `json:"tg_link"`
+	TgLinked bool   `json:"tg_linked"`
+	IsAdmin  bool   `json:"is_admin"`
+}
+
+type GetMe struct {
+	UserID string `json:"user_id" validate:"required"`
+}
+
+type GetMeResp struct {
+	ID           string   `json:"id"`
+	Email        string   `json:"email"`
+	Name         string   `json:"name"`
+	TgLink       string   `json:"tg_link"`
+	TgLinked     bool     `json:"tg_linked"`
+	IsAdmin      bool     `json:"is_admin"`
+	LearnerIn    []string `json:"learner_in"`
+	GroupAdminIn []string `json:"group_admin_in"`
 }
 
 type IsAdmin struct {
@@ -71,7 +155,13 @@ type AuthCheck struct {
 	AccessToken string `json:"access_token" validate:"required"`
 }
 
+// AuthCheckResp has UserID for user tokens
+// and ClientID with granted Scopes for service client tokens.
 type AuthCheckResp struct {
-	IsValid bool   `json:"is_valid"`
-	UserID  string `json:"user_id"`
+	IsValid  bool     `json:"is_valid"`
+	UserID   string   `json:"user_id"`
+	ClientID string   `json:"client_id"`
+	Scopes   []string `json:"scopes"`
+	// ActorID is the admin who impersonates the user
+	ActorID string `json:"actor_id,omitempty"`
 }
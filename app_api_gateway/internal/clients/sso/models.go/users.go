@@ -0,0 +1,85 @@
+package ssomodels
+
+type ListUsers struct {
+	AdminID string `json:"admin_id" validate:"required"`
+	Query   string `json:"query,omitempty"`
+	Status  string `json:"status,omitempty" validate:"omitempty,oneof=pending_verification active deactivated"`
+	Cursor  string `json:"cursor,omitempty"`
+	Limit   int32  `json:"limit,omitempty" validate:"gte=0,lte=100"`
+}
+
+type ListUsersResp struct {
+	Users      []*UserSummary `json:"users"`
+	NextCursor string         `json:"next_cursor"`
+}
+
+type UserSummary struct {
+	ID      string `json:"id"`
+	Email   string `json:"email"`
+	Name    string `json:"name"`
+	TgLink  string `json:"tg_link"`
+	IsAdmin bool   `json:"is_admin"`
+	Status  string `json:"status"`
+	Created string `json:"created"`
+}
+
+type SetUserAdmin struct {
+	AdminID string `json:"admin_id" validate:"required"`
+	UserID  string `json:"user_id" validate:"required"`
+	IsAdmin bool   `json:"is_admin"`
+}
+
+type SetUserAdminResp struct {
+	Success bool `json:"success"`
+}
+
+type ManageUser struct {
+	AdminID string `json:"admin_id" validate:"required"`
+	UserID  string `json:"user_id" validate:"required"`
+}
+
+type ManageUserResp struct {
+	Success bool `json:"success"`
+}
+
+// ImpersonateUser is a request of the platform admin to act as the user.
+// Reason is written to the audit log.
+type ImpersonateUser struct {
+	AdminID string `json:"admin_id" validate:"required"`
+	UserID  string `json:"user_id" validate:"required"`
+	Reason  string `json:"reason" validate:"max=500"`
+}
+
+type ImpersonateUserResp struct {
+	AccessToken string `json:"access_token"`
+	ExpiresAt   string `json:"expires_at"`
+}
+
+type ImportUsers struct {
+	AdminID           string           `json:"admin_id" validate:"required"`
+	Rows              []*ImportUserRow `json:"rows" validate:"required,min=1"`
+	LearningGroupID   string           `json:"learning_group_id,omitempty"`
+	LearningGroupName string           `json:"learning_group_name,omitempty" validate:"omitempty,min=3,max=100"`
+}
+
+// ImportUserRow is validated by sso, so every invalid row
+// gets into the import report.
+type ImportUserRow struct {
+	Row    int64  `json:"row"`
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+	TgLink string `json:"tg_link"`
+}
+
+type ImportUsersResp struct {
+	Results            []*ImportUserResult `json:"results"`
+	LearningGroupError string              `json:"learning_group_error,omitempty"`
+}
+
+type ImportUserResult struct {
+	Row    int64  `json:"row"`
+	Email  string `json:"email"`
+	Status string `json:"status"`
+	UserID string `json:"user_id,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
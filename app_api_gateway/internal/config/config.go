@@ -12,6 +12,8 @@ type Config struct {
 	Tracer     OpenTelemetry `yaml:"tracer"`
 	Meter      Prometheus    `yaml:"meter"`
 	Redis      Redis         `yaml:"redis"`
+	Auth       Auth          `yaml:"auth"`
+	Telegram   Telegram      `yaml:"telegram"`
 }
 
 type HTTPServer struct {
@@ -50,10 +52,31 @@ type Prometheus struct {
 }
 
 type Redis struct {
-	Host          string `yaml:"host"`
-	Port          int    `yaml:"port"`
-	PermissionsDB int    `yaml:"permissions_db"`
-	Password      string `yaml:"password"`
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	Password string `yaml:"password"`
+	// DenylistDB is sso token db where revoked access tokens are kept
+	DenylistDB int `yaml:"denylist_db"`
+}
+
+const (
+	// AuthModeLocal - access tokens are verified in the gateway against sso JWKS
+	AuthModeLocal = "local"
+	// AuthModeRemote - access tokens are checked by sso AuthCheck call
+	AuthModeRemote = "remote"
+)
+
+type Auth struct {
+	Mode                string        `yaml:"mode" env-default:"local"`
+	Issuer              string        `yaml:"issuer" env-default:"auth-service"`
+	JWKSURL             string        `yaml:"jwks_url"`
+	JWKSRefreshInterval time.Duration `yaml:"jwks_refresh_interval" env-default:"5m"`
+	DenylistCacheTTL    time.Duration `yaml:"denylist_cache_ttl" env-default:"10s"`
+}
+
+type Telegram struct {
+	// BotName is username of the notification bot without @
+	BotName string `yaml:"bot_name"`
 }
 
 func Parse(s string) (*Config, error) {
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	integrationshandler "github.com/DimTur/lp_api_gateway/internal/handlers/integrations"
 	attemptshandler "github.com/DimTur/lp_api_gateway/internal/handlers/learning_platform/attempts"
 	channelshandler "github.com/DimTur/lp_api_gateway/internal/handlers/learning_platform/channels"
 	lessonshandler "github.com/DimTur/lp_api_gateway/internal/handlers/learning_platform/lessons"
@@ -12,9 +13,13 @@ import (
 	planshandler "github.com/DimTur/lp_api_gateway/internal/handlers/learning_platform/plans"
 	questionshandler "github.com/DimTur/lp_api_gateway/internal/handlers/learning_platform/questions"
 	authmiddleware "github.com/DimTur/lp_api_gateway/internal/handlers/middleware/auth"
+	clientinfomiddleware "github.com/DimTur/lp_api_gateway/internal/handlers/middleware/clientinfo"
 	headersmiddleware "github.com/DimTur/lp_api_gateway/internal/handlers/middleware/headers"
+	scimhandler "github.com/DimTur/lp_api_gateway/internal/handlers/scim"
+	appshandler "github.com/DimTur/lp_api_gateway/internal/handlers/sso/apps"
 	authhandler "github.com/DimTur/lp_api_gateway/internal/handlers/sso/auth"
 	learninggrouphandler "github.com/DimTur/lp_api_gateway/internal/handlers/sso/learning_group"
+	usershandler "github.com/DimTur/lp_api_gateway/internal/handlers/sso/users"
 	lpservice "github.com/DimTur/lp_api_gateway/internal/services/lp"
 	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
 	"github.com/go-chi/chi/v5"
@@ -35,6 +40,7 @@ type RouterConfigurator interface {
 type ChiRouterConfigurator struct {
 	SsoService     ssoservice.SsoService
 	LpService      lpservice.LpService
+	AuthChecker    authmiddleware.AuthService
 	Logger         *slog.Logger
 	validator      *validator.Validate
 	TracerProvider trace.TracerProvider
@@ -44,6 +50,7 @@ type ChiRouterConfigurator struct {
 func NewChiRouterConfigurator(
 	ssoService ssoservice.SsoService,
 	lpService lpservice.LpService,
+	authChecker authmiddleware.AuthService,
 	logger *slog.Logger,
 	validator *validator.Validate,
 	tracerProvider trace.TracerProvider,
@@ -52,6 +59,7 @@ func NewChiRouterConfigurator(
 	return &ChiRouterConfigurator{
 		SsoService:     ssoService,
 		LpService:      lpService,
+		AuthChecker:    authChecker,
 		Logger:         logger,
 		validator:      validator,
 		TracerProvider: tracerProvider,
@@ -77,6 +85,7 @@ func (c *ChiRouterConfigurator) ConfigureRouter() http.Handler {
 		MaxAge:           300,
 	}))
 	router.Use(headersmiddleware.SecurityHeadersMiddleware)
+	router.Use(clientinfomiddleware.ClientInfoMiddleware)
 
 	// Routes
 	//
@@ -94,24 +103,92 @@ func (c *ChiRouterConfigurator) ConfigureRouter() http.Handler {
 	router.Post("/sing_in", authhandler.SignIn(c.Logger, c.validator, &c.SsoService))
 	router.Post("/sing_in_by_tg", authhandler.SignInByTelegram(c.Logger, c.validator, &c.SsoService))
 	router.Post("/check_otp", authhandler.CheckOTPAndLogIn(c.Logger, c.validator, &c.SsoService))
+	router.Post("/sing_in/mfa", authhandler.LoginMFA(c.Logger, c.validator, &c.SsoService))
+	router.Get("/verify_email", authhandler.VerifyEmail(c.Logger, c.validator, &c.SsoService))
+	router.Post("/verify_email/resend", authhandler.ResendVerification(c.Logger, c.validator, &c.SsoService))
+	router.Post("/auth/refresh", authhandler.RefreshToken(c.Logger, c.validator, &c.SsoService))
+	router.Post("/password_reset", authhandler.RequestPasswordReset(c.Logger, c.validator, &c.SsoService))
+	router.Post("/password_reset/confirm", authhandler.ConfirmPasswordReset(c.Logger, c.validator, &c.SsoService))
+	router.Post("/invite/accept", authhandler.AcceptInvite(c.Logger, c.validator, &c.SsoService))
+	router.Post("/oauth/token", appshandler.Token(c.Logger, c.validator, &c.SsoService))
 	router.Group(func(r chi.Router) {
-		r.Use(authmiddleware.AuthMiddleware(c.Logger, c.validator, &c.SsoService))
+		r.Use(authmiddleware.AuthMiddleware(c.Logger, c.validator, c.AuthChecker))
+		r.Post("/admin/apps", appshandler.RegisterApp(c.Logger, c.validator, &c.SsoService))
+		r.Post("/admin/relying_parties", appshandler.RegisterRelyingParty(c.Logger, c.validator, &c.SsoService))
+		r.Get("/admin/users", usershandler.ListUsers(c.Logger, c.validator, &c.SsoService))
+		r.Post("/admin/users/import", usershandler.ImportUsers(c.Logger, c.validator, &c.SsoService))
+		r.Post("/admin/users/{id}/admin", usershandler.GrantAdmin(c.Logger, c.validator, &c.SsoService))
+		r.Delete("/admin/users/{id}/admin", usershandler.RevokeAdmin(c.Logger, c.validator, &c.SsoService))
+		r.Post("/admin/users/{id}/deactivate", usershandler.DeactivateUser(c.Logger, c.validator, &c.SsoService))
+		r.Post("/admin/users/{id}/reactivate", usershandler.ReactivateUser(c.Logger, c.validator, &c.SsoService))
+		r.Post("/admin/users/{id}/impersonate", usershandler.ImpersonateUser(c.Logger, c.validator, &c.SsoService))
+		r.Post("/admin/users/{id}/export", usershandler.RequestUserExport(c.Logger, c.validator, &c.SsoService))
+		r.Post("/admin/users/{id}/erase", usershandler.RequestUserErasure(c.Logger, c.validator, &c.SsoService))
+		r.Get("/admin/gdpr_jobs/{id}", usershandler.GetGDPRJob(c.Logger, c.validator, &c.SsoService))
+		r.Get("/admin/gdpr_jobs/{id}/archive", usershandler.DownloadGDPRArchive(c.Logger, c.validator, &c.SsoService))
+		r.Get("/admin/audit_log", usershandler.ListAuditEvents(c.Logger, c.validator, &c.SsoService))
 		r.Patch("/profile/update_info", authhandler.UpdateUserInfo(c.Logger, c.validator, &c.SsoService))
+		r.Post("/auth/logout", authhandler.Logout(c.Logger, c.validator, &c.SsoService))
+		r.Get("/me", authhandler.Me(c.Logger, c.validator, &c.SsoService))
+		r.Post("/profile/2fa/enroll", authhandler.EnrollTOTP(c.Logger, c.validator, &c.SsoService))
+		r.Post("/profile/2fa/verify", authhandler.VerifyTOTP(c.Logger, c.validator, &c.SsoService))
+		r.Post("/profile/2fa/disable", authhandler.DisableTOTP(c.Logger, c.validator, &c.SsoService))
+		r.Get("/profile/sessions", authhandler.ListSessions(c.Logger, c.validator, &c.SsoService))
+		r.Delete("/profile/sessions/{id}", authhandler.RevokeSession(c.Logger, c.validator, &c.SsoService))
+		r.Post("/profile/telegram/link", authhandler.CreateTgLink(c.Logger, c.validator, &c.SsoService))
+		r.Delete("/profile/telegram/link", authhandler.UnlinkTelegram(c.Logger, c.validator, &c.SsoService))
+	})
+
+	// Integrations for service clients, every route requires its own scopes
+	router.Route("/integrations", func(r chi.Router) {
+		r.With(authmiddleware.ClientAuthMiddleware(c.Logger, c.validator, c.AuthChecker, authmiddleware.ScopeUsersRead)).
+			Get("/users/{id}", integrationshandler.GetUser(c.Logger, c.validator, &c.SsoService))
+	})
+
+	// SCIM 2.0 provisioning of users and learning groups for service clients
+	router.Route("/scim/v2", func(r chi.Router) {
+		r.Use(authmiddleware.ClientAuthMiddleware(c.Logger, c.validator, c.AuthChecker, authmiddleware.ScopeScimProvision))
+		r.Get("/Users", scimhandler.ListUsers(c.Logger, c.validator, &c.SsoService))
+		r.Post("/Users", scimhandler.CreateUser(c.Logger, c.validator, &c.SsoService))
+		r.Get("/Users/{id}", scimhandler.GetUser(c.Logger, c.validator, &c.SsoService))
+		r.Put("/Users/{id}", scimhandler.ReplaceUser(c.Logger, c.validator, &c.SsoService))
+		r.Patch("/Users/{id}", scimhandler.PatchUser(c.Logger, c.validator, &c.SsoService))
+		r.Delete("/Users/{id}", scimhandler.DeleteUser(c.Logger, c.validator, &c.SsoService))
+		r.Get("/Groups", scimhandler.ListGroups(c.Logger, c.validator, &c.SsoService))
+		r.Post("/Groups", scimhandler.CreateGroup(c.Logger, c.validator, &c.SsoService))
+		r.Get("/Groups/{id}", scimhandler.GetGroup(c.Logger, c.validator, &c.SsoService))
+		r.Put("/Groups/{id}", scimhandler.ReplaceGroup(c.Logger, c.validator, &c.SsoService))
+		r.Patch("/Groups/{id}", scimhandler.PatchGroup(c.Logger, c.validator, &c.SsoService))
+		r.Delete("/Groups/{id}", scimhandler.DeleteGroup(c.Logger, c.validator, &c.SsoService))
 	})
 
 	// Lerning Groups
 	router.Group(func(r chi.Router) {
-		r.Use(authmiddleware.AuthMiddleware(c.Logger, c.validator, &c.SsoService))
+		r.Use(authmiddleware.AuthMiddleware(c.Logger, c.validator, c.AuthChecker))
 		r.Post("/learning_groups", learninggrouphandler.CreateLearningGroup(c.Logger, c.validator, &c.SsoService))
 		r.Get("/learning_group/{id}", learninggrouphandler.GetLearningGroupByID(c.Logger, c.validator, &c.SsoService))
 		r.Patch("/learning_group/{id}", learninggrouphandler.UpdateLearningGroup(c.Logger, c.validator, &c.SsoService))
 		r.Delete("/learning_group/{id}", learninggrouphandler.DeleteLearningGroup(c.Logger, c.validator, &c.SsoService))
 		r.Get("/learning_groups", learninggrouphandler.GetLearningGroups(c.Logger, c.validator, &c.SsoService))
+		r.Get("/learning_group/{id}/tree", learninggrouphandler.GetLearningGroupTree(c.Logger, c.validator, &c.SsoService))
+		r.Post("/learning_group/{id}/move", learninggrouphandler.MoveLearningGroup(c.Logger, c.validator, &c.SsoService))
+		r.Post("/learning_group/{id}/invites", learninggrouphandler.CreateLgInvite(c.Logger, c.validator, &c.SsoService))
+		r.Get("/learning_group/{id}/invites", learninggrouphandler.ListLgInvites(c.Logger, c.validator, &c.SsoService))
+		r.Delete("/learning_group/{id}/invites/{code}", learninggrouphandler.RevokeLgInvite(c.Logger, c.validator, &c.SsoService))
+		r.Post("/learning_groups/join/{code}", learninggrouphandler.RedeemLgInvite(c.Logger, c.validator, &c.SsoService))
+		r.Post("/learning_group/{id}/members", learninggrouphandler.AddMembers(c.Logger, c.validator, &c.SsoService))
+		r.Delete("/learning_group/{id}/members", learninggrouphandler.RemoveMembers(c.Logger, c.validator, &c.SsoService))
+		r.Post("/learning_group/{id}/roles", learninggrouphandler.CreateLgRole(c.Logger, c.validator, &c.SsoService))
+		r.Get("/learning_group/{id}/roles", learninggrouphandler.ListLgRoles(c.Logger, c.validator, &c.SsoService))
+		r.Put("/learning_group/{id}/roles/{role_id}", learninggrouphandler.UpdateLgRole(c.Logger, c.validator, &c.SsoService))
+		r.Delete("/learning_group/{id}/roles/{role_id}", learninggrouphandler.DeleteLgRole(c.Logger, c.validator, &c.SsoService))
+		r.Post("/learning_group/{id}/roles/{role_id}/members", learninggrouphandler.AssignLgRole(c.Logger, c.validator, &c.SsoService))
+		r.Delete("/learning_group/{id}/roles/{role_id}/members", learninggrouphandler.UnassignLgRole(c.Logger, c.validator, &c.SsoService))
 	})
 
 	// Learning Platform
 	router.Group(func(r chi.Router) {
-		r.Use(authmiddleware.AuthMiddleware(c.Logger, c.validator, &c.SsoService))
+		r.Use(authmiddleware.AuthMiddleware(c.Logger, c.validator, c.AuthChecker))
 
 		// Channels
 		r.Post("/channels", channelshandler.CreateChannel(c.Logger, c.validator, &c.LpService))
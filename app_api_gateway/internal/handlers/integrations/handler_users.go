@@ -0,0 +1,88 @@
+package integrationshandler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+// UserService is used by integration endpoints which are called by service clients.
+type UserService interface {
+	GetMe(ctx context.Context, me *ssomodels.GetMe) (*ssomodels.GetMeResp, error)
+}
+
+// GetUser godoc
+// @Summary      Get user by id for a service client
+// @Description  This endpoint returns user profile and learning groups of the user. It requires a service client token with users:read scope.
+// @Tags         integrations
+// @Produce      json
+// @Param        id path string true "ID of the user"
+// @Success      200 {object} integrationshandler.GetUserResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Insufficient scope"
+// @Failure      404 {object} response.Response "User not found"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /integrations/users/{id} [get]
+// @Security ApiKeyAuth
+func GetUser(log *slog.Logger, val *validator.Validate, userService UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.integrations.GetUser"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		userID := chi.URLParam(r, "id")
+
+		log.Info("getting user", slog.String("user_id", userID), slog.Any("request from", r.Header.Get("X-Client-ID")))
+
+		resp, err := userService.GetMe(r.Context(), &ssomodels.GetMe{
+			UserID: userID,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid user id", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid user id"))
+				return
+			case errors.Is(err, ssoservice.ErrUserNotFound):
+				log.Error("user not found", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusNotFound)
+				render.JSON(w, r, response.Error("user not found"))
+				return
+			default:
+				log.Error("failed to get user", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to get user"))
+				return
+			}
+		}
+
+		log.Info("user got successfully")
+
+		render.JSON(w, r, GetUserResponse{
+			Response:     response.OK(),
+			ID:           resp.ID,
+			Email:        resp.Email,
+			Name:         resp.Name,
+			IsAdmin:      resp.IsAdmin,
+			LearnerIn:    resp.LearnerIn,
+			GroupAdminIn: resp.GroupAdminIn,
+		})
+	}
+}
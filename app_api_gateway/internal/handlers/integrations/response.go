@@ -0,0 +1,13 @@
+package integrationshandler
+
+import "github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+
+type GetUserResponse struct {
+	response.Response
+	ID           string
+	Email        string
+	Name         string
+	IsAdmin      bool
+	LearnerIn    []string
+	GroupAdminIn []string
+}
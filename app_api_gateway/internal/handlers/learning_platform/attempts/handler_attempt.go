@@ -294,6 +294,7 @@ func CompleteLesson(log *slog.Logger, val *validator.Validate, lpService LPServi
 // @Param        lesson_id path int true "ID of the lesson"
 // @Param 		 limit query int false "Limit"
 // @Param 		 offset query int false "Offset"
+// @Param 		 user_id query string false "Learner whose attempts are returned, requires attempt.view_all"
 // @Success      201 {object} attemptshandler.LessonAttemptsResponse
 // @Failure      400 {object} response.Response "Invalid data in the request"
 // @Failure      401 {object} response.Response "Unauthorized"
@@ -339,10 +340,11 @@ func GetLessonAttempts(log *slog.Logger, val *validator.Validate, lpService LPSe
 		}
 
 		attempts, err := lpService.GetLessonAttempts(r.Context(), &lpmodels.GetLessonAttempts{
-			UserID:   uID,
-			LessonID: lessonID,
-			Limit:    limit,
-			Offset:   offset,
+			UserID:    uID,
+			LearnerID: r.URL.Query().Get("user_id"),
+			LessonID:  lessonID,
+			Limit:     limit,
+			Offset:    offset,
 		})
 		if err != nil {
 			switch {
@@ -359,6 +361,7 @@ func GetLessonAttempts(log *slog.Logger, val *validator.Validate, lpService LPSe
 				w.WriteHeader(http.StatusInternalServerError)
 				render.JSON(w, r, response.Error("Internal Server Error"))
 			}
+			return
 		}
 
 		log.Info("lesson attempts retrieved")
@@ -8,10 +8,21 @@ import (
 
 	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
 	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/clientinfo"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/DimTur/lp_api_gateway/pkg/tracer"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-playground/validator/v10"
+	"go.opentelemetry.io/otel/attribute"
 )
 
+// logoutPath is the only not read-only request allowed during impersonation,
+// so the admin can end the session.
+const logoutPath = "/auth/logout"
+
+// AuthService checks access token of the request.
+// It is either ssoservice.SsoService which calls sso AuthCheck
+// or ssoservice.LocalAuthChecker which verifies token in place.
 type AuthService interface {
 	AuthCheck(ctx context.Context, authChek *ssomodels.AuthCheck) (*ssomodels.AuthCheckResp, error)
 }
@@ -42,7 +53,7 @@ func AuthMiddleware(log *slog.Logger, val *validator.Validate, authService AuthS
 			resp, err := authService.AuthCheck(r.Context(), authCheck)
 			if err != nil {
 				switch {
-				case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+				case errors.Is(err, ssogrpc.ErrInvalidCredentials), errors.Is(err, ssoservice.ErrInvalidCredentials):
 					log.Error("error checking authorization", slog.String("err", err.Error()))
 					w.WriteHeader(http.StatusUnauthorized)
 					http.Error(w, "Unauthorized", http.StatusUnauthorized)
@@ -62,11 +73,64 @@ func AuthMiddleware(log *slog.Logger, val *validator.Validate, authService AuthS
 				return
 			}
 
+			// Service client tokens are accepted only by ClientAuthMiddleware
+			if resp.UserID == "" {
+				log.Info("token doesn't belong to a user", slog.String("client_id", resp.ClientID))
+				w.WriteHeader(http.StatusUnauthorized)
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			r.Header.Del("X-Client-ID")
+			r.Header.Del("X-Actor-ID")
 			r.Header.Set("X-User-ID", resp.UserID)
 
+			if resp.ActorID != "" {
+				impersonate(w, r, log, resp, next)
+				return
+			}
+
 			log.Info("authorization successful", slog.String("user_id", resp.UserID))
 
 			next.ServeHTTP(w, r)
 		})
 	}
 }
+
+// impersonate serves the request of the admin impersonating the user.
+// Only read-only requests and logout are allowed. The admin is put
+// to the X-Actor-ID header and to the client info, so it is logged
+// and passed to backend services, and the request is traced
+// in the impersonation span.
+func impersonate(w http.ResponseWriter, r *http.Request, log *slog.Logger, resp *ssomodels.AuthCheckResp, next http.Handler) {
+	log = log.With(
+		slog.String("user_id", resp.UserID),
+		slog.String("actor_id", resp.ActorID),
+	)
+
+	switch {
+	case r.Method == http.MethodGet, r.Method == http.MethodHead, r.Method == http.MethodOptions:
+	case r.Method == http.MethodPost && r.URL.Path == logoutPath:
+	default:
+		log.Warn("request isn't allowed during impersonation")
+		http.Error(w, "Forbidden during impersonation", http.StatusForbidden)
+		return
+	}
+
+	ctx, span := tracer.AuthTracer.Start(r.Context(), "Impersonation")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("userID", resp.UserID),
+		attribute.String("actorID", resp.ActorID),
+	)
+
+	info, _ := clientinfo.FromContext(ctx)
+	info.ActorID = resp.ActorID
+	ctx = clientinfo.WithInfo(ctx, info)
+
+	r.Header.Set("X-Actor-ID", resp.ActorID)
+
+	log.Info("authorization successful")
+
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
@@ -0,0 +1,90 @@
+package authmiddleware
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-playground/validator/v10"
+)
+
+// Scopes which service clients can be granted with.
+const (
+	ScopeUsersRead     = "users:read"
+	ScopeUsersImport   = "users:import"
+	ScopeScimProvision = "scim:provision"
+)
+
+// ClientAuthMiddleware accepts only access tokens of service clients
+// which are granted all the required scopes. User tokens are rejected.
+// Client ID is passed to the handlers in X-Client-ID header.
+func ClientAuthMiddleware(log *slog.Logger, val *validator.Validate, authService AuthService, scopes ...string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			const op = "middleware.ClientAuth"
+
+			log := log.With(
+				slog.String("op", op),
+				slog.String("request_id", middleware.GetReqID(r.Context())),
+				slog.String("method", r.Method),
+				slog.String("url", r.URL.String()),
+			)
+
+			// Service clients usually send the token with the OAuth2 Bearer scheme
+			accessToken := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if accessToken == "" {
+				log.Info("authorization token not provided")
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			authCheck := &ssomodels.AuthCheck{
+				AccessToken: accessToken,
+			}
+			resp, err := authService.AuthCheck(r.Context(), authCheck)
+			if err != nil {
+				switch {
+				case errors.Is(err, ssogrpc.ErrInvalidCredentials), errors.Is(err, ssoservice.ErrInvalidCredentials):
+					log.Error("error checking authorization", slog.String("err", err.Error()))
+					http.Error(w, "Unauthorized", http.StatusUnauthorized)
+					return
+				default:
+					log.Error("internal error", slog.String("err", err.Error()))
+					http.Error(w, "internal error", http.StatusInternalServerError)
+					return
+				}
+			}
+
+			if !resp.IsValid || resp.ClientID == "" {
+				log.Info("token doesn't belong to a service client", slog.String("user_id", resp.UserID))
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			for _, scope := range scopes {
+				if !slices.Contains(resp.Scopes, scope) {
+					log.Info("insufficient scope",
+						slog.String("client_id", resp.ClientID),
+						slog.String("required_scope", scope),
+					)
+					w.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope", scope="`+strings.Join(scopes, " ")+`"`)
+					http.Error(w, "Forbidden", http.StatusForbidden)
+					return
+				}
+			}
+
+			r.Header.Del("X-User-ID")
+			r.Header.Set("X-Client-ID", resp.ClientID)
+
+			log.Info("client authorization successful", slog.String("client_id", resp.ClientID))
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
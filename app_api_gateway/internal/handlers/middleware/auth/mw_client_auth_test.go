@@ -0,0 +1,141 @@
+package authmiddleware
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/go-playground/validator/v10"
+)
+
+// memAuth resolves access tokens from the map instead of checking them.
+type memAuth map[string]*ssomodels.AuthCheckResp
+
+func (m memAuth) AuthCheck(_ context.Context, authCheck *ssomodels.AuthCheck) (*ssomodels.AuthCheckResp, error) {
+	resp, ok := m[authCheck.AccessToken]
+	if !ok {
+		return nil, fmt.Errorf("memAuth: %w", ssoservice.ErrInvalidCredentials)
+	}
+	return resp, nil
+}
+
+var testTokens = memAuth{
+	"user":        {IsValid: true, UserID: "user"},
+	"reader":      {IsValid: true, ClientID: "reader", Scopes: []string{ScopeUsersRead}},
+	"provisioner": {IsValid: true, ClientID: "provisioner", Scopes: []string{ScopeUsersRead, ScopeScimProvision}},
+}
+
+// serve runs the request through the middleware and returns the response
+// with the headers seen by the handler.
+func serve(mw func(http.Handler) http.Handler, token string) (*httptest.ResponseRecorder, http.Header) {
+	var seen http.Header
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = r.Header.Clone()
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	// Identity headers of the client are never trusted
+	req.Header.Set("X-User-ID", "spoofed")
+	req.Header.Set("X-Client-ID", "spoofed")
+
+	rec := httptest.NewRecorder()
+	mw(next).ServeHTTP(rec, req)
+	return rec, seen
+}
+
+func TestClientAuthMiddleware(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	tests := []struct {
+		name         string
+		token        string
+		scopes       []string
+		wantStatus   int
+		wantClientID string
+	}{
+		{name: "granted scope", token: "reader", scopes: []string{ScopeUsersRead}, wantStatus: http.StatusOK, wantClientID: "reader"},
+		{name: "all scopes granted", token: "provisioner", scopes: []string{ScopeUsersRead, ScopeScimProvision}, wantStatus: http.StatusOK, wantClientID: "provisioner"},
+		{name: "scope not granted", token: "reader", scopes: []string{ScopeScimProvision}, wantStatus: http.StatusForbidden},
+		{name: "one of scopes not granted", token: "reader", scopes: []string{ScopeUsersRead, ScopeUsersImport}, wantStatus: http.StatusForbidden},
+		{name: "user token", token: "user", scopes: []string{ScopeUsersRead}, wantStatus: http.StatusUnauthorized},
+		{name: "invalid token", token: "invalid", scopes: []string{ScopeUsersRead}, wantStatus: http.StatusUnauthorized},
+		{name: "without token", scopes: []string{ScopeUsersRead}, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, seen := serve(ClientAuthMiddleware(log, validator.New(), testTokens, tt.scopes...), tt.token)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusForbidden && rec.Header().Get("WWW-Authenticate") == "" {
+				t.Error("WWW-Authenticate isn't set on insufficient scope")
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			if got := seen.Get("X-Client-ID"); got != tt.wantClientID {
+				t.Errorf("X-Client-ID = %q, want %q", got, tt.wantClientID)
+			}
+			if got := seen.Get("X-User-ID"); got != "" {
+				t.Errorf("X-User-ID = %q, want empty", got)
+			}
+		})
+	}
+}
+
+func TestAuthMiddlewareRejectsClientToken(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	tests := []struct {
+		name       string
+		token      string
+		wantStatus int
+		wantUserID string
+	}{
+		{name: "user token", token: "user", wantStatus: http.StatusOK, wantUserID: "user"},
+		{name: "client token", token: "provisioner", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// User routes take the token without the Bearer scheme
+			var seen http.Header
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				seen = r.Header.Clone()
+			})
+			req := httptest.NewRequest(http.MethodGet, "/lgs", nil)
+			req.Header.Set("Authorization", tt.token)
+			req.Header.Set("X-Client-ID", "spoofed")
+
+			rec := httptest.NewRecorder()
+			AuthMiddleware(log, validator.New(), testTokens)(next).ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			if got := seen.Get("X-User-ID"); got != tt.wantUserID {
+				t.Errorf("X-User-ID = %q, want %q", got, tt.wantUserID)
+			}
+			if got := seen.Get("X-Client-ID"); got != "" {
+				t.Errorf("X-Client-ID = %q, want empty", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package clientinfomiddleware
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/DimTur/lp_api_gateway/internal/lib/clientinfo"
+)
+
+// ClientInfoMiddleware puts IP address and User-Agent of the client to the request context.
+//
+// IP is taken from the connection, X-Forwarded-For is not trusted
+// because it can be set by the client. The gateway service has
+// externalTrafficPolicy: Local, so the connection isn't SNATed by the node.
+func ClientInfoMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			ip = r.RemoteAddr
+		}
+
+		ctx := clientinfo.WithInfo(r.Context(), clientinfo.Info{
+			IP:        ip,
+			UserAgent: r.UserAgent(),
+		})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
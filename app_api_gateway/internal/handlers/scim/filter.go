@@ -0,0 +1,142 @@
+package scimhandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	errInvalidFilter = errors.New("only eq comparisons joined with and are supported")
+	errInvalidPage   = errors.New("startIndex and count must be integers")
+)
+
+// filterExpr is a comparison of SCIM filter, only eq operator is supported.
+type filterExpr struct {
+	attr  string
+	value string
+}
+
+var (
+	filterExprRe = regexp.MustCompile(`^\s*([A-Za-z][\w.]*)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*"|(?i:true|false))\s*`)
+	filterAndRe  = regexp.MustCompile(`^(?i:and)\s+`)
+)
+
+// parseFilter parses filter like `userName eq "a@b.c" and active eq true`.
+// Attribute names are lowercased, they are case insensitive in SCIM.
+func parseFilter(filter string) ([]filterExpr, error) {
+	var exprs []filterExpr
+
+	rest := strings.TrimSpace(filter)
+	for rest != "" {
+		m := filterExprRe.FindStringSubmatch(rest)
+		if m == nil {
+			return nil, errInvalidFilter
+		}
+
+		value := strings.ToLower(m[2])
+		if strings.HasPrefix(m[2], `"`) {
+			unquoted, err := strconv.Unquote(m[2])
+			if err != nil {
+				return nil, errInvalidFilter
+			}
+			value = unquoted
+		}
+		exprs = append(exprs, filterExpr{
+			attr:  strings.ToLower(m[1]),
+			value: value,
+		})
+
+		rest = rest[len(m[0]):]
+		if rest == "" {
+			break
+		}
+		and := filterAndRe.FindString(rest)
+		if and == "" {
+			return nil, errInvalidFilter
+		}
+		rest = rest[len(and):]
+		if rest == "" {
+			return nil, errInvalidFilter
+		}
+	}
+
+	return exprs, nil
+}
+
+// parsePage returns one-based startIndex and count of the list request.
+// Zero count means default page size of sso.
+func parsePage(r *http.Request) (int64, int64, error) {
+	startIndex, count := int64(1), int64(0)
+
+	if s := r.URL.Query().Get("startIndex"); s != "" {
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, 0, errInvalidPage
+		}
+		startIndex = max(v, 1)
+	}
+	if s := r.URL.Query().Get("count"); s != "" {
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, 0, errInvalidPage
+		}
+		count = max(v, 0)
+	}
+
+	return startIndex, count, nil
+}
+
+// memberFilterRe matches remove path of the single member: members[value eq "id"].
+var memberFilterRe = regexp.MustCompile(`^(?i:members)\[(.+)\]$`)
+
+// parseMemberPath returns id of the member from path with value filter.
+func parseMemberPath(path string) (string, bool) {
+	m := memberFilterRe.FindStringSubmatch(path)
+	if m == nil {
+		return "", false
+	}
+
+	exprs, err := parseFilter(m[1])
+	if err != nil || len(exprs) != 1 || exprs[0].attr != "value" {
+		return "", false
+	}
+
+	return exprs[0].value, true
+}
+
+// decodeString decodes string value of PATCH operation.
+func decodeString(raw json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// decodeBool decodes bool value of PATCH operation. Some identity
+// providers send booleans as strings, so "True" and "false" are accepted too.
+func decodeBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+
+	s, err := decodeString(raw)
+	if err != nil {
+		return false, err
+	}
+	return strconv.ParseBool(strings.ToLower(s))
+}
+
+// decodeMembers decodes members value of PATCH operation.
+func decodeMembers(raw json.RawMessage) ([]string, error) {
+	var members []Member
+	if err := json.Unmarshal(raw, &members); err != nil {
+		return nil, err
+	}
+	return memberIDs(members), nil
+}
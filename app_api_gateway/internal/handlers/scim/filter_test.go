@@ -0,0 +1,158 @@
+package scimhandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		want    []filterExpr
+		wantErr bool
+	}{
+		{name: "empty", filter: "  ", want: nil},
+		{
+			name:   "single eq",
+			filter: `userName eq "john@example.com"`,
+			want:   []filterExpr{{attr: "username", value: "john@example.com"}},
+		},
+		{
+			name:   "operator case insensitive",
+			filter: `displayName EQ "Group"`,
+			want:   []filterExpr{{attr: "displayname", value: "Group"}},
+		},
+		{
+			name:   "escaped quote",
+			filter: `displayName eq "a \"quoted\" name"`,
+			want:   []filterExpr{{attr: "displayname", value: `a "quoted" name`}},
+		},
+		{
+			name:   "joined with and",
+			filter: `userName eq "john@example.com" AND active eq True`,
+			want: []filterExpr{
+				{attr: "username", value: "john@example.com"},
+				{attr: "active", value: "true"},
+			},
+		},
+		{
+			name:   "dotted attribute",
+			filter: `emails.value eq "john@example.com"`,
+			want:   []filterExpr{{attr: "emails.value", value: "john@example.com"}},
+		},
+		{name: "unsupported operator", filter: `userName co "john"`, wantErr: true},
+		{name: "or is not supported", filter: `active eq true or active eq false`, wantErr: true},
+		{name: "dangling and", filter: `active eq true and `, wantErr: true},
+		{name: "unquoted string", filter: `userName eq john`, wantErr: true},
+		{name: "missing value", filter: `userName eq`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseFilter(tt.filter)
+			if tt.wantErr {
+				if !errors.Is(err, errInvalidFilter) {
+					t.Fatalf("parseFilter() error = %v, want %v", err, errInvalidFilter)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseFilter() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseFilter() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePage(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		wantStartIndex int64
+		wantCount      int64
+		wantErr        bool
+	}{
+		{name: "defaults", query: "", wantStartIndex: 1, wantCount: 0},
+		{name: "given", query: "startIndex=11&count=10", wantStartIndex: 11, wantCount: 10},
+		{name: "below minimum", query: "startIndex=-5&count=-1", wantStartIndex: 1, wantCount: 0},
+		{name: "invalid startIndex", query: "startIndex=first", wantErr: true},
+		{name: "invalid count", query: "count=all", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/scim/v2/Users?"+tt.query, nil)
+
+			startIndex, count, err := parsePage(r)
+			if tt.wantErr {
+				if !errors.Is(err, errInvalidPage) {
+					t.Fatalf("parsePage() error = %v, want %v", err, errInvalidPage)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parsePage() error = %v", err)
+			}
+			if startIndex != tt.wantStartIndex || count != tt.wantCount {
+				t.Errorf("parsePage() = %d, %d, want %d, %d", startIndex, count, tt.wantStartIndex, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestParseMemberPath(t *testing.T) {
+	tests := []struct {
+		path   string
+		wantID string
+		wantOK bool
+	}{
+		{path: `members[value eq "123"]`, wantID: "123", wantOK: true},
+		{path: `Members[Value EQ "123"]`, wantID: "123", wantOK: true},
+		{path: `members`, wantOK: false},
+		{path: `members[display eq "123"]`, wantOK: false},
+		{path: `members[value eq "1" and value eq "2"]`, wantOK: false},
+		{path: `emails[value eq "123"]`, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			id, ok := parseMemberPath(tt.path)
+			if ok != tt.wantOK || id != tt.wantID {
+				t.Errorf("parseMemberPath() = %q, %v, want %q, %v", id, ok, tt.wantID, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestDecodeBool(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    bool
+		wantErr bool
+	}{
+		{raw: `true`, want: true},
+		{raw: `false`, want: false},
+		{raw: `"True"`, want: true},
+		{raw: `"false"`, want: false},
+		{raw: `"yes"`, wantErr: true},
+		{raw: `1.5`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := decodeBool(json.RawMessage(tt.raw))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decodeBool() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("decodeBool() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,479 @@
+package scimhandler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-playground/validator/v10"
+)
+
+// ListGroups godoc
+// @Summary      List SCIM groups
+// @Description  SCIM 2.0 endpoint which returns page of learning groups with their learners. Filter supports eq comparison of displayName. It requires a service client token with scim:provision scope.
+// @Tags         scim
+// @Produce      json
+// @Param        filter query string false "SCIM filter, e.g. displayName eq \"Group\""
+// @Param        startIndex query int false "One-based index of the first group"
+// @Param        count query int false "Page size, max 100"
+// @Success      200 {object} scimhandler.ListResponse
+// @Failure      400 {object} scimhandler.Error "Invalid filter or page"
+// @Failure      401 {string} string "Unauthorized"
+// @Failure      403 {string} string "Insufficient scope"
+// @Failure      500 {object} scimhandler.Error "Server error"
+// @Router       /scim/v2/Groups [get]
+// @Security ApiKeyAuth
+func ListGroups(log *slog.Logger, val *validator.Validate, scimService ScimService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scim.ListGroups"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		clientID := r.Header.Get("X-Client-ID")
+		if clientID == "" {
+			log.Error("missing X-Client-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		startIndex, count, err := parsePage(r)
+		if err != nil {
+			log.Error("invalid page", slog.String("err", err.Error()))
+			renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidValue, err.Error())
+			return
+		}
+
+		list := &ssomodels.ScimListGroups{
+			ClientID:   clientID,
+			StartIndex: startIndex,
+			Count:      count,
+		}
+
+		exprs, err := parseFilter(r.URL.Query().Get("filter"))
+		if err != nil {
+			log.Error("invalid filter", slog.String("err", err.Error()))
+			renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidFilter, err.Error())
+			return
+		}
+		for _, expr := range exprs {
+			if expr.attr != "displayname" {
+				log.Error("unsupported filter attribute", slog.String("attr", expr.attr))
+				renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidFilter, "unsupported filter attribute "+expr.attr)
+				return
+			}
+			list.DisplayName = expr.value
+		}
+
+		log.Info("listing scim groups", slog.String("client_id", clientID))
+
+		resp, err := scimService.ScimListGroups(r.Context(), list)
+		if err != nil {
+			renderGroupError(w, log, err)
+			return
+		}
+
+		resources := make([]any, 0, len(resp.Groups))
+		for _, group := range resp.Groups {
+			resources = append(resources, groupFromModel(group))
+		}
+
+		log.Info("scim groups listed")
+
+		renderScim(w, log, http.StatusOK, ListResponse{
+			Schemas:      []string{SchemaListResponse},
+			TotalResults: resp.Total,
+			StartIndex:   startIndex,
+			ItemsPerPage: len(resources),
+			Resources:    resources,
+		})
+	}
+}
+
+// GetGroup godoc
+// @Summary      Get SCIM group
+// @Description  SCIM 2.0 endpoint which returns the learning group with its learners. It requires a service client token with scim:provision scope.
+// @Tags         scim
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Success      200 {object} scimhandler.Group
+// @Failure      401 {string} string "Unauthorized"
+// @Failure      403 {string} string "Insufficient scope"
+// @Failure      404 {object} scimhandler.Error "Group not found"
+// @Failure      500 {object} scimhandler.Error "Server error"
+// @Router       /scim/v2/Groups/{id} [get]
+// @Security ApiKeyAuth
+func GetGroup(log *slog.Logger, val *validator.Validate, scimService ScimService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scim.GetGroup"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		clientID := r.Header.Get("X-Client-ID")
+		if clientID == "" {
+			log.Error("missing X-Client-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		groupID := chi.URLParam(r, "id")
+
+		log.Info("getting scim group", slog.String("client_id", clientID), slog.String("learning_group_id", groupID))
+
+		group, err := scimService.ScimGetGroup(r.Context(), &ssomodels.ScimManageGroup{
+			ClientID: clientID,
+			GroupID:  groupID,
+		})
+		if err != nil {
+			renderGroupError(w, log, err)
+			return
+		}
+
+		log.Info("scim group got")
+
+		renderScim(w, log, http.StatusOK, groupFromModel(group))
+	}
+}
+
+// CreateGroup godoc
+// @Summary      Create SCIM group
+// @Description  SCIM 2.0 endpoint which creates the learning group, members become learners of the group. Group admins are assigned in the platform. It requires a service client token with scim:provision scope.
+// @Tags         scim
+// @Accept       json
+// @Produce      json
+// @Param        scimhandler.Group body scimhandler.Group true "SCIM group"
+// @Success      201 {object} scimhandler.Group
+// @Failure      400 {object} scimhandler.Error "Invalid data in the request or unknown member"
+// @Failure      401 {string} string "Unauthorized"
+// @Failure      403 {string} string "Insufficient scope"
+// @Failure      409 {object} scimhandler.Error "Group already exists"
+// @Failure      500 {object} scimhandler.Error "Server error"
+// @Router       /scim/v2/Groups [post]
+// @Security ApiKeyAuth
+func CreateGroup(log *slog.Logger, val *validator.Validate, scimService ScimService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scim.CreateGroup"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		clientID := r.Header.Get("X-Client-ID")
+		if clientID == "" {
+			log.Error("missing X-Client-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		var req Group
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidSyntax, "failed to decode request")
+			return
+		}
+
+		create := &ssomodels.ScimCreateGroup{
+			ClientID:    clientID,
+			DisplayName: req.DisplayName,
+			Members:     memberIDs(req.Members),
+		}
+		if err := val.Struct(create); err != nil {
+			log.Error("invalid request", slog.String("err", err.Error()))
+			renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidValue, "invalid displayName or members")
+			return
+		}
+
+		log.Info("creating scim group", slog.String("client_id", clientID))
+
+		group, err := scimService.ScimCreateGroup(r.Context(), create)
+		if err != nil {
+			renderGroupError(w, log, err)
+			return
+		}
+
+		log.Info("scim group created", slog.String("learning_group_id", group.ID))
+
+		resp := groupFromModel(group)
+		w.Header().Set("Location", resp.Meta.Location)
+		renderScim(w, log, http.StatusCreated, resp)
+	}
+}
+
+// ReplaceGroup godoc
+// @Summary      Replace SCIM group
+// @Description  SCIM 2.0 endpoint which replaces display name and learners of the learning group. It requires a service client token with scim:provision scope.
+// @Tags         scim
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Param        scimhandler.Group body scimhandler.Group true "SCIM group"
+// @Success      200 {object} scimhandler.Group
+// @Failure      400 {object} scimhandler.Error "Invalid data in the request or unknown member"
+// @Failure      401 {string} string "Unauthorized"
+// @Failure      403 {string} string "Insufficient scope"
+// @Failure      404 {object} scimhandler.Error "Group not found"
+// @Failure      500 {object} scimhandler.Error "Server error"
+// @Router       /scim/v2/Groups/{id} [put]
+// @Security ApiKeyAuth
+func ReplaceGroup(log *slog.Logger, val *validator.Validate, scimService ScimService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scim.ReplaceGroup"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		clientID := r.Header.Get("X-Client-ID")
+		if clientID == "" {
+			log.Error("missing X-Client-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		groupID := chi.URLParam(r, "id")
+
+		var req Group
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidSyntax, "failed to decode request")
+			return
+		}
+
+		updateGroup(w, r, log, val, scimService, &ssomodels.ScimUpdateGroup{
+			ClientID:    clientID,
+			GroupID:     groupID,
+			DisplayName: req.DisplayName,
+			Members:     memberIDs(req.Members),
+		})
+	}
+}
+
+// PatchGroup godoc
+// @Summary      Patch SCIM group
+// @Description  SCIM 2.0 endpoint which applies patch operations to displayName and members of the learning group. Add and remove of members don't touch other learners, remove path members[value eq "id"] is supported. It requires a service client token with scim:provision scope.
+// @Tags         scim
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Param        scimhandler.PatchOp body scimhandler.PatchOp true "SCIM patch operations"
+// @Success      200 {object} scimhandler.Group
+// @Failure      400 {object} scimhandler.Error "Invalid patch operation or unknown member"
+// @Failure      401 {string} string "Unauthorized"
+// @Failure      403 {string} string "Insufficient scope"
+// @Failure      404 {object} scimhandler.Error "Group not found"
+// @Failure      500 {object} scimhandler.Error "Server error"
+// @Router       /scim/v2/Groups/{id} [patch]
+// @Security ApiKeyAuth
+func PatchGroup(log *slog.Logger, val *validator.Validate, scimService ScimService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scim.PatchGroup"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		clientID := r.Header.Get("X-Client-ID")
+		if clientID == "" {
+			log.Error("missing X-Client-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		groupID := chi.URLParam(r, "id")
+
+		var req PatchOp
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidSyntax, "failed to decode request")
+			return
+		}
+
+		patch, err := parseGroupPatch(req.Operations)
+		if err != nil {
+			log.Error("invalid patch operations", slog.String("err", err.Error()))
+			renderPatchError(w, log, err)
+			return
+		}
+
+		manage := &ssomodels.ScimManageGroup{
+			ClientID: clientID,
+			GroupID:  groupID,
+		}
+
+		// Membership changes don't need the group, so concurrent
+		// patches of the same group don't overwrite each other
+		if patch.incremental() {
+			if len(patch.add) > 0 || len(patch.remove) > 0 {
+				change := &ssomodels.ScimChangeGroupMembers{
+					ClientID: clientID,
+					GroupID:  groupID,
+					Add:      patch.add,
+					Remove:   patch.remove,
+				}
+				if err := val.Struct(change); err != nil {
+					log.Error("invalid request", slog.String("err", err.Error()))
+					renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidValue, "invalid members")
+					return
+				}
+
+				log.Info("changing scim group members", slog.String("client_id", clientID), slog.String("learning_group_id", groupID))
+
+				if _, err := scimService.ScimChangeGroupMembers(r.Context(), change); err != nil {
+					renderGroupError(w, log, err)
+					return
+				}
+			}
+
+			group, err := scimService.ScimGetGroup(r.Context(), manage)
+			if err != nil {
+				renderGroupError(w, log, err)
+				return
+			}
+
+			log.Info("scim group patched")
+
+			renderScim(w, log, http.StatusOK, groupFromModel(group))
+			return
+		}
+
+		group, err := scimService.ScimGetGroup(r.Context(), manage)
+		if err != nil {
+			renderGroupError(w, log, err)
+			return
+		}
+
+		upd := &ssomodels.ScimUpdateGroup{
+			ClientID:    clientID,
+			GroupID:     groupID,
+			DisplayName: group.DisplayName,
+			Members:     patch.members,
+		}
+		if patch.displayName != "" {
+			upd.DisplayName = patch.displayName
+		}
+		if !patch.replaced {
+			members := slices.Clone(group.Members)
+			for _, id := range patch.add {
+				if !slices.Contains(members, id) {
+					members = append(members, id)
+				}
+			}
+			upd.Members = slices.DeleteFunc(members, func(id string) bool {
+				return slices.Contains(patch.remove, id)
+			})
+		}
+
+		updateGroup(w, r, log, val, scimService, upd)
+	}
+}
+
+// DeleteGroup godoc
+// @Summary      Delete SCIM group
+// @Description  SCIM 2.0 endpoint which deletes the learning group with its invites and roles. Users aren't touched. It requires a service client token with scim:provision scope.
+// @Tags         scim
+// @Param        id path string true "ID of the learning group"
+// @Success      204 "Group is deleted"
+// @Failure      401 {string} string "Unauthorized"
+// @Failure      403 {string} string "Insufficient scope"
+// @Failure      404 {object} scimhandler.Error "Group not found"
+// @Failure      500 {object} scimhandler.Error "Server error"
+// @Router       /scim/v2/Groups/{id} [delete]
+// @Security ApiKeyAuth
+func DeleteGroup(log *slog.Logger, val *validator.Validate, scimService ScimService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scim.DeleteGroup"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		clientID := r.Header.Get("X-Client-ID")
+		if clientID == "" {
+			log.Error("missing X-Client-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		groupID := chi.URLParam(r, "id")
+
+		log.Info("deleting scim group", slog.String("client_id", clientID), slog.String("learning_group_id", groupID))
+
+		_, err := scimService.ScimDeleteGroup(r.Context(), &ssomodels.ScimManageGroup{
+			ClientID: clientID,
+			GroupID:  groupID,
+		})
+		if err != nil {
+			renderGroupError(w, log, err)
+			return
+		}
+
+		log.Info("scim group deleted")
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// updateGroup is shared by PUT and PATCH which replace the whole group.
+func updateGroup(w http.ResponseWriter, r *http.Request, log *slog.Logger, val *validator.Validate, scimService ScimService, upd *ssomodels.ScimUpdateGroup) {
+	if err := val.Struct(upd); err != nil {
+		log.Error("invalid request", slog.String("err", err.Error()))
+		renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidValue, "invalid displayName or members")
+		return
+	}
+
+	log.Info("updating scim group", slog.String("client_id", upd.ClientID), slog.String("learning_group_id", upd.GroupID))
+
+	group, err := scimService.ScimUpdateGroup(r.Context(), upd)
+	if err != nil {
+		renderGroupError(w, log, err)
+		return
+	}
+
+	log.Info("scim group updated")
+
+	renderScim(w, log, http.StatusOK, groupFromModel(group))
+}
+
+func renderGroupError(w http.ResponseWriter, log *slog.Logger, err error) {
+	switch {
+	case errors.Is(err, ssoservice.ErrInvalidCredentials):
+		log.Error("invalid credentials", slog.String("err", err.Error()))
+		renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidValue, "invalid credentials")
+	case errors.Is(err, ssoservice.ErrMemberNotFound):
+		log.Error("member not found", slog.String("err", err.Error()))
+		renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidValue, "member not found")
+	case errors.Is(err, ssoservice.ErrGroupNotFound):
+		log.Error("group not found", slog.String("err", err.Error()))
+		renderScimError(w, log, http.StatusNotFound, "", "group not found")
+	case errors.Is(err, ssoservice.ErrGroupExists):
+		log.Error("group already exists", slog.String("err", err.Error()))
+		renderScimError(w, log, http.StatusConflict, scimTypeUniqueness, "group already exists")
+	default:
+		log.Error("internal error", slog.String("err", err.Error()))
+		renderScimError(w, log, http.StatusInternalServerError, "", "internal error")
+	}
+}
@@ -0,0 +1,459 @@
+package scimhandler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-playground/validator/v10"
+)
+
+// ScimService provisions users and learning groups on behalf of service clients.
+type ScimService interface {
+	ScimListUsers(ctx context.Context, list *ssomodels.ScimListUsers) (*ssomodels.ScimListUsersResp, error)
+	ScimGetUser(ctx context.Context, get *ssomodels.ScimManageUser) (*ssomodels.ScimUser, error)
+	ScimCreateUser(ctx context.Context, create *ssomodels.ScimCreateUser) (*ssomodels.ScimUser, error)
+	ScimUpdateUser(ctx context.Context, upd *ssomodels.ScimUpdateUser) (*ssomodels.ScimUser, error)
+	ScimDeleteUser(ctx context.Context, del *ssomodels.ScimManageUser) (*ssomodels.ScimResp, error)
+	ScimListGroups(ctx context.Context, list *ssomodels.ScimListGroups) (*ssomodels.ScimListGroupsResp, error)
+	ScimGetGroup(ctx context.Context, get *ssomodels.ScimManageGroup) (*ssomodels.ScimGroup, error)
+	ScimCreateGroup(ctx context.Context, create *ssomodels.ScimCreateGroup) (*ssomodels.ScimGroup, error)
+	ScimUpdateGroup(ctx context.Context, upd *ssomodels.ScimUpdateGroup) (*ssomodels.ScimGroup, error)
+	ScimChangeGroupMembers(ctx context.Context, change *ssomodels.ScimChangeGroupMembers) (*ssomodels.ScimResp, error)
+	ScimDeleteGroup(ctx context.Context, del *ssomodels.ScimManageGroup) (*ssomodels.ScimResp, error)
+}
+
+// ListUsers godoc
+// @Summary      List SCIM users
+// @Description  SCIM 2.0 endpoint which returns page of platform users. Filter supports eq comparisons of userName, emails.value and active joined with and. It requires a service client token with scim:provision scope.
+// @Tags         scim
+// @Produce      json
+// @Param        filter query string false "SCIM filter, e.g. userName eq \"user@example.com\""
+// @Param        startIndex query int false "One-based index of the first user"
+// @Param        count query int false "Page size, max 100"
+// @Success      200 {object} scimhandler.ListResponse
+// @Failure      400 {object} scimhandler.Error "Invalid filter or page"
+// @Failure      401 {string} string "Unauthorized"
+// @Failure      403 {string} string "Insufficient scope"
+// @Failure      500 {object} scimhandler.Error "Server error"
+// @Router       /scim/v2/Users [get]
+// @Security ApiKeyAuth
+func ListUsers(log *slog.Logger, val *validator.Validate, scimService ScimService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scim.ListUsers"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		clientID := r.Header.Get("X-Client-ID")
+		if clientID == "" {
+			log.Error("missing X-Client-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		startIndex, count, err := parsePage(r)
+		if err != nil {
+			log.Error("invalid page", slog.String("err", err.Error()))
+			renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidValue, err.Error())
+			return
+		}
+
+		list := &ssomodels.ScimListUsers{
+			ClientID:   clientID,
+			StartIndex: startIndex,
+			Count:      count,
+		}
+
+		exprs, err := parseFilter(r.URL.Query().Get("filter"))
+		if err != nil {
+			log.Error("invalid filter", slog.String("err", err.Error()))
+			renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidFilter, err.Error())
+			return
+		}
+		for _, expr := range exprs {
+			switch expr.attr {
+			case "username", "emails.value", "emails":
+				list.Email = expr.value
+			case "active":
+				list.Active = expr.value
+			default:
+				log.Error("unsupported filter attribute", slog.String("attr", expr.attr))
+				renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidFilter, "unsupported filter attribute "+expr.attr)
+				return
+			}
+		}
+
+		log.Info("listing scim users", slog.String("client_id", clientID))
+
+		resp, err := scimService.ScimListUsers(r.Context(), list)
+		if err != nil {
+			renderUserError(w, log, err)
+			return
+		}
+
+		resources := make([]any, 0, len(resp.Users))
+		for _, user := range resp.Users {
+			resources = append(resources, userFromModel(user))
+		}
+
+		log.Info("scim users listed")
+
+		renderScim(w, log, http.StatusOK, ListResponse{
+			Schemas:      []string{SchemaListResponse},
+			TotalResults: resp.Total,
+			StartIndex:   startIndex,
+			ItemsPerPage: len(resources),
+			Resources:    resources,
+		})
+	}
+}
+
+// GetUser godoc
+// @Summary      Get SCIM user
+// @Description  SCIM 2.0 endpoint which returns the platform user. It requires a service client token with scim:provision scope.
+// @Tags         scim
+// @Produce      json
+// @Param        id path string true "ID of the user"
+// @Success      200 {object} scimhandler.User
+// @Failure      401 {string} string "Unauthorized"
+// @Failure      403 {string} string "Insufficient scope"
+// @Failure      404 {object} scimhandler.Error "User not found"
+// @Failure      500 {object} scimhandler.Error "Server error"
+// @Router       /scim/v2/Users/{id} [get]
+// @Security ApiKeyAuth
+func GetUser(log *slog.Logger, val *validator.Validate, scimService ScimService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scim.GetUser"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		clientID := r.Header.Get("X-Client-ID")
+		if clientID == "" {
+			log.Error("missing X-Client-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		userID := chi.URLParam(r, "id")
+
+		log.Info("getting scim user", slog.String("client_id", clientID), slog.String("user_id", userID))
+
+		user, err := scimService.ScimGetUser(r.Context(), &ssomodels.ScimManageUser{
+			ClientID: clientID,
+			UserID:   userID,
+		})
+		if err != nil {
+			renderUserError(w, log, err)
+			return
+		}
+
+		log.Info("scim user got")
+
+		renderScim(w, log, http.StatusOK, userFromModel(user))
+	}
+}
+
+// CreateUser godoc
+// @Summary      Create SCIM user
+// @Description  SCIM 2.0 endpoint which creates the platform user without password. userName is the email, active user gets an invite to set the password. It requires a service client token with scim:provision scope.
+// @Tags         scim
+// @Accept       json
+// @Produce      json
+// @Param        scimhandler.User body scimhandler.User true "SCIM user"
+// @Success      201 {object} scimhandler.User
+// @Failure      400 {object} scimhandler.Error "Invalid data in the request"
+// @Failure      401 {string} string "Unauthorized"
+// @Failure      403 {string} string "Insufficient scope"
+// @Failure      409 {object} scimhandler.Error "User with the email already exists"
+// @Failure      500 {object} scimhandler.Error "Server error"
+// @Router       /scim/v2/Users [post]
+// @Security ApiKeyAuth
+func CreateUser(log *slog.Logger, val *validator.Validate, scimService ScimService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scim.CreateUser"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		clientID := r.Header.Get("X-Client-ID")
+		if clientID == "" {
+			log.Error("missing X-Client-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		var req User
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidSyntax, "failed to decode request")
+			return
+		}
+
+		create := &ssomodels.ScimCreateUser{
+			ClientID: clientID,
+			Email:    req.email(),
+			Name:     req.name(),
+			Active:   req.Active == nil || *req.Active,
+		}
+		if err := val.Struct(create); err != nil {
+			log.Error("invalid request", slog.String("err", err.Error()))
+			renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidValue, "invalid userName or name")
+			return
+		}
+
+		log.Info("creating scim user", slog.String("client_id", clientID))
+
+		user, err := scimService.ScimCreateUser(r.Context(), create)
+		if err != nil {
+			renderUserError(w, log, err)
+			return
+		}
+
+		log.Info("scim user created", slog.String("user_id", user.ID))
+
+		resp := userFromModel(user)
+		w.Header().Set("Location", resp.Meta.Location)
+		renderScim(w, log, http.StatusCreated, resp)
+	}
+}
+
+// ReplaceUser godoc
+// @Summary      Replace SCIM user
+// @Description  SCIM 2.0 endpoint which replaces email, name and active state of the platform user. Deactivated user is logged out, changed email must be verified again. Platform admins can't be changed. It requires a service client token with scim:provision scope.
+// @Tags         scim
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID of the user"
+// @Param        scimhandler.User body scimhandler.User true "SCIM user"
+// @Success      200 {object} scimhandler.User
+// @Failure      400 {object} scimhandler.Error "Invalid data in the request"
+// @Failure      401 {string} string "Unauthorized"
+// @Failure      403 {string} string "Insufficient scope or the user is platform admin"
+// @Failure      404 {object} scimhandler.Error "User not found"
+// @Failure      409 {object} scimhandler.Error "User with the email already exists"
+// @Failure      500 {object} scimhandler.Error "Server error"
+// @Router       /scim/v2/Users/{id} [put]
+// @Security ApiKeyAuth
+func ReplaceUser(log *slog.Logger, val *validator.Validate, scimService ScimService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scim.ReplaceUser"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		clientID := r.Header.Get("X-Client-ID")
+		if clientID == "" {
+			log.Error("missing X-Client-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		userID := chi.URLParam(r, "id")
+
+		var req User
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidSyntax, "failed to decode request")
+			return
+		}
+
+		upd := &ssomodels.ScimUpdateUser{
+			ClientID: clientID,
+			UserID:   userID,
+			Email:    req.email(),
+			Name:     req.name(),
+			Active:   req.Active == nil || *req.Active,
+		}
+
+		updateUser(w, r, log, val, scimService, upd)
+	}
+}
+
+// PatchUser godoc
+// @Summary      Patch SCIM user
+// @Description  SCIM 2.0 endpoint which applies add and replace operations to userName, emails, displayName, name.formatted and active of the platform user. Changed email must be verified again, platform admins can't be changed. It requires a service client token with scim:provision scope.
+// @Tags         scim
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID of the user"
+// @Param        scimhandler.PatchOp body scimhandler.PatchOp true "SCIM patch operations"
+// @Success      200 {object} scimhandler.User
+// @Failure      400 {object} scimhandler.Error "Invalid patch operation"
+// @Failure      401 {string} string "Unauthorized"
+// @Failure      403 {string} string "Insufficient scope or the user is platform admin"
+// @Failure      404 {object} scimhandler.Error "User not found"
+// @Failure      409 {object} scimhandler.Error "User with the email already exists"
+// @Failure      500 {object} scimhandler.Error "Server error"
+// @Router       /scim/v2/Users/{id} [patch]
+// @Security ApiKeyAuth
+func PatchUser(log *slog.Logger, val *validator.Validate, scimService ScimService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scim.PatchUser"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		clientID := r.Header.Get("X-Client-ID")
+		if clientID == "" {
+			log.Error("missing X-Client-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		userID := chi.URLParam(r, "id")
+
+		var req PatchOp
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidSyntax, "failed to decode request")
+			return
+		}
+
+		user, err := scimService.ScimGetUser(r.Context(), &ssomodels.ScimManageUser{
+			ClientID: clientID,
+			UserID:   userID,
+		})
+		if err != nil {
+			renderUserError(w, log, err)
+			return
+		}
+
+		upd := &ssomodels.ScimUpdateUser{
+			ClientID: clientID,
+			UserID:   userID,
+			Email:    user.Email,
+			Name:     user.Name,
+			Active:   user.Active,
+		}
+		if err := applyUserPatch(upd, req.Operations); err != nil {
+			log.Error("invalid patch operations", slog.String("err", err.Error()))
+			renderPatchError(w, log, err)
+			return
+		}
+
+		updateUser(w, r, log, val, scimService, upd)
+	}
+}
+
+// DeleteUser godoc
+// @Summary      Delete SCIM user
+// @Description  SCIM 2.0 endpoint which deactivates the platform user. Accounts aren't deleted, the user is logged out and can be reactivated. Platform admins can't be deactivated. It requires a service client token with scim:provision scope.
+// @Tags         scim
+// @Param        id path string true "ID of the user"
+// @Success      204 "User is deactivated"
+// @Failure      401 {string} string "Unauthorized"
+// @Failure      403 {string} string "Insufficient scope or the user is platform admin"
+// @Failure      404 {object} scimhandler.Error "User not found"
+// @Failure      500 {object} scimhandler.Error "Server error"
+// @Router       /scim/v2/Users/{id} [delete]
+// @Security ApiKeyAuth
+func DeleteUser(log *slog.Logger, val *validator.Validate, scimService ScimService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.scim.DeleteUser"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		clientID := r.Header.Get("X-Client-ID")
+		if clientID == "" {
+			log.Error("missing X-Client-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		userID := chi.URLParam(r, "id")
+
+		log.Info("deactivating scim user", slog.String("client_id", clientID), slog.String("user_id", userID))
+
+		_, err := scimService.ScimDeleteUser(r.Context(), &ssomodels.ScimManageUser{
+			ClientID: clientID,
+			UserID:   userID,
+		})
+		if err != nil {
+			renderUserError(w, log, err)
+			return
+		}
+
+		log.Info("scim user deactivated")
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// updateUser is shared by PUT and PATCH, both of them replace the whole user.
+func updateUser(w http.ResponseWriter, r *http.Request, log *slog.Logger, val *validator.Validate, scimService ScimService, upd *ssomodels.ScimUpdateUser) {
+	if err := val.Struct(upd); err != nil {
+		log.Error("invalid request", slog.String("err", err.Error()))
+		renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidValue, "invalid userName or name")
+		return
+	}
+
+	log.Info("updating scim user", slog.String("client_id", upd.ClientID), slog.String("user_id", upd.UserID))
+
+	user, err := scimService.ScimUpdateUser(r.Context(), upd)
+	if err != nil {
+		renderUserError(w, log, err)
+		return
+	}
+
+	log.Info("scim user updated")
+
+	renderScim(w, log, http.StatusOK, userFromModel(user))
+}
+
+func renderUserError(w http.ResponseWriter, log *slog.Logger, err error) {
+	switch {
+	case errors.Is(err, ssoservice.ErrInvalidCredentials):
+		log.Error("invalid credentials", slog.String("err", err.Error()))
+		renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidValue, "invalid credentials")
+	case errors.Is(err, ssoservice.ErrUserNotFound):
+		log.Error("user not found", slog.String("err", err.Error()))
+		renderScimError(w, log, http.StatusNotFound, "", "user not found")
+	case errors.Is(err, ssoservice.ErrUserExists):
+		log.Error("user already exists", slog.String("err", err.Error()))
+		renderScimError(w, log, http.StatusConflict, scimTypeUniqueness, "user with the email already exists")
+	case errors.Is(err, ssoservice.ErrPermissionDenied):
+		log.Error("permissions denied", slog.String("err", err.Error()))
+		renderScimError(w, log, http.StatusForbidden, "", "admin can't be changed by scim client")
+	default:
+		log.Error("internal error", slog.String("err", err.Error()))
+		renderScimError(w, log, http.StatusInternalServerError, "", "internal error")
+	}
+}
+
+func renderPatchError(w http.ResponseWriter, log *slog.Logger, err error) {
+	if errors.Is(err, errInvalidPath) {
+		renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidPath, err.Error())
+		return
+	}
+	renderScimError(w, log, http.StatusBadRequest, scimTypeInvalidValue, err.Error())
+}
@@ -0,0 +1,195 @@
+package scimhandler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+)
+
+var (
+	errInvalidPatch = errors.New("invalid patch operation")
+	errInvalidPath  = errors.New("unsupported patch path")
+)
+
+// applyUserPatch applies add and replace operations to the user.
+// Platform users have no optional attributes, so remove isn't supported.
+func applyUserPatch(upd *ssomodels.ScimUpdateUser, ops []PatchOperation) error {
+	for _, op := range ops {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+		case "remove":
+			return fmt.Errorf("%w: remove of user attributes", errInvalidPath)
+		default:
+			return fmt.Errorf("%w: %s", errInvalidPatch, op.Op)
+		}
+
+		if op.Path == "" {
+			var user User
+			if err := json.Unmarshal(op.Value, &user); err != nil {
+				return fmt.Errorf("%w: %s", errInvalidPatch, err)
+			}
+			if email := user.email(); email != "" {
+				upd.Email = email
+			}
+			if name := user.name(); name != "" {
+				upd.Name = name
+			}
+			if user.Active != nil {
+				upd.Active = *user.Active
+			}
+			continue
+		}
+
+		path := strings.ToLower(op.Path)
+		var err error
+		switch {
+		case path == "active":
+			upd.Active, err = decodeBool(op.Value)
+		case path == "username":
+			upd.Email, err = decodeString(op.Value)
+		case path == "displayname", path == "name.formatted":
+			upd.Name, err = decodeString(op.Value)
+		case path == "emails":
+			var emails []Email
+			err = json.Unmarshal(op.Value, &emails)
+			if email := (&User{Emails: emails}).email(); err == nil && email != "" {
+				upd.Email = email
+			}
+		case strings.HasPrefix(path, "emails[") && strings.HasSuffix(path, "].value"):
+			upd.Email, err = decodeString(op.Value)
+		default:
+			return fmt.Errorf("%w: %s", errInvalidPath, op.Path)
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s", errInvalidPatch, err)
+		}
+	}
+
+	return nil
+}
+
+// groupPatch is a result of PATCH operations of the group. Add and remove
+// of members are sent to sso as incremental change. Replace of members or
+// display name needs the whole group, then members is the new list.
+type groupPatch struct {
+	displayName string
+	members     []string
+	replaced    bool
+	add         []string
+	remove      []string
+}
+
+func (p *groupPatch) addMembers(ids []string) {
+	if p.replaced {
+		for _, id := range ids {
+			if !slices.Contains(p.members, id) {
+				p.members = append(p.members, id)
+			}
+		}
+		return
+	}
+	p.add = append(p.add, ids...)
+}
+
+func (p *groupPatch) removeMembers(ids []string) {
+	if p.replaced {
+		p.members = slices.DeleteFunc(p.members, func(id string) bool {
+			return slices.Contains(ids, id)
+		})
+		return
+	}
+	p.remove = append(p.remove, ids...)
+}
+
+func (p *groupPatch) replaceMembers(ids []string) {
+	p.replaced = true
+	p.members = ids
+	p.add = nil
+	p.remove = nil
+}
+
+// incremental is true if the patch only adds and removes members.
+func (p *groupPatch) incremental() bool {
+	return p.displayName == "" && !p.replaced
+}
+
+func parseGroupPatch(ops []PatchOperation) (*groupPatch, error) {
+	patch := &groupPatch{}
+
+	for _, op := range ops {
+		path := strings.ToLower(op.Path)
+
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			replace := strings.EqualFold(op.Op, "replace")
+
+			switch path {
+			case "":
+				var group struct {
+					DisplayName string   `json:"displayName"`
+					Members     []Member `json:"members"`
+				}
+				if err := json.Unmarshal(op.Value, &group); err != nil {
+					return nil, fmt.Errorf("%w: %s", errInvalidPatch, err)
+				}
+				if group.DisplayName != "" {
+					patch.displayName = group.DisplayName
+				}
+				if group.Members != nil {
+					if replace {
+						patch.replaceMembers(memberIDs(group.Members))
+					} else {
+						patch.addMembers(memberIDs(group.Members))
+					}
+				}
+			case "displayname":
+				name, err := decodeString(op.Value)
+				if err != nil {
+					return nil, fmt.Errorf("%w: %s", errInvalidPatch, err)
+				}
+				patch.displayName = name
+			case "members":
+				ids, err := decodeMembers(op.Value)
+				if err != nil {
+					return nil, fmt.Errorf("%w: %s", errInvalidPatch, err)
+				}
+				if replace {
+					patch.replaceMembers(ids)
+				} else {
+					patch.addMembers(ids)
+				}
+			default:
+				return nil, fmt.Errorf("%w: %s", errInvalidPath, op.Path)
+			}
+
+		case "remove":
+			if id, ok := parseMemberPath(op.Path); ok {
+				patch.removeMembers([]string{id})
+				continue
+			}
+			if path != "members" {
+				return nil, fmt.Errorf("%w: %s", errInvalidPath, op.Path)
+			}
+
+			// Without value all members are removed
+			if len(op.Value) == 0 || string(op.Value) == "null" {
+				patch.replaceMembers([]string{})
+				continue
+			}
+			ids, err := decodeMembers(op.Value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", errInvalidPatch, err)
+			}
+			patch.removeMembers(ids)
+
+		default:
+			return nil, fmt.Errorf("%w: %s", errInvalidPatch, op.Op)
+		}
+	}
+
+	return patch, nil
+}
@@ -0,0 +1,161 @@
+package scimhandler
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+)
+
+func TestApplyUserPatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		ops     string
+		want    ssomodels.ScimUpdateUser
+		wantErr error
+	}{
+		{
+			name: "replace active",
+			ops:  `[{"op": "replace", "path": "active", "value": false}]`,
+			want: ssomodels.ScimUpdateUser{Email: "old@example.com", Name: "Old", Active: false},
+		},
+		{
+			name: "active as string",
+			ops:  `[{"op": "Replace", "path": "active", "value": "False"}]`,
+			want: ssomodels.ScimUpdateUser{Email: "old@example.com", Name: "Old", Active: false},
+		},
+		{
+			name: "replace userName and displayName",
+			ops:  `[{"op": "replace", "path": "userName", "value": "new@example.com"}, {"op": "add", "path": "displayName", "value": "New"}]`,
+			want: ssomodels.ScimUpdateUser{Email: "new@example.com", Name: "New", Active: true},
+		},
+		{
+			name: "primary email",
+			ops:  `[{"op": "replace", "path": "emails", "value": [{"value": "work@example.com"}, {"value": "new@example.com", "primary": true}]}]`,
+			want: ssomodels.ScimUpdateUser{Email: "new@example.com", Name: "Old", Active: true},
+		},
+		{
+			name: "email value filter",
+			ops:  `[{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "new@example.com"}]`,
+			want: ssomodels.ScimUpdateUser{Email: "new@example.com", Name: "Old", Active: true},
+		},
+		{
+			name: "without path",
+			ops:  `[{"op": "replace", "value": {"name": {"givenName": "New", "familyName": "Name"}, "active": false}}]`,
+			want: ssomodels.ScimUpdateUser{Email: "old@example.com", Name: "New Name", Active: false},
+		},
+		{name: "remove", ops: `[{"op": "remove", "path": "displayName"}]`, wantErr: errInvalidPath},
+		{name: "unknown op", ops: `[{"op": "move", "path": "active", "value": true}]`, wantErr: errInvalidPatch},
+		{name: "unknown path", ops: `[{"op": "replace", "path": "title", "value": "CEO"}]`, wantErr: errInvalidPath},
+		{name: "invalid value", ops: `[{"op": "replace", "path": "userName", "value": 42}]`, wantErr: errInvalidPatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ops []PatchOperation
+			if err := json.Unmarshal([]byte(tt.ops), &ops); err != nil {
+				t.Fatalf("unmarshal ops: %v", err)
+			}
+
+			upd := ssomodels.ScimUpdateUser{Email: "old@example.com", Name: "Old", Active: true}
+			err := applyUserPatch(&upd, ops)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("applyUserPatch() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applyUserPatch() error = %v", err)
+			}
+			if upd != tt.want {
+				t.Errorf("applyUserPatch() = %+v, want %+v", upd, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseGroupPatch(t *testing.T) {
+	tests := []struct {
+		name            string
+		ops             string
+		want            groupPatch
+		wantIncremental bool
+		wantErr         error
+	}{
+		{
+			name:            "add members",
+			ops:             `[{"op": "add", "path": "members", "value": [{"value": "1"}, {"value": "2"}]}]`,
+			want:            groupPatch{add: []string{"1", "2"}},
+			wantIncremental: true,
+		},
+		{
+			name:            "remove member by filter",
+			ops:             `[{"op": "remove", "path": "members[value eq \"1\"]"}]`,
+			want:            groupPatch{remove: []string{"1"}},
+			wantIncremental: true,
+		},
+		{
+			name:            "remove members with value",
+			ops:             `[{"op": "remove", "path": "members", "value": [{"value": "1"}]}]`,
+			want:            groupPatch{remove: []string{"1"}},
+			wantIncremental: true,
+		},
+		{
+			name: "remove all members",
+			ops:  `[{"op": "remove", "path": "members"}]`,
+			want: groupPatch{members: []string{}, replaced: true},
+		},
+		{
+			name: "replace then add and remove",
+			ops: `[
+				{"op": "replace", "path": "members", "value": [{"value": "1"}, {"value": "2"}]},
+				{"op": "add", "path": "members", "value": [{"value": "2"}, {"value": "3"}]},
+				{"op": "remove", "path": "members[value eq \"1\"]"}
+			]`,
+			want: groupPatch{members: []string{"2", "3"}, replaced: true},
+		},
+		{
+			name: "rename",
+			ops:  `[{"op": "replace", "path": "displayName", "value": "Renamed"}]`,
+			want: groupPatch{displayName: "Renamed"},
+		},
+		{
+			name: "replace without path",
+			ops:  `[{"op": "replace", "value": {"displayName": "Renamed", "members": [{"value": "1"}]}}]`,
+			want: groupPatch{displayName: "Renamed", members: []string{"1"}, replaced: true},
+		},
+		{name: "unknown path", ops: `[{"op": "add", "path": "owners", "value": []}]`, wantErr: errInvalidPath},
+		{name: "remove unknown path", ops: `[{"op": "remove", "path": "displayName"}]`, wantErr: errInvalidPath},
+		{name: "unknown op", ops: `[{"op": "copy", "path": "members"}]`, wantErr: errInvalidPatch},
+		{name: "invalid members", ops: `[{"op": "add", "path": "members", "value": "1"}]`, wantErr: errInvalidPatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ops []PatchOperation
+			if err := json.Unmarshal([]byte(tt.ops), &ops); err != nil {
+				t.Fatalf("unmarshal ops: %v", err)
+			}
+
+			got, err := parseGroupPatch(ops)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("parseGroupPatch() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseGroupPatch() error = %v", err)
+			}
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("parseGroupPatch() = %+v, want %+v", *got, tt.want)
+			}
+			if got.incremental() != tt.wantIncremental {
+				t.Errorf("incremental() = %v, want %v", got.incremental(), tt.wantIncremental)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package scimhandler
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+)
+
+// Schemas and content type of SCIM 2.0 (RFC 7643, RFC 7644).
+const (
+	SchemaUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError        = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	ContentType = "application/scim+json"
+
+	basePath = "/scim/v2"
+)
+
+// SCIM error types returned in scimType of the error.
+const (
+	scimTypeInvalidFilter = "invalidFilter"
+	scimTypeInvalidPath   = "invalidPath"
+	scimTypeInvalidValue  = "invalidValue"
+	scimTypeInvalidSyntax = "invalidSyntax"
+	scimTypeUniqueness    = "uniqueness"
+)
+
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location"`
+	Version      string `json:"version,omitempty"`
+}
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// User is SCIM user resource. userName is the email of the platform user,
+// externalId isn't stored.
+type User struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	UserName    string   `json:"userName"`
+	Name        *Name    `json:"name,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Emails      []Email  `json:"emails,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+type Member struct {
+	Value string `json:"value"`
+	Ref   string `json:"$ref,omitempty"`
+}
+
+// Group is SCIM group resource, it's the learning group and
+// members are learners of the group.
+type Group struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []Member `json:"members"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int64    `json:"totalResults"`
+	StartIndex   int64    `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type PatchOp struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+// email returns the email of SCIM user: userName or the primary email.
+func (u *User) email() string {
+	if u.UserName != "" {
+		return u.UserName
+	}
+	for _, email := range u.Emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+// name returns the name of SCIM user, platform users have the single name.
+func (u *User) name() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name == nil {
+		return ""
+	}
+	if u.Name.Formatted != "" {
+		return u.Name.Formatted
+	}
+	if u.Name.GivenName != "" && u.Name.FamilyName != "" {
+		return u.Name.GivenName + " " + u.Name.FamilyName
+	}
+	return u.Name.GivenName + u.Name.FamilyName
+}
+
+func userFromModel(user *ssomodels.ScimUser) *User {
+	active := user.Active
+	return &User{
+		Schemas:     []string{SchemaUser},
+		ID:          user.ID,
+		UserName:    user.Email,
+		Name:        &Name{Formatted: user.Name},
+		DisplayName: user.Name,
+		Emails:      []Email{{Value: user.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      user.Created,
+			LastModified: user.Updated,
+			Location:     basePath + "/Users/" + user.ID,
+		},
+	}
+}
+
+func groupFromModel(group *ssomodels.ScimGroup) *Group {
+	members := make([]Member, 0, len(group.Members))
+	for _, userID := range group.Members {
+		members = append(members, Member{
+			Value: userID,
+			Ref:   basePath + "/Users/" + userID,
+		})
+	}
+
+	return &Group{
+		Schemas:     []string{SchemaGroup},
+		ID:          group.ID,
+		DisplayName: group.DisplayName,
+		Members:     members,
+		Meta: &Meta{
+			ResourceType: "Group",
+			Created:      group.Created,
+			LastModified: group.Updated,
+			Location:     basePath + "/Groups/" + group.ID,
+			Version:      `W/"` + strconv.FormatInt(group.Version, 10) + `"`,
+		},
+	}
+}
+
+func memberIDs(members []Member) []string {
+	ids := make([]string, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.Value)
+	}
+	return ids
+}
+
+// renderScim writes SCIM response, render.JSON isn't used because
+// SCIM clients expect application/scim+json content type.
+func renderScim(w http.ResponseWriter, log *slog.Logger, status int, v any) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Error("failed to encode response", slog.String("err", err.Error()))
+	}
+}
+
+func renderScimError(w http.ResponseWriter, log *slog.Logger, status int, scimType, detail string) {
+	renderScim(w, log, status, Error{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
@@ -0,0 +1,286 @@
+package appshandler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const grantTypeClientCredentials = "client_credentials"
+
+type AppService interface {
+	RegisterApp(ctx context.Context, app *ssomodels.RegisterApp) (*ssomodels.RegisterAppResp, error)
+	IssueClientToken(ctx context.Context, creds *ssomodels.IssueClientToken) (*ssomodels.IssueClientTokenResp, error)
+	RegisterRelyingParty(ctx context.Context, rp *ssomodels.RegisterRelyingParty) (*ssomodels.RegisterRelyingPartyResp, error)
+}
+
+// RegisterApp godoc
+// @Summary      Register a service client
+// @Description  This endpoint allows platform admins to register a service client for the client credentials grant. The client secret is returned only once.
+// @Tags         apps
+// @Accept       json
+// @Produce      json
+// @Param        appshandler.RegisterAppRequest body appshandler.RegisterAppRequest true "Service client parameters"
+// @Success      201 {object} appshandler.RegisterAppResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      409 {object} response.Response "App already exists"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /admin/apps [post]
+// @Security ApiKeyAuth
+func RegisterApp(log *slog.Logger, val *validator.Validate, appService AppService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.apps.RegisterApp"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		userID := r.Header.Get("X-User-ID")
+
+		var req RegisterAppRequest
+		err := render.DecodeJSON(r.Body, &req)
+		if err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request body decoded", slog.Any("request from", userID))
+
+		resp, err := appService.RegisterApp(r.Context(), &ssomodels.RegisterApp{
+			UserID: userID,
+			Name:   req.Name,
+			Scopes: req.Scopes,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid credentials", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid name or scopes"))
+				return
+			case errors.Is(err, ssoservice.ErrUserNotFound):
+				log.Error("user not found", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusUnauthorized)
+				render.JSON(w, r, response.Error("unauthorized"))
+				return
+			case errors.Is(err, ssoservice.ErrPermissionDenied):
+				log.Error("permission denied", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error("permission denied"))
+				return
+			case errors.Is(err, ssoservice.ErrAppExists):
+				log.Error("app already exists", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusConflict)
+				render.JSON(w, r, response.Error("app already exists"))
+				return
+			default:
+				log.Error("failed to register app", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to register app"))
+				return
+			}
+		}
+
+		log.Info("app registered successfully", slog.String("client_id", resp.ClientID))
+
+		w.WriteHeader(http.StatusCreated)
+		render.JSON(w, r, RegisterAppResponse{
+			Response:     response.OK(),
+			ClientID:     resp.ClientID,
+			ClientSecret: resp.ClientSecret,
+		})
+	}
+}
+
+// RegisterRelyingParty godoc
+// @Summary      Register an OIDC relying party
+// @Description  This endpoint allows platform admins to register a tool which logs users in with sso OpenID Connect provider. Public clients get no secret and must use PKCE only. The client secret is returned only once.
+// @Tags         apps
+// @Accept       json
+// @Produce      json
+// @Param        appshandler.RegisterRelyingPartyRequest body appshandler.RegisterRelyingPartyRequest true "Relying party parameters"
+// @Success      201 {object} appshandler.RegisterRelyingPartyResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      409 {object} response.Response "Relying party already exists"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /admin/relying_parties [post]
+// @Security ApiKeyAuth
+func RegisterRelyingParty(log *slog.Logger, val *validator.Validate, appService AppService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.apps.RegisterRelyingParty"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		userID := r.Header.Get("X-User-ID")
+
+		var req RegisterRelyingPartyRequest
+		err := render.DecodeJSON(r.Body, &req)
+		if err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request body decoded", slog.Any("request from", userID))
+
+		resp, err := appService.RegisterRelyingParty(r.Context(), &ssomodels.RegisterRelyingParty{
+			UserID:       userID,
+			Name:         req.Name,
+			RedirectURIs: req.RedirectURIs,
+			Public:       req.Public,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid credentials", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid name or redirect uris"))
+				return
+			case errors.Is(err, ssoservice.ErrUserNotFound):
+				log.Error("user not found", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusUnauthorized)
+				render.JSON(w, r, response.Error("unauthorized"))
+				return
+			case errors.Is(err, ssoservice.ErrPermissionDenied):
+				log.Error("permission denied", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error("permission denied"))
+				return
+			case errors.Is(err, ssoservice.ErrAppExists):
+				log.Error("relying party already exists", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusConflict)
+				render.JSON(w, r, response.Error("relying party already exists"))
+				return
+			default:
+				log.Error("failed to register relying party", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to register relying party"))
+				return
+			}
+		}
+
+		log.Info("relying party registered successfully", slog.String("client_id", resp.ClientID))
+
+		w.WriteHeader(http.StatusCreated)
+		render.JSON(w, r, RegisterRelyingPartyResponse{
+			Response:     response.OK(),
+			ClientID:     resp.ClientID,
+			ClientSecret: resp.ClientSecret,
+		})
+	}
+}
+
+// Token godoc
+// @Summary      Issue access token for a service client
+// @Description  OAuth2 token endpoint (RFC 6749). Only the client_credentials grant is supported. Client credentials are accepted in HTTP Basic auth or in the form. Scope is a space separated list, if it's empty the token gets all scopes of the client.
+// @Tags         apps
+// @Accept       x-www-form-urlencoded
+// @Produce      json
+// @Param        grant_type    formData string true  "client_credentials"
+// @Param        client_id     formData string false "Client ID"
+// @Param        client_secret formData string false "Client secret"
+// @Param        scope         formData string false "Requested scopes"
+// @Success      200 {object} appshandler.TokenResponse
+// @Failure      400 {object} appshandler.TokenErrorResponse "Invalid request, grant type or scope"
+// @Failure      401 {object} appshandler.TokenErrorResponse "Invalid client"
+// @Failure      500 {object} appshandler.TokenErrorResponse "Server error"
+// @Router       /oauth/token [post]
+func Token(log *slog.Logger, val *validator.Validate, appService AppService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.apps.Token"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		w.Header().Set("Cache-Control", "no-store")
+
+		if err := r.ParseForm(); err != nil {
+			log.Error("failed to parse form", slog.String("err", err.Error()))
+			tokenError(w, r, http.StatusBadRequest, "invalid_request", "failed to parse request")
+			return
+		}
+
+		if grantType := r.PostForm.Get("grant_type"); grantType != grantTypeClientCredentials {
+			log.Info("unsupported grant type", slog.String("grant_type", grantType))
+			tokenError(w, r, http.StatusBadRequest, "unsupported_grant_type", "")
+			return
+		}
+
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok {
+			clientID = r.PostForm.Get("client_id")
+			clientSecret = r.PostForm.Get("client_secret")
+		}
+
+		log.Info("issuing client token", slog.String("client_id", clientID))
+
+		resp, err := appService.IssueClientToken(r.Context(), &ssomodels.IssueClientToken{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Scopes:       strings.Fields(r.PostForm.Get("scope")),
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidClient):
+				log.Error("invalid client", slog.String("err", err.Error()))
+				w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+				tokenError(w, r, http.StatusUnauthorized, "invalid_client", "")
+				return
+			case errors.Is(err, ssoservice.ErrInvalidScope):
+				log.Error("invalid scope", slog.String("err", err.Error()))
+				tokenError(w, r, http.StatusBadRequest, "invalid_scope", "")
+				return
+			default:
+				log.Error("failed to issue client token", slog.String("err", err.Error()))
+				tokenError(w, r, http.StatusInternalServerError, "server_error", "")
+				return
+			}
+		}
+
+		log.Info("client token issued", slog.String("client_id", clientID))
+
+		render.JSON(w, r, TokenResponse{
+			AccessToken: resp.AccessToken,
+			TokenType:   "Bearer",
+			ExpiresIn:   resp.ExpiresIn,
+			Scope:       resp.Scope,
+		})
+	}
+}
+
+func tokenError(w http.ResponseWriter, r *http.Request, status int, code, description string) {
+	w.WriteHeader(status)
+	render.JSON(w, r, TokenErrorResponse{
+		Error:            code,
+		ErrorDescription: description,
+	})
+}
@@ -0,0 +1,12 @@
+package appshandler
+
+type RegisterAppRequest struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+type RegisterRelyingPartyRequest struct {
+	Name         string   `json:"name"`
+	RedirectURIs []string `json:"redirect_uris"`
+	Public       bool     `json:"public"`
+}
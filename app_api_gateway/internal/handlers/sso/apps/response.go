@@ -0,0 +1,30 @@
+package appshandler
+
+import "github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+
+type RegisterAppResponse struct {
+	response.Response
+	ClientID     string
+	ClientSecret string
+}
+
+type RegisterRelyingPartyResponse struct {
+	response.Response
+	ClientID     string
+	ClientSecret string
+}
+
+// TokenResponse is the OAuth2 access token response (RFC 6749, section 5.1).
+// Field names are fixed by the spec, so OAuth2 client libraries can parse it.
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope,omitempty"`
+}
+
+// TokenErrorResponse is the OAuth2 error response (RFC 6749, section 5.2).
+type TokenErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
@@ -0,0 +1,130 @@
+package authhandler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+// ListSessions godoc
+// @Summary      List active sessions
+// @Description  This endpoint returns devices where the user is logged in: user agent, IP address, login time and time of the last token refresh.
+// @Tags         auth
+// @Produce      json
+// @Success      200 {object} authhandler.ListSessionsResponse
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /profile/sessions [get]
+// @Security ApiKeyAuth
+func ListSessions(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.ListSessions"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		userID := r.Header.Get("X-User-ID")
+
+		log.Info("listing sessions", slog.Any("request from", userID))
+
+		resp, err := authService.ListSessions(r.Context(), &ssomodels.ListSessions{
+			UserID: userID,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid user id", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusUnauthorized)
+				render.JSON(w, r, response.Error("unauthorized"))
+				return
+			default:
+				log.Error("failed to list sessions", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to list sessions"))
+				return
+			}
+		}
+
+		log.Info("sessions listed successfully")
+
+		render.JSON(w, r, ListSessionsResponse{
+			Response: response.OK(),
+			Sessions: resp.Sessions,
+		})
+	}
+}
+
+// RevokeSession godoc
+// @Summary      Revoke session
+// @Description  This endpoint logs the user out on another device. Refresh token of the session stops working and its access token is revoked.
+// @Tags         auth
+// @Produce      json
+// @Param        id path string true "ID of the session"
+// @Success      200 {object} authhandler.RevokeSessionResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      404 {object} response.Response "Session not found"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /profile/sessions/{id} [delete]
+// @Security ApiKeyAuth
+func RevokeSession(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.RevokeSession"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		userID := r.Header.Get("X-User-ID")
+		sessionID := chi.URLParam(r, "id")
+
+		log.Info("revoking session", slog.Any("request from", userID))
+
+		resp, err := authService.RevokeSession(r.Context(), &ssomodels.RevokeSession{
+			UserID:    userID,
+			SessionID: sessionID,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid input", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid input"))
+				return
+			case errors.Is(err, ssoservice.ErrSessionNotFound):
+				log.Error("session not found", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusNotFound)
+				render.JSON(w, r, response.Error("session not found"))
+				return
+			default:
+				log.Error("failed to revoke session", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to revoke session"))
+				return
+			}
+		}
+
+		log.Info("session revoked successfully")
+
+		render.JSON(w, r, RevokeSessionResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package authhandler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+// CreateTgLink godoc
+// @Summary      Create telegram link
+// @Description  This endpoint returns t.me deep link of the bot with one-time token. Telegram chat which opens the link before expires_at is linked to the user instead of the previous one.
+// @Tags         auth
+// @Produce      json
+// @Success      200 {object} authhandler.CreateTgLinkResponse
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "User is deactivated"
+// @Failure      404 {object} response.Response "User not found"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /profile/telegram/link [post]
+// @Security ApiKeyAuth
+func CreateTgLink(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.CreateTgLink"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		userID := r.Header.Get("X-User-ID")
+
+		log.Info("creating telegram link", slog.Any("request from", userID))
+
+		resp, err := authService.CreateTgLink(r.Context(), &ssomodels.CreateTgLink{
+			UserID: userID,
+		})
+		if err != nil {
+			renderTelegramError(w, r, log, err, "failed to create telegram link")
+			return
+		}
+
+		log.Info("telegram link created successfully")
+
+		render.JSON(w, r, CreateTgLinkResponse{
+			Response:  response.OK(),
+			Link:      resp.Link,
+			ExpiresAt: resp.ExpiresAt,
+		})
+	}
+}
+
+// UnlinkTelegram godoc
+// @Summary      Unlink telegram
+// @Description  This endpoint unlinks telegram chat of the user. Login via telegram isn't available until the chat is linked again.
+// @Tags         auth
+// @Produce      json
+// @Success      200 {object} authhandler.UnlinkTelegramResponse
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "User is deactivated"
+// @Failure      404 {object} response.Response "User not found"
+// @Failure      409 {object} response.Response "Telegram is not linked"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /profile/telegram/link [delete]
+// @Security ApiKeyAuth
+func UnlinkTelegram(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.UnlinkTelegram"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		userID := r.Header.Get("X-User-ID")
+
+		log.Info("unlinking telegram", slog.Any("request from", userID))
+
+		resp, err := authService.UnlinkTelegram(r.Context(), &ssomodels.UnlinkTelegram{
+			UserID: userID,
+		})
+		if err != nil {
+			renderTelegramError(w, r, log, err, "failed to unlink telegram")
+			return
+		}
+
+		log.Info("telegram unlinked successfully")
+
+		render.JSON(w, r, UnlinkTelegramResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+func renderTelegramError(w http.ResponseWriter, r *http.Request, log *slog.Logger, err error, failedMsg string) {
+	switch {
+	case errors.Is(err, ssoservice.ErrInvalidCredentials):
+		log.Error("invalid user id", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusUnauthorized)
+		render.JSON(w, r, response.Error("unauthorized"))
+	case errors.Is(err, ssoservice.ErrUserDeactivated):
+		log.Error("user is deactivated", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusForbidden)
+		render.JSON(w, r, response.Error("user is deactivated"))
+	case errors.Is(err, ssoservice.ErrUserNotFound):
+		log.Error("user not found", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusNotFound)
+		render.JSON(w, r, response.Error("user not found"))
+	case errors.Is(err, ssoservice.ErrTelegramNotLinked):
+		log.Error("telegram is not linked", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusConflict)
+		render.JSON(w, r, response.Error("telegram is not linked"))
+	default:
+		log.Error(failedMsg, slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusInternalServerError)
+		render.JSON(w, r, response.Error(failedMsg))
+	}
+}
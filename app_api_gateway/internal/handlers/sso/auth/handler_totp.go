@@ -0,0 +1,314 @@
+package authhandler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+// LoginMFA godoc
+// @Summary      Finish login with second factor
+// @Description  This endpoint checks code from authenticator app or recovery code and returns tokens.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        ssomodels.LoginMFA body ssomodels.LoginMFA true "Second factor parameters"
+// @Success      200 {object} authhandler.LoginMFAResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Invalid mfa token"
+// @Failure      403 {object} response.Response "User is deactivated"
+// @Failure      429 {object} response.Response "Too many attempts"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /sing_in/mfa [post]
+func LoginMFA(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.LoginMFA"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+		meter.SignInReqCount.Add(r.Context(), 1)
+
+		var req ssomodels.LoginMFA
+		err := render.DecodeJSON(r.Body, &req)
+		if err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request body decoded")
+
+		resp, err := authService.LoginMFA(r.Context(), &req)
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid input", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid input"))
+				return
+			case errors.Is(err, ssoservice.ErrInvalidMFAToken):
+				log.Error("invalid mfa token", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusUnauthorized)
+				render.JSON(w, r, response.Error("invalid mfa token"))
+				return
+			case errors.Is(err, ssoservice.ErrTooManyAttempts):
+				log.Error("too many attempts", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusTooManyRequests)
+				render.JSON(w, r, response.Error("too many attempts, try again later"))
+				return
+			case errors.Is(err, ssoservice.ErrUserDeactivated):
+				log.Error("user is deactivated", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error("user is deactivated"))
+				return
+			default:
+				log.Error("failed to login user", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to login user"))
+				return
+			}
+		}
+
+		log.Info("user logged in successfully")
+
+		render.JSON(w, r, LoginMFAResponse{
+			Response:     response.OK(),
+			AccsessToken: resp.AccessToken,
+			RefreshToken: resp.RefreshToken,
+		})
+	}
+}
+
+// EnrollTOTP godoc
+// @Summary      Enroll 2FA
+// @Description  This endpoint generates TOTP secret and returns otpauth URI for authenticator app. 2FA is enabled after the first code is verified.
+// @Tags         auth
+// @Produce      json
+// @Success      200 {object} authhandler.EnrollTOTPResponse
+// @Failure      404 {object} response.Response "User not found"
+// @Failure      409 {object} response.Response "2FA is already enabled"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /profile/2fa/enroll [post]
+// @Security ApiKeyAuth
+func EnrollTOTP(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.EnrollTOTP"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		userID := r.Header.Get("X-User-ID")
+
+		log.Info("enrolling totp", slog.Any("request from", userID))
+
+		resp, err := authService.EnrollTOTP(r.Context(), &ssomodels.EnrollTOTP{
+			UserID: userID,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid input", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid input"))
+				return
+			case errors.Is(err, ssoservice.ErrUserNotFound):
+				log.Error("user not found", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusNotFound)
+				render.JSON(w, r, response.Error("user not found"))
+				return
+			case errors.Is(err, ssoservice.ErrTOTPAlreadyEnabled):
+				log.Error("totp is already enabled", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusConflict)
+				render.JSON(w, r, response.Error("2fa is already enabled"))
+				return
+			default:
+				log.Error("failed to enroll totp", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to enroll 2fa"))
+				return
+			}
+		}
+
+		log.Info("totp enrolled")
+
+		render.JSON(w, r, EnrollTOTPResponse{
+			Response: response.OK(),
+			URI:      resp.URI,
+			Secret:   resp.Secret,
+		})
+	}
+}
+
+// VerifyTOTP godoc
+// @Summary      Verify 2FA enrollment
+// @Description  This endpoint checks the first code from authenticator app, enables 2FA and returns one-time recovery codes.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        authhandler.TOTPCodeReq body authhandler.TOTPCodeReq true "Code from authenticator app"
+// @Success      200 {object} authhandler.VerifyTOTPResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      404 {object} response.Response "User not found"
+// @Failure      409 {object} response.Response "2FA is already enabled or not enrolled"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /profile/2fa/verify [post]
+// @Security ApiKeyAuth
+func VerifyTOTP(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.VerifyTOTP"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		var req TOTPCodeReq
+		err := render.DecodeJSON(r.Body, &req)
+		if err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		userID := r.Header.Get("X-User-ID")
+
+		log.Info("request body decoded", slog.Any("request from", userID))
+
+		resp, err := authService.VerifyTOTP(r.Context(), &ssomodels.VerifyTOTP{
+			UserID: userID,
+			Code:   req.Code,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid input", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid code"))
+				return
+			case errors.Is(err, ssoservice.ErrUserNotFound):
+				log.Error("user not found", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusNotFound)
+				render.JSON(w, r, response.Error("user not found"))
+				return
+			case errors.Is(err, ssoservice.ErrTOTPAlreadyEnabled):
+				log.Error("totp is already enabled", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusConflict)
+				render.JSON(w, r, response.Error("2fa is already enabled"))
+				return
+			case errors.Is(err, ssoservice.ErrTOTPNotEnabled):
+				log.Error("totp is not enrolled", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusConflict)
+				render.JSON(w, r, response.Error("2fa is not enrolled"))
+				return
+			default:
+				log.Error("failed to verify totp", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to verify 2fa"))
+				return
+			}
+		}
+
+		log.Info("totp enabled")
+
+		render.JSON(w, r, VerifyTOTPResponse{
+			Response:      response.OK(),
+			RecoveryCodes: resp.RecoveryCodes,
+		})
+	}
+}
+
+// DisableTOTP godoc
+// @Summary      Disable 2FA
+// @Description  This endpoint disables 2FA. Code from authenticator app or recovery code is required.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        authhandler.TOTPCodeReq body authhandler.TOTPCodeReq true "Code from authenticator app or recovery code"
+// @Success      200 {object} authhandler.DisableTOTPResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      404 {object} response.Response "User not found"
+// @Failure      409 {object} response.Response "2FA is not enabled"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /profile/2fa/disable [post]
+// @Security ApiKeyAuth
+func DisableTOTP(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.DisableTOTP"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		var req TOTPCodeReq
+		err := render.DecodeJSON(r.Body, &req)
+		if err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		userID := r.Header.Get("X-User-ID")
+
+		log.Info("request body decoded", slog.Any("request from", userID))
+
+		resp, err := authService.DisableTOTP(r.Context(), &ssomodels.DisableTOTP{
+			UserID: userID,
+			Code:   req.Code,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid input", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid code"))
+				return
+			case errors.Is(err, ssoservice.ErrUserNotFound):
+				log.Error("user not found", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusNotFound)
+				render.JSON(w, r, response.Error("user not found"))
+				return
+			case errors.Is(err, ssoservice.ErrTOTPNotEnabled):
+				log.Error("totp is not enabled", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusConflict)
+				render.JSON(w, r, response.Error("2fa is not enabled"))
+				return
+			default:
+				log.Error("failed to disable totp", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to disable 2fa"))
+				return
+			}
+		}
+
+		log.Info("totp disabled")
+
+		render.JSON(w, r, DisableTOTPResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
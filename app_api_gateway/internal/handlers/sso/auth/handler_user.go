@@ -21,17 +21,35 @@ var (
 	ErrUserExists         = errors.New("user already exists")
 )
 
+const weakPasswordMsg = "password is too short or too long, contains the email or is a known breached password"
+
 type AuthService interface {
 	RegisterUser(ctx context.Context, newUser *ssomodels.RegisterUser) (*ssomodels.RegisterResp, error)
 	LoginUser(ctx context.Context, logUser *ssomodels.LogIn) (*ssomodels.LogInResp, error)
 	LogInViaTg(ctx context.Context, email *ssomodels.LogInViaTg) (*ssomodels.LogInViaTgResp, error)
 	CheckOTPAndLogIn(ctx context.Context, otp *ssomodels.CheckOTPAndLogIn) (*ssomodels.CheckOTPAndLogInResp, error)
+	VerifyEmail(ctx context.Context, verify *ssomodels.VerifyEmail) (*ssomodels.VerifyEmailResp, error)
+	ResendVerification(ctx context.Context, resend *ssomodels.ResendVerification) (*ssomodels.ResendVerificationResp, error)
+	RequestPasswordReset(ctx context.Context, reset *ssomodels.RequestPasswordReset) (*ssomodels.RequestPasswordResetResp, error)
+	ConfirmPasswordReset(ctx context.Context, reset *ssomodels.ConfirmPasswordReset) (*ssomodels.ConfirmPasswordResetResp, error)
 	UpdateUserInfo(ctx context.Context, newInfo *ssomodels.UpdateUserInfo) (*ssomodels.UpdateUserInfoResp, error)
+	RefreshToken(ctx context.Context, refToken *ssomodels.RefreshToken) (*ssomodels.RefreshTokenResp, error)
+	Logout(ctx context.Context, logout *ssomodels.Logout) (*ssomodels.LogoutResp, error)
+	GetMe(ctx context.Context, me *ssomodels.GetMe) (*ssomodels.GetMeResp, error)
+	LoginMFA(ctx context.Context, login *ssomodels.LoginMFA) (*ssomodels.LoginMFAResp, error)
+	EnrollTOTP(ctx context.Context, enroll *ssomodels.EnrollTOTP) (*ssomodels.EnrollTOTPResp, error)
+	VerifyTOTP(ctx context.Context, verify *ssomodels.VerifyTOTP) (*ssomodels.VerifyTOTPResp, error)
+	DisableTOTP(ctx context.Context, disable *ssomodels.DisableTOTP) (*ssomodels.DisableTOTPResp, error)
+	ListSessions(ctx context.Context, list *ssomodels.ListSessions) (*ssomodels.ListSessionsResp, error)
+	RevokeSession(ctx context.Context, revoke *ssomodels.RevokeSession) (*ssomodels.RevokeSessionResp, error)
+	AcceptInvite(ctx context.Context, accept *ssomodels.AcceptInvite) (*ssomodels.AcceptInviteResp, error)
+	CreateTgLink(ctx context.Context, create *ssomodels.CreateTgLink) (*ssomodels.CreateTgLinkResp, error)
+	UnlinkTelegram(ctx context.Context, unlink *ssomodels.UnlinkTelegram) (*ssomodels.UnlinkTelegramResp, error)
 }
 
 // SingUp godoc
 // @Summary      Register a new user
-// @Description  This endpoint allows users to register with an email and password.
+// @Description  This endpoint allows users to register with an email and password. The password must be 8-128 characters long by default, must not contain the email and must not be a known breached password. If the verification email is lost or expired, ask for a new one with /verify_email/resend.
 // @Tags         auth
 // @Accept       json
 // @Produce      json
@@ -76,6 +94,11 @@ func SingUp(log *slog.Logger, val *validator.Validate, authService AuthService)
 				w.WriteHeader(http.StatusBadRequest)
 				render.JSON(w, r, response.Error("invalid credentinals"))
 				return
+			case errors.Is(err, ssoservice.ErrWeakPassword):
+				log.Error("weak password", slog.Any("email", req.Email))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error(weakPasswordMsg))
+				return
 			default:
 				log.Error("registratin failed", slog.String("err", err.Error()))
 				w.WriteHeader(http.StatusInternalServerError)
@@ -95,14 +118,16 @@ func SingUp(log *slog.Logger, val *validator.Validate, authService AuthService)
 
 // SignIn godoc
 // @Summary      User Login
-// @Description  This endpoint allows users to sign in using their email and password.
+// @Description  This endpoint allows users to sign in using their email and password. If 2FA is enabled, MFAToken is returned instead of tokens and login has to be finished via /sing_in/mfa.
 // @Tags         auth
 // @Accept       json
 // @Produce      json
 // @Param        ssomodels.LogIn body ssomodels.LogIn true "Sign-in parameters"
 // @Success      200 {object} authhandler.SingInResponse
 // @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      403 {object} response.Response "Email is not verified or user is deactivated"
 // @Failure      404 {object} response.Response "User not found"
+// @Failure      429 {object} response.Response "Too many attempts"
 // @Failure      500 {object} response.Response "Server error"
 // @Router       /sing_in [post]
 func SignIn(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
@@ -136,6 +161,21 @@ func SignIn(log *slog.Logger, val *validator.Validate, authService AuthService)
 				w.WriteHeader(http.StatusBadRequest)
 				render.JSON(w, r, response.Error("invalid input"))
 				return
+			case errors.Is(err, ssoservice.ErrEmailNotVerified):
+				log.Error("email is not verified", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error("email is not verified"))
+				return
+			case errors.Is(err, ssoservice.ErrTooManyAttempts):
+				log.Error("too many attempts", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusTooManyRequests)
+				render.JSON(w, r, response.Error("too many attempts, try again later"))
+				return
+			case errors.Is(err, ssoservice.ErrUserDeactivated):
+				log.Error("user is deactivated", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error("user is deactivated"))
+				return
 			default:
 				log.Error("failed to login user", slog.String("err", err.Error()))
 				w.WriteHeader(http.StatusInternalServerError)
@@ -150,6 +190,8 @@ func SignIn(log *slog.Logger, val *validator.Validate, authService AuthService)
 			Response:     response.OK(),
 			AccsessToken: singInResponse.AccessToken,
 			RefreshToken: singInResponse.RefreshToken,
+			MFARequired:  singInResponse.MFARequired,
+			MFAToken:     singInResponse.MFAToken,
 		})
 	}
 }
@@ -163,6 +205,7 @@ func SignIn(log *slog.Logger, val *validator.Validate, authService AuthService)
 // @Param        ssomodels.LogInViaTg body ssomodels.LogInViaTg true "Sign-in parameters"
 // @Success      200 {object} authhandler.SingInByTgResponse
 // @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      403 {object} response.Response "Email is not verified or user is deactivated"
 // @Failure      404 {object} response.Response "User not found"
 // @Failure      500 {object} response.Response "Server error"
 // @Router       /sing_in_by_tg [post]
@@ -202,6 +245,16 @@ func SignInByTelegram(log *slog.Logger, val *validator.Validate, authService Aut
 				w.WriteHeader(http.StatusNotFound)
 				render.JSON(w, r, response.Error("user not found"))
 				return
+			case errors.Is(err, ssoservice.ErrEmailNotVerified):
+				log.Error("email is not verified", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error("email is not verified"))
+				return
+			case errors.Is(err, ssoservice.ErrUserDeactivated):
+				log.Error("user is deactivated", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error("user is deactivated"))
+				return
 			default:
 				log.Error("failed to login user by telegram", slog.String("err", err.Error()))
 				w.WriteHeader(http.StatusInternalServerError)
@@ -222,14 +275,16 @@ func SignInByTelegram(log *slog.Logger, val *validator.Validate, authService Aut
 
 // CheckOTPAndLogIn godoc
 // @Summary      User Login by telegram bot
-// @Description  This endpoint allows users to sign in using their email and sends OTP code to chat.
+// @Description  This endpoint allows users to sign in using their email and OTP code from chat. If 2FA is enabled, MFAToken is returned instead of tokens and login has to be finished via /sing_in/mfa.
 // @Tags         auth
 // @Accept       json
 // @Produce      json
 // @Param        ssomodels.CheckOTPAndLogIn body ssomodels.CheckOTPAndLogIn true "Sign-in parameters"
 // @Success      200 {object} authhandler.CheckOTPAndLogInResponse
 // @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      403 {object} response.Response "Email is not verified or user is deactivated"
 // @Failure      404 {object} response.Response "User not found"
+// @Failure      429 {object} response.Response "Too many attempts"
 // @Failure      500 {object} response.Response "Server error"
 // @Router       /check_otp [post]
 func CheckOTPAndLogIn(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
@@ -268,6 +323,21 @@ func CheckOTPAndLogIn(log *slog.Logger, val *validator.Validate, authService Aut
 				w.WriteHeader(http.StatusNotFound)
 				render.JSON(w, r, response.Error("user not found"))
 				return
+			case errors.Is(err, ssoservice.ErrEmailNotVerified):
+				log.Error("email is not verified", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error("email is not verified"))
+				return
+			case errors.Is(err, ssoservice.ErrTooManyAttempts):
+				log.Error("too many attempts", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusTooManyRequests)
+				render.JSON(w, r, response.Error("too many attempts, try again later"))
+				return
+			case errors.Is(err, ssoservice.ErrUserDeactivated):
+				log.Error("user is deactivated", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusForbidden)
+				render.JSON(w, r, response.Error("user is deactivated"))
+				return
 			default:
 				log.Error("failed to check otp and login", slog.String("err", err.Error()))
 				w.WriteHeader(http.StatusInternalServerError)
@@ -282,13 +352,316 @@ func CheckOTPAndLogIn(log *slog.Logger, val *validator.Validate, authService Aut
 			Response:     response.OK(),
 			AccsessToken: resp.AccessToken,
 			RefreshToken: resp.RefreshToken,
+			MFARequired:  resp.MFARequired,
+			MFAToken:     resp.MFAToken,
+		})
+	}
+}
+
+// VerifyEmail godoc
+// @Summary      Verify email
+// @Description  This endpoint confirms that the user owns the email. The token is sent to the user after registration.
+// @Tags         auth
+// @Produce      json
+// @Param        token query string true "Verification token"
+// @Success      200 {object} authhandler.VerifyEmailResponse
+// @Failure      400 {object} response.Response "Invalid verification token"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /verify_email [get]
+func VerifyEmail(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.VerifyEmail"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		resp, err := authService.VerifyEmail(r.Context(), &ssomodels.VerifyEmail{
+			Token: r.URL.Query().Get("token"),
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidVerifyToken):
+				log.Error("invalid verification token", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid verification token"))
+				return
+			default:
+				log.Error("failed to verify email", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to verify email"))
+				return
+			}
+		}
+
+		log.Info("email verified successfully")
+
+		render.JSON(w, r, VerifyEmailResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+// ResendVerification godoc
+// @Summary      Resend verification email
+// @Description  This endpoint sends new verification email to the user who hasn't verified the email yet. It succeeds for unknown and already verified emails too, so it can't be used to find accounts. Only a few emails per hour are sent.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        ssomodels.ResendVerification body ssomodels.ResendVerification true "Email of the user"
+// @Success      200 {object} authhandler.ResendVerificationResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /verify_email/resend [post]
+func ResendVerification(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.ResendVerification"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		var req ssomodels.ResendVerification
+		err := render.DecodeJSON(r.Body, &req)
+		if err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request body decoded", slog.Any("request from", req.Email))
+
+		resp, err := authService.ResendVerification(r.Context(), &req)
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid input", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid input"))
+				return
+			default:
+				log.Error("failed to resend verification email", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to resend verification email"))
+				return
+			}
+		}
+
+		log.Info("verification email resent")
+
+		render.JSON(w, r, ResendVerificationResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+// RequestPasswordReset godoc
+// @Summary      Request password reset
+// @Description  This endpoint sends OTP code for password reset to telegram chat of the user.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        ssomodels.RequestPasswordReset body ssomodels.RequestPasswordReset true "Password reset parameters"
+// @Success      200 {object} authhandler.PasswordResetResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /password_reset [post]
+func RequestPasswordReset(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.RequestPasswordReset"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		var req ssomodels.RequestPasswordReset
+		err := render.DecodeJSON(r.Body, &req)
+		if err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request body decoded", slog.Any("request from", req.Email))
+
+		resp, err := authService.RequestPasswordReset(r.Context(), &req)
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid input", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid input"))
+				return
+			default:
+				log.Error("failed to request password reset", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to request password reset"))
+				return
+			}
+		}
+
+		log.Info("password reset requested")
+
+		render.JSON(w, r, PasswordResetResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+// ConfirmPasswordReset godoc
+// @Summary      Confirm password reset
+// @Description  This endpoint checks OTP code and sets new password of the user. New password must meet the same policy as on sign up. All sessions of the user are closed.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        ssomodels.ConfirmPasswordReset body ssomodels.ConfirmPasswordReset true "Password reset parameters"
+// @Success      200 {object} authhandler.PasswordResetResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      429 {object} response.Response "Too many attempts"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /password_reset/confirm [post]
+func ConfirmPasswordReset(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.ConfirmPasswordReset"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		var req ssomodels.ConfirmPasswordReset
+		err := render.DecodeJSON(r.Body, &req)
+		if err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request body decoded", slog.Any("request from", req.Email))
+
+		resp, err := authService.ConfirmPasswordReset(r.Context(), &req)
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid input", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid input"))
+				return
+			case errors.Is(err, ssoservice.ErrWeakPassword):
+				log.Error("weak password", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error(weakPasswordMsg))
+				return
+			case errors.Is(err, ssoservice.ErrTooManyAttempts):
+				log.Error("too many attempts", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusTooManyRequests)
+				render.JSON(w, r, response.Error("too many attempts, try again later"))
+				return
+			default:
+				log.Error("failed to reset password", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to reset password"))
+				return
+			}
+		}
+
+		log.Info("password reset successfully")
+
+		render.JSON(w, r, PasswordResetResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+// AcceptInvite godoc
+// @Summary      Accept invite
+// @Description  This endpoint sets the first password of the user imported by admin and activates the account. The token is sent to the user after import. The password must meet the same policy as on sign up.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        ssomodels.AcceptInvite body ssomodels.AcceptInvite true "Invite parameters"
+// @Success      200 {object} authhandler.AcceptInviteResponse
+// @Failure      400 {object} response.Response "Invalid data in the request or invalid invite token"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /invite/accept [post]
+func AcceptInvite(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.AcceptInvite"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		var req ssomodels.AcceptInvite
+		err := render.DecodeJSON(r.Body, &req)
+		if err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request body decoded")
+
+		resp, err := authService.AcceptInvite(r.Context(), &req)
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid input", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid input"))
+				return
+			case errors.Is(err, ssoservice.ErrInvalidInviteToken):
+				log.Error("invalid invite token", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid invite token"))
+				return
+			case errors.Is(err, ssoservice.ErrWeakPassword):
+				log.Error("weak password", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error(weakPasswordMsg))
+				return
+			default:
+				log.Error("failed to accept invite", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to accept invite"))
+				return
+			}
+		}
+
+		log.Info("invite accepted")
+
+		render.JSON(w, r, AcceptInviteResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
 		})
 	}
 }
 
 // UpdateUserInfo godoc
 // @Summary      Change self user info
-// @Description  This endpoint allow users to change their profile.
+// @Description  This endpoint allow users to change their profile. Changed email has to be verified again: a verification email is sent to the new address and login is refused until the email is verified.
 // @Tags         auth
 // @Accept       json
 // @Produce      json
@@ -350,3 +723,203 @@ func UpdateUserInfo(log *slog.Logger, val *validator.Validate, authService AuthS
 		})
 	}
 }
+
+// Logout godoc
+// @Summary      User logout
+// @Description  This endpoint revokes access token of the user. If refresh token is passed, the session is closed too.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        authhandler.LogoutReq body authhandler.LogoutReq false "Logout parameters"
+// @Success      200 {object} authhandler.LogoutResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /auth/logout [post]
+// @Security ApiKeyAuth
+func Logout(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.Logout"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		var req LogoutReq
+		if r.ContentLength != 0 {
+			err := render.DecodeJSON(r.Body, &req)
+			if err != nil {
+				log.Error("failed to decode request body", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("failed to decode request"))
+				return
+			}
+		}
+
+		log.Info("request body decoded", slog.Any("request from", r.Header.Get("X-User-ID")))
+
+		resp, err := authService.Logout(r.Context(), &ssomodels.Logout{
+			AccessToken:  r.Header.Get("Authorization"),
+			RefreshToken: req.RefreshToken,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidAccessToken):
+				log.Error("invalid access token", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusUnauthorized)
+				render.JSON(w, r, response.Error("unauthorized"))
+				return
+			case errors.Is(err, ssoservice.ErrInvalidRefreshToken):
+				log.Error("invalid refresh token", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid refresh token"))
+				return
+			default:
+				log.Error("failed to logout user", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to logout user"))
+				return
+			}
+		}
+
+		log.Info("user logged out successfully")
+
+		render.JSON(w, r, LogoutResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+// RefreshToken godoc
+// @Summary      Refresh tokens
+// @Description  This endpoint exchanges refresh token for a new pair of access and refresh tokens.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        ssomodels.RefreshToken body ssomodels.RefreshToken true "Refresh parameters"
+// @Success      200 {object} authhandler.RefreshTokenResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Invalid refresh token"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /auth/refresh [post]
+func RefreshToken(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.RefreshToken"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		var req ssomodels.RefreshToken
+		err := render.DecodeJSON(r.Body, &req)
+		if err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request body decoded")
+
+		resp, err := authService.RefreshToken(r.Context(), &req)
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid input", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid input"))
+				return
+			case errors.Is(err, ssoservice.ErrInvalidRefreshToken):
+				log.Error("invalid refresh token", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusUnauthorized)
+				render.JSON(w, r, response.Error("invalid refresh token"))
+				return
+			default:
+				log.Error("failed to refresh tokens", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to refresh tokens"))
+				return
+			}
+		}
+
+		log.Info("tokens refreshed successfully")
+
+		render.JSON(w, r, RefreshTokenResponse{
+			Response:     response.OK(),
+			AccsessToken: resp.AccessToken,
+			RefreshToken: resp.RefreshToken,
+		})
+	}
+}
+
+// Me godoc
+// @Summary      Get self profile
+// @Description  This endpoint returns profile of the user, admin flag, telegram link status and learning groups of the user.
+// @Tags         auth
+// @Produce      json
+// @Success      200 {object} authhandler.MeResponse
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      404 {object} response.Response "User not found"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /me [get]
+// @Security ApiKeyAuth
+func Me(log *slog.Logger, val *validator.Validate, authService AuthService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.auth.Me"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		userID := r.Header.Get("X-User-ID")
+
+		log.Info("getting user profile", slog.Any("request from", userID))
+
+		resp, err := authService.GetMe(r.Context(), &ssomodels.GetMe{
+			UserID: userID,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, ssoservice.ErrInvalidCredentials):
+				log.Error("invalid user id", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusUnauthorized)
+				render.JSON(w, r, response.Error("unauthorized"))
+				return
+			case errors.Is(err, ssoservice.ErrUserNotFound):
+				log.Error("user not found", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusNotFound)
+				render.JSON(w, r, response.Error("user not found"))
+				return
+			default:
+				log.Error("failed to get user profile", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusInternalServerError)
+				render.JSON(w, r, response.Error("failed to get user profile"))
+				return
+			}
+		}
+
+		log.Info("user profile got successfully")
+
+		render.JSON(w, r, MeResponse{
+			Response:     response.OK(),
+			ID:           resp.ID,
+			Email:        resp.Email,
+			Name:         resp.Name,
+			TgLink:       resp.TgLink,
+			TgLinked:     resp.TgLinked,
+			IsAdmin:      resp.IsAdmin,
+			LearnerIn:    resp.LearnerIn,
+			GroupAdminIn: resp.GroupAdminIn,
+		})
+	}
+}
@@ -1,8 +1,15 @@
 package authhandler
 
 type UpdateUserInfoReq struct {
-	Email   string `json:"email,omitempty"`
-	Name    string `json:"name,omitempty"`
-	TgLink  string `json:"tg_link,omitempty"`
-	IsAdmin bool   `json:"is_admin,omitempty"`
+	Email  string `json:"email,omitempty"`
+	Name   string `json:"name,omitempty"`
+	TgLink string `json:"tg_link,omitempty"`
+}
+
+type LogoutReq struct {
+	RefreshToken string `json:"refresh_token,omitempty"`
+}
+
+type TOTPCodeReq struct {
+	Code string `json:"code"`
 }
@@ -1,6 +1,9 @@
 package authhandler
 
-import "github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+import (
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+)
 
 type SingUpResponse struct {
 	response.Response
@@ -11,6 +14,8 @@ type SingInResponse struct {
 	response.Response
 	AccsessToken string
 	RefreshToken string
+	MFARequired  bool
+	MFAToken     string
 }
 
 type SingInByTgResponse struct {
@@ -23,9 +28,97 @@ type CheckOTPAndLogInResponse struct {
 	response.Response
 	AccsessToken string
 	RefreshToken string
+	MFARequired  bool
+	MFAToken     string
 }
 
 type UpdateUserInfoResponse struct {
 	response.Response
 	Success bool
 }
+
+type LogoutResponse struct {
+	response.Response
+	Success bool
+}
+
+type RefreshTokenResponse struct {
+	response.Response
+	AccsessToken string
+	RefreshToken string
+}
+
+type MeResponse struct {
+	response.Response
+	ID           string
+	Email        string
+	Name         string
+	TgLink       string
+	TgLinked     bool
+	IsAdmin      bool
+	LearnerIn    []string
+	GroupAdminIn []string
+}
+
+type PasswordResetResponse struct {
+	response.Response
+	Success bool
+}
+
+type VerifyEmailResponse struct {
+	response.Response
+	Success bool
+}
+
+type ResendVerificationResponse struct {
+	response.Response
+	Success bool
+}
+
+type AcceptInviteResponse struct {
+	response.Response
+	Success bool
+}
+
+type LoginMFAResponse struct {
+	response.Response
+	AccsessToken string
+	RefreshToken string
+}
+
+type EnrollTOTPResponse struct {
+	response.Response
+	URI    string
+	Secret string
+}
+
+type VerifyTOTPResponse struct {
+	response.Response
+	RecoveryCodes []string
+}
+
+type DisableTOTPResponse struct {
+	response.Response
+	Success bool
+}
+
+type ListSessionsResponse struct {
+	response.Response
+	Sessions []*ssomodels.Session
+}
+
+type RevokeSessionResponse struct {
+	response.Response
+	Success bool
+}
+
+type CreateTgLinkResponse struct {
+	response.Response
+	Link      string
+	ExpiresAt string
+}
+
+type UnlinkTelegramResponse struct {
+	response.Response
+	Success bool
+}
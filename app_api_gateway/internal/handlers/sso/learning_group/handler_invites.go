@@ -0,0 +1,273 @@
+package learninggrouphandler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+// CreateLgInvite godoc
+// @Summary      Create learning group invite
+// @Description  This endpoint allows group admins to create invite code of the learning group. The code can be redeemed max_uses times until it expires in expires_in seconds.
+// @Tags         learning groups
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Param        learninggrouphandler.CreateLgInviteRequest body learninggrouphandler.CreateLgInviteRequest true "Invite parameters"
+// @Success      200 {object} learninggrouphandler.LgInviteResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /learning_group/{id}/invites [post]
+// @Security ApiKeyAuth
+func CreateLgInvite(log *slog.Logger, val *validator.Validate, lgService LgService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.learning_group.CreateLgInvite"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		uID := r.Header.Get("X-User-ID")
+		if uID == "" {
+			log.Error("missing X-User-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		lgID := chi.URLParam(r, "id")
+
+		var req CreateLgInviteRequest
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request received to create invite",
+			slog.Any("request from", uID),
+			slog.String("learning group id", lgID),
+		)
+
+		invite, err := lgService.CreateLgInvite(r.Context(), &ssomodels.CreateLgInvite{
+			UserID:    uID,
+			LgID:      lgID,
+			MaxUses:   req.MaxUses,
+			ExpiresIn: req.ExpiresIn,
+		})
+		if err != nil {
+			renderInviteError(w, r, log, err, "failed to create invite")
+			return
+		}
+
+		log.Info("invite created successfully")
+
+		render.JSON(w, r, LgInviteResponse{
+			Response: response.OK(),
+			Invite:   invite,
+		})
+	}
+}
+
+// ListLgInvites godoc
+// @Summary      List learning group invites
+// @Description  This endpoint allows group admins to get invite codes of the learning group which can still be redeemed.
+// @Tags         learning groups
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Success      200 {object} learninggrouphandler.ListLgInvitesResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /learning_group/{id}/invites [get]
+// @Security ApiKeyAuth
+func ListLgInvites(log *slog.Logger, val *validator.Validate, lgService LgService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.learning_group.ListLgInvites"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		uID := r.Header.Get("X-User-ID")
+		if uID == "" {
+			log.Error("missing X-User-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		lgID := chi.URLParam(r, "id")
+
+		log.Info("request received to list invites",
+			slog.Any("request from", uID),
+			slog.String("learning group id", lgID),
+		)
+
+		resp, err := lgService.ListLgInvites(r.Context(), &ssomodels.ListLgInvites{
+			UserID: uID,
+			LgID:   lgID,
+		})
+		if err != nil {
+			renderInviteError(w, r, log, err, "failed to list invites")
+			return
+		}
+
+		log.Info("invites listed successfully")
+
+		render.JSON(w, r, ListLgInvitesResponse{
+			Response: response.OK(),
+			Invites:  resp.Invites,
+		})
+	}
+}
+
+// RevokeLgInvite godoc
+// @Summary      Revoke learning group invite
+// @Description  This endpoint allows group admins to revoke invite code of the learning group. Learners who already joined stay in the group.
+// @Tags         learning groups
+// @Produce      json
+// @Param        id   path string true "ID of the learning group"
+// @Param        code path string true "Invite code"
+// @Success      200 {object} learninggrouphandler.RevokeLgInviteResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "Invite not found"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /learning_group/{id}/invites/{code} [delete]
+// @Security ApiKeyAuth
+func RevokeLgInvite(log *slog.Logger, val *validator.Validate, lgService LgService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.learning_group.RevokeLgInvite"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		uID := r.Header.Get("X-User-ID")
+		if uID == "" {
+			log.Error("missing X-User-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		lgID := chi.URLParam(r, "id")
+
+		log.Info("request received to revoke invite",
+			slog.Any("request from", uID),
+			slog.String("learning group id", lgID),
+		)
+
+		resp, err := lgService.RevokeLgInvite(r.Context(), &ssomodels.RevokeLgInvite{
+			UserID: uID,
+			LgID:   lgID,
+			Code:   chi.URLParam(r, "code"),
+		})
+		if err != nil {
+			renderInviteError(w, r, log, err, "failed to revoke invite")
+			return
+		}
+
+		log.Info("invite revoked successfully")
+
+		render.JSON(w, r, RevokeLgInviteResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+// RedeemLgInvite godoc
+// @Summary      Join learning group by invite
+// @Description  This endpoint allows user to join the learning group as a learner with invite code. Self-join links of the frontend call it.
+// @Tags         learning groups
+// @Produce      json
+// @Param        code path string true "Invite code"
+// @Success      200 {object} learninggrouphandler.RedeemLgInviteResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      404 {object} response.Response "Invite not found, expired or spent"
+// @Failure      409 {object} response.Response "User is already a learner"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /learning_groups/join/{code} [post]
+// @Security ApiKeyAuth
+func RedeemLgInvite(log *slog.Logger, val *validator.Validate, lgService LgService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.learning_group.RedeemLgInvite"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		uID := r.Header.Get("X-User-ID")
+		if uID == "" {
+			log.Error("missing X-User-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		log.Info("request received to redeem invite", slog.Any("request from", uID))
+
+		resp, err := lgService.RedeemLgInvite(r.Context(), &ssomodels.RedeemLgInvite{
+			UserID: uID,
+			Code:   chi.URLParam(r, "code"),
+		})
+		if err != nil {
+			renderInviteError(w, r, log, err, "failed to redeem invite")
+			return
+		}
+
+		log.Info("invite redeemed successfully", slog.String("learning_group_id", resp.LgID))
+
+		render.JSON(w, r, RedeemLgInviteResponse{
+			Response:        response.OK(),
+			LearningGroupID: resp.LgID,
+		})
+	}
+}
+
+func renderInviteError(w http.ResponseWriter, r *http.Request, log *slog.Logger, err error, failedMsg string) {
+	switch {
+	case errors.Is(err, ssoservice.ErrInvalidCredentials):
+		log.Error("invalid input", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusBadRequest)
+		render.JSON(w, r, response.Error("invalid input"))
+	case errors.Is(err, ssoservice.ErrPermissionDenied):
+		log.Error("permission denied", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusForbidden)
+		render.JSON(w, r, response.Error("permission denied"))
+	case errors.Is(err, ssoservice.ErrInviteNotFound):
+		log.Error("invite not found", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusNotFound)
+		render.JSON(w, r, response.Error("invite not found"))
+	case errors.Is(err, ssoservice.ErrAlreadyLearner):
+		log.Error("user is already a learner", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusConflict)
+		render.JSON(w, r, response.Error("user is already a learner"))
+	default:
+		log.Error(failedMsg, slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusInternalServerError)
+		render.JSON(w, r, response.Error(failedMsg))
+	}
+}
@@ -32,6 +32,20 @@ type LgService interface {
 	UpdateLearningGroup(ctx context.Context, updFields *ssomodels.UpdateLearningGroup) (*ssomodels.UpdateLearningGroupResp, error)
 	DeleteLearningGroup(ctx context.Context, lgID *ssomodels.DelLgByID) (*ssomodels.DelLgByIDResp, error)
 	GetLearningGroups(ctx context.Context, uID *ssomodels.GetLGroups) (*ssomodels.GetLGroupsResp, error)
+	GetLearningGroupTree(ctx context.Context, get *ssomodels.GetLgTree) (*ssomodels.LgTreeNode, error)
+	MoveLearningGroup(ctx context.Context, move *ssomodels.MoveLearningGroup) (*ssomodels.MoveLearningGroupResp, error)
+	CreateLgInvite(ctx context.Context, create *ssomodels.CreateLgInvite) (*ssomodels.LgInvite, error)
+	ListLgInvites(ctx context.Context, list *ssomodels.ListLgInvites) (*ssomodels.ListLgInvitesResp, error)
+	RevokeLgInvite(ctx context.Context, revoke *ssomodels.RevokeLgInvite) (*ssomodels.RevokeLgInviteResp, error)
+	RedeemLgInvite(ctx context.Context, redeem *ssomodels.RedeemLgInvite) (*ssomodels.RedeemLgInviteResp, error)
+	AddMembers(ctx context.Context, change *ssomodels.ChangeLgMembers) (*ssomodels.ChangeLgMembersResp, error)
+	RemoveMembers(ctx context.Context, change *ssomodels.ChangeLgMembers) (*ssomodels.ChangeLgMembersResp, error)
+	CreateLgRole(ctx context.Context, create *ssomodels.CreateLgRole) (*ssomodels.LgRole, error)
+	ListLgRoles(ctx context.Context, list *ssomodels.ListLgRoles) (*ssomodels.ListLgRolesResp, error)
+	UpdateLgRole(ctx context.Context, upd *ssomodels.UpdateLgRole) (*ssomodels.LgRoleResp, error)
+	DeleteLgRole(ctx context.Context, del *ssomodels.DeleteLgRole) (*ssomodels.LgRoleResp, error)
+	AssignLgRole(ctx context.Context, change *ssomodels.ChangeLgRoleMembers) (*ssomodels.LgRoleResp, error)
+	UnassignLgRole(ctx context.Context, change *ssomodels.ChangeLgRoleMembers) (*ssomodels.LgRoleResp, error)
 }
 
 // CreateLearningGroup godoc
@@ -80,6 +94,7 @@ func CreateLearningGroup(log *slog.Logger, val *validator.Validate, lgService Lg
 
 		resp, err := lgService.CreateLearningGroup(r.Context(), &ssomodels.CreateLearningGroup{
 			Name:        req.Name,
+			ParentID:    req.ParentID,
 			CreatedBy:   uID,
 			ModifiedBy:  uID,
 			GroupAdmins: []string{uID},
@@ -210,6 +225,7 @@ func GetLearningGroupByID(log *slog.Logger, val *validator.Validate, lgService L
 // @Success      200 {object} learninggrouphandler.UpdateLGroupResponse
 // @Failure      400 {object} response.Response "Invalid data in the request"
 // @Failure      404 {object} response.Response "Not Found"
+// @Failure      409 {object} response.Response "Learning group was changed since the version"
 // @Failure      500 {object} response.Response "Server error"
 // @Router       /learning_group/{id} [patch]
 // @Security ApiKeyAuth
@@ -254,6 +270,7 @@ func UpdateLearningGroup(log *slog.Logger, val *validator.Validate, lgService Lg
 			ModifiedBy:  uID,
 			GroupAdmins: req.GroupAdmins,
 			Learners:    req.Learners,
+			Version:     req.Version,
 		})
 		if err != nil {
 			switch {
@@ -266,6 +283,11 @@ func UpdateLearningGroup(log *slog.Logger, val *validator.Validate, lgService Lg
 				w.WriteHeader(http.StatusBadRequest)
 				render.JSON(w, r, response.Error("learning group not found"))
 				return
+			case errors.Is(err, ssoservice.ErrVersionConflict):
+				log.Error("learning group version conflict", slog.Int64("version", req.Version))
+				w.WriteHeader(http.StatusConflict)
+				render.JSON(w, r, response.Error("learning group was changed, reload it and retry"))
+				return
 			case errors.Is(err, ssoservice.ErrInvalidCredentials):
 				log.Error("invalid credentinals", slog.Any("learning_group_id", lgID))
 				w.WriteHeader(http.StatusBadRequest)
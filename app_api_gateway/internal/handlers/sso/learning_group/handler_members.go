@@ -0,0 +1,178 @@
+package learninggrouphandler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+// AddMembers godoc
+// @Summary      Add learning group members
+// @Description  This endpoint allows group admins to add users with the role (learner or group_admin) to the learning group. Other members are kept, so concurrent changes don't overwrite each other.
+// @Tags         learning groups
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Param        learninggrouphandler.ChangeLgMembersRequest body learninggrouphandler.ChangeLgMembersRequest true "Role and users"
+// @Success      200 {object} learninggrouphandler.ChangeLgMembersResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "Learning group not found"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /learning_group/{id}/members [post]
+// @Security ApiKeyAuth
+func AddMembers(log *slog.Logger, val *validator.Validate, lgService LgService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.learning_group.AddMembers"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		uID := r.Header.Get("X-User-ID")
+		if uID == "" {
+			log.Error("missing X-User-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		lgID := chi.URLParam(r, "id")
+
+		var req ChangeLgMembersRequest
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request received to add members",
+			slog.Any("request from", uID),
+			slog.String("learning group id", lgID),
+		)
+
+		resp, err := lgService.AddMembers(r.Context(), &ssomodels.ChangeLgMembers{
+			UserID:  uID,
+			LgID:    lgID,
+			Role:    req.Role,
+			UserIDs: req.UserIDs,
+		})
+		if err != nil {
+			renderMembersError(w, r, log, err, "failed to add members")
+			return
+		}
+
+		log.Info("members added successfully")
+
+		render.JSON(w, r, ChangeLgMembersResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+// RemoveMembers godoc
+// @Summary      Remove learning group members
+// @Description  This endpoint allows group admins to remove the role (learner or group_admin) of users in the learning group. Group admin can't remove own admin role.
+// @Tags         learning groups
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Param        learninggrouphandler.ChangeLgMembersRequest body learninggrouphandler.ChangeLgMembersRequest true "Role and users"
+// @Success      200 {object} learninggrouphandler.ChangeLgMembersResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "Learning group not found"
+// @Failure      409 {object} response.Response "Group must keep a group admin"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /learning_group/{id}/members [delete]
+// @Security ApiKeyAuth
+func RemoveMembers(log *slog.Logger, val *validator.Validate, lgService LgService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.learning_group.RemoveMembers"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		uID := r.Header.Get("X-User-ID")
+		if uID == "" {
+			log.Error("missing X-User-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		lgID := chi.URLParam(r, "id")
+
+		var req ChangeLgMembersRequest
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request received to remove members",
+			slog.Any("request from", uID),
+			slog.String("learning group id", lgID),
+		)
+
+		resp, err := lgService.RemoveMembers(r.Context(), &ssomodels.ChangeLgMembers{
+			UserID:  uID,
+			LgID:    lgID,
+			Role:    req.Role,
+			UserIDs: req.UserIDs,
+		})
+		if err != nil {
+			renderMembersError(w, r, log, err, "failed to remove members")
+			return
+		}
+
+		log.Info("members removed successfully")
+
+		render.JSON(w, r, ChangeLgMembersResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+func renderMembersError(w http.ResponseWriter, r *http.Request, log *slog.Logger, err error, failedMsg string) {
+	switch {
+	case errors.Is(err, ssoservice.ErrInvalidCredentials):
+		log.Error("invalid input", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusBadRequest)
+		render.JSON(w, r, response.Error("invalid input"))
+	case errors.Is(err, ssoservice.ErrPermissionDenied):
+		log.Error("permission denied", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusForbidden)
+		render.JSON(w, r, response.Error("permission denied"))
+	case errors.Is(err, ssoservice.ErrGroupNotFound):
+		log.Error("learning group not found", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusNotFound)
+		render.JSON(w, r, response.Error("learning group not found"))
+	case errors.Is(err, ssoservice.ErrLastGroupAdmin):
+		log.Error("group must keep a group admin", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusConflict)
+		render.JSON(w, r, response.Error("group must keep a group admin"))
+	default:
+		log.Error(failedMsg, slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusInternalServerError)
+		render.JSON(w, r, response.Error(failedMsg))
+	}
+}
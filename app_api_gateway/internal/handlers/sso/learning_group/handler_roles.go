@@ -0,0 +1,431 @@
+package learninggrouphandler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+// CreateLgRole godoc
+// @Summary      Create learning group role
+// @Description  This endpoint allows group admins to create named role of the learning group with a set of permissions: channel.create, channel.view, channel.edit, channel.delete, channel.share, plan.view, plan.edit, plan.delete, plan.share, lesson.view, lesson.edit, attempt.create and attempt.view_all. Members of the role get the permissions in channels shared with the group.
+// @Tags         learning groups
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Param        learninggrouphandler.LgRoleRequest body learninggrouphandler.LgRoleRequest true "Name and permissions of the role"
+// @Success      200 {object} learninggrouphandler.LgRoleResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      409 {object} response.Response "Role with the name already exists"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /learning_group/{id}/roles [post]
+// @Security ApiKeyAuth
+func CreateLgRole(log *slog.Logger, val *validator.Validate, lgService LgService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.learning_group.CreateLgRole"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		uID := r.Header.Get("X-User-ID")
+		if uID == "" {
+			log.Error("missing X-User-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		lgID := chi.URLParam(r, "id")
+
+		var req LgRoleRequest
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request received to create role",
+			slog.Any("request from", uID),
+			slog.String("learning group id", lgID),
+		)
+
+		role, err := lgService.CreateLgRole(r.Context(), &ssomodels.CreateLgRole{
+			UserID:      uID,
+			LgID:        lgID,
+			Name:        req.Name,
+			Permissions: req.Permissions,
+		})
+		if err != nil {
+			renderRoleError(w, r, log, err, "failed to create role")
+			return
+		}
+
+		log.Info("role created successfully")
+
+		render.JSON(w, r, LgRoleResponse{
+			Response: response.OK(),
+			Role:     role,
+		})
+	}
+}
+
+// ListLgRoles godoc
+// @Summary      List learning group roles
+// @Description  This endpoint allows group admins to get custom roles of the learning group with their permissions and members.
+// @Tags         learning groups
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Success      200 {object} learninggrouphandler.ListLgRolesResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /learning_group/{id}/roles [get]
+// @Security ApiKeyAuth
+func ListLgRoles(log *slog.Logger, val *validator.Validate, lgService LgService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.learning_group.ListLgRoles"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		uID := r.Header.Get("X-User-ID")
+		if uID == "" {
+			log.Error("missing X-User-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		lgID := chi.URLParam(r, "id")
+
+		log.Info("request received to list roles",
+			slog.Any("request from", uID),
+			slog.String("learning group id", lgID),
+		)
+
+		resp, err := lgService.ListLgRoles(r.Context(), &ssomodels.ListLgRoles{
+			UserID: uID,
+			LgID:   lgID,
+		})
+		if err != nil {
+			renderRoleError(w, r, log, err, "failed to list roles")
+			return
+		}
+
+		log.Info("roles listed successfully")
+
+		render.JSON(w, r, ListLgRolesResponse{
+			Response: response.OK(),
+			Roles:    resp.Roles,
+		})
+	}
+}
+
+// UpdateLgRole godoc
+// @Summary      Update learning group role
+// @Description  This endpoint allows group admins to replace name and permissions of the role. Members of the role get new permissions with the next request.
+// @Tags         learning groups
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Param        role_id path string true "ID of the role"
+// @Param        learninggrouphandler.LgRoleRequest body learninggrouphandler.LgRoleRequest true "Name and permissions of the role"
+// @Success      200 {object} learninggrouphandler.LgRoleSuccessResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "Role not found"
+// @Failure      409 {object} response.Response "Role with the name already exists"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /learning_group/{id}/roles/{role_id} [put]
+// @Security ApiKeyAuth
+func UpdateLgRole(log *slog.Logger, val *validator.Validate, lgService LgService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.learning_group.UpdateLgRole"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		uID := r.Header.Get("X-User-ID")
+		if uID == "" {
+			log.Error("missing X-User-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		lgID := chi.URLParam(r, "id")
+
+		var req LgRoleRequest
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request received to update role",
+			slog.Any("request from", uID),
+			slog.String("learning group id", lgID),
+		)
+
+		resp, err := lgService.UpdateLgRole(r.Context(), &ssomodels.UpdateLgRole{
+			UserID:      uID,
+			LgID:        lgID,
+			RoleID:      chi.URLParam(r, "role_id"),
+			Name:        req.Name,
+			Permissions: req.Permissions,
+		})
+		if err != nil {
+			renderRoleError(w, r, log, err, "failed to update role")
+			return
+		}
+
+		log.Info("role updated successfully")
+
+		render.JSON(w, r, LgRoleSuccessResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+// DeleteLgRole godoc
+// @Summary      Delete learning group role
+// @Description  This endpoint allows group admins to delete the role. Members of the role lose its permissions.
+// @Tags         learning groups
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Param        role_id path string true "ID of the role"
+// @Success      200 {object} learninggrouphandler.LgRoleSuccessResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "Role not found"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /learning_group/{id}/roles/{role_id} [delete]
+// @Security ApiKeyAuth
+func DeleteLgRole(log *slog.Logger, val *validator.Validate, lgService LgService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.learning_group.DeleteLgRole"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		uID := r.Header.Get("X-User-ID")
+		if uID == "" {
+			log.Error("missing X-User-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		lgID := chi.URLParam(r, "id")
+
+		log.Info("request received to delete role",
+			slog.Any("request from", uID),
+			slog.String("learning group id", lgID),
+		)
+
+		resp, err := lgService.DeleteLgRole(r.Context(), &ssomodels.DeleteLgRole{
+			UserID: uID,
+			LgID:   lgID,
+			RoleID: chi.URLParam(r, "role_id"),
+		})
+		if err != nil {
+			renderRoleError(w, r, log, err, "failed to delete role")
+			return
+		}
+
+		log.Info("role deleted successfully")
+
+		render.JSON(w, r, LgRoleSuccessResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+// AssignLgRole godoc
+// @Summary      Assign learning group role
+// @Description  This endpoint allows group admins to assign the role to users. Users who already have the role are skipped.
+// @Tags         learning groups
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Param        role_id path string true "ID of the role"
+// @Param        learninggrouphandler.LgRoleMembersRequest body learninggrouphandler.LgRoleMembersRequest true "Users"
+// @Success      200 {object} learninggrouphandler.LgRoleSuccessResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "Role not found"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /learning_group/{id}/roles/{role_id}/members [post]
+// @Security ApiKeyAuth
+func AssignLgRole(log *slog.Logger, val *validator.Validate, lgService LgService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.learning_group.AssignLgRole"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		uID := r.Header.Get("X-User-ID")
+		if uID == "" {
+			log.Error("missing X-User-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		lgID := chi.URLParam(r, "id")
+
+		var req LgRoleMembersRequest
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request received to assign role",
+			slog.Any("request from", uID),
+			slog.String("learning group id", lgID),
+		)
+
+		resp, err := lgService.AssignLgRole(r.Context(), &ssomodels.ChangeLgRoleMembers{
+			UserID:  uID,
+			LgID:    lgID,
+			RoleID:  chi.URLParam(r, "role_id"),
+			UserIDs: req.UserIDs,
+		})
+		if err != nil {
+			renderRoleError(w, r, log, err, "failed to assign role")
+			return
+		}
+
+		log.Info("role assigned successfully")
+
+		render.JSON(w, r, LgRoleSuccessResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+// UnassignLgRole godoc
+// @Summary      Unassign learning group role
+// @Description  This endpoint allows group admins to take the role away from users.
+// @Tags         learning groups
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Param        role_id path string true "ID of the role"
+// @Param        learninggrouphandler.LgRoleMembersRequest body learninggrouphandler.LgRoleMembersRequest true "Users"
+// @Success      200 {object} learninggrouphandler.LgRoleSuccessResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "Role not found"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /learning_group/{id}/roles/{role_id}/members [delete]
+// @Security ApiKeyAuth
+func UnassignLgRole(log *slog.Logger, val *validator.Validate, lgService LgService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.learning_group.UnassignLgRole"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		uID := r.Header.Get("X-User-ID")
+		if uID == "" {
+			log.Error("missing X-User-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		lgID := chi.URLParam(r, "id")
+
+		var req LgRoleMembersRequest
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request received to unassign role",
+			slog.Any("request from", uID),
+			slog.String("learning group id", lgID),
+		)
+
+		resp, err := lgService.UnassignLgRole(r.Context(), &ssomodels.ChangeLgRoleMembers{
+			UserID:  uID,
+			LgID:    lgID,
+			RoleID:  chi.URLParam(r, "role_id"),
+			UserIDs: req.UserIDs,
+		})
+		if err != nil {
+			renderRoleError(w, r, log, err, "failed to unassign role")
+			return
+		}
+
+		log.Info("role unassigned successfully")
+
+		render.JSON(w, r, LgRoleSuccessResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+func renderRoleError(w http.ResponseWriter, r *http.Request, log *slog.Logger, err error, failedMsg string) {
+	switch {
+	case errors.Is(err, ssoservice.ErrInvalidCredentials):
+		log.Error("invalid input", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusBadRequest)
+		render.JSON(w, r, response.Error("invalid input"))
+	case errors.Is(err, ssoservice.ErrPermissionDenied):
+		log.Error("permission denied", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusForbidden)
+		render.JSON(w, r, response.Error("permission denied"))
+	case errors.Is(err, ssoservice.ErrRoleNotFound):
+		log.Error("role not found", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusNotFound)
+		render.JSON(w, r, response.Error("role not found"))
+	case errors.Is(err, ssoservice.ErrRoleExists):
+		log.Error("role already exists", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusConflict)
+		render.JSON(w, r, response.Error("role already exists"))
+	default:
+		log.Error(failedMsg, slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusInternalServerError)
+		render.JSON(w, r, response.Error(failedMsg))
+	}
+}
@@ -0,0 +1,171 @@
+package learninggrouphandler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+// GetLearningGroupTree godoc
+// @Summary      Get learning group tree
+// @Description  This endpoint returns the learning group with all its descendant groups. Learners of the group or of its descendants and group admins of the group or of its ancestors can get it.
+// @Tags         learning groups
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Success      200 {object} learninggrouphandler.LgTreeResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "Learning group not found"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /learning_group/{id}/tree [get]
+// @Security ApiKeyAuth
+func GetLearningGroupTree(log *slog.Logger, val *validator.Validate, lgService LgService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.learning_group.GetLearningGroupTree"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		uID := r.Header.Get("X-User-ID")
+		if uID == "" {
+			log.Error("missing X-User-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		lgID := chi.URLParam(r, "id")
+
+		log.Info("request received to get learning group tree",
+			slog.Any("request from", uID),
+			slog.String("learning group id", lgID),
+		)
+
+		tree, err := lgService.GetLearningGroupTree(r.Context(), &ssomodels.GetLgTree{
+			UserID: uID,
+			LgID:   lgID,
+		})
+		if err != nil {
+			renderTreeError(w, r, log, err, "failed to get learning group tree")
+			return
+		}
+
+		log.Info("learning group tree got successfully")
+
+		render.JSON(w, r, LgTreeResponse{
+			Response: response.OK(),
+			Tree:     tree,
+		})
+	}
+}
+
+// MoveLearningGroup godoc
+// @Summary      Move learning group
+// @Description  This endpoint moves the learning group with its descendants under another group, empty parent_id makes it a root group. The user has to be group admin of both groups or of their ancestors. A group can't be moved under itself or its descendants. Channels shared with the new parent become available to learners of the moved groups.
+// @Tags         learning groups
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "ID of the learning group"
+// @Param        learninggrouphandler.MoveLearningGroupRequest body learninggrouphandler.MoveLearningGroupRequest true "New parent"
+// @Success      200 {object} learninggrouphandler.MoveLGroupResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "Learning group not found"
+// @Failure      409 {object} response.Response "Group was changed or can't be moved into its own subtree"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /learning_group/{id}/move [post]
+// @Security ApiKeyAuth
+func MoveLearningGroup(log *slog.Logger, val *validator.Validate, lgService LgService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.learning_group.MoveLearningGroup"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		uID := r.Header.Get("X-User-ID")
+		if uID == "" {
+			log.Error("missing X-User-ID in headers")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		lgID := chi.URLParam(r, "id")
+
+		var req MoveLearningGroupRequest
+		if err := render.DecodeJSON(r.Body, &req); err != nil {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		log.Info("request received to move learning group",
+			slog.Any("request from", uID),
+			slog.String("learning group id", lgID),
+			slog.String("parent id", req.ParentID),
+		)
+
+		resp, err := lgService.MoveLearningGroup(r.Context(), &ssomodels.MoveLearningGroup{
+			UserID:   uID,
+			LgID:     lgID,
+			ParentID: req.ParentID,
+			Version:  req.Version,
+		})
+		if err != nil {
+			renderTreeError(w, r, log, err, "failed to move learning group")
+			return
+		}
+
+		log.Info("learning group moved successfully")
+
+		render.JSON(w, r, MoveLGroupResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+func renderTreeError(w http.ResponseWriter, r *http.Request, log *slog.Logger, err error, failedMsg string) {
+	switch {
+	case errors.Is(err, ssoservice.ErrInvalidCredentials):
+		log.Error("invalid input", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusBadRequest)
+		render.JSON(w, r, response.Error("invalid input"))
+	case errors.Is(err, ssoservice.ErrPermissionDenied):
+		log.Error("permission denied", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusForbidden)
+		render.JSON(w, r, response.Error("permission denied"))
+	case errors.Is(err, ssoservice.ErrGroupNotFound):
+		log.Error("learning group not found", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusNotFound)
+		render.JSON(w, r, response.Error("learning group not found"))
+	case errors.Is(err, ssoservice.ErrVersionConflict):
+		log.Error("learning group version conflict", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusConflict)
+		render.JSON(w, r, response.Error("learning group was changed, reload it and retry"))
+	case errors.Is(err, ssoservice.ErrGroupCycle):
+		log.Error("learning group can't be moved into its own subtree", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusConflict)
+		render.JSON(w, r, response.Error("learning group can't be moved into its own subtree"))
+	default:
+		log.Error(failedMsg, slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusInternalServerError)
+		render.JSON(w, r, response.Error(failedMsg))
+	}
+}
@@ -1,19 +1,54 @@
 package learninggrouphandler
 
+// CreateLearningGroupRequest parent_id is optional, the user has to be
+// group admin of the parent or of its ancestors.
 type CreateLearningGroupRequest struct {
-	Name string `json:"name" validate:"required,min=3,max=100"`
+	Name     string `json:"name" validate:"required,min=3,max=100"`
+	ParentID string `json:"parent_id,omitempty"`
 }
 
 type GetLgByIDRequest struct {
 	LgID string `json:"learning_group_id" validate:"required"`
 }
 
+// UpdateLearningGroupRequest version is the version of the group got before the update.
+// The update is rejected if the group was changed since then, zero version skips the check.
 type UpdateLearningGroupRequest struct {
 	Name        string   `json:"name,omitempty"`
 	GroupAdmins []string `json:"group_admins,omitempty"`
 	Learners    []string `json:"learners,omitempty"`
+	Version     int64    `json:"version,omitempty"`
+}
+
+// ChangeLgMembersRequest role is learner or group_admin
+type ChangeLgMembersRequest struct {
+	Role    string   `json:"role" validate:"required,oneof=learner group_admin"`
+	UserIDs []string `json:"user_ids" validate:"required,min=1,max=1000,dive,required"`
+}
+
+// MoveLearningGroupRequest empty parent_id makes the group a root one.
+// Zero version skips the check.
+type MoveLearningGroupRequest struct {
+	ParentID string `json:"parent_id"`
+	Version  int64  `json:"version,omitempty"`
 }
 
 type DelLgByIDRequest struct {
 	LgID string `json:"learning_group_id" validate:"required"`
 }
+
+// CreateLgInviteRequest expires_in is in seconds, 30 days at most
+type CreateLgInviteRequest struct {
+	MaxUses   int64 `json:"max_uses" validate:"required,gte=1,lte=1000"`
+	ExpiresIn int64 `json:"expires_in" validate:"required,gte=60,lte=2592000"`
+}
+
+// LgRoleRequest permissions are from the catalog of sso
+type LgRoleRequest struct {
+	Name        string   `json:"name" validate:"required,min=3,max=50"`
+	Permissions []string `json:"permissions" validate:"required,min=1,max=20,dive,required"`
+}
+
+type LgRoleMembersRequest struct {
+	UserIDs []string `json:"user_ids" validate:"required,min=1,max=1000,dive,required"`
+}
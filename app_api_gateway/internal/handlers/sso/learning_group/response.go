@@ -29,3 +29,53 @@ type GetLearningGroupsResponse struct {
 	response.Response
 	LearningGroups *ssomodels.GetLGroupsResp
 }
+
+type LgTreeResponse struct {
+	response.Response
+	Tree *ssomodels.LgTreeNode
+}
+
+type MoveLGroupResponse struct {
+	response.Response
+	Success bool
+}
+
+type LgInviteResponse struct {
+	response.Response
+	Invite *ssomodels.LgInvite
+}
+
+type ListLgInvitesResponse struct {
+	response.Response
+	Invites []*ssomodels.LgInvite
+}
+
+type RevokeLgInviteResponse struct {
+	response.Response
+	Success bool
+}
+
+type RedeemLgInviteResponse struct {
+	response.Response
+	LearningGroupID string
+}
+
+type ChangeLgMembersResponse struct {
+	response.Response
+	Success bool
+}
+
+type LgRoleResponse struct {
+	response.Response
+	Role *ssomodels.LgRole
+}
+
+type ListLgRolesResponse struct {
+	response.Response
+	Roles []*ssomodels.LgRole
+}
+
+type LgRoleSuccessResponse struct {
+	response.Response
+	Success bool
+}
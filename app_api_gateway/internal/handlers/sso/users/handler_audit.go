@@ -0,0 +1,83 @@
+package usershandler
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+// ListAuditEvents godoc
+// @Summary      List audit log
+// @Description  This endpoint allows platform admins to browse the audit log of sso: logins, one-time codes, token refreshes, profile and admin role changes, learning group changes. Events go from the newest. Pass next_cursor of the response as cursor to get the next page, it's empty on the last page.
+// @Tags         users
+// @Produce      json
+// @Param        user_id    query string false "User the event is about or who did it"
+// @Param        event_type query string false "login_success, login_failure, otp_issued, otp_verified, token_refresh, profile_update, admin_flag_change, learning_group_create, learning_group_update, learning_group_delete, telegram_link, telegram_unlink, lg_role_change, user_provision, impersonation, user_deactivate or user_reactivate"
+// @Param        from       query string false "RFC3339 time, events created at or after it"
+// @Param        to         query string false "RFC3339 time, events created at or before it"
+// @Param        cursor     query string false "Cursor of the page"
+// @Param        limit      query int    false "Page size, 50 by default, 100 at most"
+// @Success      200 {object} usershandler.ListAuditEventsResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /admin/audit_log [get]
+// @Security ApiKeyAuth
+func ListAuditEvents(log *slog.Logger, val *validator.Validate, userService UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.users.ListAuditEvents"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		adminID := r.Header.Get("X-User-ID")
+
+		var limit int64
+		if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+			var err error
+			limit, err = strconv.ParseInt(limitStr, 10, 32)
+			if err != nil {
+				log.Error("invalid limit", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid limit"))
+				return
+			}
+		}
+
+		log.Info("listing audit events", slog.Any("request from", adminID))
+
+		resp, err := userService.ListAuditEvents(r.Context(), &ssomodels.ListAuditEvents{
+			AdminID:   adminID,
+			UserID:    r.URL.Query().Get("user_id"),
+			EventType: r.URL.Query().Get("event_type"),
+			From:      r.URL.Query().Get("from"),
+			To:        r.URL.Query().Get("to"),
+			Cursor:    r.URL.Query().Get("cursor"),
+			Limit:     int32(limit),
+		})
+		if err != nil {
+			renderError(w, r, log, err, "failed to list audit events")
+			return
+		}
+
+		log.Info("audit events listed successfully")
+
+		render.JSON(w, r, ListAuditEventsResponse{
+			Response:   response.OK(),
+			Events:     resp.Events,
+			NextCursor: resp.NextCursor,
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package usershandler
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	gdprJobTypeExport      = "export"
+	gdprJobStatusCompleted = "completed"
+)
+
+// RequestUserExport godoc
+// @Summary      Export user data
+// @Description  This endpoint allows platform admins to start export of all data of a user: profile, learning groups and lesson attempts. Poll the job and download the archive when it's completed.
+// @Tags         users
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Success      202 {object} usershandler.GDPRJobResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "User not found"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /admin/users/{id}/export [post]
+// @Security ApiKeyAuth
+func RequestUserExport(log *slog.Logger, val *validator.Validate, userService UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.users.RequestUserExport"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		adminID := r.Header.Get("X-User-ID")
+		userID := chi.URLParam(r, "id")
+
+		log.Info("requesting user data export", slog.Any("request from", adminID), slog.String("user_id", userID))
+
+		resp, err := userService.RequestUserExport(r.Context(), &ssomodels.ManageUser{
+			AdminID: adminID,
+			UserID:  userID,
+		})
+		if err != nil {
+			renderError(w, r, log, err, "failed to request user data export")
+			return
+		}
+
+		log.Info("user data export requested successfully", slog.String("job_id", resp.JobID))
+
+		w.WriteHeader(http.StatusAccepted)
+		render.JSON(w, r, GDPRJobResponse{
+			Response: response.OK(),
+			JobID:    resp.JobID,
+		})
+	}
+}
+
+// RequestUserErasure godoc
+// @Summary      Erase user data
+// @Description  This endpoint allows platform admins to start erasure of all data of a deactivated user. The user is removed from learning groups, content and lesson attempts are kept under a pseudonym and the profile is erased.
+// @Tags         users
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Success      202 {object} usershandler.GDPRJobResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "User not found"
+// @Failure      409 {object} response.Response "User is not deactivated"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /admin/users/{id}/erase [post]
+// @Security ApiKeyAuth
+func RequestUserErasure(log *slog.Logger, val *validator.Validate, userService UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.users.RequestUserErasure"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		adminID := r.Header.Get("X-User-ID")
+		userID := chi.URLParam(r, "id")
+
+		log.Info("requesting user data erasure", slog.Any("request from", adminID), slog.String("user_id", userID))
+
+		resp, err := userService.RequestUserErasure(r.Context(), &ssomodels.ManageUser{
+			AdminID: adminID,
+			UserID:  userID,
+		})
+		if err != nil {
+			renderError(w, r, log, err, "failed to request user data erasure")
+			return
+		}
+
+		log.Info("user data erasure requested successfully", slog.String("job_id", resp.JobID))
+
+		w.WriteHeader(http.StatusAccepted)
+		render.JSON(w, r, GDPRJobResponse{
+			Response: response.OK(),
+			JobID:    resp.JobID,
+		})
+	}
+}
+
+// GetGDPRJob godoc
+// @Summary      Get export or erasure job
+// @Description  This endpoint allows platform admins to check status of user data export or erasure. Status is pending, completed or failed.
+// @Tags         users
+// @Produce      json
+// @Param        id path string true "Job ID"
+// @Success      200 {object} usershandler.GetGDPRJobResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "Job not found"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /admin/gdpr_jobs/{id} [get]
+// @Security ApiKeyAuth
+func GetGDPRJob(log *slog.Logger, val *validator.Validate, userService UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.users.GetGDPRJob"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		adminID := r.Header.Get("X-User-ID")
+		jobID := chi.URLParam(r, "id")
+
+		log.Info("getting gdpr job", slog.Any("request from", adminID), slog.String("job_id", jobID))
+
+		job, err := userService.GetGDPRJob(r.Context(), &ssomodels.GetGDPRJob{
+			AdminID: adminID,
+			JobID:   jobID,
+		})
+		if err != nil {
+			renderError(w, r, log, err, "failed to get gdpr job")
+			return
+		}
+
+		render.JSON(w, r, GetGDPRJobResponse{
+			Response: response.OK(),
+			Job:      job,
+		})
+	}
+}
+
+// DownloadGDPRArchive godoc
+// @Summary      Download user data archive
+// @Description  This endpoint allows platform admins to download JSON archive of completed user data export.
+// @Tags         users
+// @Produce      json
+// @Param        id path string true "Job ID"
+// @Success      200 {file} file "JSON archive"
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "Job not found"
+// @Failure      409 {object} response.Response "Archive is not ready"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /admin/gdpr_jobs/{id}/archive [get]
+// @Security ApiKeyAuth
+func DownloadGDPRArchive(log *slog.Logger, val *validator.Validate, userService UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.users.DownloadGDPRArchive"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		adminID := r.Header.Get("X-User-ID")
+		jobID := chi.URLParam(r, "id")
+
+		log.Info("downloading gdpr archive", slog.Any("request from", adminID), slog.String("job_id", jobID))
+
+		job, err := userService.GetGDPRJob(r.Context(), &ssomodels.GetGDPRJob{
+			AdminID: adminID,
+			JobID:   jobID,
+		})
+		if err != nil {
+			renderError(w, r, log, err, "failed to get gdpr job")
+			return
+		}
+
+		if job.Type != gdprJobTypeExport || job.Status != gdprJobStatusCompleted {
+			log.Warn("archive is not ready", slog.String("type", job.Type), slog.String("status", job.Status))
+			w.WriteHeader(http.StatusConflict)
+			render.JSON(w, r, response.Error("archive is not ready"))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"user_%s.json\"", job.UserID))
+		if _, err := w.Write(job.Archive); err != nil {
+			log.Error("failed to write archive", slog.String("err", err.Error()))
+			return
+		}
+
+		log.Info("gdpr archive downloaded successfully")
+	}
+}
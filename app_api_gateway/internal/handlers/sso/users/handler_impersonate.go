@@ -0,0 +1,83 @@
+package usershandler
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+// ImpersonateUserRequest is an optional body of the impersonation request.
+type ImpersonateUserRequest struct {
+	Reason string `json:"reason"`
+}
+
+// ImpersonateUser godoc
+// @Summary      Impersonate user
+// @Description  This endpoint allows platform admins to see the platform as the user does, e.g. as a learner. It returns access token of the user valid for 15 minutes, the token can't be refreshed. Only read-only requests and logout are allowed with the token. Admins and deactivated users can't be impersonated. Every session is written to the audit log with the reason.
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        id   path string                               true  "User ID"
+// @Param        body body usershandler.ImpersonateUserRequest  false "Reason of the impersonation"
+// @Success      200 {object} usershandler.ImpersonateUserResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "User not found"
+// @Failure      409 {object} response.Response "User can't be impersonated"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /admin/users/{id}/impersonate [post]
+// @Security ApiKeyAuth
+func ImpersonateUser(log *slog.Logger, val *validator.Validate, userService UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.users.ImpersonateUser"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		var req ImpersonateUserRequest
+		err := render.DecodeJSON(r.Body, &req)
+		if err != nil && !errors.Is(err, io.EOF) {
+			log.Error("failed to decode request body", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to decode request"))
+			return
+		}
+
+		adminID := r.Header.Get("X-User-ID")
+		userID := chi.URLParam(r, "id")
+
+		log.Info("impersonating user", slog.Any("request from", adminID), slog.String("user_id", userID))
+
+		resp, err := userService.ImpersonateUser(r.Context(), &ssomodels.ImpersonateUser{
+			AdminID: adminID,
+			UserID:  userID,
+			Reason:  req.Reason,
+		})
+		if err != nil {
+			renderError(w, r, log, err, "failed to impersonate user")
+			return
+		}
+
+		log.Info("impersonation session started successfully")
+
+		render.JSON(w, r, ImpersonateUserResponse{
+			Response:    response.OK(),
+			AccessToken: resp.AccessToken,
+			ExpiresAt:   resp.ExpiresAt,
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package usershandler
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	maxImportFileSize = 5 << 20 // TODO: transfer to config
+	maxImportRows     = 1000    // TODO: transfer to config
+)
+
+var errTooManyRows = errors.New("too many rows")
+
+// ImportUsers godoc
+// @Summary      Import users from CSV
+// @Description  This endpoint allows platform admins to create users from CSV file with columns email, name and optional tg_link. Header row is optional. Every created user gets an invite to set the password. Rows are validated separately, the report has status created, reinvited, skipped_duplicate or invalid for every row. Existing users who haven't accepted the invite yet get the new one (reinvited). Users of created, reinvited and duplicate rows can be added as learners to the existing learning group or to the new one, admin becomes group admin of the new group.
+// @Tags         users
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file                formData file   true  "CSV file, 1000 rows at most"
+// @Param        learning_group_id   formData string false "Add users to the learning group"
+// @Param        learning_group_name formData string false "Create learning group with users"
+// @Success      200 {object} usershandler.ImportUsersResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /admin/users/import [post]
+// @Security ApiKeyAuth
+func ImportUsers(log *slog.Logger, val *validator.Validate, userService UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.users.ImportUsers"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		adminID := r.Header.Get("X-User-ID")
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxImportFileSize)
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			log.Error("failed to get file", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("failed to get file"))
+			return
+		}
+		defer file.Close()
+
+		rows, err := parseImportRows(file)
+		if err != nil {
+			log.Error("failed to parse file", slog.String("err", err.Error()))
+			w.WriteHeader(http.StatusBadRequest)
+			if errors.Is(err, errTooManyRows) {
+				render.JSON(w, r, response.Error(fmt.Sprintf("file has more than %d rows", maxImportRows)))
+				return
+			}
+			render.JSON(w, r, response.Error("invalid csv file"))
+			return
+		}
+
+		learningGroupID := r.FormValue("learning_group_id")
+		learningGroupName := r.FormValue("learning_group_name")
+		if learningGroupID != "" && learningGroupName != "" {
+			log.Error("both learning group id and name are set")
+			w.WriteHeader(http.StatusBadRequest)
+			render.JSON(w, r, response.Error("set either learning_group_id or learning_group_name"))
+			return
+		}
+
+		log.Info("importing users", slog.Any("request from", adminID), slog.Int("rows", len(rows)))
+
+		resp, err := userService.ImportUsers(r.Context(), &ssomodels.ImportUsers{
+			AdminID:           adminID,
+			Rows:              rows,
+			LearningGroupID:   learningGroupID,
+			LearningGroupName: learningGroupName,
+		})
+		if err != nil {
+			renderError(w, r, log, err, "failed to import users")
+			return
+		}
+
+		log.Info("users imported successfully")
+
+		render.JSON(w, r, ImportUsersResponse{
+			Response:           response.OK(),
+			Results:            resp.Results,
+			LearningGroupError: resp.LearningGroupError,
+		})
+	}
+}
+
+// parseImportRows reads rows of email, name and tg_link.
+// The first row is skipped if it's a header. Row is a line in the file.
+func parseImportRows(file io.Reader) ([]*ssomodels.ImportUserRow, error) {
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows []*ssomodels.ImportUserRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rows) == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "email") {
+			continue
+		}
+		if len(rows) == maxImportRows {
+			return nil, errTooManyRows
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := &ssomodels.ImportUserRow{
+			Row:   int64(line),
+			Email: strings.TrimSpace(record[0]),
+		}
+		if len(record) > 1 {
+			row.Name = strings.TrimSpace(record[1])
+		}
+		if len(record) > 2 {
+			row.TgLink = strings.TrimSpace(record[2])
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, errors.New("file has no rows")
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,292 @@
+package usershandler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+	ssoservice "github.com/DimTur/lp_api_gateway/internal/services/sso"
+	"github.com/DimTur/lp_api_gateway/pkg/meter"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+)
+
+type UserService interface {
+	ListUsers(ctx context.Context, list *ssomodels.ListUsers) (*ssomodels.ListUsersResp, error)
+	SetUserAdmin(ctx context.Context, set *ssomodels.SetUserAdmin) (*ssomodels.SetUserAdminResp, error)
+	DeactivateUser(ctx context.Context, manage *ssomodels.ManageUser) (*ssomodels.ManageUserResp, error)
+	ReactivateUser(ctx context.Context, manage *ssomodels.ManageUser) (*ssomodels.ManageUserResp, error)
+	ImpersonateUser(ctx context.Context, imp *ssomodels.ImpersonateUser) (*ssomodels.ImpersonateUserResp, error)
+	RequestUserExport(ctx context.Context, req *ssomodels.ManageUser) (*ssomodels.GDPRJobResp, error)
+	RequestUserErasure(ctx context.Context, req *ssomodels.ManageUser) (*ssomodels.GDPRJobResp, error)
+	GetGDPRJob(ctx context.Context, get *ssomodels.GetGDPRJob) (*ssomodels.GDPRJob, error)
+	ImportUsers(ctx context.Context, imp *ssomodels.ImportUsers) (*ssomodels.ImportUsersResp, error)
+	ListAuditEvents(ctx context.Context, list *ssomodels.ListAuditEvents) (*ssomodels.ListAuditEventsResp, error)
+}
+
+// ListUsers godoc
+// @Summary      List users
+// @Description  This endpoint allows platform admins to browse users. Query is searched in email, name and telegram link. Pass next_cursor of the response as cursor to get the next page, it's empty on the last page.
+// @Tags         users
+// @Produce      json
+// @Param        query  query string false "Search in email, name and telegram link"
+// @Param        status query string false "pending_verification, active or deactivated"
+// @Param        cursor query string false "Cursor of the page"
+// @Param        limit  query int    false "Page size, 20 by default, 100 at most"
+// @Success      200 {object} usershandler.ListUsersResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /admin/users [get]
+// @Security ApiKeyAuth
+func ListUsers(log *slog.Logger, val *validator.Validate, userService UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.users.ListUsers"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		adminID := r.Header.Get("X-User-ID")
+
+		var limit int64
+		if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+			var err error
+			limit, err = strconv.ParseInt(limitStr, 10, 32)
+			if err != nil {
+				log.Error("invalid limit", slog.String("err", err.Error()))
+				w.WriteHeader(http.StatusBadRequest)
+				render.JSON(w, r, response.Error("invalid limit"))
+				return
+			}
+		}
+
+		log.Info("listing users", slog.Any("request from", adminID))
+
+		resp, err := userService.ListUsers(r.Context(), &ssomodels.ListUsers{
+			AdminID: adminID,
+			Query:   r.URL.Query().Get("query"),
+			Status:  r.URL.Query().Get("status"),
+			Cursor:  r.URL.Query().Get("cursor"),
+			Limit:   int32(limit),
+		})
+		if err != nil {
+			renderError(w, r, log, err, "failed to list users")
+			return
+		}
+
+		log.Info("users listed successfully")
+
+		render.JSON(w, r, ListUsersResponse{
+			Response:   response.OK(),
+			Users:      resp.Users,
+			NextCursor: resp.NextCursor,
+		})
+	}
+}
+
+// GrantAdmin godoc
+// @Summary      Grant admin role
+// @Description  This endpoint allows platform admins to make another user a platform admin.
+// @Tags         users
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Success      200 {object} usershandler.ManageUserResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "User not found"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /admin/users/{id}/admin [post]
+// @Security ApiKeyAuth
+func GrantAdmin(log *slog.Logger, val *validator.Validate, userService UserService) http.HandlerFunc {
+	return setUserAdmin(log, userService, "handlers.sso.users.GrantAdmin", true)
+}
+
+// RevokeAdmin godoc
+// @Summary      Revoke admin role
+// @Description  This endpoint allows platform admins to take the platform admin role away from another user. Admins can't revoke their own role.
+// @Tags         users
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Success      200 {object} usershandler.ManageUserResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "User not found"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /admin/users/{id}/admin [delete]
+// @Security ApiKeyAuth
+func RevokeAdmin(log *slog.Logger, val *validator.Validate, userService UserService) http.HandlerFunc {
+	return setUserAdmin(log, userService, "handlers.sso.users.RevokeAdmin", false)
+}
+
+func setUserAdmin(log *slog.Logger, userService UserService, op string, isAdmin bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		adminID := r.Header.Get("X-User-ID")
+		userID := chi.URLParam(r, "id")
+
+		log.Info("changing admin role", slog.Any("request from", adminID), slog.String("user_id", userID))
+
+		resp, err := userService.SetUserAdmin(r.Context(), &ssomodels.SetUserAdmin{
+			AdminID: adminID,
+			UserID:  userID,
+			IsAdmin: isAdmin,
+		})
+		if err != nil {
+			renderError(w, r, log, err, "failed to change admin role")
+			return
+		}
+
+		log.Info("admin role changed successfully")
+
+		render.JSON(w, r, ManageUserResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+// DeactivateUser godoc
+// @Summary      Deactivate user
+// @Description  This endpoint allows platform admins to block a user account. The user can't log in any more, all refresh tokens of the user are revoked and issued access tokens are rejected.
+// @Tags         users
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Success      200 {object} usershandler.ManageUserResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "User not found"
+// @Failure      409 {object} response.Response "User is already deactivated"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /admin/users/{id}/deactivate [post]
+// @Security ApiKeyAuth
+func DeactivateUser(log *slog.Logger, val *validator.Validate, userService UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.users.DeactivateUser"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		adminID := r.Header.Get("X-User-ID")
+		userID := chi.URLParam(r, "id")
+
+		log.Info("deactivating user", slog.Any("request from", adminID), slog.String("user_id", userID))
+
+		resp, err := userService.DeactivateUser(r.Context(), &ssomodels.ManageUser{
+			AdminID: adminID,
+			UserID:  userID,
+		})
+		if err != nil {
+			renderError(w, r, log, err, "failed to deactivate user")
+			return
+		}
+
+		log.Info("user deactivated successfully")
+
+		render.JSON(w, r, ManageUserResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+// ReactivateUser godoc
+// @Summary      Reactivate user
+// @Description  This endpoint allows platform admins to unblock a deactivated user account.
+// @Tags         users
+// @Produce      json
+// @Param        id path string true "User ID"
+// @Success      200 {object} usershandler.ManageUserResponse
+// @Failure      400 {object} response.Response "Invalid data in the request"
+// @Failure      401 {object} response.Response "Unauthorized"
+// @Failure      403 {object} response.Response "Permission denied"
+// @Failure      404 {object} response.Response "User not found"
+// @Failure      409 {object} response.Response "User is not deactivated"
+// @Failure      500 {object} response.Response "Server error"
+// @Router       /admin/users/{id}/reactivate [post]
+// @Security ApiKeyAuth
+func ReactivateUser(log *slog.Logger, val *validator.Validate, userService UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.sso.users.ReactivateUser"
+
+		log = log.With(
+			slog.String("op", op),
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+		)
+
+		meter.AllReqCount.Add(r.Context(), 1)
+
+		adminID := r.Header.Get("X-User-ID")
+		userID := chi.URLParam(r, "id")
+
+		log.Info("reactivating user", slog.Any("request from", adminID), slog.String("user_id", userID))
+
+		resp, err := userService.ReactivateUser(r.Context(), &ssomodels.ManageUser{
+			AdminID: adminID,
+			UserID:  userID,
+		})
+		if err != nil {
+			renderError(w, r, log, err, "failed to reactivate user")
+			return
+		}
+
+		log.Info("user reactivated successfully")
+
+		render.JSON(w, r, ManageUserResponse{
+			Response: response.OK(),
+			Success:  resp.Success,
+		})
+	}
+}
+
+func renderError(w http.ResponseWriter, r *http.Request, log *slog.Logger, err error, failedMsg string) {
+	switch {
+	case errors.Is(err, ssoservice.ErrInvalidCredentials):
+		log.Error("invalid input", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusBadRequest)
+		render.JSON(w, r, response.Error("invalid input"))
+	case errors.Is(err, ssoservice.ErrUserNotFound):
+		log.Error("user not found", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusNotFound)
+		render.JSON(w, r, response.Error("user not found"))
+	case errors.Is(err, ssoservice.ErrPermissionDenied):
+		log.Error("permission denied", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusForbidden)
+		render.JSON(w, r, response.Error("permission denied"))
+	case errors.Is(err, ssoservice.ErrUserStatusConflict):
+		log.Error("user status conflict", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusConflict)
+		render.JSON(w, r, response.Error("user status doesn't allow the action"))
+	case errors.Is(err, ssoservice.ErrGDPRJobNotFound):
+		log.Error("gdpr job not found", slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusNotFound)
+		render.JSON(w, r, response.Error("gdpr job not found"))
+	default:
+		log.Error(failedMsg, slog.String("err", err.Error()))
+		w.WriteHeader(http.StatusInternalServerError)
+		render.JSON(w, r, response.Error(failedMsg))
+	}
+}
@@ -0,0 +1,45 @@
+package usershandler
+
+import (
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/internal/lib/api/response"
+)
+
+type ListUsersResponse struct {
+	response.Response
+	Users      []*ssomodels.UserSummary
+	NextCursor string
+}
+
+type ManageUserResponse struct {
+	response.Response
+	Success bool
+}
+
+type ImpersonateUserResponse struct {
+	response.Response
+	AccessToken string
+	ExpiresAt   string
+}
+
+type GDPRJobResponse struct {
+	response.Response
+	JobID string
+}
+
+type GetGDPRJobResponse struct {
+	response.Response
+	Job *ssomodels.GDPRJob
+}
+
+type ImportUsersResponse struct {
+	response.Response
+	Results            []*ssomodels.ImportUserResult
+	LearningGroupError string
+}
+
+type ListAuditEventsResponse struct {
+	response.Response
+	Events     []*ssomodels.AuditEvent
+	NextCursor string
+}
@@ -0,0 +1,30 @@
+package clientinfo
+
+import "context"
+
+// gRPC metadata keys used to pass client info to the backend services.
+const (
+	MDClientIP  = "x-client-ip"
+	MDUserAgent = "x-user-agent"
+	MDActorID   = "x-actor-id"
+)
+
+type ctxKey struct{}
+
+// Info describes the client which sent the HTTP request.
+// ActorID is set by the auth middleware when the admin impersonates the user.
+type Info struct {
+	IP        string
+	UserAgent string
+	ActorID   string
+}
+
+func WithInfo(ctx context.Context, info Info) context.Context {
+	return context.WithValue(ctx, ctxKey{}, info)
+}
+
+// FromContext returns client info put to the context by the clientinfo middleware.
+func FromContext(ctx context.Context) (Info, bool) {
+	info, ok := ctx.Value(ctxKey{}).(Info)
+	return info, ok
+}
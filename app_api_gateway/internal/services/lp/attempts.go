@@ -48,10 +48,11 @@ func (lp *LpService) TryLesson(ctx context.Context, lesson *lpmodels.TryLesson)
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	p, err := lp.PermissionsProvider.CheckCreaterOrLearnerAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    lesson.UserID,
-		ChannelID: lesson.ChannelID,
-		PlanID:    lesson.PlanID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     lesson.UserID,
+		ChannelID:  lesson.ChannelID,
+		PlanID:     lesson.PlanID,
+		Permission: permissions.PermAttemptCreate,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -245,10 +246,20 @@ func (lp *LpService) GetLessonAttempts(ctx context.Context, inputParams *lpmodel
 
 	log.Info("start getting lesson attempts")
 
+	ownerID := inputParams.UserID
+	if inputParams.LearnerID != "" {
+		ownerID = inputParams.LearnerID
+	}
+
 	// Start Getting
-	log.Info("getting lesson attempts")
+	log.Info("getting lesson attempts", slog.String("learner_id", ownerID))
 	span.AddEvent("started_getting_lesson_attempts")
-	resp, err := lp.AttemptProvider.GetLessonAttempts(ctx, inputParams)
+	resp, err := lp.AttemptProvider.GetLessonAttempts(ctx, &lpmodels.GetLessonAttempts{
+		UserID:   ownerID,
+		LessonID: inputParams.LessonID,
+		Limit:    inputParams.Limit,
+		Offset:   inputParams.Offset,
+	})
 	if err != nil {
 		switch {
 		case errors.Is(err, lpgrpc.ErrInvalidCredentials):
@@ -264,6 +275,34 @@ func (lp *LpService) GetLessonAttempts(ctx context.Context, inputParams *lpmodel
 	}
 	span.AddEvent("completed_getting_lesson_attempts")
 
+	// Attempts of other learners are shown only with attempt.view_all
+	// in the channels of the attempts
+	if ownerID != inputParams.UserID {
+		span.AddEvent("checking_permissons_for_user")
+		checked := make(map[int64]bool)
+		for _, attempt := range resp.LessonAttempts {
+			if checked[attempt.ChannelID] {
+				continue
+			}
+			checked[attempt.ChannelID] = true
+
+			p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+				UserID:     inputParams.UserID,
+				ChannelID:  attempt.ChannelID,
+				Permission: permissions.PermAttemptViewAll,
+			})
+			if err != nil {
+				log.Error("can't check permissions", slog.String("err", err.Error()))
+				return &lpmodels.GetLessonAttemptsResp{}, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+			}
+			if !p {
+				log.Info("permissions denied", slog.Int64("channel_id", attempt.ChannelID))
+				return &lpmodels.GetLessonAttemptsResp{}, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+			}
+		}
+		span.AddEvent("completed_checking_permissons_for_user")
+	}
+
 	log.Info("lesson attempts got successfully")
 
 	return resp, nil
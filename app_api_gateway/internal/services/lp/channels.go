@@ -49,9 +49,10 @@ func (lp *LpService) CreateChannel(ctx context.Context, newChannel *lpmodels.Cre
 	log.Info("creating new channel")
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.IsGroupAdmin(ctx, &ssomodels.IsGroupAdmin{
-		UserID: newChannel.CreatedBy,
-		LgID:   newChannel.LearningGroupId,
+	p, err := lp.PermissionsProvider.HasLgPermission(ctx, &permissions.CheckLgPerm{
+		UserID:     newChannel.CreatedBy,
+		LgID:       newChannel.LearningGroupId,
+		Permission: permissions.PermChannelCreate,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -113,9 +114,10 @@ func (lp *LpService) GetChannel(ctx context.Context, channel *lpmodels.GetChanne
 	span.AddEvent("validation_completed")
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.CheckCreaterOrLearnerAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    channel.UserID,
-		ChannelID: channel.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     channel.UserID,
+		ChannelID:  channel.ChannelID,
+		Permission: permissions.PermChannelView,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -240,9 +242,10 @@ func (lp *LpService) UpdateChannel(ctx context.Context, updChannel *lpmodels.Upd
 	span.AddEvent("validation_completed")
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    updChannel.UserID,
-		ChannelID: updChannel.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     updChannel.UserID,
+		ChannelID:  updChannel.ChannelID,
+		Permission: permissions.PermChannelEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -315,9 +318,10 @@ func (lp *LpService) DeleteChannel(ctx context.Context, delChannel *lpmodels.Del
 	span.AddEvent("validation_completed")
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    delChannel.UserID,
-		ChannelID: delChannel.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     delChannel.UserID,
+		ChannelID:  delChannel.ChannelID,
+		Permission: permissions.PermChannelDelete,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -382,9 +386,10 @@ func (lp *LpService) ShareChannelToGroup(ctx context.Context, s *lpmodels.Sharin
 	span.SetAttributes(attribute.Int64("channel_id", s.ChannelID))
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    s.UserID,
-		ChannelID: s.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     s.UserID,
+		ChannelID:  s.ChannelID,
+		Permission: permissions.PermChannelShare,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
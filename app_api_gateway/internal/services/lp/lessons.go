@@ -46,10 +46,11 @@ func (lp *LpService) CreateLesson(ctx context.Context, lesson *lpmodels.CreateLe
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    lesson.CreatedBy,
-		PlanID:    lesson.PlanID,
-		ChannelID: lesson.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     lesson.CreatedBy,
+		PlanID:     lesson.PlanID,
+		ChannelID:  lesson.ChannelID,
+		Permission: permissions.PermLessonEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -113,10 +114,11 @@ func (lp *LpService) GetLesson(ctx context.Context, lesson *lpmodels.GetLesson)
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	p, err := lp.PermissionsProvider.CheckCreaterOrLearnerAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    lesson.UserID,
-		ChannelID: lesson.ChannelID,
-		PlanID:    lesson.PlanID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     lesson.UserID,
+		ChannelID:  lesson.ChannelID,
+		PlanID:     lesson.PlanID,
+		Permission: permissions.PermLessonView,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -178,10 +180,11 @@ func (lp *LpService) GetLessons(ctx context.Context, inputParam *lpmodels.GetLes
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	perm, err := lp.PermissionsProvider.CheckCreaterOrLearnerAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    inputParam.UserID,
-		ChannelID: inputParam.ChannelID,
-		PlanID:    inputParam.PlanID,
+	perm, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     inputParam.UserID,
+		ChannelID:  inputParam.ChannelID,
+		PlanID:     inputParam.PlanID,
+		Permission: permissions.PermLessonView,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -248,10 +251,11 @@ func (lp *LpService) UpdateLesson(ctx context.Context, updLesson *lpmodels.Updat
 	span.AddEvent("validation_completed")
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    updLesson.LastModifiedBy,
-		PlanID:    updLesson.PlanID,
-		ChannelID: updLesson.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     updLesson.LastModifiedBy,
+		PlanID:     updLesson.PlanID,
+		ChannelID:  updLesson.ChannelID,
+		Permission: permissions.PermLessonEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -331,10 +335,11 @@ func (lp *LpService) DeleteLesson(ctx context.Context, delLess *lpmodels.DeleteL
 	span.AddEvent("validation_completed")
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    delLess.UserID,
-		PlanID:    delLess.PlanID,
-		ChannelID: delLess.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     delLess.UserID,
+		PlanID:     delLess.PlanID,
+		ChannelID:  delLess.ChannelID,
+		Permission: permissions.PermLessonEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
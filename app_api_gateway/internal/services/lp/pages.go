@@ -46,10 +46,11 @@ func (lp *LpService) CreateImagePage(ctx context.Context, page *lpmodels.CreateI
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    page.CreatedBy,
-		PlanID:    page.PlanID,
-		ChannelID: page.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     page.CreatedBy,
+		PlanID:     page.PlanID,
+		ChannelID:  page.ChannelID,
+		Permission: permissions.PermLessonEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -113,10 +114,11 @@ func (lp *LpService) CreateVideoPage(ctx context.Context, page *lpmodels.CreateV
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    page.CreatedBy,
-		PlanID:    page.PlanID,
-		ChannelID: page.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     page.CreatedBy,
+		PlanID:     page.PlanID,
+		ChannelID:  page.ChannelID,
+		Permission: permissions.PermLessonEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -180,10 +182,11 @@ func (lp *LpService) CreatePdfPage(ctx context.Context, page *lpmodels.CreatePDF
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    page.CreatedBy,
-		PlanID:    page.PlanID,
-		ChannelID: page.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     page.CreatedBy,
+		PlanID:     page.PlanID,
+		ChannelID:  page.ChannelID,
+		Permission: permissions.PermLessonEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -247,10 +250,11 @@ func (lp *LpService) GetImagePage(ctx context.Context, page *lpmodels.GetPage) (
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	p, err := lp.PermissionsProvider.CheckCreaterOrLearnerAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    page.UserID,
-		ChannelID: page.ChannelID,
-		PlanID:    page.PlanID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     page.UserID,
+		ChannelID:  page.ChannelID,
+		PlanID:     page.PlanID,
+		Permission: permissions.PermLessonView,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -312,10 +316,11 @@ func (lp *LpService) GetVideoPage(ctx context.Context, page *lpmodels.GetPage) (
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	p, err := lp.PermissionsProvider.CheckCreaterOrLearnerAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    page.UserID,
-		ChannelID: page.ChannelID,
-		PlanID:    page.PlanID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     page.UserID,
+		ChannelID:  page.ChannelID,
+		PlanID:     page.PlanID,
+		Permission: permissions.PermLessonView,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -377,10 +382,11 @@ func (lp *LpService) GetPDFPage(ctx context.Context, page *lpmodels.GetPage) (*l
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	p, err := lp.PermissionsProvider.CheckCreaterOrLearnerAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    page.UserID,
-		ChannelID: page.ChannelID,
-		PlanID:    page.PlanID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     page.UserID,
+		ChannelID:  page.ChannelID,
+		PlanID:     page.PlanID,
+		Permission: permissions.PermLessonView,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -440,10 +446,11 @@ func (lp *LpService) GetPages(ctx context.Context, inputParams *lpmodels.GetPage
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	perm, err := lp.PermissionsProvider.CheckCreaterOrLearnerAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    inputParams.UserID,
-		ChannelID: inputParams.ChannelID,
-		PlanID:    inputParams.PlanID,
+	perm, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     inputParams.UserID,
+		ChannelID:  inputParams.ChannelID,
+		PlanID:     inputParams.PlanID,
+		Permission: permissions.PermLessonView,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -510,10 +517,11 @@ func (lp *LpService) UpdateImagePage(ctx context.Context, updIPage *lpmodels.Upd
 	span.AddEvent("validation_completed")
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    updIPage.LastModifiedBy,
-		PlanID:    updIPage.PlanID,
-		ChannelID: updIPage.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     updIPage.LastModifiedBy,
+		PlanID:     updIPage.PlanID,
+		ChannelID:  updIPage.ChannelID,
+		Permission: permissions.PermLessonEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -594,10 +602,11 @@ func (lp *LpService) UpdateVideoPage(ctx context.Context, updIPage *lpmodels.Upd
 	span.AddEvent("validation_completed")
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    updIPage.LastModifiedBy,
-		PlanID:    updIPage.PlanID,
-		ChannelID: updIPage.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     updIPage.LastModifiedBy,
+		PlanID:     updIPage.PlanID,
+		ChannelID:  updIPage.ChannelID,
+		Permission: permissions.PermLessonEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -678,10 +687,11 @@ func (lp *LpService) UpdatePDFPage(ctx context.Context, updIPage *lpmodels.Updat
 	span.AddEvent("validation_completed")
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    updIPage.LastModifiedBy,
-		PlanID:    updIPage.PlanID,
-		ChannelID: updIPage.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     updIPage.LastModifiedBy,
+		PlanID:     updIPage.PlanID,
+		ChannelID:  updIPage.ChannelID,
+		Permission: permissions.PermLessonEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -761,10 +771,11 @@ func (lp *LpService) DeletePage(ctx context.Context, delPage *lpmodels.DeletePag
 	span.AddEvent("validation_completed")
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    delPage.UserID,
-		PlanID:    delPage.PlanID,
-		ChannelID: delPage.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     delPage.UserID,
+		PlanID:     delPage.PlanID,
+		ChannelID:  delPage.ChannelID,
+		Permission: permissions.PermLessonEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -46,9 +46,10 @@ func (lp *LpService) CreatePlan(ctx context.Context, plan *lpmodels.CreatePlan)
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    plan.CreatedBy,
-		ChannelID: plan.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     plan.CreatedBy,
+		ChannelID:  plan.ChannelID,
+		Permission: permissions.PermPlanEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -111,10 +112,11 @@ func (lp *LpService) GetPlan(ctx context.Context, plan *lpmodels.GetPlan) (*lpmo
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	p, err := lp.PermissionsProvider.CheckCreaterOrLearnerAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    plan.UserID,
-		PlanID:    plan.PlanID,
-		ChannelID: plan.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     plan.UserID,
+		PlanID:     plan.PlanID,
+		ChannelID:  plan.ChannelID,
+		Permission: permissions.PermPlanView,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -173,9 +175,10 @@ func (lp *LpService) GetPlans(ctx context.Context, inputParam *lpmodels.GetPlans
 
 	// Start check admin permissions
 	span.AddEvent("checking_permissons_for_user")
-	adminPerm, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    inputParam.UserID,
-		ChannelID: inputParam.ChannelID,
+	adminPerm, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     inputParam.UserID,
+		ChannelID:  inputParam.ChannelID,
+		Permission: permissions.PermPlanEdit,
 	})
 	fmt.Println("err", err)
 	if err != nil {
@@ -206,9 +209,10 @@ func (lp *LpService) GetPlans(ctx context.Context, inputParam *lpmodels.GetPlans
 	}
 
 	// Start check learner permissions
-	perm, err := lp.PermissionsProvider.CheckCreaterOrLearnerAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    inputParam.UserID,
-		ChannelID: inputParam.ChannelID,
+	perm, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     inputParam.UserID,
+		ChannelID:  inputParam.ChannelID,
+		Permission: permissions.PermPlanView,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -270,9 +274,10 @@ func (lp *LpService) GetPlansForGroupAdmin(ctx context.Context, inputParam *lpmo
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	perm, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    inputParam.UserID,
-		ChannelID: inputParam.ChannelID,
+	perm, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     inputParam.UserID,
+		ChannelID:  inputParam.ChannelID,
+		Permission: permissions.PermPlanEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -337,10 +342,11 @@ func (lp *LpService) UpdatePlan(ctx context.Context, updPlan *lpmodels.UpdatePla
 	span.AddEvent("validation_completed")
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    updPlan.LastModifiedBy,
-		PlanID:    updPlan.PlanID,
-		ChannelID: updPlan.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     updPlan.LastModifiedBy,
+		PlanID:     updPlan.PlanID,
+		ChannelID:  updPlan.ChannelID,
+		Permission: permissions.PermPlanEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -420,10 +426,11 @@ func (lp *LpService) DeletePlan(ctx context.Context, delPlan *lpmodels.DelPlan)
 	span.AddEvent("validation_completed")
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    delPlan.UserID,
-		PlanID:    delPlan.PlanID,
-		ChannelID: delPlan.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     delPlan.UserID,
+		PlanID:     delPlan.PlanID,
+		ChannelID:  delPlan.ChannelID,
+		Permission: permissions.PermPlanDelete,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -493,10 +500,11 @@ func (lp *LpService) SharePlanWithUser(ctx context.Context, sharePlanWithUser *l
 	span.AddEvent("validation_completed")
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    sharePlanWithUser.UserID,
-		PlanID:    sharePlanWithUser.PlanID,
-		ChannelID: sharePlanWithUser.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     sharePlanWithUser.UserID,
+		PlanID:     sharePlanWithUser.PlanID,
+		ChannelID:  sharePlanWithUser.ChannelID,
+		Permission: permissions.PermPlanShare,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
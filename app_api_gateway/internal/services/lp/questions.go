@@ -44,10 +44,11 @@ func (lp *LpService) CreateQuestionPage(ctx context.Context, question *lpmodels.
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    question.CreatedBy,
-		PlanID:    question.PlanID,
-		ChannelID: question.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     question.CreatedBy,
+		PlanID:     question.PlanID,
+		ChannelID:  question.ChannelID,
+		Permission: permissions.PermLessonEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -111,10 +112,11 @@ func (lp *LpService) GetQuestionPage(ctx context.Context, question *lpmodels.Get
 
 	// Start check permissions
 	span.AddEvent("checking_permissons_for_user")
-	p, err := lp.PermissionsProvider.CheckCreaterOrLearnerAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    question.UserID,
-		ChannelID: question.ChannelID,
-		PlanID:    question.PlanID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     question.UserID,
+		ChannelID:  question.ChannelID,
+		PlanID:     question.PlanID,
+		Permission: permissions.PermLessonView,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -178,10 +180,11 @@ func (lp *LpService) UpdateQuestionPage(ctx context.Context, updQust *lpmodels.U
 	span.AddEvent("validation_completed")
 
 	// Start check permissions
-	p, err := lp.PermissionsProvider.CheckCreatorOrAdminAndSharePermissions(ctx, &permissions.CheckPerm{
-		UserID:    updQust.LastModifiedBy,
-		PlanID:    updQust.PlanID,
-		ChannelID: updQust.ChannelID,
+	p, err := lp.PermissionsProvider.CheckPermission(ctx, &permissions.CheckPerm{
+		UserID:     updQust.LastModifiedBy,
+		PlanID:     updQust.PlanID,
+		ChannelID:  updQust.ChannelID,
+		Permission: permissions.PermLessonEdit,
 	})
 	if err != nil {
 		log.Error("can't check permissions", slog.String("err", err.Error()))
@@ -23,15 +23,7 @@ type AttemptPermissionsProvider interface {
 }
 
 type LgPermissionsProvider interface {
-	UserIsGroupAdminIn(ctx context.Context, user *ssomodels.UserIsGroupAdminIn) ([]string, error)
-	UserIsLearnerIn(ctx context.Context, user *ssomodels.UserIsLearnerIn) ([]string, error)
-	IsGroupAdmin(ctx context.Context, uIsGroupAdmin *ssomodels.IsGroupAdmin) (*ssomodels.IsGroupAdminResp, error)
-}
-
-type RedisPermissionsProvider interface {
-	SaveLgUser(ctx context.Context, userID string, groupIDs []string) error
-	SaveLgShareWithChannel(ctx context.Context, channelID int64, groupIDs []string) error
-	CheckGroupsIntersection(ctx context.Context, userID string, channelID int64) (bool, error)
+	GetUserPermissions(ctx context.Context, user *ssomodels.GetUserPermissions) (*ssomodels.GetUserPermissionsResp, error)
 }
 
 type PermissionsService struct {
@@ -41,7 +33,6 @@ type PermissionsService struct {
 	planPermissionsProvider    PlanPermissionsProvider
 	attemptPermissionsProvider AttemptPermissionsProvider
 	lgPermissionsProvider      LgPermissionsProvider
-	redisPermissionsProvider   RedisPermissionsProvider
 }
 
 func New(
@@ -51,7 +42,6 @@ func New(
 	planPermissionsProvider PlanPermissionsProvider,
 	attemptPermissionsProvider AttemptPermissionsProvider,
 	lgPermissionsProvider LgPermissionsProvider,
-	redisPermissionsProvider RedisPermissionsProvider,
 ) *PermissionsService {
 	return &PermissionsService{
 		log:                        log,
@@ -60,6 +50,5 @@ func New(
 		planPermissionsProvider:    planPermissionsProvider,
 		attemptPermissionsProvider: attemptPermissionsProvider,
 		lgPermissionsProvider:      lgPermissionsProvider,
-		redisPermissionsProvider:   redisPermissionsProvider,
 	}
 }
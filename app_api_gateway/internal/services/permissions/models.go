@@ -1,9 +1,34 @@
 package permissions
 
+// Permissions granted by roles of learning groups, the catalog is kept by sso.
+// The copy is compared with app_sso/internal/domain/models/lg_role.go by tests.
+const (
+	PermChannelCreate  = "channel.create"
+	PermChannelView    = "channel.view"
+	PermChannelEdit    = "channel.edit"
+	PermChannelDelete  = "channel.delete"
+	PermChannelShare   = "channel.share"
+	PermPlanView       = "plan.view"
+	PermPlanEdit       = "plan.edit"
+	PermPlanDelete     = "plan.delete"
+	PermPlanShare      = "plan.share"
+	PermLessonView     = "lesson.view"
+	PermLessonEdit     = "lesson.edit"
+	PermAttemptCreate  = "attempt.create"
+	PermAttemptViewAll = "attempt.view_all"
+)
+
 type CheckPerm struct {
-	UserID    string `json:"user_id" validate:"required"`
-	PlanID    int64  `json:"plna_id,omitempty"`
-	ChannelID int64  `json:"channel_id" validate:"required"`
+	UserID     string `json:"user_id" validate:"required"`
+	PlanID     int64  `json:"plna_id,omitempty"`
+	ChannelID  int64  `json:"channel_id" validate:"required"`
+	Permission string `json:"permission" validate:"required"`
+}
+
+type CheckLgPerm struct {
+	UserID     string `json:"user_id" validate:"required"`
+	LgID       string `json:"learning_group_id" validate:"required"`
+	Permission string `json:"permission" validate:"required"`
 }
 
 type IsUserShareWithPlan struct {
@@ -0,0 +1,67 @@
+package permissions
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// ssoCatalog is the file of sso where permissions of roles are declared.
+var ssoCatalog = filepath.Join("..", "..", "..", "..", "app_sso", "internal", "domain", "models", "lg_role.go")
+
+func TestPermissionsMatchSSO(t *testing.T) {
+	if _, err := os.Stat(ssoCatalog); os.IsNotExist(err) {
+		t.Skipf("sso sources aren't found at %s", ssoCatalog)
+	}
+
+	want := permConsts(t, ssoCatalog)
+	got := permConsts(t, "models.go")
+
+	if len(want) == 0 {
+		t.Fatalf("no permissions found in %s", ssoCatalog)
+	}
+	if !maps.Equal(got, want) {
+		t.Errorf("permissions = %v, want %v as in sso", got, want)
+	}
+}
+
+// permConsts returns Perm* string constants declared in the file.
+func permConsts(t *testing.T, path string) map[string]string {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		t.Fatalf("parse %s: %v", path, err)
+	}
+
+	perms := make(map[string]string)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			for i, name := range value.Names {
+				if !strings.HasPrefix(name.Name, "Perm") || i >= len(value.Values) {
+					continue
+				}
+				lit, ok := value.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+				perms[name.Name], err = strconv.Unquote(lit.Value)
+				if err != nil {
+					t.Fatalf("unquote %s: %v", name.Name, err)
+				}
+			}
+		}
+	}
+	return perms
+}
@@ -0,0 +1,174 @@
+package ssoservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/pkg/tracer"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (sso *SsoService) RegisterApp(ctx context.Context, app *ssomodels.RegisterApp) (*ssomodels.RegisterAppResp, error) {
+	const op = "internal.services.sso.apps.RegisterApp"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", app.UserID),
+		slog.String("app_name", app.Name),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "RegisterApp")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(app); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("user_id", app.UserID))
+
+	log.Info("registering app")
+
+	// Start registering
+	span.AddEvent("started_registering_app")
+	resp, err := sso.AppProvider.RegisterApp(ctx, app)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrPermissionDenied):
+			log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		case errors.Is(err, ssogrpc.ErrAppExists):
+			log.Error("app already exists", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrAppExists)
+		default:
+			log.Error("failed to register app", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_registering_app")
+	span.SetAttributes(attribute.String("client_id", resp.ClientID))
+
+	log.Info("app registered", slog.String("client_id", resp.ClientID))
+
+	return &ssomodels.RegisterAppResp{
+		ClientID:     resp.ClientID,
+		ClientSecret: resp.ClientSecret,
+	}, nil
+}
+
+func (sso *SsoService) IssueClientToken(ctx context.Context, creds *ssomodels.IssueClientToken) (*ssomodels.IssueClientTokenResp, error) {
+	const op = "internal.services.sso.apps.IssueClientToken"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("client_id", creds.ClientID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "IssueClientToken")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(creds); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidClient)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("client_id", creds.ClientID))
+
+	log.Info("issuing client token")
+
+	// Start issuing
+	span.AddEvent("started_issuing_client_token")
+	resp, err := sso.AppProvider.IssueClientToken(ctx, creds)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidClient):
+			log.Error("invalid client", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidClient)
+		case errors.Is(err, ssogrpc.ErrInvalidScope):
+			log.Error("invalid scope", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidScope)
+		default:
+			log.Error("failed to issue client token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_issuing_client_token")
+
+	log.Info("client token issued")
+
+	return &ssomodels.IssueClientTokenResp{
+		AccessToken: resp.AccessToken,
+		ExpiresIn:   resp.ExpiresIn,
+		Scope:       resp.Scope,
+	}, nil
+}
+
+func (sso *SsoService) RegisterRelyingParty(ctx context.Context, rp *ssomodels.RegisterRelyingParty) (*ssomodels.RegisterRelyingPartyResp, error) {
+	const op = "internal.services.sso.apps.RegisterRelyingParty"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", rp.UserID),
+		slog.String("name", rp.Name),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "RegisterRelyingParty")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(rp); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("user_id", rp.UserID))
+
+	log.Info("registering relying party")
+
+	// Start registering
+	span.AddEvent("started_registering_relying_party")
+	resp, err := sso.AppProvider.RegisterRelyingParty(ctx, rp)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrPermissionDenied):
+			log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		case errors.Is(err, ssogrpc.ErrAppExists):
+			log.Error("relying party already exists", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrAppExists)
+		default:
+			log.Error("failed to register relying party", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_registering_relying_party")
+	span.SetAttributes(attribute.String("client_id", resp.ClientID))
+
+	log.Info("relying party registered", slog.String("client_id", resp.ClientID))
+
+	return &ssomodels.RegisterRelyingPartyResp{
+		ClientID:     resp.ClientID,
+		ClientSecret: resp.ClientSecret,
+	}, nil
+}
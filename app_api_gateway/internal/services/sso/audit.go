@@ -0,0 +1,61 @@
+package ssoservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/pkg/tracer"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (sso *SsoService) ListAuditEvents(ctx context.Context, list *ssomodels.ListAuditEvents) (*ssomodels.ListAuditEventsResp, error) {
+	const op = "internal.services.sso.audit.ListAuditEvents"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("admin_id", list.AdminID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ListAuditEvents")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(list); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("admin_id", list.AdminID))
+
+	log.Info("listing audit events")
+
+	// Start listing
+	span.AddEvent("started_listing_audit_events")
+	resp, err := sso.UserProvider.ListAuditEvents(ctx, list)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrPermissionDenied):
+			log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		default:
+			log.Error("failed to list audit events", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_listing_audit_events")
+
+	log.Info("audit events listed")
+
+	return resp, nil
+}
@@ -17,6 +17,23 @@ var (
 	ErrUserExists          = errors.New("user already exists")
 	ErrInvalidUserID       = errors.New("invalid user id")
 	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrInvalidAccessToken  = errors.New("invalid access token")
+	ErrEmailNotVerified    = errors.New("email is not verified")
+	ErrInvalidVerifyToken  = errors.New("invalid verification token")
+	ErrInvalidMFAToken     = errors.New("invalid mfa token")
+	ErrTOTPAlreadyEnabled  = errors.New("totp is already enabled")
+	ErrTOTPNotEnabled      = errors.New("totp is not enabled")
+	ErrTooManyAttempts     = errors.New("too many attempts")
+	ErrInvalidClient       = errors.New("invalid client")
+	ErrInvalidScope        = errors.New("invalid scope")
+	ErrAppExists           = errors.New("app already exists")
+	ErrWeakPassword        = errors.New("password doesn't meet policy")
+	ErrSessionNotFound     = errors.New("session not found")
+	ErrUserDeactivated     = errors.New("user is deactivated")
+	ErrUserStatusConflict  = errors.New("user status doesn't allow the action")
+	ErrGDPRJobNotFound     = errors.New("gdpr job not found")
+	ErrInvalidInviteToken  = errors.New("invalid invite token")
+	ErrTelegramNotLinked   = errors.New("telegram is not linked")
 )
 
 func (sso *SsoService) RegisterUser(ctx context.Context, newUser *ssomodels.RegisterUser) (*ssomodels.RegisterResp, error) {
@@ -52,6 +69,9 @@ func (sso *SsoService) RegisterUser(ctx context.Context, newUser *ssomodels.Regi
 		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
 			log.Error("invalid credentinals", slog.Any("email", newUser.Email))
 			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrWeakPassword):
+			log.Error("weak password", slog.Any("email", newUser.Email))
+			return nil, fmt.Errorf("%s: %w", op, ErrWeakPassword)
 		default:
 			log.Error("registratin failed", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
@@ -97,6 +117,15 @@ func (sso *SsoService) LoginUser(ctx context.Context, logUser *ssomodels.LogIn)
 		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
 			log.Error("invalid credentinals", slog.Any("email", logUser.Email))
 			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrEmailNotVerified):
+			log.Error("email is not verified", slog.Any("email", logUser.Email))
+			return nil, fmt.Errorf("%s: %w", op, ErrEmailNotVerified)
+		case errors.Is(err, ssogrpc.ErrTooManyAttempts):
+			log.Error("too many attempts", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTooManyAttempts)
+		case errors.Is(err, ssogrpc.ErrUserDeactivated):
+			log.Error("user is deactivated", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserDeactivated)
 		default:
 			log.Error("failed to login user", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
@@ -110,6 +139,8 @@ func (sso *SsoService) LoginUser(ctx context.Context, logUser *ssomodels.LogIn)
 	return &ssomodels.LogInResp{
 		AccessToken:  logIn.AccessToken,
 		RefreshToken: logIn.RefreshToken,
+		MFARequired:  logIn.MFARequired,
+		MFAToken:     logIn.MFAToken,
 	}, nil
 }
 
@@ -146,6 +177,12 @@ func (sso *SsoService) LogInViaTg(ctx context.Context, email *ssomodels.LogInVia
 		case errors.Is(err, ssogrpc.ErrUserNotFound):
 			log.Error("user not found", slog.Any("email", email.Email))
 			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrEmailNotVerified):
+			log.Error("email is not verified", slog.Any("email", email.Email))
+			return nil, fmt.Errorf("%s: %w", op, ErrEmailNotVerified)
+		case errors.Is(err, ssogrpc.ErrUserDeactivated):
+			log.Error("user is deactivated", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserDeactivated)
 		default:
 			log.Error("failed to login user by telegram", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
@@ -195,6 +232,15 @@ func (sso *SsoService) CheckOTPAndLogIn(ctx context.Context, otp *ssomodels.Chec
 		case errors.Is(err, ssogrpc.ErrUserNotFound):
 			log.Error("user not found", slog.Any("email", otp.Email))
 			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrEmailNotVerified):
+			log.Error("email is not verified", slog.Any("email", otp.Email))
+			return nil, fmt.Errorf("%s: %w", op, ErrEmailNotVerified)
+		case errors.Is(err, ssogrpc.ErrTooManyAttempts):
+			log.Error("too many attempts", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTooManyAttempts)
+		case errors.Is(err, ssogrpc.ErrUserDeactivated):
+			log.Error("user is deactivated", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserDeactivated)
 		default:
 			log.Error("failed to check otp and login", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
@@ -208,6 +254,236 @@ func (sso *SsoService) CheckOTPAndLogIn(ctx context.Context, otp *ssomodels.Chec
 	return &ssomodels.CheckOTPAndLogInResp{
 		AccessToken:  resp.AccessToken,
 		RefreshToken: resp.RefreshToken,
+		MFARequired:  resp.MFARequired,
+		MFAToken:     resp.MFAToken,
+	}, nil
+}
+
+func (sso *SsoService) VerifyEmail(ctx context.Context, verify *ssomodels.VerifyEmail) (*ssomodels.VerifyEmailResp, error) {
+	const op = "internal.services.sso.auth.VerifyEmail"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "VerifyEmail")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(verify); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidVerifyToken)
+	}
+	span.AddEvent("validation_completed")
+
+	log.Info("verifying email")
+
+	// Start verifying
+	span.AddEvent("started_verifying_email")
+	resp, err := sso.AuthProvider.VerifyEmail(ctx, verify)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidVerifyToken):
+			log.Error("invalid verification token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidVerifyToken)
+		default:
+			log.Error("failed to verify email", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_verifying_email")
+
+	log.Info("email verified successfully")
+
+	return &ssomodels.VerifyEmailResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (sso *SsoService) AcceptInvite(ctx context.Context, accept *ssomodels.AcceptInvite) (*ssomodels.AcceptInviteResp, error) {
+	const op = "internal.services.sso.auth.AcceptInvite"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "AcceptInvite")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(accept); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+
+	log.Info("accepting invite")
+
+	// Start accepting
+	span.AddEvent("started_accepting_invite")
+	resp, err := sso.AuthProvider.AcceptInvite(ctx, accept)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidInviteToken):
+			log.Error("invalid invite token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidInviteToken)
+		case errors.Is(err, ssogrpc.ErrWeakPassword):
+			log.Error("weak password", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrWeakPassword)
+		default:
+			log.Error("failed to accept invite", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_accepting_invite")
+
+	log.Info("invite accepted")
+
+	return &ssomodels.AcceptInviteResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (sso *SsoService) ResendVerification(ctx context.Context, resend *ssomodels.ResendVerification) (*ssomodels.ResendVerificationResp, error) {
+	const op = "internal.services.sso.auth.ResendVerification"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_email", resend.Email),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ResendVerification")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(resend); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("email", resend.Email))
+
+	log.Info("resending verification email")
+
+	// Start resending
+	span.AddEvent("started_resending_verification")
+	resp, err := sso.AuthProvider.ResendVerification(ctx, resend)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.Any("email", resend.Email))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		default:
+			log.Error("failed to resend verification email", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_resending_verification")
+
+	log.Info("verification email resent")
+
+	return &ssomodels.ResendVerificationResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (sso *SsoService) RequestPasswordReset(ctx context.Context, reset *ssomodels.RequestPasswordReset) (*ssomodels.RequestPasswordResetResp, error) {
+	const op = "internal.services.sso.auth.RequestPasswordReset"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_email", reset.Email),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "RequestPasswordReset")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(reset); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("email", reset.Email))
+
+	log.Info("requesting password reset")
+
+	// Start requesting
+	span.AddEvent("started_requesting_password_reset")
+	resp, err := sso.AuthProvider.RequestPasswordReset(ctx, reset)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.Any("email", reset.Email))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrWeakPassword):
+			log.Error("weak password", slog.Any("email", reset.Email))
+			return nil, fmt.Errorf("%s: %w", op, ErrWeakPassword)
+		default:
+			log.Error("failed to request password reset", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_requesting_password_reset")
+
+	log.Info("password reset requested")
+
+	return &ssomodels.RequestPasswordResetResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (sso *SsoService) ConfirmPasswordReset(ctx context.Context, reset *ssomodels.ConfirmPasswordReset) (*ssomodels.ConfirmPasswordResetResp, error) {
+	const op = "internal.services.sso.auth.ConfirmPasswordReset"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_email", reset.Email),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ConfirmPasswordReset")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(reset); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("email", reset.Email))
+
+	log.Info("confirming password reset")
+
+	// Start confirming
+	span.AddEvent("started_confirming_password_reset")
+	resp, err := sso.AuthProvider.ConfirmPasswordReset(ctx, reset)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.Any("email", reset.Email))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrWeakPassword):
+			log.Error("weak password", slog.Any("email", reset.Email))
+			return nil, fmt.Errorf("%s: %w", op, ErrWeakPassword)
+		case errors.Is(err, ssogrpc.ErrTooManyAttempts):
+			log.Error("too many attempts", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTooManyAttempts)
+		default:
+			log.Error("failed to confirm password reset", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_confirming_password_reset")
+
+	log.Info("password reset successfully")
+
+	return &ssomodels.ConfirmPasswordResetResp{
+		Success: resp.Success,
 	}, nil
 }
 
@@ -295,10 +571,184 @@ func (sso *SsoService) AuthCheck(ctx context.Context, authCheck *ssomodels.AuthC
 		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 	}
 	span.AddEvent("completed_auth_cheking")
-	span.SetAttributes(attribute.String("userID", resp.UserID))
+	span.SetAttributes(attribute.String("userID", resp.UserID), attribute.String("clientID", resp.ClientID))
+	if resp.ActorID != "" {
+		span.SetAttributes(attribute.String("actorID", resp.ActorID))
+	}
 
 	return &ssomodels.AuthCheckResp{
-		IsValid: resp.IsValid,
-		UserID:  resp.UserID,
+		IsValid:  resp.IsValid,
+		UserID:   resp.UserID,
+		ClientID: resp.ClientID,
+		Scopes:   resp.Scopes,
+		ActorID:  resp.ActorID,
+	}, nil
+}
+
+func (sso *SsoService) Logout(ctx context.Context, logout *ssomodels.Logout) (*ssomodels.LogoutResp, error) {
+	const op = "internal.services.sso.auth.Logout"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "Logout")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(logout); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+
+	log.Info("logging out user")
+
+	// Start logout
+	span.AddEvent("started_user_logout")
+	resp, err := sso.AuthProvider.Logout(ctx, logout)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidAccessToken):
+			log.Error("invalid access token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+		case errors.Is(err, ssogrpc.ErrInvalidRefreshToken):
+			log.Error("invalid refresh token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+		default:
+			log.Error("failed to logout user", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_user_logout")
+
+	log.Info("user logged out successfully")
+
+	return &ssomodels.LogoutResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (sso *SsoService) RefreshToken(ctx context.Context, refToken *ssomodels.RefreshToken) (*ssomodels.RefreshTokenResp, error) {
+	const op = "internal.services.sso.auth.RefreshToken"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "RefreshToken")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(refToken); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+
+	log.Info("refreshing tokens")
+
+	// Start refreshing
+	span.AddEvent("started_refreshing_tokens")
+	resp, err := sso.AuthProvider.RefreshToken(ctx, refToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidRefreshToken):
+			log.Error("invalid refresh token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		default:
+			log.Error("failed to refresh tokens", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_refreshing_tokens")
+
+	log.Info("tokens refreshed successfully")
+
+	return &ssomodels.RefreshTokenResp{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+	}, nil
+}
+
+// GetMe collects profile of the user together with learning groups
+// where the user is a learner or a group admin.
+func (sso *SsoService) GetMe(ctx context.Context, me *ssomodels.GetMe) (*ssomodels.GetMeResp, error) {
+	const op = "internal.services.sso.auth.GetMe"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", me.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "GetMe")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(me); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("user_id", me.UserID))
+
+	log.Info("getting user profile")
+
+	// Start getting profile
+	span.AddEvent("started_getting_user_info")
+	user, err := sso.AuthProvider.GetUserInfo(ctx, &ssomodels.GetUserInfo{
+		UserID: me.UserID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		default:
+			log.Error("failed to get user info", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_getting_user_info")
+
+	// Start getting learning groups
+	span.AddEvent("started_getting_user_learning_groups")
+	learnerIn, err := sso.LgProvider.UserIsLearnerIn(ctx, &ssomodels.UserIsLearnerIn{
+		UserID: me.UserID,
+	})
+	if err != nil && !errors.Is(err, ssogrpc.ErrUserNotFound) {
+		log.Error("failed to get learning groups where user is learner", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+	}
+
+	groupAdminIn, err := sso.LgProvider.UserIsGroupAdminIn(ctx, &ssomodels.UserIsGroupAdminIn{
+		UserID: me.UserID,
+	})
+	if err != nil && !errors.Is(err, ssogrpc.ErrUserNotFound) {
+		log.Error("failed to get learning groups where user is group admin", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+	}
+	span.AddEvent("completed_getting_user_learning_groups")
+
+	log.Info("user profile got successfully")
+
+	return &ssomodels.GetMeResp{
+		ID:           user.ID,
+		Email:        user.Email,
+		Name:         user.Name,
+		TgLink:       user.TgLink,
+		TgLinked:     user.TgLinked,
+		IsAdmin:      user.IsAdmin,
+		LearnerIn:    learnerIn,
+		GroupAdminIn: groupAdminIn,
 	}, nil
 }
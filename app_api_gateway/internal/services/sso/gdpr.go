@@ -0,0 +1,173 @@
+package ssoservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/pkg/tracer"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (sso *SsoService) RequestUserExport(ctx context.Context, req *ssomodels.ManageUser) (*ssomodels.GDPRJobResp, error) {
+	const op = "internal.services.sso.gdpr.RequestUserExport"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("admin_id", req.AdminID),
+		slog.String("user_id", req.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "RequestUserExport")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(req); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(
+		attribute.String("admin_id", req.AdminID),
+		attribute.String("user_id", req.UserID),
+	)
+
+	log.Info("requesting user data export")
+
+	// Start requesting
+	span.AddEvent("started_requesting_export")
+	resp, err := sso.UserProvider.RequestUserExport(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrPermissionDenied):
+			log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		case errors.Is(err, ssogrpc.ErrUserStatusConflict):
+			log.Error("user status conflict", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserStatusConflict)
+		default:
+			log.Error("failed to request user data export", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_requesting_export")
+	span.SetAttributes(attribute.String("job_id", resp.JobID))
+
+	log.Info("user data export requested", slog.String("job_id", resp.JobID))
+
+	return resp, nil
+}
+
+func (sso *SsoService) RequestUserErasure(ctx context.Context, req *ssomodels.ManageUser) (*ssomodels.GDPRJobResp, error) {
+	const op = "internal.services.sso.gdpr.RequestUserErasure"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("admin_id", req.AdminID),
+		slog.String("user_id", req.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "RequestUserErasure")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(req); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(
+		attribute.String("admin_id", req.AdminID),
+		attribute.String("user_id", req.UserID),
+	)
+
+	log.Info("requesting user data erasure")
+
+	// Start requesting
+	span.AddEvent("started_requesting_erasure")
+	resp, err := sso.UserProvider.RequestUserErasure(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrPermissionDenied):
+			log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		case errors.Is(err, ssogrpc.ErrUserStatusConflict):
+			log.Error("user status conflict", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserStatusConflict)
+		default:
+			log.Error("failed to request user data erasure", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_requesting_erasure")
+	span.SetAttributes(attribute.String("job_id", resp.JobID))
+
+	log.Info("user data erasure requested", slog.String("job_id", resp.JobID))
+
+	return resp, nil
+}
+
+func (sso *SsoService) GetGDPRJob(ctx context.Context, get *ssomodels.GetGDPRJob) (*ssomodels.GDPRJob, error) {
+	const op = "internal.services.sso.gdpr.GetGDPRJob"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("admin_id", get.AdminID),
+		slog.String("job_id", get.JobID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "GetGDPRJob")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(get); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(
+		attribute.String("admin_id", get.AdminID),
+		attribute.String("job_id", get.JobID),
+	)
+
+	// Start getting
+	span.AddEvent("started_getting_gdpr_job")
+	job, err := sso.UserProvider.GetGDPRJob(ctx, get)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrGDPRJobNotFound):
+			log.Error("gdpr job not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrGDPRJobNotFound)
+		case errors.Is(err, ssogrpc.ErrPermissionDenied):
+			log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		default:
+			log.Error("failed to get gdpr job", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_getting_gdpr_job")
+
+	return job, nil
+}
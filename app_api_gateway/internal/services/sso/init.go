@@ -13,8 +13,24 @@ type AuthServiceProvider interface {
 	LoginUser(ctx context.Context, logUser *ssomodels.LogIn) (*ssomodels.LogInResp, error)
 	LogInViaTg(ctx context.Context, email *ssomodels.LogInViaTg) (*ssomodels.LogInViaTgResp, error)
 	CheckOTPAndLogIn(ctx context.Context, otp *ssomodels.CheckOTPAndLogIn) (*ssomodels.CheckOTPAndLogInResp, error)
+	VerifyEmail(ctx context.Context, verify *ssomodels.VerifyEmail) (*ssomodels.VerifyEmailResp, error)
+	ResendVerification(ctx context.Context, resend *ssomodels.ResendVerification) (*ssomodels.ResendVerificationResp, error)
+	RequestPasswordReset(ctx context.Context, reset *ssomodels.RequestPasswordReset) (*ssomodels.RequestPasswordResetResp, error)
+	ConfirmPasswordReset(ctx context.Context, reset *ssomodels.ConfirmPasswordReset) (*ssomodels.ConfirmPasswordResetResp, error)
 	UpdateUserInfo(ctx context.Context, newInfo *ssomodels.UpdateUserInfo) (*ssomodels.UpdateUserInfoResp, error)
+	RefreshToken(ctx context.Context, refToken *ssomodels.RefreshToken) (*ssomodels.RefreshTokenResp, error)
+	GetUserInfo(ctx context.Context, user *ssomodels.GetUserInfo) (*ssomodels.GetUserInfoResp, error)
 	AuthCheck(ctx context.Context, authCheck *ssomodels.AuthCheck) (*ssomodels.AuthCheckResp, error)
+	Logout(ctx context.Context, logout *ssomodels.Logout) (*ssomodels.LogoutResp, error)
+	LoginMFA(ctx context.Context, login *ssomodels.LoginMFA) (*ssomodels.LoginMFAResp, error)
+	EnrollTOTP(ctx context.Context, enroll *ssomodels.EnrollTOTP) (*ssomodels.EnrollTOTPResp, error)
+	VerifyTOTP(ctx context.Context, verify *ssomodels.VerifyTOTP) (*ssomodels.VerifyTOTPResp, error)
+	DisableTOTP(ctx context.Context, disable *ssomodels.DisableTOTP) (*ssomodels.DisableTOTPResp, error)
+	ListSessions(ctx context.Context, list *ssomodels.ListSessions) (*ssomodels.ListSessionsResp, error)
+	RevokeSession(ctx context.Context, revoke *ssomodels.RevokeSession) (*ssomodels.RevokeSessionResp, error)
+	AcceptInvite(ctx context.Context, accept *ssomodels.AcceptInvite) (*ssomodels.AcceptInviteResp, error)
+	CreateTgLinkToken(ctx context.Context, create *ssomodels.CreateTgLink) (*ssomodels.CreateTgLinkResp, error)
+	UnlinkTelegram(ctx context.Context, unlink *ssomodels.UnlinkTelegram) (*ssomodels.UnlinkTelegramResp, error)
 }
 
 type LgServiceProvider interface {
@@ -23,7 +39,56 @@ type LgServiceProvider interface {
 	UpdateLearningGroup(ctx context.Context, updFields *ssomodels.UpdateLearningGroup) (*ssomodels.UpdateLearningGroupResp, error)
 	DeleteLearningGroup(ctx context.Context, lgID *ssomodels.DelLgByID) (*ssomodels.DelLgByIDResp, error)
 	GetLearningGroups(ctx context.Context, uID *ssomodels.GetLGroups) (*ssomodels.GetLGroupsResp, error)
+	GetLearningGroupTree(ctx context.Context, get *ssomodels.GetLgTree) (*ssomodels.LgTreeNode, error)
+	MoveLearningGroup(ctx context.Context, move *ssomodels.MoveLearningGroup) (*ssomodels.MoveLearningGroupResp, error)
 	UserIsLearnerIn(ctx context.Context, user *ssomodels.UserIsLearnerIn) ([]string, error)
+	UserIsGroupAdminIn(ctx context.Context, user *ssomodels.UserIsGroupAdminIn) ([]string, error)
+	CreateLgInvite(ctx context.Context, create *ssomodels.CreateLgInvite) (*ssomodels.LgInvite, error)
+	ListLgInvites(ctx context.Context, list *ssomodels.ListLgInvites) (*ssomodels.ListLgInvitesResp, error)
+	RevokeLgInvite(ctx context.Context, revoke *ssomodels.RevokeLgInvite) (*ssomodels.RevokeLgInviteResp, error)
+	RedeemLgInvite(ctx context.Context, redeem *ssomodels.RedeemLgInvite) (*ssomodels.RedeemLgInviteResp, error)
+	AddMembers(ctx context.Context, change *ssomodels.ChangeLgMembers) (*ssomodels.ChangeLgMembersResp, error)
+	RemoveMembers(ctx context.Context, change *ssomodels.ChangeLgMembers) (*ssomodels.ChangeLgMembersResp, error)
+	CreateLgRole(ctx context.Context, create *ssomodels.CreateLgRole) (*ssomodels.LgRole, error)
+	ListLgRoles(ctx context.Context, list *ssomodels.ListLgRoles) (*ssomodels.ListLgRolesResp, error)
+	UpdateLgRole(ctx context.Context, upd *ssomodels.UpdateLgRole) (*ssomodels.LgRoleResp, error)
+	DeleteLgRole(ctx context.Context, del *ssomodels.DeleteLgRole) (*ssomodels.LgRoleResp, error)
+	AssignLgRole(ctx context.Context, change *ssomodels.ChangeLgRoleMembers) (*ssomodels.LgRoleResp, error)
+	UnassignLgRole(ctx context.Context, change *ssomodels.ChangeLgRoleMembers) (*ssomodels.LgRoleResp, error)
+	ScimListGroups(ctx context.Context, list *ssomodels.ScimListGroups) (*ssomodels.ScimListGroupsResp, error)
+	ScimGetGroup(ctx context.Context, get *ssomodels.ScimManageGroup) (*ssomodels.ScimGroup, error)
+	ScimCreateGroup(ctx context.Context, create *ssomodels.ScimCreateGroup) (*ssomodels.ScimGroup, error)
+	ScimUpdateGroup(ctx context.Context, upd *ssomodels.ScimUpdateGroup) (*ssomodels.ScimGroup, error)
+	ScimChangeGroupMembers(ctx context.Context, change *ssomodels.ScimChangeGroupMembers) (*ssomodels.ScimResp, error)
+	ScimDeleteGroup(ctx context.Context, del *ssomodels.ScimManageGroup) (*ssomodels.ScimResp, error)
+}
+
+// AppServiceProvider manages clients registered in sso:
+// service clients and OIDC relying parties.
+type AppServiceProvider interface {
+	RegisterApp(ctx context.Context, app *ssomodels.RegisterApp) (*ssomodels.RegisterAppResp, error)
+	IssueClientToken(ctx context.Context, creds *ssomodels.IssueClientToken) (*ssomodels.IssueClientTokenResp, error)
+	RegisterRelyingParty(ctx context.Context, rp *ssomodels.RegisterRelyingParty) (*ssomodels.RegisterRelyingPartyResp, error)
+}
+
+// UserServiceProvider manages user accounts on behalf of platform admins
+// and SCIM service clients.
+type UserServiceProvider interface {
+	ListUsers(ctx context.Context, list *ssomodels.ListUsers) (*ssomodels.ListUsersResp, error)
+	SetUserAdmin(ctx context.Context, set *ssomodels.SetUserAdmin) (*ssomodels.SetUserAdminResp, error)
+	DeactivateUser(ctx context.Context, manage *ssomodels.ManageUser) (*ssomodels.ManageUserResp, error)
+	ImpersonateUser(ctx context.Context, imp *ssomodels.ImpersonateUser) (*ssomodels.ImpersonateUserResp, error)
+	ReactivateUser(ctx context.Context, manage *ssomodels.ManageUser) (*ssomodels.ManageUserResp, error)
+	RequestUserExport(ctx context.Context, req *ssomodels.ManageUser) (*ssomodels.GDPRJobResp, error)
+	RequestUserErasure(ctx context.Context, req *ssomodels.ManageUser) (*ssomodels.GDPRJobResp, error)
+	GetGDPRJob(ctx context.Context, get *ssomodels.GetGDPRJob) (*ssomodels.GDPRJob, error)
+	ImportUsers(ctx context.Context, imp *ssomodels.ImportUsers) (*ssomodels.ImportUsersResp, error)
+	ListAuditEvents(ctx context.Context, list *ssomodels.ListAuditEvents) (*ssomodels.ListAuditEventsResp, error)
+	ScimListUsers(ctx context.Context, list *ssomodels.ScimListUsers) (*ssomodels.ScimListUsersResp, error)
+	ScimGetUser(ctx context.Context, get *ssomodels.ScimManageUser) (*ssomodels.ScimUser, error)
+	ScimCreateUser(ctx context.Context, create *ssomodels.ScimCreateUser) (*ssomodels.ScimUser, error)
+	ScimUpdateUser(ctx context.Context, upd *ssomodels.ScimUpdateUser) (*ssomodels.ScimUser, error)
+	ScimDeleteUser(ctx context.Context, del *ssomodels.ScimManageUser) (*ssomodels.ScimResp, error)
 }
 
 type SsoService struct {
@@ -31,6 +96,10 @@ type SsoService struct {
 	Validator    *validator.Validate
 	AuthProvider AuthServiceProvider
 	LgProvider   LgServiceProvider
+	AppProvider  AppServiceProvider
+	UserProvider UserServiceProvider
+	// TgBotName is username of the bot in telegram deep links
+	TgBotName string
 }
 
 func New(
@@ -38,11 +107,17 @@ func New(
 	validator *validator.Validate,
 	authProvider AuthServiceProvider,
 	lgProvider LgServiceProvider,
+	appProvider AppServiceProvider,
+	userProvider UserServiceProvider,
+	tgBotName string,
 ) *SsoService {
 	return &SsoService{
 		Log:          log,
 		Validator:    validator,
 		AuthProvider: authProvider,
 		LgProvider:   lgProvider,
+		AppProvider:  appProvider,
+		UserProvider: userProvider,
+		TgBotName:    tgBotName,
 	}
 }
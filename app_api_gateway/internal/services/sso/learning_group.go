@@ -18,6 +18,7 @@ var (
 	ErrGroupExists        = errors.New("group already exists")
 	ErrGroupNotFound      = errors.New("group not found")
 	ErrPermissionDenied   = errors.New("you don't have permissions")
+	ErrVersionConflict    = errors.New("group was changed by another request")
 
 	ErrInternal = errors.New("internal error")
 )
@@ -55,6 +56,9 @@ func (sso *SsoService) CreateLearningGroup(ctx context.Context, newLg *ssomodels
 		case errors.Is(err, ssogrpc.ErrGroupExists):
 			log.Error("learning group already exists", slog.Any("name", newLg.Name))
 			return nil, fmt.Errorf("%s: %w", op, ErrGroupExists)
+		case errors.Is(err, ssogrpc.ErrPermissionDenied):
+			log.Error("permissions denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
 		default:
 			log.Error("failed to creating new learning group", slog.String("err", err.Error()))
 			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
@@ -151,6 +155,9 @@ func (sso *SsoService) UpdateLearningGroup(ctx context.Context, updFields *ssomo
 		case errors.Is(err, ssogrpc.ErrGroupNotFound):
 			log.Error("learning group not found", slog.Any("learning_group_id", updFields.LgId))
 			return nil, fmt.Errorf("%s: %w", op, ErrGroupNotFound)
+		case errors.Is(err, ssogrpc.ErrVersionConflict):
+			log.Error("learning group version conflict", slog.Int64("version", updFields.Version))
+			return nil, fmt.Errorf("%s: %w", op, ErrVersionConflict)
 		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
 			log.Error("invalid credentinals", slog.Any("learning_group_id", updFields.LgId))
 			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
@@ -0,0 +1,183 @@
+package ssoservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/pkg/tracer"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	ErrInviteNotFound = errors.New("invite not found")
+	ErrAlreadyLearner = errors.New("user is already a learner")
+)
+
+func (sso *SsoService) CreateLgInvite(ctx context.Context, create *ssomodels.CreateLgInvite) (*ssomodels.LgInvite, error) {
+	const op = "internal.services.sso.lg_invites.CreateLgInvite"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", create.UserID),
+		slog.String("learning_group_id", create.LgID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "CreateLgInvite")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(create); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", create.LgID))
+
+	log.Info("creating learning group invite")
+
+	// Start creating
+	span.AddEvent("started_creating_invite")
+	resp, err := sso.LgProvider.CreateLgInvite(ctx, create)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, lgInviteError(log, err, "failed to create invite"))
+	}
+	span.AddEvent("completed_creating_invite")
+
+	log.Info("learning group invite created")
+
+	return resp, nil
+}
+
+func (sso *SsoService) ListLgInvites(ctx context.Context, list *ssomodels.ListLgInvites) (*ssomodels.ListLgInvitesResp, error) {
+	const op = "internal.services.sso.lg_invites.ListLgInvites"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", list.UserID),
+		slog.String("learning_group_id", list.LgID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ListLgInvites")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(list); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", list.LgID))
+
+	log.Info("listing learning group invites")
+
+	// Start listing
+	span.AddEvent("started_listing_invites")
+	resp, err := sso.LgProvider.ListLgInvites(ctx, list)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, lgInviteError(log, err, "failed to list invites"))
+	}
+	span.AddEvent("completed_listing_invites")
+
+	log.Info("learning group invites listed")
+
+	return resp, nil
+}
+
+func (sso *SsoService) RevokeLgInvite(ctx context.Context, revoke *ssomodels.RevokeLgInvite) (*ssomodels.RevokeLgInviteResp, error) {
+	const op = "internal.services.sso.lg_invites.RevokeLgInvite"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", revoke.UserID),
+		slog.String("learning_group_id", revoke.LgID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "RevokeLgInvite")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(revoke); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", revoke.LgID))
+
+	log.Info("revoking learning group invite")
+
+	// Start revoking
+	span.AddEvent("started_revoking_invite")
+	resp, err := sso.LgProvider.RevokeLgInvite(ctx, revoke)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, lgInviteError(log, err, "failed to revoke invite"))
+	}
+	span.AddEvent("completed_revoking_invite")
+
+	log.Info("learning group invite revoked")
+
+	return resp, nil
+}
+
+func (sso *SsoService) RedeemLgInvite(ctx context.Context, redeem *ssomodels.RedeemLgInvite) (*ssomodels.RedeemLgInviteResp, error) {
+	const op = "internal.services.sso.lg_invites.RedeemLgInvite"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", redeem.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "RedeemLgInvite")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(redeem); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("user_id", redeem.UserID))
+
+	log.Info("redeeming learning group invite")
+
+	// Start redeeming
+	span.AddEvent("started_redeeming_invite")
+	resp, err := sso.LgProvider.RedeemLgInvite(ctx, redeem)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, lgInviteError(log, err, "failed to redeem invite"))
+	}
+	span.AddEvent("completed_redeeming_invite")
+	span.SetAttributes(attribute.String("learning_group_id", resp.LgID))
+
+	log.Info("learning group invite redeemed", slog.String("learning_group_id", resp.LgID))
+
+	return resp, nil
+}
+
+// lgInviteError maps client errors of invite calls to service errors.
+func lgInviteError(log *slog.Logger, err error, failedMsg string) error {
+	switch {
+	case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+		log.Error("invalid credentinals", slog.String("err", err.Error()))
+		return ErrInvalidCredentials
+	case errors.Is(err, ssogrpc.ErrPermissionDenied):
+		log.Error("permission denied", slog.String("err", err.Error()))
+		return ErrPermissionDenied
+	case errors.Is(err, ssogrpc.ErrInviteNotFound):
+		log.Error("invite not found", slog.String("err", err.Error()))
+		return ErrInviteNotFound
+	case errors.Is(err, ssogrpc.ErrAlreadyLearner):
+		log.Error("user is already a learner", slog.String("err", err.Error()))
+		return ErrAlreadyLearner
+	default:
+		log.Error(failedMsg, slog.String("err", err.Error()))
+		return ErrInternal
+	}
+}
@@ -0,0 +1,110 @@
+package ssoservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/pkg/tracer"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var ErrLastGroupAdmin = errors.New("group must keep a group admin")
+
+func (sso *SsoService) AddMembers(ctx context.Context, change *ssomodels.ChangeLgMembers) (*ssomodels.ChangeLgMembersResp, error) {
+	const op = "internal.services.sso.lg_members.AddMembers"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", change.UserID),
+		slog.String("learning_group_id", change.LgID),
+		slog.String("role", change.Role),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "AddMembers")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(change); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", change.LgID))
+
+	log.Info("adding learning group members")
+
+	// Start adding
+	span.AddEvent("started_adding_members")
+	resp, err := sso.LgProvider.AddMembers(ctx, change)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, lgMembersError(log, err, "failed to add members"))
+	}
+	span.AddEvent("completed_adding_members")
+
+	log.Info("learning group members added")
+
+	return resp, nil
+}
+
+func (sso *SsoService) RemoveMembers(ctx context.Context, change *ssomodels.ChangeLgMembers) (*ssomodels.ChangeLgMembersResp, error) {
+	const op = "internal.services.sso.lg_members.RemoveMembers"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", change.UserID),
+		slog.String("learning_group_id", change.LgID),
+		slog.String("role", change.Role),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "RemoveMembers")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(change); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", change.LgID))
+
+	log.Info("removing learning group members")
+
+	// Start removing
+	span.AddEvent("started_removing_members")
+	resp, err := sso.LgProvider.RemoveMembers(ctx, change)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, lgMembersError(log, err, "failed to remove members"))
+	}
+	span.AddEvent("completed_removing_members")
+
+	log.Info("learning group members removed")
+
+	return resp, nil
+}
+
+// lgMembersError maps client errors of members calls to service errors.
+func lgMembersError(log *slog.Logger, err error, failedMsg string) error {
+	switch {
+	case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+		log.Error("invalid credentinals", slog.String("err", err.Error()))
+		return ErrInvalidCredentials
+	case errors.Is(err, ssogrpc.ErrPermissionDenied):
+		log.Error("permission denied", slog.String("err", err.Error()))
+		return ErrPermissionDenied
+	case errors.Is(err, ssogrpc.ErrGroupNotFound):
+		log.Error("learning group not found", slog.String("err", err.Error()))
+		return ErrGroupNotFound
+	case errors.Is(err, ssogrpc.ErrLastGroupAdmin):
+		log.Error("group must keep a group admin", slog.String("err", err.Error()))
+		return ErrLastGroupAdmin
+	default:
+		log.Error(failedMsg, slog.String("err", err.Error()))
+		return ErrInternal
+	}
+}
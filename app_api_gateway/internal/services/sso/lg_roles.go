@@ -0,0 +1,260 @@
+package ssoservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/pkg/tracer"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	ErrRoleNotFound = errors.New("role not found")
+	ErrRoleExists   = errors.New("role already exists")
+)
+
+func (sso *SsoService) CreateLgRole(ctx context.Context, create *ssomodels.CreateLgRole) (*ssomodels.LgRole, error) {
+	const op = "internal.services.sso.lg_roles.CreateLgRole"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", create.UserID),
+		slog.String("learning_group_id", create.LgID),
+		slog.String("role", create.Name),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "CreateLgRole")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(create); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", create.LgID))
+
+	log.Info("creating learning group role")
+
+	// Start creating
+	span.AddEvent("started_creating_role")
+	resp, err := sso.LgProvider.CreateLgRole(ctx, create)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, lgRoleError(log, err, "failed to create role"))
+	}
+	span.AddEvent("completed_creating_role")
+
+	log.Info("learning group role created")
+
+	return resp, nil
+}
+
+func (sso *SsoService) ListLgRoles(ctx context.Context, list *ssomodels.ListLgRoles) (*ssomodels.ListLgRolesResp, error) {
+	const op = "internal.services.sso.lg_roles.ListLgRoles"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", list.UserID),
+		slog.String("learning_group_id", list.LgID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ListLgRoles")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(list); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", list.LgID))
+
+	log.Info("listing learning group roles")
+
+	// Start listing
+	span.AddEvent("started_listing_roles")
+	resp, err := sso.LgProvider.ListLgRoles(ctx, list)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, lgRoleError(log, err, "failed to list roles"))
+	}
+	span.AddEvent("completed_listing_roles")
+
+	log.Info("learning group roles listed")
+
+	return resp, nil
+}
+
+func (sso *SsoService) UpdateLgRole(ctx context.Context, upd *ssomodels.UpdateLgRole) (*ssomodels.LgRoleResp, error) {
+	const op = "internal.services.sso.lg_roles.UpdateLgRole"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", upd.UserID),
+		slog.String("learning_group_id", upd.LgID),
+		slog.String("role_id", upd.RoleID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "UpdateLgRole")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(upd); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", upd.LgID))
+
+	log.Info("updating learning group role")
+
+	// Start updating
+	span.AddEvent("started_updating_role")
+	resp, err := sso.LgProvider.UpdateLgRole(ctx, upd)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, lgRoleError(log, err, "failed to update role"))
+	}
+	span.AddEvent("completed_updating_role")
+
+	log.Info("learning group role updated")
+
+	return resp, nil
+}
+
+func (sso *SsoService) DeleteLgRole(ctx context.Context, del *ssomodels.DeleteLgRole) (*ssomodels.LgRoleResp, error) {
+	const op = "internal.services.sso.lg_roles.DeleteLgRole"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", del.UserID),
+		slog.String("learning_group_id", del.LgID),
+		slog.String("role_id", del.RoleID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "DeleteLgRole")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(del); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", del.LgID))
+
+	log.Info("deleting learning group role")
+
+	// Start deleting
+	span.AddEvent("started_deleting_role")
+	resp, err := sso.LgProvider.DeleteLgRole(ctx, del)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, lgRoleError(log, err, "failed to delete role"))
+	}
+	span.AddEvent("completed_deleting_role")
+
+	log.Info("learning group role deleted")
+
+	return resp, nil
+}
+
+func (sso *SsoService) AssignLgRole(ctx context.Context, change *ssomodels.ChangeLgRoleMembers) (*ssomodels.LgRoleResp, error) {
+	const op = "internal.services.sso.lg_roles.AssignLgRole"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", change.UserID),
+		slog.String("learning_group_id", change.LgID),
+		slog.String("role_id", change.RoleID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "AssignLgRole")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(change); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", change.LgID))
+
+	log.Info("assigning learning group role")
+
+	// Start assigning
+	span.AddEvent("started_assigning_role")
+	resp, err := sso.LgProvider.AssignLgRole(ctx, change)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, lgRoleError(log, err, "failed to assign role"))
+	}
+	span.AddEvent("completed_assigning_role")
+
+	log.Info("learning group role assigned")
+
+	return resp, nil
+}
+
+func (sso *SsoService) UnassignLgRole(ctx context.Context, change *ssomodels.ChangeLgRoleMembers) (*ssomodels.LgRoleResp, error) {
+	const op = "internal.services.sso.lg_roles.UnassignLgRole"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", change.UserID),
+		slog.String("learning_group_id", change.LgID),
+		slog.String("role_id", change.RoleID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "UnassignLgRole")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(change); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", change.LgID))
+
+	log.Info("unassigning learning group role")
+
+	// Start unassigning
+	span.AddEvent("started_unassigning_role")
+	resp, err := sso.LgProvider.UnassignLgRole(ctx, change)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, lgRoleError(log, err, "failed to unassign role"))
+	}
+	span.AddEvent("completed_unassigning_role")
+
+	log.Info("learning group role unassigned")
+
+	return resp, nil
+}
+
+// lgRoleError maps client errors of roles calls to service errors.
+func lgRoleError(log *slog.Logger, err error, failedMsg string) error {
+	switch {
+	case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+		log.Error("invalid credentinals", slog.String("err", err.Error()))
+		return ErrInvalidCredentials
+	case errors.Is(err, ssogrpc.ErrPermissionDenied):
+		log.Error("permission denied", slog.String("err", err.Error()))
+		return ErrPermissionDenied
+	case errors.Is(err, ssogrpc.ErrRoleNotFound):
+		log.Error("role not found", slog.String("err", err.Error()))
+		return ErrRoleNotFound
+	case errors.Is(err, ssogrpc.ErrRoleExists):
+		log.Error("role already exists", slog.String("err", err.Error()))
+		return ErrRoleExists
+	default:
+		log.Error(failedMsg, slog.String("err", err.Error()))
+		return ErrInternal
+	}
+}
@@ -0,0 +1,115 @@
+package ssoservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/pkg/tracer"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var ErrGroupCycle = errors.New("group can't be moved into its own subtree")
+
+func (sso *SsoService) GetLearningGroupTree(ctx context.Context, get *ssomodels.GetLgTree) (*ssomodels.LgTreeNode, error) {
+	const op = "internal.services.sso.lg_tree.GetLearningGroupTree"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", get.UserID),
+		slog.String("learning_group_id", get.LgID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "GetLearningGroupTree")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(get); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", get.LgID))
+
+	log.Info("getting learning group tree")
+
+	// Start getting
+	span.AddEvent("started_getting_learning_group_tree")
+	tree, err := sso.LgProvider.GetLearningGroupTree(ctx, get)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, lgTreeError(log, err, "failed to get learning group tree"))
+	}
+	span.AddEvent("completed_getting_learning_group_tree")
+
+	log.Info("learning group tree got")
+
+	return tree, nil
+}
+
+func (sso *SsoService) MoveLearningGroup(ctx context.Context, move *ssomodels.MoveLearningGroup) (*ssomodels.MoveLearningGroupResp, error) {
+	const op = "internal.services.sso.lg_tree.MoveLearningGroup"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", move.UserID),
+		slog.String("learning_group_id", move.LgID),
+		slog.String("parent_id", move.ParentID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "MoveLearningGroup")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(move); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(
+		attribute.String("learning_group_id", move.LgID),
+		attribute.String("parent_id", move.ParentID),
+	)
+
+	log.Info("moving learning group")
+
+	// Start moving
+	span.AddEvent("started_moving_learning_group")
+	resp, err := sso.LgProvider.MoveLearningGroup(ctx, move)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, lgTreeError(log, err, "failed to move learning group"))
+	}
+	span.AddEvent("completed_moving_learning_group")
+
+	log.Info("learning group moved")
+
+	return resp, nil
+}
+
+// lgTreeError maps client errors of tree calls to service errors.
+func lgTreeError(log *slog.Logger, err error, failedMsg string) error {
+	switch {
+	case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+		log.Error("invalid credentinals", slog.String("err", err.Error()))
+		return ErrInvalidCredentials
+	case errors.Is(err, ssogrpc.ErrPermissionDenied):
+		log.Error("permission denied", slog.String("err", err.Error()))
+		return ErrPermissionDenied
+	case errors.Is(err, ssogrpc.ErrGroupNotFound):
+		log.Error("learning group not found", slog.String("err", err.Error()))
+		return ErrGroupNotFound
+	case errors.Is(err, ssogrpc.ErrVersionConflict):
+		log.Error("learning group version conflict", slog.String("err", err.Error()))
+		return ErrVersionConflict
+	case errors.Is(err, ssogrpc.ErrGroupCycle):
+		log.Error("learning group can't be moved into its own subtree", slog.String("err", err.Error()))
+		return ErrGroupCycle
+	default:
+		log.Error(failedMsg, slog.String("err", err.Error()))
+		return ErrInternal
+	}
+}
@@ -0,0 +1,253 @@
+package ssoservice
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/pkg/tracer"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type KeyProvider interface {
+	Key(ctx context.Context, kid string) (ed25519.PublicKey, bool)
+	Keys() []ed25519.PublicKey
+}
+
+type DenylistProvider interface {
+	IsAccessTokenDenied(ctx context.Context, jti string) (bool, error)
+	IsUserDenied(ctx context.Context, userID string) (bool, error)
+}
+
+// LocalAuthChecker verifies access tokens in the gateway against sso public keys
+// instead of calling sso AuthCheck on every request.
+type LocalAuthChecker struct {
+	Log              *slog.Logger
+	Validator        *validator.Validate
+	Issuer           string
+	KeyProvider      KeyProvider
+	DenylistProvider DenylistProvider
+
+	denylist *denylistCache
+}
+
+func NewLocalAuthChecker(
+	log *slog.Logger,
+	validator *validator.Validate,
+	issuer string,
+	keyProvider KeyProvider,
+	denylistProvider DenylistProvider,
+	denylistCacheTTL time.Duration,
+) *LocalAuthChecker {
+	return &LocalAuthChecker{
+		Log:              log,
+		Validator:        validator,
+		Issuer:           issuer,
+		KeyProvider:      keyProvider,
+		DenylistProvider: denylistProvider,
+		denylist:         newDenylistCache(denylistCacheTTL),
+	}
+}
+
+func (lc *LocalAuthChecker) AuthCheck(ctx context.Context, authCheck *ssomodels.AuthCheck) (*ssomodels.AuthCheckResp, error) {
+	const op = "internal.services.sso.local_auth.AuthCheck"
+
+	log := lc.Log.With(
+		slog.String("op", op),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "LocalAuthCheck")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := lc.Validator.Struct(authCheck); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+
+	// Start token verification
+	span.AddEvent("started_token_verification")
+	token, err := jwt.Parse(authCheck.AccessToken, func(token *jwt.Token) (interface{}, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			// Tokens issued before key rotation was introduced
+			keys := lc.KeyProvider.Keys()
+			set := jwt.VerificationKeySet{Keys: make([]jwt.VerificationKey, 0, len(keys))}
+			for _, key := range keys {
+				set.Keys = append(set.Keys, key)
+			}
+			return set, nil
+		}
+
+		key, ok := lc.KeyProvider.Key(ctx, kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown kid %q", kid)
+		}
+		return key, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithIssuer(lc.Issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		log.Warn("token verification failed", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	// Service clients use access tokens issued with the client credentials grant
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid || (claims["type"] != "access" && claims["type"] != "client") {
+		log.Warn("invalid token claims or type")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	subject, ok := claims["sub"].(string)
+	if !ok {
+		log.Warn("invalid subject claim")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	jti, ok := claims["jti"].(string)
+	if !ok {
+		log.Warn("invalid jti claim")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("completed_token_verification")
+
+	// Start denylist checking
+	span.AddEvent("started_denylist_checking")
+	denied, ok := lc.denylist.get(jti)
+	if !ok {
+		denied, err = lc.DenylistProvider.IsAccessTokenDenied(ctx, jti)
+		if err != nil {
+			log.Error("failed to check access token denylist", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+		lc.denylist.set(jti, denied)
+	}
+	if denied {
+		log.Warn("access token is revoked", slog.String("sub", subject))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("completed_denylist_checking")
+
+	if claims["type"] == "client" {
+		span.SetAttributes(attribute.String("clientID", subject))
+		scope, _ := claims["scope"].(string)
+		return &ssomodels.AuthCheckResp{
+			IsValid:  true,
+			ClientID: subject,
+			Scopes:   strings.Fields(scope),
+		}, nil
+	}
+	span.SetAttributes(attribute.String("userID", subject))
+
+	// Impersonation token keeps the admin in the "act" claim
+	var actorID string
+	if act, ok := claims["act"].(map[string]interface{}); ok {
+		actorID, _ = act["sub"].(string)
+		if actorID == "" {
+			log.Warn("invalid act claim")
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		}
+		span.SetAttributes(attribute.String("actorID", actorID))
+	}
+
+	// Deactivated users are denied until their last access token expires.
+	// Impersonation ends too when the admin is deactivated.
+	span.AddEvent("started_user_denylist_checking")
+	for _, userID := range []string{subject, actorID} {
+		if userID == "" {
+			continue
+		}
+		userKey := "user_" + userID
+		userDenied, ok := lc.denylist.get(userKey)
+		if !ok {
+			userDenied, err = lc.DenylistProvider.IsUserDenied(ctx, userID)
+			if err != nil {
+				log.Error("failed to check user denylist", slog.String("err", err.Error()))
+				return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+			}
+			lc.denylist.set(userKey, userDenied)
+		}
+		if userDenied {
+			log.Warn("user is deactivated", slog.String("sub", userID))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		}
+	}
+	span.AddEvent("completed_user_denylist_checking")
+
+	return &ssomodels.AuthCheckResp{
+		IsValid: true,
+		UserID:  subject,
+		ActorID: actorID,
+	}, nil
+}
+
+// denylistCache caches denylist lookups for ttl.
+// Revoked token is still accepted until the cached "not denied" entry expires.
+type denylistCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]denylistEntry
+	// cleanedAt is time of the last removal of expired entries
+	cleanedAt time.Time
+}
+
+type denylistEntry struct {
+	denied    bool
+	expiresAt time.Time
+}
+
+func newDenylistCache(ttl time.Duration) *denylistCache {
+	return &denylistCache{
+		ttl:       ttl,
+		entries:   make(map[string]denylistEntry),
+		cleanedAt: time.Now(),
+	}
+}
+
+func (c *denylistCache) get(jti string) (bool, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[jti]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return false, false
+	}
+	return entry.denied, true
+}
+
+func (c *denylistCache) set(jti string, denied bool) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.cleanedAt) > c.ttl {
+		for key, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, key)
+			}
+		}
+		c.cleanedAt = now
+	}
+
+	c.entries[jti] = denylistEntry{
+		denied:    denied,
+		expiresAt: now.Add(c.ttl),
+	}
+}
@@ -0,0 +1,294 @@
+package ssoservice
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testIssuer = "sso.test"
+
+type memKeys struct {
+	keys map[string]ed25519.PublicKey
+}
+
+func (m *memKeys) Key(_ context.Context, kid string) (ed25519.PublicKey, bool) {
+	key, ok := m.keys[kid]
+	return key, ok
+}
+
+func (m *memKeys) Keys() []ed25519.PublicKey {
+	keys := make([]ed25519.PublicKey, 0, len(m.keys))
+	for _, key := range m.keys {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// memDenylist counts lookups, so caching can be checked.
+type memDenylist struct {
+	mu      sync.Mutex
+	tokens  map[string]bool
+	users   map[string]bool
+	lookups int
+	err     error
+}
+
+func (m *memDenylist) IsAccessTokenDenied(_ context.Context, jti string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lookups++
+	return m.tokens[jti], m.err
+}
+
+func (m *memDenylist) IsUserDenied(_ context.Context, userID string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lookups++
+	return m.users[userID], m.err
+}
+
+type localAuthSuite struct {
+	checker  *LocalAuthChecker
+	denylist *memDenylist
+	privKey  ed25519.PrivateKey
+}
+
+func newLocalAuthSuite(t *testing.T, cacheTTL time.Duration) *localAuthSuite {
+	t.Helper()
+
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	denylist := &memDenylist{tokens: make(map[string]bool), users: make(map[string]bool)}
+	checker := NewLocalAuthChecker(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		validator.New(),
+		testIssuer,
+		&memKeys{keys: map[string]ed25519.PublicKey{"test": pubKey}},
+		denylist,
+		cacheTTL,
+	)
+
+	return &localAuthSuite{checker: checker, denylist: denylist, privKey: privKey}
+}
+
+// token signs claims like sso does, fields of claims override defaults.
+func (s *localAuthSuite) token(t *testing.T, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	all := jwt.MapClaims{
+		"iss":  testIssuer,
+		"sub":  "user",
+		"jti":  "jti",
+		"iat":  time.Now().Unix(),
+		"exp":  time.Now().Add(time.Minute).Unix(),
+		"type": "access",
+	}
+	for k, v := range claims {
+		all[k] = v
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, all)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(s.privKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func (s *localAuthSuite) check(token string) (*ssomodels.AuthCheckResp, error) {
+	return s.checker.AuthCheck(context.Background(), &ssomodels.AuthCheck{AccessToken: token})
+}
+
+func TestLocalAuthCheck(t *testing.T) {
+	s := newLocalAuthSuite(t, 0)
+
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherSigned, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+		"iss":  testIssuer,
+		"sub":  "user",
+		"jti":  "jti",
+		"exp":  time.Now().Add(time.Minute).Unix(),
+		"type": "access",
+	}).SignedString(otherKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		want    ssomodels.AuthCheckResp
+		wantErr error
+	}{
+		{
+			name:  "access token",
+			token: s.token(t, "test", nil),
+			want:  ssomodels.AuthCheckResp{IsValid: true, UserID: "user"},
+		},
+		{
+			name:  "token without kid",
+			token: s.token(t, "", nil),
+			want:  ssomodels.AuthCheckResp{IsValid: true, UserID: "user"},
+		},
+		{
+			name:  "impersonation token",
+			token: s.token(t, "test", jwt.MapClaims{"act": map[string]interface{}{"sub": "admin"}}),
+			want:  ssomodels.AuthCheckResp{IsValid: true, UserID: "user", ActorID: "admin"},
+		},
+		{
+			name:  "client token",
+			token: s.token(t, "test", jwt.MapClaims{"sub": "client", "type": "client", "scope": "lg.read lg.write"}),
+			want:  ssomodels.AuthCheckResp{IsValid: true, ClientID: "client", Scopes: []string{"lg.read", "lg.write"}},
+		},
+		{name: "empty token", token: "", wantErr: ErrInvalidCredentials},
+		{name: "unknown kid", token: s.token(t, "unknown", nil), wantErr: ErrInvalidCredentials},
+		{name: "signed with other key", token: otherSigned, wantErr: ErrInvalidCredentials},
+		{name: "wrong issuer", token: s.token(t, "test", jwt.MapClaims{"iss": "other"}), wantErr: ErrInvalidCredentials},
+		{name: "refresh token", token: s.token(t, "test", jwt.MapClaims{"type": "refresh"}), wantErr: ErrInvalidCredentials},
+		{name: "mfa pending token", token: s.token(t, "test", jwt.MapClaims{"type": "mfa_pending"}), wantErr: ErrInvalidCredentials},
+		{name: "expired token", token: s.token(t, "test", jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()}), wantErr: ErrInvalidCredentials},
+		{name: "without jti", token: s.token(t, "test", jwt.MapClaims{"jti": nil}), wantErr: ErrInvalidCredentials},
+		{name: "empty actor", token: s.token(t, "test", jwt.MapClaims{"act": map[string]interface{}{}}), wantErr: ErrInvalidCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.check(tt.token)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("AuthCheck() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AuthCheck() error = %v", err)
+			}
+
+			if got.IsValid != tt.want.IsValid || got.UserID != tt.want.UserID ||
+				got.ActorID != tt.want.ActorID || got.ClientID != tt.want.ClientID ||
+				len(got.Scopes) != len(tt.want.Scopes) {
+				t.Fatalf("AuthCheck() = %+v, want %+v", got, tt.want)
+			}
+			for i := range tt.want.Scopes {
+				if got.Scopes[i] != tt.want.Scopes[i] {
+					t.Errorf("AuthCheck() scopes = %v, want %v", got.Scopes, tt.want.Scopes)
+				}
+			}
+		})
+	}
+}
+
+func TestLocalAuthCheckDenylist(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+		deny   func(d *memDenylist)
+	}{
+		{name: "revoked token", deny: func(d *memDenylist) { d.tokens["jti"] = true }},
+		{name: "deactivated user", deny: func(d *memDenylist) { d.users["user"] = true }},
+		{
+			name:   "deactivated impersonating admin",
+			claims: jwt.MapClaims{"act": map[string]interface{}{"sub": "admin"}},
+			deny:   func(d *memDenylist) { d.users["admin"] = true },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newLocalAuthSuite(t, 0)
+			token := s.token(t, "test", tt.claims)
+
+			if _, err := s.check(token); err != nil {
+				t.Fatalf("AuthCheck() before denial error = %v", err)
+			}
+
+			tt.deny(s.denylist)
+			if _, err := s.check(token); !errors.Is(err, ErrInvalidCredentials) {
+				t.Errorf("AuthCheck() after denial error = %v, want %v", err, ErrInvalidCredentials)
+			}
+		})
+	}
+
+	t.Run("denylist unavailable", func(t *testing.T) {
+		s := newLocalAuthSuite(t, 0)
+		s.denylist.err = errors.New("redis is down")
+
+		if _, err := s.check(s.token(t, "test", nil)); !errors.Is(err, ErrInternal) {
+			t.Errorf("AuthCheck() error = %v, want %v", err, ErrInternal)
+		}
+	})
+}
+
+func TestLocalAuthCheckDenylistCache(t *testing.T) {
+	s := newLocalAuthSuite(t, time.Minute)
+	token := s.token(t, "test", nil)
+
+	for i := 0; i < 3; i++ {
+		if _, err := s.check(token); err != nil {
+			t.Fatalf("AuthCheck() error = %v", err)
+		}
+	}
+	// One lookup of the token and one of the user
+	if s.denylist.lookups != 2 {
+		t.Errorf("denylist lookups = %d, want 2", s.denylist.lookups)
+	}
+
+	// Revoked token is accepted until the cached entry expires
+	s.denylist.tokens["jti"] = true
+	if _, err := s.check(token); err != nil {
+		t.Errorf("AuthCheck() with cached entry error = %v", err)
+	}
+
+	s.checker.denylist.mu.Lock()
+	for key, entry := range s.checker.denylist.entries {
+		entry.expiresAt = time.Now().Add(-time.Second)
+		s.checker.denylist.entries[key] = entry
+	}
+	s.checker.denylist.mu.Unlock()
+
+	if _, err := s.check(token); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("AuthCheck() after cache expiry error = %v, want %v", err, ErrInvalidCredentials)
+	}
+	if s.denylist.lookups != 3 {
+		t.Errorf("denylist lookups = %d, want 3", s.denylist.lookups)
+	}
+}
+
+func TestDenylistCacheCleanup(t *testing.T) {
+	c := newDenylistCache(time.Minute)
+	c.set("old", false)
+
+	c.mu.Lock()
+	c.entries["old"] = denylistEntry{expiresAt: time.Now().Add(-time.Second)}
+	c.cleanedAt = time.Now().Add(-2 * time.Minute)
+	c.mu.Unlock()
+
+	c.set("new", true)
+
+	if _, ok := c.entries["old"]; ok {
+		t.Error("expired entry is kept after cleanup")
+	}
+	if denied, ok := c.get("new"); !ok || !denied {
+		t.Errorf("get() = %v, %v, want true, true", denied, ok)
+	}
+}
@@ -0,0 +1,433 @@
+package ssoservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/pkg/tracer"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var ErrMemberNotFound = errors.New("member not found")
+
+func (sso *SsoService) ScimListUsers(ctx context.Context, list *ssomodels.ScimListUsers) (*ssomodels.ScimListUsersResp, error) {
+	const op = "internal.services.sso.scim.ScimListUsers"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("client_id", list.ClientID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ScimListUsers")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(list); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+
+	log.Info("listing users")
+
+	span.AddEvent("started_listing_users")
+	resp, err := sso.UserProvider.ScimListUsers(ctx, list)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, scimUserError(log, err, "failed to list users"))
+	}
+	span.AddEvent("completed_listing_users")
+
+	log.Info("users listed")
+
+	return resp, nil
+}
+
+func (sso *SsoService) ScimGetUser(ctx context.Context, get *ssomodels.ScimManageUser) (*ssomodels.ScimUser, error) {
+	const op = "internal.services.sso.scim.ScimGetUser"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("client_id", get.ClientID),
+		slog.String("user_id", get.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ScimGetUser")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(get); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("user_id", get.UserID))
+
+	log.Info("getting user")
+
+	span.AddEvent("started_getting_user")
+	resp, err := sso.UserProvider.ScimGetUser(ctx, get)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, scimUserError(log, err, "failed to get user"))
+	}
+	span.AddEvent("completed_getting_user")
+
+	log.Info("user got")
+
+	return resp, nil
+}
+
+func (sso *SsoService) ScimCreateUser(ctx context.Context, create *ssomodels.ScimCreateUser) (*ssomodels.ScimUser, error) {
+	const op = "internal.services.sso.scim.ScimCreateUser"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("client_id", create.ClientID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ScimCreateUser")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(create); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+
+	log.Info("creating user")
+
+	span.AddEvent("started_creating_user")
+	resp, err := sso.UserProvider.ScimCreateUser(ctx, create)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, scimUserError(log, err, "failed to create user"))
+	}
+	span.AddEvent("completed_creating_user")
+
+	log.Info("user created")
+
+	return resp, nil
+}
+
+func (sso *SsoService) ScimUpdateUser(ctx context.Context, upd *ssomodels.ScimUpdateUser) (*ssomodels.ScimUser, error) {
+	const op = "internal.services.sso.scim.ScimUpdateUser"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("client_id", upd.ClientID),
+		slog.String("user_id", upd.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ScimUpdateUser")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(upd); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("user_id", upd.UserID))
+
+	log.Info("updating user")
+
+	span.AddEvent("started_updating_user")
+	resp, err := sso.UserProvider.ScimUpdateUser(ctx, upd)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, scimUserError(log, err, "failed to update user"))
+	}
+	span.AddEvent("completed_updating_user")
+
+	log.Info("user updated")
+
+	return resp, nil
+}
+
+func (sso *SsoService) ScimDeleteUser(ctx context.Context, del *ssomodels.ScimManageUser) (*ssomodels.ScimResp, error) {
+	const op = "internal.services.sso.scim.ScimDeleteUser"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("client_id", del.ClientID),
+		slog.String("user_id", del.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ScimDeleteUser")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(del); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("user_id", del.UserID))
+
+	log.Info("deactivating user")
+
+	span.AddEvent("started_deactivating_user")
+	resp, err := sso.UserProvider.ScimDeleteUser(ctx, del)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, scimUserError(log, err, "failed to deactivate user"))
+	}
+	span.AddEvent("completed_deactivating_user")
+
+	log.Info("user deactivated")
+
+	return resp, nil
+}
+
+func (sso *SsoService) ScimListGroups(ctx context.Context, list *ssomodels.ScimListGroups) (*ssomodels.ScimListGroupsResp, error) {
+	const op = "internal.services.sso.scim.ScimListGroups"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("client_id", list.ClientID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ScimListGroups")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(list); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+
+	log.Info("listing learning groups")
+
+	span.AddEvent("started_listing_groups")
+	resp, err := sso.LgProvider.ScimListGroups(ctx, list)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, scimGroupError(log, err, "failed to list groups"))
+	}
+	span.AddEvent("completed_listing_groups")
+
+	log.Info("learning groups listed")
+
+	return resp, nil
+}
+
+func (sso *SsoService) ScimGetGroup(ctx context.Context, get *ssomodels.ScimManageGroup) (*ssomodels.ScimGroup, error) {
+	const op = "internal.services.sso.scim.ScimGetGroup"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("client_id", get.ClientID),
+		slog.String("learning_group_id", get.GroupID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ScimGetGroup")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(get); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", get.GroupID))
+
+	log.Info("getting learning group")
+
+	span.AddEvent("started_getting_group")
+	resp, err := sso.LgProvider.ScimGetGroup(ctx, get)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, scimGroupError(log, err, "failed to get group"))
+	}
+	span.AddEvent("completed_getting_group")
+
+	log.Info("learning group got")
+
+	return resp, nil
+}
+
+func (sso *SsoService) ScimCreateGroup(ctx context.Context, create *ssomodels.ScimCreateGroup) (*ssomodels.ScimGroup, error) {
+	const op = "internal.services.sso.scim.ScimCreateGroup"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("client_id", create.ClientID),
+		slog.String("learning_group", create.DisplayName),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ScimCreateGroup")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(create); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+
+	log.Info("creating learning group")
+
+	span.AddEvent("started_creating_group")
+	resp, err := sso.LgProvider.ScimCreateGroup(ctx, create)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, scimGroupError(log, err, "failed to create group"))
+	}
+	span.AddEvent("completed_creating_group")
+
+	log.Info("learning group created")
+
+	return resp, nil
+}
+
+func (sso *SsoService) ScimUpdateGroup(ctx context.Context, upd *ssomodels.ScimUpdateGroup) (*ssomodels.ScimGroup, error) {
+	const op = "internal.services.sso.scim.ScimUpdateGroup"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("client_id", upd.ClientID),
+		slog.String("learning_group_id", upd.GroupID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ScimUpdateGroup")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(upd); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", upd.GroupID))
+
+	log.Info("updating learning group")
+
+	span.AddEvent("started_updating_group")
+	resp, err := sso.LgProvider.ScimUpdateGroup(ctx, upd)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, scimGroupError(log, err, "failed to update group"))
+	}
+	span.AddEvent("completed_updating_group")
+
+	log.Info("learning group updated")
+
+	return resp, nil
+}
+
+func (sso *SsoService) ScimChangeGroupMembers(ctx context.Context, change *ssomodels.ScimChangeGroupMembers) (*ssomodels.ScimResp, error) {
+	const op = "internal.services.sso.scim.ScimChangeGroupMembers"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("client_id", change.ClientID),
+		slog.String("learning_group_id", change.GroupID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ScimChangeGroupMembers")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(change); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", change.GroupID))
+
+	log.Info("changing learning group members")
+
+	span.AddEvent("started_changing_members")
+	resp, err := sso.LgProvider.ScimChangeGroupMembers(ctx, change)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, scimGroupError(log, err, "failed to change members"))
+	}
+	span.AddEvent("completed_changing_members")
+
+	log.Info("learning group members changed")
+
+	return resp, nil
+}
+
+func (sso *SsoService) ScimDeleteGroup(ctx context.Context, del *ssomodels.ScimManageGroup) (*ssomodels.ScimResp, error) {
+	const op = "internal.services.sso.scim.ScimDeleteGroup"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("client_id", del.ClientID),
+		slog.String("learning_group_id", del.GroupID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ScimDeleteGroup")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(del); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("learning_group_id", del.GroupID))
+
+	log.Info("deleting learning group")
+
+	span.AddEvent("started_deleting_group")
+	resp, err := sso.LgProvider.ScimDeleteGroup(ctx, del)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, scimGroupError(log, err, "failed to delete group"))
+	}
+	span.AddEvent("completed_deleting_group")
+
+	log.Info("learning group deleted")
+
+	return resp, nil
+}
+
+func scimUserError(log *slog.Logger, err error, failedMsg string) error {
+	switch {
+	case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+		log.Error("invalid credentinals", slog.String("err", err.Error()))
+		return ErrInvalidCredentials
+	case errors.Is(err, ssogrpc.ErrUserNotFound):
+		log.Error("user not found", slog.String("err", err.Error()))
+		return ErrUserNotFound
+	case errors.Is(err, ssogrpc.ErrUserExists):
+		log.Error("user already exists", slog.String("err", err.Error()))
+		return ErrUserExists
+	case errors.Is(err, ssogrpc.ErrPermissionDenied):
+		log.Error("permissions denied", slog.String("err", err.Error()))
+		return ErrPermissionDenied
+	default:
+		log.Error(failedMsg, slog.String("err", err.Error()))
+		return ErrInternal
+	}
+}
+
+func scimGroupError(log *slog.Logger, err error, failedMsg string) error {
+	switch {
+	case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+		log.Error("invalid credentinals", slog.String("err", err.Error()))
+		return ErrInvalidCredentials
+	case errors.Is(err, ssogrpc.ErrMemberNotFound):
+		log.Error("member not found", slog.String("err", err.Error()))
+		return ErrMemberNotFound
+	case errors.Is(err, ssogrpc.ErrGroupNotFound):
+		log.Error("group not found", slog.String("err", err.Error()))
+		return ErrGroupNotFound
+	case errors.Is(err, ssogrpc.ErrGroupExists):
+		log.Error("group already exists", slog.String("err", err.Error()))
+		return ErrGroupExists
+	default:
+		log.Error(failedMsg, slog.String("err", err.Error()))
+		return ErrInternal
+	}
+}
@@ -0,0 +1,103 @@
+package ssoservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/pkg/tracer"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (sso *SsoService) ListSessions(ctx context.Context, list *ssomodels.ListSessions) (*ssomodels.ListSessionsResp, error) {
+	const op = "internal.services.sso.sessions.ListSessions"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", list.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ListSessions")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(list); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("user_id", list.UserID))
+
+	log.Info("listing sessions")
+
+	// Start listing
+	span.AddEvent("started_listing_sessions")
+	resp, err := sso.AuthProvider.ListSessions(ctx, list)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		default:
+			log.Error("failed to list sessions", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_listing_sessions")
+
+	log.Info("sessions listed")
+
+	return resp, nil
+}
+
+func (sso *SsoService) RevokeSession(ctx context.Context, revoke *ssomodels.RevokeSession) (*ssomodels.RevokeSessionResp, error) {
+	const op = "internal.services.sso.sessions.RevokeSession"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", revoke.UserID),
+		slog.String("session_id", revoke.SessionID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "RevokeSession")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(revoke); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("user_id", revoke.UserID))
+
+	log.Info("revoking session")
+
+	// Start revoking
+	span.AddEvent("started_revoking_session")
+	resp, err := sso.AuthProvider.RevokeSession(ctx, revoke)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrSessionNotFound):
+			log.Error("session not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrSessionNotFound)
+		default:
+			log.Error("failed to revoke session", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_revoking_session")
+
+	log.Info("session revoked")
+
+	return &ssomodels.RevokeSessionResp{
+		Success: resp.Success,
+	}, nil
+}
@@ -0,0 +1,108 @@
+package ssoservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/pkg/tracer"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// CreateTgLink returns deep link of the bot which links telegram chat to the user.
+// The link starts the bot with one-time token, so chat can't be linked to a foreign account.
+func (sso *SsoService) CreateTgLink(ctx context.Context, create *ssomodels.CreateTgLink) (*ssomodels.CreateTgLinkResp, error) {
+	const op = "internal.services.sso.telegram.CreateTgLink"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", create.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "CreateTgLink")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(create); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("user_id", create.UserID))
+
+	log.Info("creating telegram link")
+
+	// Start creating
+	span.AddEvent("started_creating_tg_link")
+	resp, err := sso.AuthProvider.CreateTgLinkToken(ctx, create)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, telegramError(log, err, "failed to create telegram link"))
+	}
+	span.AddEvent("completed_creating_tg_link")
+
+	resp.Link = fmt.Sprintf("https://t.me/%s?start=%s", sso.TgBotName, resp.Token)
+
+	log.Info("telegram link created")
+
+	return resp, nil
+}
+
+func (sso *SsoService) UnlinkTelegram(ctx context.Context, unlink *ssomodels.UnlinkTelegram) (*ssomodels.UnlinkTelegramResp, error) {
+	const op = "internal.services.sso.telegram.UnlinkTelegram"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", unlink.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "UnlinkTelegram")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(unlink); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("user_id", unlink.UserID))
+
+	log.Info("unlinking telegram")
+
+	// Start unlinking
+	span.AddEvent("started_unlinking_telegram")
+	resp, err := sso.AuthProvider.UnlinkTelegram(ctx, unlink)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, telegramError(log, err, "failed to unlink telegram"))
+	}
+	span.AddEvent("completed_unlinking_telegram")
+
+	log.Info("telegram unlinked")
+
+	return resp, nil
+}
+
+// telegramError maps client errors of telegram calls to service errors.
+func telegramError(log *slog.Logger, err error, failedMsg string) error {
+	switch {
+	case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+		log.Error("invalid credentinals", slog.String("err", err.Error()))
+		return ErrInvalidCredentials
+	case errors.Is(err, ssogrpc.ErrUserNotFound):
+		log.Error("user not found", slog.String("err", err.Error()))
+		return ErrUserNotFound
+	case errors.Is(err, ssogrpc.ErrTelegramNotLinked):
+		log.Error("telegram is not linked", slog.String("err", err.Error()))
+		return ErrTelegramNotLinked
+	case errors.Is(err, ssogrpc.ErrUserDeactivated):
+		log.Error("user is deactivated", slog.String("err", err.Error()))
+		return ErrUserDeactivated
+	default:
+		log.Error(failedMsg, slog.String("err", err.Error()))
+		return ErrInternal
+	}
+}
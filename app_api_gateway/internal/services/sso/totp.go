@@ -0,0 +1,219 @@
+package ssoservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/pkg/tracer"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (sso *SsoService) LoginMFA(ctx context.Context, login *ssomodels.LoginMFA) (*ssomodels.LoginMFAResp, error) {
+	const op = "internal.services.sso.totp.LoginMFA"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "LoginMFA")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(login); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+
+	log.Info("checking second factor")
+
+	// Start login
+	span.AddEvent("started_user_mfa_login")
+	resp, err := sso.AuthProvider.LoginMFA(ctx, login)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidMFAToken):
+			log.Error("invalid mfa token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidMFAToken)
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid code", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrTooManyAttempts):
+			log.Error("too many attempts", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTooManyAttempts)
+		case errors.Is(err, ssogrpc.ErrUserDeactivated):
+			log.Error("user is deactivated", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserDeactivated)
+		default:
+			log.Error("failed to login user", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_user_mfa_login")
+
+	log.Info("user logged in successfully")
+
+	return &ssomodels.LoginMFAResp{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+	}, nil
+}
+
+func (sso *SsoService) EnrollTOTP(ctx context.Context, enroll *ssomodels.EnrollTOTP) (*ssomodels.EnrollTOTPResp, error) {
+	const op = "internal.services.sso.totp.EnrollTOTP"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", enroll.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "EnrollTOTP")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(enroll); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("user_id", enroll.UserID))
+
+	log.Info("enrolling totp")
+
+	// Start enrolling
+	span.AddEvent("started_enrolling_totp")
+	resp, err := sso.AuthProvider.EnrollTOTP(ctx, enroll)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrTOTPAlreadyEnabled):
+			log.Error("totp is already enabled", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTOTPAlreadyEnabled)
+		default:
+			log.Error("failed to enroll totp", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_enrolling_totp")
+
+	log.Info("totp enrolled")
+
+	return &ssomodels.EnrollTOTPResp{
+		URI:    resp.URI,
+		Secret: resp.Secret,
+	}, nil
+}
+
+func (sso *SsoService) VerifyTOTP(ctx context.Context, verify *ssomodels.VerifyTOTP) (*ssomodels.VerifyTOTPResp, error) {
+	const op = "internal.services.sso.totp.VerifyTOTP"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", verify.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "VerifyTOTP")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(verify); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("user_id", verify.UserID))
+
+	log.Info("verifying totp")
+
+	// Start verifying
+	span.AddEvent("started_verifying_totp")
+	resp, err := sso.AuthProvider.VerifyTOTP(ctx, verify)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid code", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrTOTPAlreadyEnabled):
+			log.Error("totp is already enabled", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTOTPAlreadyEnabled)
+		case errors.Is(err, ssogrpc.ErrTOTPNotEnabled):
+			log.Error("totp is not enrolled", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTOTPNotEnabled)
+		default:
+			log.Error("failed to verify totp", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_verifying_totp")
+
+	log.Info("totp enabled")
+
+	return &ssomodels.VerifyTOTPResp{
+		RecoveryCodes: resp.RecoveryCodes,
+	}, nil
+}
+
+func (sso *SsoService) DisableTOTP(ctx context.Context, disable *ssomodels.DisableTOTP) (*ssomodels.DisableTOTPResp, error) {
+	const op = "internal.services.sso.totp.DisableTOTP"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("user_id", disable.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "DisableTOTP")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(disable); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("user_id", disable.UserID))
+
+	log.Info("disabling totp")
+
+	// Start disabling
+	span.AddEvent("started_disabling_totp")
+	resp, err := sso.AuthProvider.DisableTOTP(ctx, disable)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid code", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrTOTPNotEnabled):
+			log.Error("totp is not enabled", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrTOTPNotEnabled)
+		default:
+			log.Error("failed to disable totp", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_disabling_totp")
+
+	log.Info("totp disabled")
+
+	return &ssomodels.DisableTOTPResp{
+		Success: resp.Success,
+	}, nil
+}
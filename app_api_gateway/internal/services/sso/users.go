@@ -0,0 +1,340 @@
+package ssoservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ssogrpc "github.com/DimTur/lp_api_gateway/internal/clients/sso/grpc"
+	ssomodels "github.com/DimTur/lp_api_gateway/internal/clients/sso/models.go"
+	"github.com/DimTur/lp_api_gateway/pkg/tracer"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (sso *SsoService) ListUsers(ctx context.Context, list *ssomodels.ListUsers) (*ssomodels.ListUsersResp, error) {
+	const op = "internal.services.sso.users.ListUsers"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("admin_id", list.AdminID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ListUsers")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(list); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(attribute.String("admin_id", list.AdminID))
+
+	log.Info("listing users")
+
+	// Start listing
+	span.AddEvent("started_listing_users")
+	resp, err := sso.UserProvider.ListUsers(ctx, list)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrPermissionDenied):
+			log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		default:
+			log.Error("failed to list users", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_listing_users")
+
+	log.Info("users listed")
+
+	return resp, nil
+}
+
+func (sso *SsoService) SetUserAdmin(ctx context.Context, set *ssomodels.SetUserAdmin) (*ssomodels.SetUserAdminResp, error) {
+	const op = "internal.services.sso.users.SetUserAdmin"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("admin_id", set.AdminID),
+		slog.String("user_id", set.UserID),
+		slog.Bool("is_admin", set.IsAdmin),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "SetUserAdmin")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(set); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(
+		attribute.String("admin_id", set.AdminID),
+		attribute.String("user_id", set.UserID),
+	)
+
+	log.Info("changing admin role")
+
+	// Start changing
+	span.AddEvent("started_changing_admin_role")
+	resp, err := sso.UserProvider.SetUserAdmin(ctx, set)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrPermissionDenied):
+			log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		case errors.Is(err, ssogrpc.ErrUserStatusConflict):
+			log.Error("user status conflict", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserStatusConflict)
+		default:
+			log.Error("failed to change admin role", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_changing_admin_role")
+
+	log.Info("admin role changed")
+
+	return &ssomodels.SetUserAdminResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (sso *SsoService) DeactivateUser(ctx context.Context, manage *ssomodels.ManageUser) (*ssomodels.ManageUserResp, error) {
+	const op = "internal.services.sso.users.DeactivateUser"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("admin_id", manage.AdminID),
+		slog.String("user_id", manage.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "DeactivateUser")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(manage); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(
+		attribute.String("admin_id", manage.AdminID),
+		attribute.String("user_id", manage.UserID),
+	)
+
+	log.Info("deactivating user")
+
+	// Start deactivating
+	span.AddEvent("started_deactivating_user")
+	resp, err := sso.UserProvider.DeactivateUser(ctx, manage)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrPermissionDenied):
+			log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		case errors.Is(err, ssogrpc.ErrUserStatusConflict):
+			log.Error("user status conflict", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserStatusConflict)
+		default:
+			log.Error("failed to deactivate user", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_deactivating_user")
+
+	log.Info("user deactivated")
+
+	return &ssomodels.ManageUserResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (sso *SsoService) ImpersonateUser(ctx context.Context, imp *ssomodels.ImpersonateUser) (*ssomodels.ImpersonateUserResp, error) {
+	const op = "internal.services.sso.users.ImpersonateUser"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("admin_id", imp.AdminID),
+		slog.String("user_id", imp.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ImpersonateUser")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(imp); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(
+		attribute.String("admin_id", imp.AdminID),
+		attribute.String("user_id", imp.UserID),
+	)
+
+	log.Info("impersonating user")
+
+	// Start impersonation
+	span.AddEvent("started_impersonating_user")
+	resp, err := sso.UserProvider.ImpersonateUser(ctx, imp)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrPermissionDenied):
+			log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		case errors.Is(err, ssogrpc.ErrUserStatusConflict):
+			log.Error("user can't be impersonated", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserStatusConflict)
+		default:
+			log.Error("failed to impersonate user", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_impersonating_user")
+
+	log.Info("impersonation session started")
+
+	return resp, nil
+}
+
+func (sso *SsoService) ReactivateUser(ctx context.Context, manage *ssomodels.ManageUser) (*ssomodels.ManageUserResp, error) {
+	const op = "internal.services.sso.users.ReactivateUser"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("admin_id", manage.AdminID),
+		slog.String("user_id", manage.UserID),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ReactivateUser")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(manage); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(
+		attribute.String("admin_id", manage.AdminID),
+		attribute.String("user_id", manage.UserID),
+	)
+
+	log.Info("reactivating user")
+
+	// Start reactivating
+	span.AddEvent("started_reactivating_user")
+	resp, err := sso.UserProvider.ReactivateUser(ctx, manage)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrPermissionDenied):
+			log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		case errors.Is(err, ssogrpc.ErrUserStatusConflict):
+			log.Error("user status conflict", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserStatusConflict)
+		default:
+			log.Error("failed to reactivate user", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_reactivating_user")
+
+	log.Info("user reactivated")
+
+	return &ssomodels.ManageUserResp{
+		Success: resp.Success,
+	}, nil
+}
+
+func (sso *SsoService) ImportUsers(ctx context.Context, imp *ssomodels.ImportUsers) (*ssomodels.ImportUsersResp, error) {
+	const op = "internal.services.sso.users.ImportUsers"
+
+	log := sso.Log.With(
+		slog.String("op", op),
+		slog.String("admin_id", imp.AdminID),
+		slog.Int("rows", len(imp.Rows)),
+	)
+
+	_, span := tracer.AuthTracer.Start(ctx, "ImportUsers")
+	defer span.End()
+
+	// Validation
+	span.AddEvent("validation_started")
+	if err := sso.Validator.Struct(imp); err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	span.AddEvent("validation_completed")
+	span.SetAttributes(
+		attribute.String("admin_id", imp.AdminID),
+		attribute.Int("rows", len(imp.Rows)),
+	)
+
+	log.Info("importing users")
+
+	// Start importing
+	span.AddEvent("started_importing_users")
+	resp, err := sso.UserProvider.ImportUsers(ctx, imp)
+	if err != nil {
+		switch {
+		case errors.Is(err, ssogrpc.ErrInvalidCredentials):
+			log.Error("invalid credentinals", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, ssogrpc.ErrUserNotFound):
+			log.Error("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, ssogrpc.ErrPermissionDenied):
+			log.Error("permission denied", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+		default:
+			log.Error("failed to import users", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInternal)
+		}
+	}
+	span.AddEvent("completed_importing_users")
+
+	log.Info("users imported")
+
+	return resp, nil
+}
@@ -0,0 +1,43 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+)
+
+// IsAccessTokenDenied checks if access token jti is in the denylist
+// which sso keeps for revoked tokens.
+func (r *RedisClient) IsAccessTokenDenied(ctx context.Context, jti string) (bool, error) {
+	const op = "storage.redis.IsAccessTokenDenied"
+
+	exists, err := r.client.Exists(ctx, denylistKey(jti)).Result()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return exists > 0, nil
+}
+
+// IsUserDenied checks if the user is in the denylist which sso keeps
+// for deactivated users.
+func (r *RedisClient) IsUserDenied(ctx context.Context, userID string) (bool, error) {
+	const op = "storage.redis.IsUserDenied"
+
+	exists, err := r.client.Exists(ctx, userDenylistKey(userID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return exists > 0, nil
+}
+
+// Denylist keys are written by sso, see
+// app_sso/internal/services/storage/redis/denylist.go.
+// The formats have to be changed in both services at once.
+func denylistKey(jti string) string {
+	return fmt.Sprintf("denylist_%s", jti)
+}
+
+func userDenylistKey(userID string) string {
+	return fmt.Sprintf("denylist_user_%s", userID)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/DimTur/lp_learning_platform/internal/services/redis"
 	attstorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/attempts"
 	channelstorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/channels"
+	gdprstorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/gdpr"
 	lessonstorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/lessons"
 	pagestorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/pages"
 	planstorage "github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/plans"
@@ -57,6 +58,7 @@ func NewServeCmd() *cobra.Command {
 			pageStorage := pagestorage.NewPagesStorage(storagePool)
 			questionStorage := questionstorage.NewQuestionsStorage(storagePool)
 			attemptStorage := attstorage.NewAttemptsStorage(storagePool)
+			gdprStorage := gdprstorage.NewGDPRStorage(storagePool)
 
 			ssoClient, err := ssogrpc.New(
 				ctx,
@@ -108,7 +110,7 @@ func NewServeCmd() *cobra.Command {
 				return err
 			}
 
-			startConsumers(ctx, cfg, rmq, channelStorage, planStorage, log, &wg)
+			startConsumers(ctx, cfg, rmq, channelStorage, planStorage, gdprStorage, log, &wg)
 
 			grpcCloser, err := application.GRPCSrv.Run()
 			if err != nil {
@@ -163,14 +165,16 @@ func startConsumers(
 	rmq *rabbitmq.RMQClient,
 	channelStorage *channelstorage.ChannelPostgresStorage,
 	planStorage *planstorage.PlansPostgresStorage,
+	gdprStorage *gdprstorage.GDPRPostgresStorage,
 	log *slog.Logger,
 	wg *sync.WaitGroup,
 ) {
 	channelsConsumer := consumers.NewConsumeChannel(rmq, channelStorage, log)
 	plansConsumer := consumers.NewConsumePlan(rmq, planStorage, rmq, log)
 	learnersConsumer := consumers.NewConsumeSharedLearnersWithPlan(rmq, planStorage, rmq, log)
+	gdprConsumer := consumers.NewConsumeGDPRJobs(rmq, gdprStorage, rmq, log)
 
-	wg.Add(3)
+	wg.Add(4)
 	go func() {
 		defer wg.Done()
 		if err := channelsConsumer.Start(
@@ -218,4 +222,20 @@ func startConsumers(
 			log.Error("failed to start spfu consumer", slog.Any("err", err))
 		}
 	}()
+
+	go func() {
+		defer wg.Done()
+		if err := gdprConsumer.Start(
+			ctx,
+			cfg.RabbitMQ.GDPR.GDPRConsumer.Queue,
+			cfg.RabbitMQ.GDPR.GDPRConsumer.Consumer,
+			cfg.RabbitMQ.GDPR.GDPRConsumer.AutoAck,
+			cfg.RabbitMQ.GDPR.GDPRConsumer.Exclusive,
+			cfg.RabbitMQ.GDPR.GDPRConsumer.NoLocal,
+			cfg.RabbitMQ.GDPR.GDPRConsumer.NoWait,
+			cfg.RabbitMQ.GDPR.GDPRConsumer.ConsumerArgs.ToMap(),
+		); err != nil {
+			log.Error("failed to start gdpr consumer", slog.Any("err", err))
+		}
+	}()
 }
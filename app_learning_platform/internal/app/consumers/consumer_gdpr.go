@@ -0,0 +1,140 @@
+package consumers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/rabbitmq"
+	"github.com/DimTur/lp_learning_platform/internal/services/storage/postgresql/gdpr"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	exchangeGDPR         = "share"
+	gdprResultRoutingKey = "gdpr_result"
+
+	gdprJobTypeExport = "export"
+	gdprJobTypeErase  = "erase"
+)
+
+type GDPRStorage interface {
+	GetUserAttemptsHistory(ctx context.Context, userID string) ([]*gdpr.LessonAttempt, error)
+	AnonymizeUser(ctx context.Context, userID, pseudonym string) error
+}
+
+// ConsumerGDPRJobs does learning platform part of user data export
+// and erasure requested by sso and sends result back.
+type ConsumerGDPRJobs struct {
+	msgQueue       MessageQueue
+	gdprStorage    GDPRStorage
+	rabbitMQQueues RabbitMQQueues
+	logger         *slog.Logger
+}
+
+func NewConsumeGDPRJobs(
+	msgQueue MessageQueue,
+	gdprStorage GDPRStorage,
+	rabbitMQQueues RabbitMQQueues,
+	logger *slog.Logger,
+) *ConsumerGDPRJobs {
+	return &ConsumerGDPRJobs{
+		msgQueue:       msgQueue,
+		gdprStorage:    gdprStorage,
+		rabbitMQQueues: rabbitMQQueues,
+		logger:         logger,
+	}
+}
+
+func (c *ConsumerGDPRJobs) Start(ctx context.Context,
+	queueName, consumer string,
+	autoAck, exclusive, noLocal, noWait bool,
+	args map[string]interface{},
+) error {
+	const op = "ConsumerGDPRJobs.Start"
+
+	log := c.logger.With(slog.String("op", op))
+	log.Info("Starting to consume gdpr jobs messages")
+
+	return c.msgQueue.Consume(
+		ctx,
+		queueName,
+		consumer,
+		autoAck,
+		exclusive,
+		noLocal,
+		noWait,
+		args,
+		c.handleMessage)
+}
+
+func (c *ConsumerGDPRJobs) handleMessage(ctx context.Context, msg interface{}) error {
+	const op = "consumer_gdpr.handleMessage"
+
+	log := c.logger.With(
+		slog.String("op", op),
+	)
+
+	del, ok := msg.(amqp.Delivery)
+	if !ok {
+		c.logger.Error("failed to cast message to amqp.Delivery")
+		return nil // Return nil to avoid calling Nack/Ack
+	}
+
+	// Decoding JSON message
+	var message rabbitmq.GDPRJob
+	if err := json.Unmarshal(del.Body, &message); err != nil {
+		c.logger.Error("failed to unmarshal message to GDPRJob", slog.Any("err", err))
+		return err
+	}
+
+	log = log.With(
+		slog.String("job_id", message.JobID),
+		slog.String("type", message.Type),
+	)
+	log.Info("handling gdpr job")
+
+	result := &rabbitmq.GDPRJobResult{
+		JobID: message.JobID,
+		Type:  message.Type,
+	}
+
+	switch message.Type {
+	case gdprJobTypeExport:
+		attempts, err := c.gdprStorage.GetUserAttemptsHistory(ctx, message.UserID)
+		if err != nil {
+			log.Error("failed to get attempts history", slog.String("err", err.Error()))
+			result.Error = "failed to get attempts history"
+		} else {
+			result.Attempts = attempts
+		}
+	case gdprJobTypeErase:
+		if message.Pseudonym == "" {
+			log.Error("empty pseudonym")
+			result.Error = "empty pseudonym"
+		} else if err := c.gdprStorage.AnonymizeUser(ctx, message.UserID, message.Pseudonym); err != nil {
+			log.Error("failed to anonymize user", slog.String("err", err.Error()))
+			result.Error = "failed to anonymize user"
+		}
+	default:
+		log.Error("unknown gdpr job type")
+		result.Error = "unknown job type"
+	}
+
+	// Serialization and publication message
+	msgBody, err := json.Marshal(result)
+	if err != nil {
+		log.Error("failed to marshal gdpr job result", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = c.rabbitMQQueues.Publish(ctx, exchangeGDPR, gdprResultRoutingKey, msgBody); err != nil {
+		log.Error("failed to publish gdpr job result", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("gdpr job result sent")
+
+	return nil
+}
@@ -8,6 +8,7 @@ type RabbitMQ struct {
 	Channel  Channel `yaml:"channel"`
 	Plan     Plan    `yaml:"plan"`
 	Spfu     Spfu    `yaml:"spfu"`
+	GDPR     GDPR    `yaml:"gdpr"`
 }
 
 type ConsumerConfig struct {
@@ -0,0 +1,5 @@
+package config
+
+type GDPR struct {
+	GDPRConsumer ConsumerConfig `yaml:"gdpr_consumer"`
+}
@@ -5,3 +5,19 @@ type Spfu struct {
 	UserIDs         []string `json:"user_ids"`
 	CreatedBy       string   `json:"created_by"`
 }
+
+// GDPRJob comes from sso. Pseudonym replaces user id on erasure.
+type GDPRJob struct {
+	JobID     string `json:"job_id"`
+	Type      string `json:"type"`
+	UserID    string `json:"user_id"`
+	Pseudonym string `json:"pseudonym"`
+}
+
+// GDPRJobResult goes back to sso. Attempts is filled on export only.
+type GDPRJobResult struct {
+	JobID    string      `json:"job_id"`
+	Type     string      `json:"type"`
+	Attempts interface{} `json:"attempts,omitempty"`
+	Error    string      `json:"error,omitempty"`
+}
@@ -0,0 +1,172 @@
+package gdpr
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/DimTur/lp_learning_platform/internal/services/storage"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type GDPRPostgresStorage struct {
+	db *pgxpool.Pool
+}
+
+func NewGDPRStorage(db *pgxpool.Pool) *GDPRPostgresStorage {
+	return &GDPRPostgresStorage{db: db}
+}
+
+const (
+	getUserLessonAttemptsQuery = `
+	SELECT
+		la.id,
+		la.lesson_id,
+		la.plan_id,
+		la.channel_id,
+		la.start_time,
+		la.end_time,
+		la.is_complete,
+		la.is_successful,
+		COALESCE(la.percentage_score, 0)
+	FROM
+		attempt_lessonattempt la
+	WHERE
+		la.user_id = $1
+	ORDER BY
+		la.start_time`
+
+	getUserQuestionAnswersQuery = `
+	SELECT
+		pa.lesson_attempt_id,
+		qpa.page_id,
+		COALESCE(qpa.user_answer, ''),
+		qa.is_successful
+	FROM
+		question_questionpageattempt qpa
+	JOIN
+		question_abstractquestionattempt qa ON qa.id = qpa.question_attempt_id
+	JOIN
+		pages_abstractpageattempt pa ON pa.id = qa.page_attempt_id
+	JOIN
+		attempt_lessonattempt la ON la.id = pa.lesson_attempt_id
+	WHERE
+		la.user_id = $1
+	ORDER BY
+		qpa.id`
+)
+
+// GetUserAttemptsHistory returns all lesson attempts of the user
+// with answers to questions.
+func (g *GDPRPostgresStorage) GetUserAttemptsHistory(ctx context.Context, userID string) ([]*LessonAttempt, error) {
+	const op = "storage.postgresql.gdpr.gdpr.GetUserAttemptsHistory"
+
+	rows, err := g.db.Query(ctx, getUserLessonAttemptsQuery, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrQueryFailed)
+	}
+	defer rows.Close()
+
+	attempts := make([]*LessonAttempt, 0)
+	attemptsByID := make(map[int64]*LessonAttempt)
+	for rows.Next() {
+		var attempt LessonAttempt
+		if err := rows.Scan(
+			&attempt.ID,
+			&attempt.LessonID,
+			&attempt.PlanID,
+			&attempt.ChannelID,
+			&attempt.StartTime,
+			&attempt.EndTime,
+			&attempt.IsComplete,
+			&attempt.IsSuccessful,
+			&attempt.PercentageScore,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		attempt.Answers = make([]QuestionAnswer, 0)
+		attempts = append(attempts, &attempt)
+		attemptsByID[attempt.ID] = &attempt
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	answerRows, err := g.db.Query(ctx, getUserQuestionAnswersQuery, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrQueryFailed)
+	}
+	defer answerRows.Close()
+
+	for answerRows.Next() {
+		var (
+			lessonAttemptID int64
+			answer          QuestionAnswer
+		)
+		if err := answerRows.Scan(
+			&lessonAttemptID,
+			&answer.PageID,
+			&answer.UserAnswer,
+			&answer.IsSuccessful,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrScanFailed)
+		}
+		if attempt, ok := attemptsByID[lessonAttemptID]; ok {
+			attempt.Answers = append(attempt.Answers, answer)
+		}
+	}
+
+	if err := answerRows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return attempts, nil
+}
+
+// anonymizeQueries replace user id with pseudonym in all tables
+// which reference users
+var anonymizeQueries = []string{
+	`UPDATE channels SET created_by = $2 WHERE created_by = $1`,
+	`UPDATE channels SET last_modified_by = $2 WHERE last_modified_by = $1`,
+	`UPDATE plans SET created_by = $2 WHERE created_by = $1`,
+	`UPDATE plans SET last_modified_by = $2 WHERE last_modified_by = $1`,
+	`UPDATE lessons SET created_by = $2 WHERE created_by = $1`,
+	`UPDATE lessons SET last_modified_by = $2 WHERE last_modified_by = $1`,
+	`UPDATE pages_abstractpages SET created_by = $2 WHERE created_by = $1`,
+	`UPDATE pages_abstractpages SET last_modified_by = $2 WHERE last_modified_by = $1`,
+	`UPDATE attempt_lessonattempt SET user_id = $2 WHERE user_id = $1`,
+	`UPDATE shared_channels_learninggroups SET created_by = $2 WHERE created_by = $1`,
+	`UPDATE shared_plans_users SET user_id = $2 WHERE user_id = $1`,
+	`UPDATE shared_plans_users SET created_by = $2 WHERE created_by = $1`,
+}
+
+// AnonymizeUser replaces user id with pseudonym in one transaction,
+// so content and statistics stay but can't be linked to the user.
+func (g *GDPRPostgresStorage) AnonymizeUser(ctx context.Context, userID, pseudonym string) error {
+	const op = "storage.postgresql.gdpr.gdpr.AnonymizeUser"
+
+	tx, err := g.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, storage.ErrFailedTransaction)
+	}
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+				log.Printf("%s: %v", op, storage.ErrRollBack)
+			}
+		}
+	}()
+
+	for _, query := range anonymizeQueries {
+		if _, err = tx.Exec(ctx, query, userID, pseudonym); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, storage.ErrCommitTransaction)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package gdpr
+
+import "time"
+
+type LessonAttempt struct {
+	ID              int64            `json:"id"`
+	LessonID        int64            `json:"lesson_id"`
+	PlanID          int64            `json:"plan_id"`
+	ChannelID       int64            `json:"channel_id"`
+	StartTime       time.Time        `json:"start_time"`
+	EndTime         *time.Time       `json:"end_time"`
+	IsComplete      bool             `json:"is_complete"`
+	IsSuccessful    bool             `json:"is_successful"`
+	PercentageScore int64            `json:"percentage_score"`
+	Answers         []QuestionAnswer `json:"answers"`
+}
+
+type QuestionAnswer struct {
+	PageID       int64  `json:"page_id"`
+	UserAnswer   string `json:"user_answer"`
+	IsSuccessful bool   `json:"is_successful"`
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/DimTur/lp_notification/internal/app/sender"
 	"github.com/DimTur/lp_notification/internal/app/telegram"
+	emailclient "github.com/DimTur/lp_notification/internal/clients/email"
 	tgclient "github.com/DimTur/lp_notification/internal/clients/telegram"
 	"github.com/DimTur/lp_notification/internal/config"
 	rabbitmq_store "github.com/DimTur/lp_notification/internal/storage/rabbitmq"
@@ -45,6 +46,15 @@ func NewServeCmd() *cobra.Command {
 				log.Error("failed init tg client", slog.Any("err", err))
 			}
 
+			emailClient := emailclient.NewEmailClient(
+				cfg.Email.SMTPHost,
+				cfg.Email.SMTPPort,
+				cfg.Email.Username,
+				cfg.Email.Password,
+				cfg.Email.From,
+				log,
+			)
+
 			// Init RabbitMQ
 			rmq, err := initRabbitMQ(cfg)
 			if err != nil {
@@ -64,7 +74,7 @@ func NewServeCmd() *cobra.Command {
 				)
 			}()
 
-			startConsumers(ctx, cfg, rmq, tgClient, log, &wg)
+			startConsumers(ctx, cfg, rmq, tgClient, emailClient, log, &wg)
 
 			log.Info("tg bot starting at:", slog.Any("port", cfg.Server.Port))
 			<-ctx.Done()
@@ -93,13 +103,17 @@ func startConsumers(
 	cfg *config.Config,
 	rmq *rabbitmq_store.RMQClient,
 	tgClient *tgclient.TgClient,
+	emailClient *emailclient.EmailClient,
 	log *slog.Logger,
 	wg *sync.WaitGroup,
 ) {
 	otpConsumer := sender.NewConsumeOTP(rmq, tgClient, log)
 	shareConsumer := sender.NewConsumeNotification(rmq, tgClient, log)
+	emailVerificationConsumer := sender.NewConsumeEmailVerification(rmq, emailClient, cfg.Links.VerifyEmailURL, log)
+	userInviteConsumer := sender.NewConsumeUserInvite(rmq, emailClient, cfg.Links.AcceptInviteURL, log)
+	chatResultConsumer := sender.NewConsumeChatResult(rmq, tgClient, log)
 
-	wg.Add(2)
+	wg.Add(5)
 	go func() {
 		defer wg.Done()
 		if err := otpConsumer.Start(
@@ -131,4 +145,50 @@ func startConsumers(
 			log.Error("failed to start notification consumer", slog.Any("err", err))
 		}
 	}()
+
+	go func() {
+		defer wg.Done()
+		if err := emailVerificationConsumer.Start(
+			ctx,
+			cfg.RabbitMQ.EmailVerification.EmailVerificationConsumer.Queue,
+			cfg.RabbitMQ.EmailVerification.EmailVerificationConsumer.Consumer,
+			cfg.RabbitMQ.EmailVerification.EmailVerificationConsumer.AutoAck,
+			cfg.RabbitMQ.EmailVerification.EmailVerificationConsumer.Exclusive,
+			cfg.RabbitMQ.EmailVerification.EmailVerificationConsumer.NoLocal,
+			cfg.RabbitMQ.EmailVerification.EmailVerificationConsumer.NoWait,
+			cfg.RabbitMQ.EmailVerification.EmailVerificationConsumer.ConsumerArgs.ToMap(),
+		); err != nil {
+			log.Error("failed to start email verification consumer", slog.Any("err", err))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if err := userInviteConsumer.Start(
+			ctx,
+			cfg.RabbitMQ.UserInvite.UserInviteConsumer.Queue,
+			cfg.RabbitMQ.UserInvite.UserInviteConsumer.Consumer,
+			cfg.RabbitMQ.UserInvite.UserInviteConsumer.AutoAck,
+			cfg.RabbitMQ.UserInvite.UserInviteConsumer.Exclusive,
+			cfg.RabbitMQ.UserInvite.UserInviteConsumer.NoLocal,
+			cfg.RabbitMQ.UserInvite.UserInviteConsumer.NoWait,
+			cfg.RabbitMQ.UserInvite.UserInviteConsumer.ConsumerArgs.ToMap(),
+		); err != nil {
+			log.Error("failed to start user invite consumer", slog.Any("err", err))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if err := chatResultConsumer.Start(
+			ctx,
+			cfg.RabbitMQ.ChatResult.ChatResultConsumer.Queue,
+			cfg.RabbitMQ.ChatResult.ChatResultConsumer.Consumer,
+			cfg.RabbitMQ.ChatResult.ChatResultConsumer.AutoAck,
+			cfg.RabbitMQ.ChatResult.ChatResultConsumer.Exclusive,
+			cfg.RabbitMQ.ChatResult.ChatResultConsumer.NoLocal,
+			cfg.RabbitMQ.ChatResult.ChatResultConsumer.NoWait,
+			cfg.RabbitMQ.ChatResult.ChatResultConsumer.ConsumerArgs.ToMap(),
+		); err != nil {
+			log.Error("failed to start chat result consumer", slog.Any("err", err))
+		}
+	}()
 }
@@ -0,0 +1,118 @@
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	tgclient "github.com/DimTur/lp_notification/internal/clients/telegram"
+	rabbitmq_store "github.com/DimTur/lp_notification/internal/storage/rabbitmq"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	msgTgLinked       = "Your telegram is linked to your account! 👌"
+	msgTgUnlinked     = "Your telegram is unlinked from your account 👋"
+	msgTgInvalidToken = "The link is expired or already used 🥲 Open the telegram link from your profile on the platform again 🔗"
+	msgTgNotLinked    = "This telegram isn't linked to any account 🤔"
+	msgTgFailed       = "Something went wrong, please try again later 🥲"
+)
+
+// ConsumeChatResult tells the user in telegram how sso handled
+// the link or unlink request of the chat.
+type ConsumeChatResult struct {
+	msgQueue MessageQueue
+	tgClient *tgclient.TgClient
+	logger   *slog.Logger
+}
+
+func NewConsumeChatResult(
+	msgQueue MessageQueue,
+	tgClient *tgclient.TgClient,
+	logger *slog.Logger,
+) *ConsumeChatResult {
+	return &ConsumeChatResult{
+		msgQueue: msgQueue,
+		tgClient: tgClient,
+		logger:   logger,
+	}
+}
+
+func (c *ConsumeChatResult) Start(
+	ctx context.Context,
+	queueName, consumer string,
+	autoAck, exclusive, noLocal, noWait bool,
+	args map[string]interface{},
+) error {
+	const op = "ConsumeChatResult.Start"
+
+	log := c.logger.With(slog.String("op", op))
+	log.Info("Starting to consume chat result messages")
+
+	return c.msgQueue.Consume(
+		ctx,
+		queueName,
+		consumer,
+		autoAck,
+		exclusive,
+		noLocal,
+		noWait,
+		args,
+		c.handleMessage,
+	)
+}
+
+func (c *ConsumeChatResult) handleMessage(ctx context.Context, msg interface{}) error {
+	const op = "ConsumeChatResult.handleMessage"
+
+	// Casting a message to a type amqp.Delivery
+	del, ok := msg.(amqp.Delivery)
+	if !ok {
+		c.logger.Error("failed to cast message to amqp.Delivery")
+		return nil // Return nil to avoid calling Nack/Ack
+	}
+
+	var message rabbitmq_store.TgChatResult
+	// Decoding JSON message
+	if err := json.Unmarshal(del.Body, &message); err != nil {
+		c.logger.Error("failed to unmarshal message to TgChatResult", slog.Any("err", err))
+		return err
+	}
+
+	chatID, err := strconv.Atoi(message.ChatID)
+	if err != nil {
+		c.logger.Error("invalid chat id", slog.String("chat_id", message.ChatID))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := c.tgClient.SendMessage(chatID, chatResultText(message)); err != nil {
+		c.logger.Error("Error sending message to Telegram", slog.Any("err", err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	c.logger.Info("Chat result sent to Telegram",
+		slog.Int("chat_id", chatID),
+		slog.String("action", message.Action),
+		slog.String("status", message.Status),
+	)
+
+	return nil
+}
+
+func chatResultText(result rabbitmq_store.TgChatResult) string {
+	switch result.Status {
+	case rabbitmq_store.TgChatStatusDone:
+		if result.Action == rabbitmq_store.TgChatActionUnlink {
+			return msgTgUnlinked
+		}
+		return msgTgLinked
+	case rabbitmq_store.TgChatStatusInvalidToken:
+		return msgTgInvalidToken
+	case rabbitmq_store.TgChatStatusNotLinked:
+		return msgTgNotLinked
+	default:
+		return msgTgFailed
+	}
+}
@@ -0,0 +1,90 @@
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	emailclient "github.com/DimTur/lp_notification/internal/clients/email"
+	rabbitmq_store "github.com/DimTur/lp_notification/internal/storage/rabbitmq"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const emailVerificationSubject = "Confirm your email"
+
+type ConsumeEmailVerification struct {
+	msgQueue       MessageQueue
+	emailClient    *emailclient.EmailClient
+	verifyEmailURL string
+	logger         *slog.Logger
+}
+
+func NewConsumeEmailVerification(
+	msgQueue MessageQueue,
+	emailClient *emailclient.EmailClient,
+	verifyEmailURL string,
+	logger *slog.Logger,
+) *ConsumeEmailVerification {
+	return &ConsumeEmailVerification{
+		msgQueue:       msgQueue,
+		emailClient:    emailClient,
+		verifyEmailURL: verifyEmailURL,
+		logger:         logger,
+	}
+}
+
+func (c *ConsumeEmailVerification) Start(
+	ctx context.Context,
+	queueName, consumer string,
+	autoAck, exclusive, noLocal, noWait bool,
+	args map[string]interface{},
+) error {
+	const op = "ConsumeEmailVerification.Start"
+
+	log := c.logger.With(slog.String("op", op))
+	log.Info("Starting to consume email verification messages")
+
+	return c.msgQueue.Consume(
+		ctx,
+		queueName,
+		consumer,
+		autoAck,
+		exclusive,
+		noLocal,
+		noWait,
+		args,
+		c.handleMessage,
+	)
+}
+
+func (c *ConsumeEmailVerification) handleMessage(ctx context.Context, msg interface{}) error {
+	const op = "ConsumeEmailVerification.handleMessage"
+
+	// Casting a message to a type amqp.Delivery
+	del, ok := msg.(amqp.Delivery)
+	if !ok {
+		c.logger.Error("failed to cast message to amqp.Delivery")
+		return nil // Return nil to avoid calling Nack/Ack
+	}
+
+	var message rabbitmq_store.MsgEmailVerification
+	// Decoding JSON message
+	if err := json.Unmarshal(del.Body, &message); err != nil {
+		c.logger.Error("failed to unmarshal message to MsgEmailVerification", slog.Any("err", err))
+		return err
+	}
+
+	link := fmt.Sprintf("%s?token=%s", c.verifyEmailURL, url.QueryEscape(message.Token))
+	body := fmt.Sprintf("Welcome to the learning platform!\r\n\r\nConfirm your email by following the link: %s", link)
+
+	if err := c.emailClient.SendMessage(message.Email, emailVerificationSubject, body); err != nil {
+		c.logger.Error("Error sending verification email", slog.Any("err", err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	c.logger.Info("Verification email sent", slog.String("user_id", message.UserID))
+
+	return nil
+}
@@ -3,6 +3,7 @@ package sender
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log/slog"
 
 	tgclient "github.com/DimTur/lp_notification/internal/clients/telegram"
@@ -10,6 +11,8 @@ import (
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
+const msgPasswordResetCode = "Your password reset code: %s 🔐\n\nIf you didn't ask to reset your password, ignore this message and don't share the code with anyone"
+
 type MessageQueue interface {
 	Consume(
 		ctx context.Context,
@@ -78,7 +81,7 @@ func (c *ConsumeOTP) handleMessage(ctx context.Context, msg interface{}) error {
 	}
 
 	// Sending a message in Telegram
-	if err := c.tgClient.SendMessage(message.ChatID, message.Otp.Code); err != nil {
+	if err := c.tgClient.SendMessage(message.ChatID, otpText(message.Otp)); err != nil {
 		c.logger.Error("Error sending message to Telegram", slog.Any("err", err))
 		return err
 	}
@@ -87,3 +90,12 @@ func (c *ConsumeOTP) handleMessage(ctx context.Context, msg interface{}) error {
 
 	return nil
 }
+
+// otpText returns bare login code, so it is easy to copy,
+// and tells what password reset code is for.
+func otpText(otp rabbitmq_store.OTP) string {
+	if otp.Purpose == rabbitmq_store.OTPPurposePasswordReset {
+		return fmt.Sprintf(msgPasswordResetCode, otp.Code)
+	}
+	return otp.Code
+}
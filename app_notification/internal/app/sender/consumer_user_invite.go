@@ -0,0 +1,91 @@
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	emailclient "github.com/DimTur/lp_notification/internal/clients/email"
+	rabbitmq_store "github.com/DimTur/lp_notification/internal/storage/rabbitmq"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const userInviteSubject = "You are invited to the learning platform"
+
+type ConsumeUserInvite struct {
+	msgQueue        MessageQueue
+	emailClient     *emailclient.EmailClient
+	acceptInviteURL string
+	logger          *slog.Logger
+}
+
+func NewConsumeUserInvite(
+	msgQueue MessageQueue,
+	emailClient *emailclient.EmailClient,
+	acceptInviteURL string,
+	logger *slog.Logger,
+) *ConsumeUserInvite {
+	return &ConsumeUserInvite{
+		msgQueue:        msgQueue,
+		emailClient:     emailClient,
+		acceptInviteURL: acceptInviteURL,
+		logger:          logger,
+	}
+}
+
+func (c *ConsumeUserInvite) Start(
+	ctx context.Context,
+	queueName, consumer string,
+	autoAck, exclusive, noLocal, noWait bool,
+	args map[string]interface{},
+) error {
+	const op = "ConsumeUserInvite.Start"
+
+	log := c.logger.With(slog.String("op", op))
+	log.Info("Starting to consume user invite messages")
+
+	return c.msgQueue.Consume(
+		ctx,
+		queueName,
+		consumer,
+		autoAck,
+		exclusive,
+		noLocal,
+		noWait,
+		args,
+		c.handleMessage,
+	)
+}
+
+func (c *ConsumeUserInvite) handleMessage(ctx context.Context, msg interface{}) error {
+	const op = "ConsumeUserInvite.handleMessage"
+
+	// Casting a message to a type amqp.Delivery
+	del, ok := msg.(amqp.Delivery)
+	if !ok {
+		c.logger.Error("failed to cast message to amqp.Delivery")
+		return nil // Return nil to avoid calling Nack/Ack
+	}
+
+	var message rabbitmq_store.MsgUserInvite
+	// Decoding JSON message
+	if err := json.Unmarshal(del.Body, &message); err != nil {
+		c.logger.Error("failed to unmarshal message to MsgUserInvite", slog.Any("err", err))
+		return err
+	}
+
+	// Invited user sets the password on accepting the invite
+	link := fmt.Sprintf("%s?token=%s", c.acceptInviteURL, url.QueryEscape(message.Token))
+	body := fmt.Sprintf("Hello, %s!\r\n\r\nAn account on the learning platform was created for you. Set your password by following the link: %s\r\n\r\nInvite token: %s", message.Name, link, message.Token)
+
+	if err := c.emailClient.SendMessage(message.Email, userInviteSubject, body); err != nil {
+		c.logger.Error("Error sending invite email", slog.Any("err", err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	c.logger.Info("Invite email sent", slog.String("user_id", message.UserID))
+
+	return nil
+}
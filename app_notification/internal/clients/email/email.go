@@ -0,0 +1,77 @@
+package email
+
+import (
+	"fmt"
+	"log/slog"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	"github.com/DimTur/lp_notification/lib/e"
+)
+
+type EmailClient struct {
+	addr string
+	auth smtp.Auth
+	from string
+
+	logger *slog.Logger
+}
+
+func NewEmailClient(
+	host string,
+	port int,
+	username string,
+	password string,
+	from string,
+
+	logger *slog.Logger,
+) *EmailClient {
+	const op = "email_client"
+
+	logger = logger.With(
+		slog.String("op", op),
+	)
+
+	// Local relays (e.g. mailhog) accept mail without auth
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &EmailClient{
+		addr: net.JoinHostPort(host, strconv.Itoa(port)),
+		auth: auth,
+		from: from,
+
+		logger: logger,
+	}
+}
+
+// SendMessage sends plain text email to the recipient.
+func (c *EmailClient) SendMessage(to, subject, body string) (err error) {
+	const op = "internal.clients.email.SendMessage"
+
+	log := c.logger.With(
+		slog.String("op", op),
+	)
+
+	defer func() { err = e.WrapIfErr(log, op, "can't send email", err) }()
+
+	if strings.ContainsAny(to, "\r\n") || strings.ContainsAny(subject, "\r\n") {
+		return fmt.Errorf("invalid header value")
+	}
+
+	msg := strings.Join([]string{
+		"From: " + c.from,
+		"To: " + to,
+		"Subject: " + subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+
+	return smtp.SendMail(c.addr, c.auth, c.from, []string{to}, []byte(msg))
+}
@@ -6,6 +6,8 @@ type Config struct {
 	Server      Server      `yaml:"server"`
 	TelegramBot TelegramBot `yaml:"telegram_bot"`
 	RabbitMQ    RabbitMQ    `yaml:"rabbit_mq"`
+	Email       Email       `yaml:"email"`
+	Links       Links       `yaml:"links"`
 }
 
 type Server struct {
@@ -19,6 +21,22 @@ type TelegramBot struct {
 	BatchSize  int    `yaml:"batch_size"`
 }
 
+type Email struct {
+	SMTPHost string `yaml:"smtp_host"`
+	SMTPPort int    `yaml:"smtp_port"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	From     string `yaml:"from"`
+}
+
+// Links are the public urls put into emails, the token is added as query
+// parameter. AcceptInviteURL is the page where the invited user sets
+// the password, the page sends it with the token to POST /invite/accept.
+type Links struct {
+	VerifyEmailURL  string `yaml:"verify_email_url"`
+	AcceptInviteURL string `yaml:"accept_invite_url"`
+}
+
 func Parse(s string) (*Config, error) {
 	c := &Config{}
 	if err := cleanenv.ReadConfig(s, c); err != nil {
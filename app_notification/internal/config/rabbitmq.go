@@ -1,12 +1,15 @@
 package config
 
 type RabbitMQ struct {
-	UserName     string       `yaml:"username"`
-	Password     string       `yaml:"password"`
-	Host         string       `yaml:"host"`
-	Port         int          `yaml:"port"`
-	OTP          OTP          `yaml:"otp"`
-	Notification Notification `yaml:"notification"`
+	UserName          string            `yaml:"username"`
+	Password          string            `yaml:"password"`
+	Host              string            `yaml:"host"`
+	Port              int               `yaml:"port"`
+	OTP               OTP               `yaml:"otp"`
+	Notification      Notification      `yaml:"notification"`
+	EmailVerification EmailVerification `yaml:"email_verification"`
+	UserInvite        UserInvite        `yaml:"user_invite"`
+	ChatResult        ChatResult        `yaml:"chat_result"`
 }
 
 type ConsumerConfig struct {
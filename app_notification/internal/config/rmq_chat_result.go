@@ -0,0 +1,6 @@
+package config
+
+// ChatResult queue carries results of telegram link and unlink requests from sso.
+type ChatResult struct {
+	ChatResultConsumer ConsumerConfig `yaml:"chat_result_consumer"`
+}
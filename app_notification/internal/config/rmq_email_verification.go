@@ -0,0 +1,5 @@
+package config
+
+type EmailVerification struct {
+	EmailVerificationConsumer ConsumerConfig `yaml:"email_verification_consumer"`
+}
@@ -0,0 +1,5 @@
+package config
+
+type UserInvite struct {
+	UserInviteConsumer ConsumerConfig `yaml:"user_invite_consumer"`
+}
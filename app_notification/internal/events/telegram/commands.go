@@ -11,7 +11,8 @@ import (
 )
 
 const (
-	StartCmd = "/start"
+	StartCmd  = "/start"
+	UnlinkCmd = "/unlink"
 
 	exchangeChatID   = "chat_id"
 	queueChatID      = "chat_id"
@@ -31,44 +32,76 @@ func (p *Processor) doCmd(text string, chatID int, username string) error {
 
 	log.Info("sending new command")
 
-	switch text {
+	// Deep link t.me/<bot>?start=<token> sends "/start <token>"
+	cmd, arg, _ := strings.Cut(text, " ")
+	arg = strings.TrimSpace(arg)
+
+	switch cmd {
 	case StartCmd:
-		return p.saveChatID(username, chatID)
+		if arg == "" {
+			return p.tg.SendMessage(chatID, msgHello+msgNoLinkToken)
+		}
+		return p.linkChat(arg, chatID)
+	case UnlinkCmd:
+		return p.unlinkChat(chatID)
 	default:
 		return p.tg.SendMessage(chatID, msgUnknownCommand)
 	}
 }
 
-func (p *Processor) saveChatID(username string, chatID int) error {
-	const op = "runTg"
+// linkChat asks sso to link the chat to the user who got the token.
+// The token is checked by sso, the bot doesn't trust telegram username.
+// sso reports the result to the chat when the request is handled.
+func (p *Processor) linkChat(token string, chatID int) error {
+	const op = "internal.events.telegram.commands.linkChat"
 
-	log := p.logger.With(
-		slog.String("op", op),
-		slog.String("username", username),
-		slog.Int("chatID", chatID),
-	)
+	msgUserTg := &rabbitmq_store.UserTg{
+		ChatID: strconv.Itoa(chatID),
+		Token:  token,
+		Action: rabbitmq_store.TgChatActionLink,
+	}
+	if err := p.publishUserTg(op, chatID, msgUserTg); err != nil {
+		return err
+	}
 
-	chatIDStr := strconv.Itoa(chatID)
+	p.tg.SendMessage(chatID, msgHello)
+	p.tg.SendMessage(chatID, msgLinkRequested)
+	return nil
+}
 
-	// TODO: delete
-	fmt.Printf("username: %s, chat ID: %s", username, chatIDStr)
+func (p *Processor) unlinkChat(chatID int) error {
+	const op = "internal.events.telegram.commands.unlinkChat"
 
 	msgUserTg := &rabbitmq_store.UserTg{
-		TgLink: username,
-		ChatID: chatIDStr,
+		ChatID: strconv.Itoa(chatID),
+		Action: rabbitmq_store.TgChatActionUnlink,
 	}
+	if err := p.publishUserTg(op, chatID, msgUserTg); err != nil {
+		return err
+	}
+
+	p.tg.SendMessage(chatID, msgUnlinkRequested)
+	return nil
+}
+
+func (p *Processor) publishUserTg(op string, chatID int, msgUserTg *rabbitmq_store.UserTg) error {
+	log := p.logger.With(
+		slog.String("op", op),
+		slog.Int("chatID", chatID),
+		slog.String("action", msgUserTg.Action),
+	)
+
 	msgBody, err := json.Marshal(msgUserTg)
 	if err != nil {
-		log.Error("err to marshal otp", slog.String("err", err.Error()))
+		log.Error("err to marshal chat message", slog.String("err", err.Error()))
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	if err := p.storage.Publish(p.ctx, exchangeChatID, chatIDRoutingKey, msgBody); err != nil {
-		p.tg.SendMessage(chatID, msgUserNotFound)
-		return err
+		log.Error("err to publish chat message", slog.String("err", err.Error()))
+		p.tg.SendMessage(chatID, msgRequestFailed)
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	p.tg.SendMessage(chatID, msgHello)
-	p.tg.SendMessage(chatID, msgSaved)
 	return nil
 }
@@ -3,8 +3,11 @@ package telegram
 const msgHello = "Hi there! 👾\n\n"
 
 const (
-	msgUnknownCommand = "Unknown command 🤔"
-	msgUserNotFound   = "You are not register on our platform 🥲"
-	msgSaved          = "Your telegram is linked to your account! 👌"
-	msg               = "You have already linked your tg account 🤗"
+	msgUnknownCommand  = "Unknown command 🤔"
+	msgUserNotFound    = "You are not register on our platform 🥲"
+	msgLinkRequested   = "Request to link your telegram is sent, we will let you know when it's done ⏳"
+	msg                = "You have already linked your tg account 🤗"
+	msgNoLinkToken     = "Open the telegram link from your profile on the platform to link your account 🔗"
+	msgUnlinkRequested = "Request to unlink your telegram is sent, we will let you know when it's done ⏳"
+	msgRequestFailed   = "Something went wrong, please try again later 🥲"
 )
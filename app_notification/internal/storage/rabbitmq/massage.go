@@ -4,7 +4,14 @@ import (
 	"time"
 )
 
+// Purposes of OTP, sso sends login codes and password reset codes.
+const (
+	OTPPurposeLogin         = "login"
+	OTPPurposePasswordReset = "password_reset"
+)
+
 type OTP struct {
+	Purpose   string    `json:"purpose"`
 	UserID    string    `json:"user_id"`
 	Code      string    `json:"code"`
 	ExpiresAt time.Time `json:"expires_at"`
@@ -16,9 +23,48 @@ type MsgOTP struct {
 	ChatID int `json:"chat_id"`
 }
 
+// Actions of UserTg message.
+const (
+	TgChatActionLink   = "link"
+	TgChatActionUnlink = "unlink"
+)
+
+// UserTg asks sso to link the chat to the user with one-time Token
+// of the /start deep link or to unlink the chat.
 type UserTg struct {
 	TgLink string `json:"tg_link"`
 	ChatID string `json:"chat_id"`
+	Token  string `json:"token,omitempty"`
+	Action string `json:"action"`
+}
+
+// Statuses of TgChatResult message.
+const (
+	TgChatStatusDone         = "done"
+	TgChatStatusInvalidToken = "invalid_token"
+	TgChatStatusNotLinked    = "not_linked"
+	TgChatStatusFailed       = "failed"
+)
+
+// TgChatResult is the answer of sso to UserTg message.
+type TgChatResult struct {
+	ChatID string `json:"chat_id"`
+	Action string `json:"action"`
+	Status string `json:"status"`
+}
+
+type MsgEmailVerification struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Token  string `json:"token"`
+}
+
+type MsgUserInvite struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+	TgLink string `json:"tg_link"`
+	Token  string `json:"token"`
 }
 
 type NotificationMsg struct {
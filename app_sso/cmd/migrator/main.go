@@ -7,6 +7,7 @@ import (
 	"github.com/DimTur/lp_auth/internal/config"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/mongodb"
+	_ "github.com/golang-migrate/migrate/v4/database/sqlite3"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/spf13/cobra"
 )
@@ -33,6 +34,9 @@ func ApplyMigrations() *cobra.Command {
 				cfg.Storage.Password,
 				cfg.Storage.DbName,
 			)
+			if cfg.StorageType == config.StorageTypeSQLite {
+				uri = "sqlite3://" + cfg.SQLite.Path
+			}
 
 			m, err := migrate.New(
 				"file://"+migrationsPath,
@@ -8,6 +8,7 @@ import (
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/DimTur/lp_auth/internal/app"
 	"github.com/DimTur/lp_auth/internal/app/consumer"
@@ -15,6 +16,10 @@ import (
 	"github.com/DimTur/lp_auth/internal/services/rabbitmq"
 	"github.com/DimTur/lp_auth/internal/services/storage/mongodb"
 	authredis "github.com/DimTur/lp_auth/internal/services/storage/redis"
+	"github.com/DimTur/lp_auth/internal/services/storage/sqlite"
+	"github.com/DimTur/lp_auth/internal/utils/passpolicy"
+	"github.com/DimTur/lp_auth/pkg/crypto"
+	"github.com/DimTur/lp_auth/pkg/jwt"
 	"github.com/go-playground/validator/v10"
 	"github.com/spf13/cobra"
 )
@@ -38,15 +43,7 @@ func NewServeCmd() *cobra.Command {
 				return err
 			}
 
-			uri := fmt.Sprintf(
-				"mongodb://%s:%s@%s:27017/%s?authSource=admin",
-				cfg.Storage.UserName,
-				cfg.Storage.Password,
-				cfg.Storage.Host,
-				cfg.Storage.DbName,
-			)
-
-			storage, err := mongodb.NewMongoClient(ctx, uri, cfg.Storage.DbName)
+			storage, err := initStorage(ctx, cfg)
 			if err != nil {
 				return err
 			}
@@ -84,9 +81,34 @@ func NewServeCmd() *cobra.Command {
 				log.Error("failed init rabbit mq", slog.Any("err", err))
 			}
 
+			jwtKeys, err := jwt.LoadKeys(cfg.JWT.KeysDir)
+			if err != nil {
+				return err
+			}
+
+			passwordHasher := crypto.NewPasswordHasher(crypto.Argon2Params{
+				Memory:      cfg.Password.Argon2.Memory,
+				Iterations:  cfg.Password.Argon2.Iterations,
+				Parallelism: cfg.Password.Argon2.Parallelism,
+			})
+
+			passwordPolicy, err := passpolicy.New(
+				cfg.Password.Policy.MinLength,
+				cfg.Password.Policy.MaxLength,
+				cfg.Password.Policy.BreachedListPath,
+			)
+			if err != nil {
+				return err
+			}
+
 			validate := validator.New()
 
 			application, err := app.NewApp(
+				storage,
+				storage,
+				storage,
+				storage,
+				storage,
 				storage,
 				storage,
 				tokenRedis,
@@ -95,9 +117,14 @@ func NewServeCmd() *cobra.Command {
 				cfg.JWT.Issuer,
 				cfg.JWT.AccessExpiresIn,
 				cfg.JWT.RefreshExpiresIn,
-				cfg.JWT.PublicKey,
-				cfg.JWT.PrivateKey,
+				cfg.JWT.KeyGracePeriod,
+				jwtKeys,
+				cfg.JWT.ActiveKID,
+				cfg.OIDC.Issuer,
+				passwordHasher,
+				passwordPolicy,
 				cfg.GRPCServer.Address,
+				cfg.HTTPServer.Address,
 				log,
 				validate,
 			)
@@ -105,19 +132,28 @@ func NewServeCmd() *cobra.Command {
 				return err
 			}
 
-			startConsumers(ctx, cfg, rmq, storage, log, &wg)
+			startConsumers(ctx, cfg, rmq, storage, application.Auth, application.GDPR, log, &wg)
+			startKeysReloader(ctx, cfg, application.JWTManager, log, &wg)
 
 			grpcCloser, err := application.GRPCSrv.Run()
 			if err != nil {
 				return err
 			}
 
+			httpCloser, err := application.HTTPSrv.Run()
+			if err != nil {
+				return err
+			}
+
 			log.Info("server listening:", slog.Any("port", cfg.GRPCServer.Address))
 			<-ctx.Done()
 			wg.Wait()
 
 			rmq.Close()
 			grpcCloser()
+			if err := httpCloser(); err != nil {
+				log.Error("failed to stop http server", slog.Any("err", err))
+			}
 
 			return nil
 		},
@@ -127,6 +163,21 @@ func NewServeCmd() *cobra.Command {
 	return c
 }
 
+func initStorage(ctx context.Context, cfg *config.Config) (app.Storage, error) {
+	if cfg.StorageType == config.StorageTypeSQLite {
+		return sqlite.New(ctx, cfg.SQLite.Path)
+	}
+
+	uri := fmt.Sprintf(
+		"mongodb://%s:%s@%s:27017/%s?authSource=admin",
+		cfg.Storage.UserName,
+		cfg.Storage.Password,
+		cfg.Storage.Host,
+		cfg.Storage.DbName,
+	)
+	return mongodb.NewMongoClient(ctx, uri, cfg.Storage.DbName)
+}
+
 func initRabbitMQ(cfg *config.Config) (*rabbitmq.RMQClient, error) {
 	rmqUrl := fmt.Sprintf(
 		"amqp://%s:%s@%s:%d/",
@@ -143,13 +194,16 @@ func startConsumers(
 	cfg *config.Config,
 	rmq *rabbitmq.RMQClient,
 	authStorage app.AuthStorage,
+	tgLinker consumer.TelegramLinker,
+	gdprJobCompleter consumer.GDPRJobCompleter,
 	log *slog.Logger,
 	wg *sync.WaitGroup,
 ) {
-	chatConsumer := consumer.NewConsumeChat(rmq, authStorage, log)
+	chatConsumer := consumer.NewConsumeChat(rmq, tgLinker, rmq, log)
 	shareConsumer := consumer.NewConsumeShare(rmq, authStorage, rmq, log)
+	gdprResultConsumer := consumer.NewConsumeGDPRResult(rmq, gdprJobCompleter, log)
 
-	wg.Add(2)
+	wg.Add(3)
 	go func() {
 		defer wg.Done()
 		if err := chatConsumer.Start(
@@ -181,4 +235,64 @@ func startConsumers(
 			log.Error("failed to start share plans consumer", slog.Any("err", err))
 		}
 	}()
+
+	go func() {
+		defer wg.Done()
+		if err := gdprResultConsumer.Start(
+			ctx,
+			cfg.RabbitMQ.GDPRResult.GDPRResultConsumer.Queue,
+			cfg.RabbitMQ.GDPRResult.GDPRResultConsumer.Consumer,
+			cfg.RabbitMQ.GDPRResult.GDPRResultConsumer.AutoAck,
+			cfg.RabbitMQ.GDPRResult.GDPRResultConsumer.Exclusive,
+			cfg.RabbitMQ.GDPRResult.GDPRResultConsumer.NoLocal,
+			cfg.RabbitMQ.GDPRResult.GDPRResultConsumer.NoWait,
+			cfg.RabbitMQ.GDPRResult.GDPRResultConsumer.ConsumerArgs.ToMap(),
+		); err != nil {
+			log.Error("failed to start gdpr result consumer", slog.Any("err", err))
+		}
+	}()
+}
+
+// startKeysReloader periodically reloads signing keys from the keys directory,
+// so keys can be rotated by updating k8s secret without restart.
+// Retirement time of keys is saved next to them to survive restarts.
+func startKeysReloader(
+	ctx context.Context,
+	cfg *config.Config,
+	jwtManager *jwt.JWTManager,
+	log *slog.Logger,
+	wg *sync.WaitGroup,
+) {
+	saveRetirements := func() {
+		if err := jwtManager.SaveRetirements(cfg.JWT.KeysDir); err != nil {
+			log.Warn("failed to save jwt key retirement time", slog.Any("err", err))
+		}
+	}
+	saveRetirements()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(cfg.JWT.KeysReloadInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				keys, err := jwt.LoadKeys(cfg.JWT.KeysDir)
+				if err != nil {
+					log.Error("failed to load jwt keys", slog.Any("err", err))
+					continue
+				}
+				if err := jwtManager.SetKeys(keys, cfg.JWT.ActiveKID); err != nil {
+					log.Error("failed to set jwt keys", slog.Any("err", err))
+					continue
+				}
+				saveRetirements()
+			}
+		}
+	}()
 }
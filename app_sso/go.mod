@@ -3,9 +3,10 @@ module github.com/DimTur/lp_auth
 go 1.23.0
 
 require (
-	github.com/DimTur/lp_protos v0.3.6
+	github.com/DimTur/lp_protos v0.3.8
 	github.com/grpc-ecosystem/go-grpc-middleware/v2 v2.1.0
 	github.com/ilyakaznacheev/cleanenv v1.5.0
+	github.com/mattn/go-sqlite3 v1.14.22
 	github.com/rabbitmq/amqp091-go v1.10.0
 	github.com/redis/go-redis/v9 v9.7.0
 	github.com/spf13/cobra v1.8.1
@@ -54,3 +55,6 @@ require (
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 	olympos.io/encoding/edn v0.0.0-20201019073823-d3554ca0b0a3 // indirect
 )
+
+// lp_protos lives in this repository, see ../lp_protos/readme.md
+replace github.com/DimTur/lp_protos => ../lp_protos
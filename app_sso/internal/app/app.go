@@ -1,12 +1,19 @@
 package app
 
 import (
+	"context"
 	"log/slog"
 	"time"
 
 	grpcapp "github.com/DimTur/lp_auth/internal/app/grpc"
+	httpapp "github.com/DimTur/lp_auth/internal/app/http"
+	"github.com/DimTur/lp_auth/internal/services/apps"
+	"github.com/DimTur/lp_auth/internal/services/audit"
 	"github.com/DimTur/lp_auth/internal/services/auth"
+	"github.com/DimTur/lp_auth/internal/services/gdpr"
 	learninggroup "github.com/DimTur/lp_auth/internal/services/learning_group"
+	"github.com/DimTur/lp_auth/internal/services/oidc"
+	"github.com/DimTur/lp_auth/internal/services/users"
 	"github.com/DimTur/lp_auth/pkg/crypto"
 	"github.com/DimTur/lp_auth/pkg/jwt"
 	"github.com/go-playground/validator/v10"
@@ -22,52 +29,127 @@ type GroupStorage interface {
 	learninggroup.GroupSaver
 	learninggroup.GroupeProvider
 	learninggroup.GroupeDel
+	learninggroup.InviteStore
+	learninggroup.RoleStore
+}
+
+type AppStorage interface {
+	apps.AppSaver
+	apps.AppProvider
+	apps.UserProvider
+}
+
+type OIDCStorage interface {
+	oidc.RelyingPartySaver
+	oidc.RelyingPartyProvider
+	oidc.UserProvider
+}
+
+type UserStorage interface {
+	users.UserSaver
+	users.UserProvider
+	users.TokenRevoker
+}
+
+type GDPRStorage interface {
+	gdpr.GDPRJobSaver
+	gdpr.GDPRJobProvider
+	gdpr.UserProvider
+	gdpr.UserEraser
+}
+
+type AuditStorage interface {
+	audit.EventSaver
+	audit.EventProvider
+	audit.UserProvider
+}
+
+// Storage is implemented by mongodb and sqlite storages.
+type Storage interface {
+	AuthStorage
+	GroupStorage
+	AppStorage
+	OIDCStorage
+	UserStorage
+	GDPRStorage
+	AuditStorage
+	Close(ctx context.Context) error
 }
 
 type TokenRedis interface {
 	auth.TokenRedisStore
+	auth.TgLinkTokenStore
+	oidc.AuthCodeStore
+	users.TokenRedisStore
 }
 
 type OTPRedis interface {
 	auth.OTPRedisStore
+	auth.LoginLimiterStore
 }
 
 type AuthRabbitMq interface {
 	auth.RabbitMQQueues
+	gdpr.RabbitMQQueues
+	users.RabbitMQQueues
 }
 
 type App struct {
-	GRPCSrv *grpcapp.Server
+	GRPCSrv    *grpcapp.Server
+	HTTPSrv    *httpapp.Server
+	JWTManager *jwt.JWTManager
+	// GDPR is used by consumer of gdpr job results
+	GDPR *gdpr.GDPRHandlers
+	// Auth is used by consumer of telegram chat links
+	Auth *auth.AuthHandlers
 }
 
 func NewApp(
 	authStorage AuthStorage,
 	groupStorage GroupStorage,
+	appStorage AppStorage,
+	oidcStorage OIDCStorage,
+	userStorage UserStorage,
+	gdprStorage GDPRStorage,
+	auditStorage AuditStorage,
 	tokenRedis TokenRedis,
 	otpRedis OTPRedis,
 	authRabbitMq AuthRabbitMq,
 	jwtIssuer string,
 	jwtAccessExpiresIn time.Duration,
 	jwtRefreshExpiresIn time.Duration,
-	jwtPublicKey string,
-	jwtPrivetKey string,
+	jwtKeyGracePeriod time.Duration,
+	jwtKeys []jwt.Key,
+	jwtActiveKID string,
+	oidcIssuer string,
+	passwordHasher crypto.PasswordHasher,
+	passwordPolicy auth.PasswordPolicy,
 	grpcAddr string,
+	httpAddr string,
 
 	logger *slog.Logger,
 	validator *validator.Validate,
 ) (*App, error) {
-	passwordHasher := crypto.NewPasswordHasher()
 	jwtManager, err := jwt.NewJWTManager(
 		jwtIssuer,
 		jwtAccessExpiresIn,
 		jwtRefreshExpiresIn,
-		[]byte(jwtPublicKey),
-		[]byte(jwtPrivetKey),
+		jwtKeyGracePeriod,
+		jwtKeys,
+		jwtActiveKID,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	auditHandlers := audit.New(
+		logger,
+		validator,
+		auditStorage,
+		auditStorage,
+		auditStorage,
+	)
+
 	authGRPCHandlers := auth.New(
 		logger,
 		validator,
@@ -76,9 +158,13 @@ func NewApp(
 		authStorage,
 		tokenRedis,
 		otpRedis,
+		otpRedis,
+		tokenRedis,
 		authRabbitMq,
 		passwordHasher,
+		passwordPolicy,
 		jwtManager,
+		auditHandlers,
 	)
 
 	lgGRPCHandlers := learninggroup.New(
@@ -87,6 +173,58 @@ func NewApp(
 		groupStorage,
 		groupStorage,
 		groupStorage,
+		groupStorage,
+		groupStorage,
+		authRabbitMq,
+		auditHandlers,
+	)
+
+	appGRPCHandlers := apps.New(
+		logger,
+		validator,
+		appStorage,
+		appStorage,
+		appStorage,
+		passwordHasher,
+		jwtManager,
+	)
+
+	oidcHandlers := oidc.New(
+		logger,
+		validator,
+		oidcIssuer,
+		oidcStorage,
+		oidcStorage,
+		oidcStorage,
+		authGRPCHandlers,
+		tokenRedis,
+		passwordHasher,
+		jwtManager,
+	)
+
+	userGRPCHandlers := users.New(
+		logger,
+		validator,
+		userStorage,
+		userStorage,
+		userStorage,
+		tokenRedis,
+		jwtManager,
+		jwtManager,
+		jwtManager,
+		authRabbitMq,
+		lgGRPCHandlers,
+		auditHandlers,
+		jwtAccessExpiresIn,
+	)
+
+	gdprHandlers := gdpr.New(
+		logger,
+		validator,
+		gdprStorage,
+		gdprStorage,
+		gdprStorage,
+		gdprStorage,
 		authRabbitMq,
 	)
 
@@ -94,6 +232,11 @@ func NewApp(
 		grpcAddr,
 		authGRPCHandlers,
 		lgGRPCHandlers,
+		appGRPCHandlers,
+		oidcHandlers,
+		userGRPCHandlers,
+		gdprHandlers,
+		auditHandlers,
 		logger,
 		validator,
 	)
@@ -101,7 +244,21 @@ func NewApp(
 		return nil, err
 	}
 
+	httpServer, err := httpapp.NewHTTPServer(
+		httpAddr,
+		jwtManager,
+		oidcHandlers,
+		logger,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &App{
-		GRPCSrv: grpcServer,
+		GRPCSrv:    grpcServer,
+		HTTPSrv:    httpServer,
+		JWTManager: jwtManager,
+		GDPR:       gdprHandlers,
+		Auth:       authGRPCHandlers,
 	}, nil
 }
@@ -6,14 +6,14 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"time"
 
 	"github.com/DimTur/lp_auth/internal/domain/models"
 	"github.com/DimTur/lp_auth/internal/services/auth"
-	"github.com/DimTur/lp_auth/internal/services/storage"
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
+const chatResultRoutingKey = "chat_result"
+
 var (
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrUserNotFound       = errors.New("user not found")
@@ -34,21 +34,30 @@ type AuthStorage interface {
 	auth.UserProvider
 }
 
+// TelegramLinker links and unlinks telegram chats of users
+type TelegramLinker interface {
+	LinkTelegram(ctx context.Context, token, chatID string) error
+	UnlinkTelegramChat(ctx context.Context, chatID string) error
+}
+
 type ConsumeUserChatID struct {
-	msgQueue    MessageQueue
-	authStorage AuthStorage
-	logger      *slog.Logger
+	msgQueue       MessageQueue
+	tgLinker       TelegramLinker
+	rabbitMQQueues RabbitMQQueues
+	logger         *slog.Logger
 }
 
 func NewConsumeChat(
 	msgQueue MessageQueue,
-	authStorage AuthStorage,
+	tgLinker TelegramLinker,
+	rabbitMQQueues RabbitMQQueues,
 	logger *slog.Logger,
 ) *ConsumeUserChatID {
 	return &ConsumeUserChatID{
-		msgQueue:    msgQueue,
-		authStorage: authStorage,
-		logger:      logger,
+		msgQueue:       msgQueue,
+		tgLinker:       tgLinker,
+		rabbitMQQueues: rabbitMQQueues,
+		logger:         logger,
 	}
 }
 
@@ -91,37 +100,68 @@ func (c *ConsumeUserChatID) handleMessage(ctx context.Context, msg interface{})
 
 	var message models.UserTg
 	// Decoding JSON message
-	if err := json.Unmarshal(del.Body, &message); err != nil {
+	err := json.Unmarshal(del.Body, &message)
+	if err != nil {
 		c.logger.Error("failed to unmarshal message to UserTg", slog.Any("err", err))
 		return err
 	}
 
-	user, err := c.authStorage.FindUserByTgLink(ctx, message.TgLink)
+	// Chat is linked only with one-time token of the user, tg_link
+	// typed into the profile isn't trusted.
+	switch message.Action {
+	case models.TgChatActionUnlink:
+		err = c.tgLinker.UnlinkTelegramChat(ctx, message.ChatID)
+	case "":
+		message.Action = models.TgChatActionLink
+		fallthrough
+	case models.TgChatActionLink:
+		err = c.tgLinker.LinkTelegram(ctx, message.Token, message.ChatID)
+	default:
+		log.Warn("unknown action", slog.String("action", message.Action))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
 	if err != nil {
-		if errors.Is(err, storage.ErrUserNotFound) {
+		switch {
+		case errors.Is(err, auth.ErrInvalidTgLinkToken):
+			log.Warn("invalid telegram link token", slog.String("err", err.Error()))
+			c.sendResult(ctx, log, &message, models.TgChatStatusInvalidToken)
+			return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, auth.ErrTelegramNotLinked):
+			log.Warn("chat is not linked", slog.String("err", err.Error()))
+			c.sendResult(ctx, log, &message, models.TgChatStatusNotLinked)
+			return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		case errors.Is(err, auth.ErrUserNotFound):
 			log.Warn("user not found", slog.String("err", err.Error()))
+			c.sendResult(ctx, log, &message, models.TgChatStatusFailed)
 			return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		default:
+			log.Error("failed to handle chat message", slog.String("err", err.Error()))
+			c.sendResult(ctx, log, &message, models.TgChatStatusFailed)
+			return fmt.Errorf("%s: %w", op, err)
 		}
-		log.Error("failed to update user info", slog.String("err", err.Error()))
-		return fmt.Errorf("%s: %w", op, err)
-	}
-
-	chatID := &models.DBUpdateUserInfo{
-		ID:      user.ID,
-		ChatID:  message.ChatID,
-		Updated: time.Now(),
 	}
-	if err = c.authStorage.UpdateUserInfo(ctx, chatID); err != nil {
-		if errors.Is(err, storage.ErrInvalidCredentials) {
-			log.Warn("invalid credentials", slog.String("err", err.Error()))
-			return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
-		}
 
-		log.Error("failed to save user chat id", slog.String("err", err.Error()))
-		return fmt.Errorf("%s: %w", op, err)
-	}
+	c.sendResult(ctx, log, &message, models.TgChatStatusDone)
 
 	log.Info("handling message")
 
 	return nil
 }
+
+// sendResult tells the telegram bot how the message was handled.
+// Errors are only logged, the chat message itself is already handled.
+func (c *ConsumeUserChatID) sendResult(ctx context.Context, log *slog.Logger, message *models.UserTg, status string) {
+	msgBody, err := json.Marshal(&models.TgChatResult{
+		ChatID: message.ChatID,
+		Action: message.Action,
+		Status: status,
+	})
+	if err != nil {
+		log.Error("failed to marshal chat result", slog.String("err", err.Error()))
+		return
+	}
+
+	if err := c.rabbitMQQueues.Publish(ctx, exchange, chatResultRoutingKey, msgBody); err != nil {
+		log.Error("failed to publish chat result", slog.String("err", err.Error()))
+	}
+}
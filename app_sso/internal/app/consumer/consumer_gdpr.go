@@ -0,0 +1,88 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+type GDPRJobCompleter interface {
+	CompleteJob(ctx context.Context, result *models.GDPRJobResult) error
+}
+
+// ConsumeGDPRResult finishes gdpr jobs with results from learning platform.
+type ConsumeGDPRResult struct {
+	msgQueue     MessageQueue
+	jobCompleter GDPRJobCompleter
+	logger       *slog.Logger
+}
+
+func NewConsumeGDPRResult(
+	msgQueue MessageQueue,
+	jobCompleter GDPRJobCompleter,
+	logger *slog.Logger,
+) *ConsumeGDPRResult {
+	return &ConsumeGDPRResult{
+		msgQueue:     msgQueue,
+		jobCompleter: jobCompleter,
+		logger:       logger,
+	}
+}
+
+func (c *ConsumeGDPRResult) Start(
+	ctx context.Context,
+	queueName, consumer string,
+	autoAck, exclusive, noLocal, noWait bool,
+	args map[string]interface{},
+) error {
+	const op = "ConsumeGDPRResult.Start"
+
+	log := c.logger.With(slog.String("op", op))
+	log.Info("Starting to consume gdpr result messages")
+
+	return c.msgQueue.Consume(
+		ctx,
+		queueName,
+		consumer,
+		autoAck,
+		exclusive,
+		noLocal,
+		noWait,
+		args,
+		c.handleMessage,
+	)
+}
+
+func (c *ConsumeGDPRResult) handleMessage(ctx context.Context, msg interface{}) error {
+	const op = "consumer_gdpr.handleMessage"
+
+	log := c.logger.With(
+		slog.String("op", op),
+	)
+
+	del, ok := msg.(amqp.Delivery)
+	if !ok {
+		c.logger.Error("failed to cast message to amqp.Delivery")
+		return nil // Return nil to avoid calling Nack/Ack
+	}
+
+	// Decoding JSON message
+	var message models.GDPRJobResult
+	if err := json.Unmarshal(del.Body, &message); err != nil {
+		c.logger.Error("failed to unmarshal message to GDPRJobResult", slog.Any("err", err))
+		return err
+	}
+
+	log.Info("handling gdpr job result", slog.String("job_id", message.JobID))
+
+	if err := c.jobCompleter.CompleteJob(ctx, &message); err != nil {
+		log.Error("failed to complete gdpr job", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	handlers "github.com/DimTur/lp_auth/internal/grpc/sso_handlers"
+	"github.com/DimTur/lp_auth/internal/utils/clientinfo"
 	"github.com/go-playground/validator/v10"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"google.golang.org/grpc"
@@ -37,6 +38,11 @@ func NewGRPCServer(
 	gRPCAddr string,
 	authHandlers handlers.AuthHandlers,
 	lgHandlers handlers.LGHAndlers,
+	appHandlers handlers.AppHandlers,
+	oidcHandlers handlers.OIDCHandlers,
+	userHandlers handlers.UserHandlers,
+	gdprHandlers handlers.GDPRHandlers,
+	auditHandlers handlers.AuditHandlers,
 	logger *slog.Logger,
 	validator *validator.Validate,
 ) (*Server, error) {
@@ -60,12 +66,13 @@ func NewGRPCServer(
 	gRPCSrv := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			recovery.UnaryServerInterceptor(recovery.WithRecoveryHandler(grpcPanicRecoveryHandler)),
+			clientinfo.UnaryServerInterceptor,
 		),
 		grpc.ChainStreamInterceptor(
 			recovery.StreamServerInterceptor(recovery.WithRecoveryHandler(grpcPanicRecoveryHandler)),
 		),
 	)
-	handlers.RegisterSsoServiceServer(gRPCSrv, authHandlers, lgHandlers)
+	handlers.RegisterSsoServiceServer(gRPCSrv, authHandlers, lgHandlers, appHandlers, oidcHandlers, userHandlers, gdprHandlers, auditHandlers)
 
 	// register health check service
 	healthService := NewHealthChecker(logger)
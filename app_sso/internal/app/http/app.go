@@ -0,0 +1,95 @@
+package httpapp
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	httphandlers "github.com/DimTur/lp_auth/internal/http/handlers"
+	"github.com/DimTur/lp_auth/internal/utils/clientinfo"
+)
+
+const (
+	// HTTPDefaultShutdownTimeout - period to wait result of http.Server.Shutdown
+	HTTPDefaultShutdownTimeout = 5 * time.Second
+)
+
+// Server - public http endpoints of sso: JWKS and OIDC provider
+type Server struct {
+	httpAddr        string
+	httpSrv         *http.Server
+	listener        net.Listener
+	shutdownTimeout time.Duration
+
+	logger *slog.Logger
+}
+
+func NewHTTPServer(
+	httpAddr string,
+	jwksProvider httphandlers.JWKSProvider,
+	oidcProvider httphandlers.OIDCProvider,
+	logger *slog.Logger,
+) (*Server, error) {
+	const op = "http-server"
+
+	logger = logger.With(
+		slog.String("op", op),
+		slog.String("addr", httpAddr),
+	)
+
+	netListener, err := net.Listen("tcp", httpAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /.well-known/jwks.json", httphandlers.JWKS(logger, jwksProvider))
+
+	// OIDC provider
+	mux.Handle("GET /.well-known/openid-configuration", httphandlers.OIDCDiscovery(logger, oidcProvider))
+	mux.Handle("GET /authorize", httphandlers.OIDCAuthorize(logger, oidcProvider))
+	mux.Handle("POST /authorize", httphandlers.OIDCLogin(logger, oidcProvider))
+	mux.Handle("POST /token", httphandlers.OIDCToken(logger, oidcProvider))
+	mux.Handle("GET /userinfo", httphandlers.OIDCUserInfo(logger, oidcProvider))
+	mux.Handle("POST /userinfo", httphandlers.OIDCUserInfo(logger, oidcProvider))
+
+	return &Server{
+		httpAddr: httpAddr,
+		httpSrv: &http.Server{
+			Handler:           clientinfo.HTTPMiddleware(mux),
+			ReadHeaderTimeout: 5 * time.Second,
+		},
+		listener:        netListener,
+		shutdownTimeout: HTTPDefaultShutdownTimeout,
+		logger:          logger,
+	}, nil
+}
+
+func (s *Server) Run() (func() error, error) {
+	const op = "httpapp.Run"
+	s.logger.With(slog.String("op", op)).Info("starting", slog.String("httpAddr", s.httpAddr))
+
+	go func() {
+		err := s.httpSrv.Serve(s.listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("http server", slog.Any("err", err))
+		}
+	}()
+
+	s.logger.Info("http server is running", slog.String("addr", s.httpAddr))
+	return s.close, nil
+}
+
+// close - gracefully shutdown server
+func (s *Server) close() error {
+	const op = "httpapp.stop"
+	s.logger.With(slog.String("op", op)).Info("stopping http server", slog.String("addr", s.httpAddr))
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+
+	return s.httpSrv.Shutdown(ctx)
+}
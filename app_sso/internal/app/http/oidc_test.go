@@ -0,0 +1,529 @@
+package httpapp_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	httpapp "github.com/DimTur/lp_auth/internal/app/http"
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/auth"
+	"github.com/DimTur/lp_auth/internal/services/oidc"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/DimTur/lp_auth/pkg/crypto"
+	"github.com/DimTur/lp_auth/pkg/jwt"
+	"github.com/go-playground/validator/v10"
+	gojwt "github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testRedirectURI = "http://127.0.0.1:9999/callback"
+	testEmail       = "learner@example.com"
+	testPassword    = "correct horse battery staple"
+)
+
+// memStore keeps relying parties, users and authorization codes in memory
+// instead of Mongo and Redis.
+type memStore struct {
+	mu    sync.Mutex
+	rps   map[string]*models.RelyingParty
+	users map[string]*models.User
+	codes map[string]*models.OIDCAuthCode
+}
+
+func newMemStore() *memStore {
+	return &memStore{
+		rps:   make(map[string]*models.RelyingParty),
+		users: make(map[string]*models.User),
+		codes: make(map[string]*models.OIDCAuthCode),
+	}
+}
+
+func (m *memStore) SaveRelyingParty(_ context.Context, rp *models.DBCreateRelyingParty) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rp.ID = fmt.Sprintf("rp-%d", len(m.rps)+1)
+	m.rps[rp.ID] = &models.RelyingParty{
+		ID:           rp.ID,
+		Name:         rp.Name,
+		SecretHash:   rp.SecretHash,
+		RedirectURIs: rp.RedirectURIs,
+		CreatedBy:    rp.CreatedBy,
+		Created:      rp.Created,
+	}
+	return nil
+}
+
+func (m *memStore) FindRelyingPartyByID(_ context.Context, clientID string) (*models.RelyingParty, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rp, ok := m.rps[clientID]
+	if !ok {
+		return nil, storage.ErrRelyingPartyNotFound
+	}
+	return rp, nil
+}
+
+func (m *memStore) FindUserByID(_ context.Context, userID string) (*models.User, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	user, ok := m.users[userID]
+	if !ok {
+		return nil, storage.ErrUserNotFound
+	}
+	u := *user
+	return &u, nil
+}
+
+func (m *memStore) GetUserRoles(ctx context.Context, userID string) (*models.UserRoles, error) {
+	user, err := m.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.UserRoles{IsAdmin: user.IsAdmin}, nil
+}
+
+func (m *memStore) SaveOIDCAuthCode(_ context.Context, code string, authCode *models.OIDCAuthCode, _ time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.codes[code] = authCode
+	return nil
+}
+
+func (m *memStore) PopOIDCAuthCode(_ context.Context, code string) (*models.OIDCAuthCode, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	authCode, ok := m.codes[code]
+	if !ok {
+		return nil, storage.ErrAuthCodeNotFound
+	}
+	delete(m.codes, code)
+	return authCode, nil
+}
+
+func (m *memStore) setStatus(userID, status string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.users[userID].Status = status
+}
+
+// passwordAuthenticator checks email and password only, lockout and 2FA
+// of auth.AuthenticateUser are out of the scope of this test.
+type passwordAuthenticator struct {
+	store  *memStore
+	hasher crypto.PasswordHasher
+}
+
+func (a *passwordAuthenticator) AuthenticateUser(_ context.Context, creds *models.AuthenticateUser) (*models.User, error) {
+	a.store.mu.Lock()
+	defer a.store.mu.Unlock()
+
+	for _, user := range a.store.users {
+		if user.Email == creds.Email && a.hasher.ComparePassword(user.PassHash, creds.Password) {
+			if user.Status == models.UserStatusDeactivated {
+				return nil, auth.ErrUserDeactivated
+			}
+			u := *user
+			return &u, nil
+		}
+	}
+	return nil, auth.ErrInvalidCredentials
+}
+
+type provider struct {
+	issuer   string
+	clientID string
+	store    *memStore
+	userID   string
+}
+
+func startProvider(t *testing.T) *provider {
+	t.Helper()
+
+	// Issuer has to be known before the server is created
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+	issuer := "http://" + addr
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	hasher := crypto.NewPasswordHasher(crypto.Argon2Params{Memory: 8 * 1024, Iterations: 1, Parallelism: 1})
+
+	_, privKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwtManager, err := jwt.NewJWTManager(issuer, time.Minute, time.Hour, time.Hour, []jwt.Key{{ID: "test", PrivateKey: privKey}}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := newMemStore()
+	passHash, err := hasher.HashPassword(testPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.users["admin"] = &models.User{ID: "admin", Email: "admin@example.com", IsAdmin: true, Status: models.UserStatusActive}
+	store.users["learner"] = &models.User{
+		ID:       "learner",
+		Email:    testEmail,
+		Name:     "Learner",
+		PassHash: passHash,
+		Status:   models.UserStatusActive,
+	}
+
+	oidcHandlers := oidc.New(
+		log,
+		validator.New(),
+		issuer,
+		store,
+		store,
+		store,
+		&passwordAuthenticator{store: store, hasher: hasher},
+		store,
+		hasher,
+		jwtManager,
+	)
+
+	creds, err := oidcHandlers.RegisterRelyingParty(context.Background(), &models.CreateRelyingParty{
+		UserID:       "admin",
+		Name:         "internal tool",
+		RedirectURIs: []string{testRedirectURI},
+		Public:       true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv, err := httpapp.NewHTTPServer(addr, jwtManager, oidcHandlers, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	closer, err := srv.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { closer() })
+
+	return &provider{
+		issuer:   issuer,
+		clientID: creds.ClientID,
+		store:    store,
+		userID:   "learner",
+	}
+}
+
+// testClient is a public relying party which logs the user in with
+// authorization code flow and PKCE, like internal tools do.
+type testClient struct {
+	t        *testing.T
+	http     *http.Client
+	clientID string
+	config   struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		UserinfoEndpoint      string `json:"userinfo_endpoint"`
+		JWKSURI               string `json:"jwks_uri"`
+	}
+}
+
+func newTestClient(t *testing.T, issuer, clientID string) *testClient {
+	c := &testClient{
+		t:        t,
+		clientID: clientID,
+		http: &http.Client{
+			Timeout: 5 * time.Second,
+			// Redirect to the relying party is read by the test
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		},
+	}
+
+	resp := c.do(http.MethodGet, issuer+"/.well-known/openid-configuration", nil, "")
+	c.decode(resp, http.StatusOK, &c.config)
+	if c.config.Issuer != issuer {
+		t.Fatalf("discovery issuer = %q, want %q", c.config.Issuer, issuer)
+	}
+
+	return c
+}
+
+func (c *testClient) do(method, target string, form url.Values, bearer string) *http.Response {
+	c.t.Helper()
+
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequest(method, target, body)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if bearer != "" {
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return resp
+}
+
+func (c *testClient) decode(resp *http.Response, wantStatus int, v any) {
+	c.t.Helper()
+	defer resp.Body.Close()
+
+	data, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != wantStatus {
+		c.t.Fatalf("%s %s: status = %d, want %d, body: %s", resp.Request.Method, resp.Request.URL.Path, resp.StatusCode, wantStatus, data)
+	}
+	if v != nil {
+		if err := json.Unmarshal(data, v); err != nil {
+			c.t.Fatalf("decode %s: %v", resp.Request.URL.Path, err)
+		}
+	}
+}
+
+// login goes through /authorize and returns authorization code and PKCE verifier.
+func (c *testClient) login(scope, nonce string) (string, string) {
+	c.t.Helper()
+
+	verifier := randomHex(c.t, 32)
+	sum := sha256.Sum256([]byte(verifier))
+	state := randomHex(c.t, 8)
+
+	params := url.Values{
+		"client_id":             {c.clientID},
+		"redirect_uri":          {testRedirectURI},
+		"response_type":         {"code"},
+		"scope":                 {scope},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(sum[:])},
+		"code_challenge_method": {"S256"},
+	}
+
+	resp := c.do(http.MethodGet, c.config.AuthorizationEndpoint+"?"+params.Encode(), nil, "")
+	c.decode(resp, http.StatusOK, nil)
+
+	params.Set("email", testEmail)
+	params.Set("password", testPassword)
+	resp = c.do(http.MethodPost, c.config.AuthorizationEndpoint, params, "")
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		c.t.Fatalf("login: status = %d, want %d", resp.StatusCode, http.StatusFound)
+	}
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if got := location.Query().Get("state"); got != state {
+		c.t.Fatalf("state = %q, want %q", got, state)
+	}
+	code := location.Query().Get("code")
+	if code == "" {
+		c.t.Fatalf("no code in redirect %s", location)
+	}
+
+	return code, verifier
+}
+
+func (c *testClient) exchange(code, verifier string) *http.Response {
+	return c.do(http.MethodPost, c.config.TokenEndpoint, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {testRedirectURI},
+		"client_id":     {c.clientID},
+		"code_verifier": {verifier},
+	}, "")
+}
+
+// verifyIDToken checks signature of the ID token with provider JWKS.
+func (c *testClient) verifyIDToken(idToken string) gojwt.MapClaims {
+	c.t.Helper()
+
+	var jwks jwt.JWKS
+	c.decode(c.do(http.MethodGet, c.config.JWKSURI, nil, ""), http.StatusOK, &jwks)
+
+	claims := gojwt.MapClaims{}
+	_, err := gojwt.ParseWithClaims(idToken, claims, func(token *gojwt.Token) (interface{}, error) {
+		for _, key := range jwks.Keys {
+			if key.Kid == token.Header["kid"] {
+				x, err := base64.RawURLEncoding.DecodeString(key.X)
+				return ed25519.PublicKey(x), err
+			}
+		}
+		return nil, fmt.Errorf("unknown kid %v", token.Header["kid"])
+	},
+		gojwt.WithValidMethods([]string{"EdDSA"}),
+		gojwt.WithIssuer(c.config.Issuer),
+		gojwt.WithAudience(c.clientID),
+	)
+	if err != nil {
+		c.t.Fatalf("verify id token: %v", err)
+	}
+
+	return claims
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+	Scope       string `json:"scope"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func TestOIDCAuthorizationCodeFlow(t *testing.T) {
+	p := startProvider(t)
+	c := newTestClient(t, p.issuer, p.clientID)
+
+	nonce := randomHex(t, 8)
+	code, verifier := c.login("openid email profile", nonce)
+
+	var tokens tokenResponse
+	c.decode(c.exchange(code, verifier), http.StatusOK, &tokens)
+	if tokens.TokenType != "Bearer" || tokens.Scope != "openid email profile" {
+		t.Fatalf("unexpected token response %+v", tokens)
+	}
+
+	claims := c.verifyIDToken(tokens.IDToken)
+	if claims["sub"] != p.userID || claims["nonce"] != nonce || claims["email"] != testEmail {
+		t.Fatalf("unexpected id token claims %v", claims)
+	}
+
+	var userInfo models.OIDCUserInfo
+	c.decode(c.do(http.MethodGet, c.config.UserinfoEndpoint, nil, tokens.AccessToken), http.StatusOK, &userInfo)
+	if userInfo.Sub != p.userID || userInfo.Email != testEmail || userInfo.Name != "Learner" {
+		t.Fatalf("unexpected userinfo %+v", userInfo)
+	}
+
+	// Code is single use
+	var errResp errorResponse
+	c.decode(c.exchange(code, verifier), http.StatusBadRequest, &errResp)
+	if errResp.Error != "invalid_grant" {
+		t.Fatalf("replayed code: error = %q, want invalid_grant", errResp.Error)
+	}
+}
+
+func TestOIDCTokenErrors(t *testing.T) {
+	p := startProvider(t)
+	c := newTestClient(t, p.issuer, p.clientID)
+
+	tests := []struct {
+		name       string
+		form       func(code, verifier string) url.Values
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name: "wrong verifier",
+			form: func(code, _ string) url.Values {
+				return url.Values{"grant_type": {"authorization_code"}, "code": {code}, "redirect_uri": {testRedirectURI}, "client_id": {p.clientID}, "code_verifier": {"wrong"}}
+			},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "invalid_grant",
+		},
+		{
+			name: "another redirect uri",
+			form: func(code, verifier string) url.Values {
+				return url.Values{"grant_type": {"authorization_code"}, "code": {code}, "redirect_uri": {"http://127.0.0.1:9999/other"}, "client_id": {p.clientID}, "code_verifier": {verifier}}
+			},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "invalid_grant",
+		},
+		{
+			name: "unknown client",
+			form: func(code, verifier string) url.Values {
+				return url.Values{"grant_type": {"authorization_code"}, "code": {code}, "redirect_uri": {testRedirectURI}, "client_id": {"unknown"}, "code_verifier": {verifier}}
+			},
+			wantStatus: http.StatusUnauthorized,
+			wantError:  "invalid_client",
+		},
+		{
+			name: "unsupported grant type",
+			form: func(code, verifier string) url.Values {
+				return url.Values{"grant_type": {"password"}, "code": {code}, "redirect_uri": {testRedirectURI}, "client_id": {p.clientID}, "code_verifier": {verifier}}
+			},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "unsupported_grant_type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c.t = t
+			code, verifier := c.login("openid", "")
+
+			var errResp errorResponse
+			c.decode(c.do(http.MethodPost, c.config.TokenEndpoint, tt.form(code, verifier), ""), tt.wantStatus, &errResp)
+			if errResp.Error != tt.wantError {
+				t.Fatalf("error = %q, want %q", errResp.Error, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestOIDCDeactivatedUser(t *testing.T) {
+	p := startProvider(t)
+	c := newTestClient(t, p.issuer, p.clientID)
+
+	code, verifier := c.login("openid email", "")
+	var tokens tokenResponse
+	c.decode(c.exchange(code, verifier), http.StatusOK, &tokens)
+
+	code, verifier = c.login("openid email", "")
+	p.store.setStatus(p.userID, models.UserStatusDeactivated)
+
+	var errResp errorResponse
+	c.decode(c.exchange(code, verifier), http.StatusBadRequest, &errResp)
+	if errResp.Error != "invalid_grant" {
+		t.Fatalf("exchange: error = %q, want invalid_grant", errResp.Error)
+	}
+
+	c.decode(c.do(http.MethodGet, c.config.UserinfoEndpoint, nil, tokens.AccessToken), http.StatusUnauthorized, &errResp)
+	if errResp.Error != "invalid_token" {
+		t.Fatalf("userinfo: error = %q, want invalid_token", errResp.Error)
+	}
+}
+
+func randomHex(t *testing.T, n int) string {
+	t.Helper()
+
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatal(err)
+	}
+	return hex.EncodeToString(b)
+}
@@ -1,24 +1,39 @@
 package config
 
 import (
-	"os"
+	"fmt"
 	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
+const (
+	StorageTypeMongoDB = "mongodb"
+	StorageTypeSQLite  = "sqlite"
+)
+
 type Config struct {
 	GRPCServer GRPCServer `yaml:"grpc_server"`
-	Storage    MongoDB    `yaml:"mongo_db"`
-	Redis      Redis      `yaml:"redis"`
-	RabbitMQ   RabbitMQ   `yaml:"rabbit_mq"`
-	JWT        JWT        `yaml:"jwt"`
+	HTTPServer HTTPServer `yaml:"http_server"`
+	// StorageType is mongodb or sqlite
+	StorageType string   `yaml:"storage_type" env-default:"mongodb"`
+	Storage     MongoDB  `yaml:"mongo_db"`
+	SQLite      SQLite   `yaml:"sqlite"`
+	Redis       Redis    `yaml:"redis"`
+	RabbitMQ    RabbitMQ `yaml:"rabbit_mq"`
+	JWT         JWT      `yaml:"jwt"`
+	OIDC        OIDC     `yaml:"oidc"`
+	Password    Password `yaml:"password"`
 }
 
 type GRPCServer struct {
 	Address string `yaml:"address" env-default:":9090"`
 }
 
+type HTTPServer struct {
+	Address string `yaml:"address" env-default:":8002"`
+}
+
 type MongoDB struct {
 	Host     string `yaml:"host"`
 	DbName   string `yaml:"db_name"`
@@ -26,14 +41,48 @@ type MongoDB struct {
 	Password string `yaml:"password"`
 }
 
+// SQLite is used instead of MongoDB for local development
+type SQLite struct {
+	Path string `yaml:"path" env-default:"./storage/sso.db"`
+}
+
 type JWT struct {
 	Issuer           string        `yaml:"issuer"`
 	AccessExpiresIn  time.Duration `yaml:"access_expires_in"`
 	RefreshExpiresIn time.Duration `yaml:"refresh_expires_in"`
-	PublicKey        string        `yaml:"public_key"`
-	PrivateKey       string        `yaml:"private_key"`
-	PublicKeyTest    string        `yaml:"public_key_test"`
-	PrivateKeyTest   string        `yaml:"private_key_test"`
+	// KeysDir is directory with Ed25519 private keys, <kid>.pem each.
+	// Retired keys have <kid>.retired with RFC 3339 retirement time
+	KeysDir   string `yaml:"keys_dir"`
+	ActiveKID string `yaml:"active_kid"`
+	// KeyGracePeriod defaults to refresh_expires_in
+	KeyGracePeriod     time.Duration `yaml:"key_grace_period"`
+	KeysReloadInterval time.Duration `yaml:"keys_reload_interval" env-default:"1m"`
+}
+
+type OIDC struct {
+	// Issuer is public URL of sso http server, e.g. https://sso.example.com
+	Issuer string `yaml:"issuer" env-default:"http://localhost:8002"`
+}
+
+type Password struct {
+	Argon2 Argon2         `yaml:"argon2"`
+	Policy PasswordPolicy `yaml:"policy"`
+}
+
+// Argon2 are cost parameters of password hashes. Existing hashes made
+// with weaker parameters are rehashed on the next login.
+type Argon2 struct {
+	// Memory is in KiB
+	Memory      uint32 `yaml:"memory" env-default:"65536"`
+	Iterations  uint32 `yaml:"iterations" env-default:"3"`
+	Parallelism uint8  `yaml:"parallelism" env-default:"2"`
+}
+
+type PasswordPolicy struct {
+	MinLength int `yaml:"min_length" env-default:"8"`
+	MaxLength int `yaml:"max_length" env-default:"128"`
+	// BreachedListPath is text file with one breached password per line
+	BreachedListPath string `yaml:"breached_list_path"`
 }
 
 func Parse(s string) (*Config, error) {
@@ -42,18 +91,15 @@ func Parse(s string) (*Config, error) {
 		return nil, err
 	}
 
-	privateKey, err := os.ReadFile(c.JWT.PrivateKey)
-	if err != nil {
-		return nil, err
+	switch c.StorageType {
+	case StorageTypeMongoDB, StorageTypeSQLite:
+	default:
+		return nil, fmt.Errorf("unknown storage type %q", c.StorageType)
 	}
-	publicKey, err := os.ReadFile(c.JWT.PublicKey)
-	if err != nil {
-		return nil, err
+
+	if c.JWT.KeyGracePeriod == 0 {
+		c.JWT.KeyGracePeriod = c.JWT.RefreshExpiresIn
 	}
-	c.JWT.PrivateKey = string(privateKey)
-	c.JWT.PublicKey = string(publicKey)
-	c.JWT.PrivateKeyTest = string(privateKey)
-	c.JWT.PublicKeyTest = string(publicKey)
 
 	return c, nil
 }
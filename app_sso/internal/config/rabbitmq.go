@@ -7,6 +7,7 @@ type RabbitMQ struct {
 	Port         int          `yaml:"port"`
 	ChatID       Chat         `yaml:"chat"`
 	Notification Notification `yaml:"notification"`
+	GDPRResult   GDPRResult   `yaml:"gdpr_result"`
 }
 
 type ConsumerConfig struct {
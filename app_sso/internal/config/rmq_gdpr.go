@@ -0,0 +1,5 @@
+package config
+
+type GDPRResult struct {
+	GDPRResultConsumer ConsumerConfig `yaml:"gdpr_result_consumer"`
+}
@@ -1,7 +1,47 @@
 package models
 
+import "time"
+
+// App is a service client which authenticates with the client credentials grant.
 type App struct {
-	ID     int64
-	Name   string
-	Secret string
+	ID         string    `json:"id" bson:"_id"`
+	Name       string    `json:"name" bson:"name"`
+	SecretHash []byte    `json:"-" bson:"secret_hash"`
+	Scopes     []string  `json:"scopes" bson:"scopes"`
+	CreatedBy  string    `json:"created_by" bson:"created_by"`
+	Created    time.Time `json:"created" bson:"created"`
+}
+
+type CreateApp struct {
+	UserID string   `json:"user_id" validate:"required"`
+	Name   string   `json:"name" validate:"required,min=3,max=100"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,required"`
+}
+
+type DBCreateApp struct {
+	ID         string    `json:"id" bson:"_id"`
+	Name       string    `json:"name" bson:"name"`
+	SecretHash []byte    `json:"-" bson:"secret_hash"`
+	Scopes     []string  `json:"scopes" bson:"scopes"`
+	CreatedBy  string    `json:"created_by" bson:"created_by"`
+	Created    time.Time `json:"created" bson:"created"`
+}
+
+// AppCredentials are returned once on app registration,
+// only the secret hash is stored.
+type AppCredentials struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+type ClientCredentials struct {
+	ClientID     string   `json:"client_id" validate:"required"`
+	ClientSecret string   `json:"client_secret" validate:"required"`
+	Scopes       []string `json:"scopes" validate:"dive,required"`
+}
+
+type ClientToken struct {
+	AccessToken string        `json:"access_token"`
+	ExpiresIn   time.Duration `json:"expires_in"`
+	Scopes      []string      `json:"scopes"`
 }
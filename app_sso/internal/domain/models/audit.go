@@ -0,0 +1,82 @@
+package models
+
+import "time"
+
+const (
+	AuditEventLoginSuccess        = "login_success"
+	AuditEventLoginFailure        = "login_failure"
+	AuditEventOTPIssued           = "otp_issued"
+	AuditEventOTPVerified         = "otp_verified"
+	AuditEventTokenRefresh        = "token_refresh"
+	AuditEventProfileUpdate       = "profile_update"
+	AuditEventAdminFlagChange     = "admin_flag_change"
+	AuditEventLearningGroupCreate = "learning_group_create"
+	AuditEventLearningGroupUpdate = "learning_group_update"
+	AuditEventLearningGroupDelete = "learning_group_delete"
+	AuditEventTelegramLink        = "telegram_link"
+	AuditEventTelegramUnlink      = "telegram_unlink"
+	AuditEventLgRoleChange        = "lg_role_change"
+	AuditEventUserProvision       = "user_provision"
+	AuditEventImpersonation       = "impersonation"
+	AuditEventUserDeactivate      = "user_deactivate"
+	AuditEventUserReactivate      = "user_reactivate"
+)
+
+// Login methods of login events.
+const (
+	AuthMethodPassword     = "password"
+	AuthMethodOTP          = "otp"
+	AuthMethodTOTP         = "totp"
+	AuthMethodRecoveryCode = "recovery_code"
+)
+
+// AuditEvent is a record of the append-only audit log.
+// UserID is the user the event is about, ActorID is the user who did it,
+// they differ for admin actions. ActorID is the service client id
+// of SCIM changes and the admin of impersonation. UserID is empty for login failures
+// of unknown emails. TargetID is learning group id of learning group
+// events, session (token family) id of token refresh events
+// and access token id of impersonation events.
+// Email, IP and UserAgent are personal data, they are removed from events
+// of the user on GDPR erasure. Ids stay, they don't identify erased user.
+type AuditEvent struct {
+	ID        string    `json:"id" bson:"_id,omitempty"`
+	Type      string    `json:"type" bson:"type"`
+	UserID    string    `json:"user_id" bson:"user_id,omitempty"`
+	ActorID   string    `json:"actor_id" bson:"actor_id,omitempty"`
+	Email     string    `json:"email" bson:"email,omitempty"`
+	Method    string    `json:"method" bson:"method,omitempty"`
+	TargetID  string    `json:"target_id" bson:"target_id,omitempty"`
+	Details   string    `json:"details" bson:"details,omitempty"`
+	IP        string    `json:"ip" bson:"ip,omitempty"`
+	UserAgent string    `json:"user_agent" bson:"user_agent,omitempty"`
+	Created   time.Time `json:"created" bson:"created"`
+}
+
+// ListAuditEvents is a page request of the audit log.
+// UserID matches both subject and actor of the event. Cursor is ID
+// of the last event of the previous page.
+type ListAuditEvents struct {
+	AdminID   string    `json:"admin_id" validate:"required"`
+	UserID    string    `json:"user_id,omitempty"`
+	EventType string    `json:"event_type,omitempty" validate:"omitempty,oneof=login_success login_failure otp_issued otp_verified token_refresh profile_update admin_flag_change learning_group_create learning_group_update learning_group_delete telegram_link telegram_unlink lg_role_change user_provision impersonation user_deactivate user_reactivate"`
+	From      time.Time `json:"from,omitempty"`
+	To        time.Time `json:"to,omitempty"`
+	Cursor    string    `json:"cursor,omitempty"`
+	Limit     int64     `json:"limit,omitempty" validate:"gte=0,lte=100"`
+}
+
+type AuditFilter struct {
+	UserID    string
+	EventType string
+	From      time.Time
+	To        time.Time
+	BeforeID  string
+	Limit     int64
+}
+
+type AuditEventsPage struct {
+	Events []*AuditEvent `json:"events"`
+	// NextCursor is empty on the last page
+	NextCursor string `json:"next_cursor"`
+}
@@ -0,0 +1,112 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	GDPRJobTypeExport = "export"
+	GDPRJobTypeErase  = "erase"
+)
+
+const (
+	GDPRJobStatusPending   = "pending"
+	GDPRJobStatusCompleted = "completed"
+	GDPRJobStatusFailed    = "failed"
+)
+
+// GDPRJob is export or erasure of the user data. Learning platform
+// does its part asynchronously, so the job is finished by its result.
+type GDPRJob struct {
+	ID          string    `json:"id" bson:"_id,omitempty"`
+	Type        string    `json:"type" bson:"type"`
+	UserID      string    `json:"user_id" bson:"user_id"`
+	RequestedBy string    `json:"requested_by" bson:"requested_by"`
+	Status      string    `json:"status" bson:"status"`
+	Error       string    `json:"error" bson:"error"`
+	Archive     []byte    `json:"-" bson:"archive"`
+	Created     time.Time `json:"created" bson:"created"`
+	Updated     time.Time `json:"updated" bson:"updated"`
+}
+
+type DBCreateGDPRJob struct {
+	ID          string    `bson:"_id,omitempty"`
+	Type        string    `bson:"type" validate:"required,oneof=export erase"`
+	UserID      string    `bson:"user_id" validate:"required"`
+	RequestedBy string    `bson:"requested_by" validate:"required"`
+	Status      string    `bson:"status" validate:"required"`
+	Created     time.Time `bson:"created" validate:"required"`
+	Updated     time.Time `bson:"updated" validate:"required"`
+}
+
+// DBUpdateGDPRJob finishes the job. The job with archive is deleted
+// after ExpiresAt, so personal data isn't kept longer than needed.
+type DBUpdateGDPRJob struct {
+	ID        string    `bson:"_id" validate:"required"`
+	Status    string    `bson:"status" validate:"required"`
+	Error     string    `bson:"error"`
+	Archive   []byte    `bson:"archive,omitempty"`
+	ExpiresAt time.Time `bson:"expires_at,omitempty"`
+	Updated   time.Time `bson:"updated" validate:"required"`
+}
+
+type GDPRRequest struct {
+	AdminID string `json:"admin_id" validate:"required"`
+	UserID  string `json:"user_id" validate:"required"`
+}
+
+type GetGDPRJob struct {
+	AdminID string `json:"admin_id" validate:"required"`
+	JobID   string `json:"job_id" validate:"required"`
+}
+
+// GDPRJobMsg is sent to learning platform.
+// Pseudonym replaces user id in learning platform data on erasure.
+type GDPRJobMsg struct {
+	JobID     string `json:"job_id"`
+	Type      string `json:"type"`
+	UserID    string `json:"user_id"`
+	Pseudonym string `json:"pseudonym,omitempty"`
+}
+
+// GDPRJobResult comes back from learning platform.
+// Attempts is lesson attempts history of the user, export only.
+type GDPRJobResult struct {
+	JobID    string          `json:"job_id" validate:"required"`
+	Type     string          `json:"type" validate:"required,oneof=export erase"`
+	Attempts json.RawMessage `json:"attempts,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+// UserDataArchive is JSON archive of all user data on the platform.
+type UserDataArchive struct {
+	UserID         string              `json:"user_id"`
+	ExportedAt     time.Time           `json:"exported_at"`
+	Profile        UserDataProfile     `json:"profile"`
+	LearningGroups []UserLearningGroup `json:"learning_groups"`
+	Attempts       json.RawMessage     `json:"attempts"`
+}
+
+type UserDataProfile struct {
+	Email       string    `json:"email"`
+	Name        string    `json:"name"`
+	TgLink      string    `json:"tg_link"`
+	IsAdmin     bool      `json:"is_admin"`
+	Status      string    `json:"status"`
+	TOTPEnabled bool      `json:"totp_enabled"`
+	Created     time.Time `json:"created"`
+	Updated     time.Time `json:"updated"`
+}
+
+const (
+	GroupRoleLearner    = "learner"
+	GroupRoleGroupAdmin = "group_admin"
+)
+
+// UserLearningGroup is membership of the user in the learning group.
+type UserLearningGroup struct {
+	ID   string `json:"id" bson:"_id"`
+	Name string `json:"name" bson:"name"`
+	Role string `json:"role" bson:"-"`
+}
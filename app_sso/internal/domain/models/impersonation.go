@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// ImpersonateUser is a request of the platform admin to act as the user,
+// e.g. to see the platform as the learner does. Reason is written
+// to the audit log.
+type ImpersonateUser struct {
+	AdminID string `json:"admin_id" validate:"required"`
+	UserID  string `json:"user_id" validate:"required"`
+	Reason  string `json:"reason" validate:"max=500"`
+}
+
+// ImpersonationToken is short-lived access token of the impersonated user.
+// It can't be refreshed, the admin has to start new session.
+type ImpersonationToken struct {
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
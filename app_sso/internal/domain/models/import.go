@@ -0,0 +1,50 @@
+package models
+
+const (
+	ImportStatusCreated          = "created"
+	ImportStatusSkippedDuplicate = "skipped_duplicate"
+	ImportStatusInvalid          = "invalid"
+	// ImportStatusReinvited is set for existing users who haven't accepted
+	// the invite yet, they get the new one.
+	ImportStatusReinvited = "reinvited"
+)
+
+// ImportUsers is a bulk import of users by platform admin.
+// Imported users can be added to existing learning group by LearningGroupID
+// or to the new one named LearningGroupName.
+type ImportUsers struct {
+	AdminID           string           `json:"admin_id" validate:"required"`
+	Rows              []*ImportUserRow `json:"rows" validate:"required,min=1"`
+	LearningGroupID   string           `json:"learning_group_id,omitempty" validate:"excluded_with=LearningGroupName"`
+	LearningGroupName string           `json:"learning_group_name,omitempty" validate:"omitempty,min=3,max=100"`
+}
+
+// ImportUserRow is validated separately, so one invalid row
+// doesn't fail the whole import.
+type ImportUserRow struct {
+	// Row is a number of the row in the source file
+	Row    int64  `json:"row"`
+	Email  string `json:"email" validate:"required,email"`
+	Name   string `json:"name,omitempty"`
+	TgLink string `json:"tg_link,omitempty"`
+}
+
+type ImportRowResult struct {
+	Row    int64  `json:"row"`
+	Email  string `json:"email"`
+	Status string `json:"status"`
+	UserID string `json:"user_id,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+type ImportReport struct {
+	Results []*ImportRowResult `json:"results"`
+	// LearningGroupError is set if users are created,
+	// but weren't added to the learning group
+	LearningGroupError string `json:"learning_group_error,omitempty"`
+}
+
+type AcceptInvite struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
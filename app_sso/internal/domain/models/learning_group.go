@@ -4,28 +4,36 @@ import (
 	"time"
 )
 
+// LearningGroup Version is incremented by every change of the group.
+// ParentID is empty for the root group of the tree.
 type LearningGroup struct {
 	ID          string      `json:"id" bson:"_id"`
 	Name        string      `json:"name" bson:"name"`
+	ParentID    string      `json:"parent_id" bson:"parent_id,omitempty"`
 	CreatedBy   string      `json:"created_by" bson:"created_by"`
 	ModifiedBy  string      `json:"modified_by" bson:"modified_by"`
 	Created     time.Time   `json:"created" bson:"created"`
 	Updated     time.Time   `json:"updated" bson:"updated"`
 	Learners    []GroupUser `json:"learners" bson:"learners"`
 	GroupAdmins []GroupUser `json:"group_admins" bson:"group_admins"`
+	Version     int64       `json:"version" bson:"version"`
 }
 
 type LearningGroupShort struct {
 	ID         string    `json:"id" bson:"_id"`
 	Name       string    `json:"name" bson:"name"`
+	ParentID   string    `json:"parent_id" bson:"parent_id,omitempty"`
 	CreatedBy  string    `json:"created_by" bson:"created_by"`
 	ModifiedBy string    `json:"modified_by" bson:"modified_by"`
 	Created    time.Time `json:"created" bson:"created"`
 	Updated    time.Time `json:"updated" bson:"updated"`
 }
 
+// CreateLearningGroup ParentID is optional, the group is created
+// in the subtree of the parent.
 type CreateLearningGroup struct {
 	Name        string   `json:"name" validate:"required,min=3,max=100"`
+	ParentID    string   `json:"parent_id,omitempty"`
 	CreatedBy   string   `json:"created_by" validate:"required"`
 	ModifiedBy  string   `json:"modified_by" validate:"required"`
 	GroupAdmins []string `json:"group_admins" validate:"required"`
@@ -55,6 +63,8 @@ type DelGroup struct {
 	LgId   string `json:"learning_group_id" validate:"required"`
 }
 
+// UpdateLearningGroup Version is the version of the group the update is based on.
+// Update of the changed group fails, zero version skips the check.
 type UpdateLearningGroup struct {
 	UserID      string   `json:"user_id" validate:"required"`
 	LgId        string   `json:"learning_group_id" validate:"required"`
@@ -62,6 +72,16 @@ type UpdateLearningGroup struct {
 	ModifiedBy  string   `json:"modified_by" validate:"required"`
 	GroupAdmins []string `json:"group_admins,omitempty"`
 	Learners    []string `json:"learners,omitempty"`
+	Version     int64    `json:"version,omitempty" validate:"gte=0"`
+}
+
+// ChangeLgMembers adds or removes users with the role without
+// touching other members of the group.
+type ChangeLgMembers struct {
+	UserID  string   `json:"user_id" validate:"required"`
+	LgId    string   `json:"learning_group_id" validate:"required"`
+	Role    string   `json:"role" validate:"required,oneof=learner group_admin"`
+	UserIDs []string `json:"user_ids" validate:"required,min=1,max=1000,dive,required"`
 }
 
 type GroupUser struct {
@@ -73,14 +93,17 @@ type GroupUser struct {
 type DBCreateLearningGroup struct {
 	ID          string    `bson:"_id" validate:"required"`
 	Name        string    `bson:"name" validate:"required,min=3,max=100"`
+	ParentID    string    `bson:"parent_id,omitempty"`
 	GroupAdmins []string  `bson:"group_admins" validate:"required"`
 	CreatedBy   string    `bson:"created_by" validate:"required"`
 	ModifiedBy  string    `bson:"modified_by" validate:"required"`
 	Created     time.Time `bson:"created" validate:"required"`
 	Updated     time.Time `bson:"updated" validate:"required"`
 	Learners    []string  `bson:"learners,omitempty"`
+	Version     int64     `bson:"version"`
 }
 
+// DBUpdateLearningGroup Version is expected version of the group, zero skips the check.
 type DBUpdateLearningGroup struct {
 	ID          string    `bson:"_id" validate:"required"`
 	Name        string    `bson:"name,omitempty" validate:"min=3,max=100"`
@@ -88,17 +111,28 @@ type DBUpdateLearningGroup struct {
 	Updated     time.Time `bson:"updated,omitempty" validate:"required"`
 	GroupAdmins []string  `bson:"group_admins" validate:"required"`
 	Learners    []string  `bson:"learners,omitempty"`
+	Version     int64     `bson:"version,omitempty"`
+}
+
+type DBChangeLgMembers struct {
+	LgID       string
+	Role       string
+	UserIDs    []string
+	ModifiedBy string
+	Updated    time.Time
 }
 
 type DBLearningGroup struct {
 	ID          string        `bson:"_id"`
 	Name        string        `bson:"name"`
+	ParentID    string        `bson:"parent_id,omitempty"`
 	CreatedBy   string        `bson:"created_by"`
 	ModifiedBy  string        `bson:"modified_by"`
 	Created     time.Time     `bson:"created"`
 	Updated     time.Time     `bson:"updated"`
 	Learners    []DBGroupUser `bson:"learners"`
 	GroupAdmins []DBGroupUser `bson:"group_admins"`
+	Version     int64         `bson:"version"`
 }
 
 type DBGroupUser struct {
@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// LgInvite is a code to join the learning group as a learner.
+// The code is valid until it expires or all uses are spent.
+type LgInvite struct {
+	Code      string    `json:"code" bson:"_id"`
+	LgID      string    `json:"learning_group_id" bson:"learning_group_id"`
+	CreatedBy string    `json:"created_by" bson:"created_by"`
+	MaxUses   int64     `json:"max_uses" bson:"max_uses"`
+	Uses      int64     `json:"uses" bson:"uses"`
+	ExpiresAt time.Time `json:"expires_at" bson:"expires_at"`
+	Created   time.Time `json:"created" bson:"created"`
+}
+
+// CreateLgInvite is a request of the group admin. ExpiresIn is in seconds.
+type CreateLgInvite struct {
+	UserID    string `json:"user_id" validate:"required"`
+	LgId      string `json:"learning_group_id" validate:"required"`
+	MaxUses   int64  `json:"max_uses" validate:"required,gte=1,lte=1000"`
+	ExpiresIn int64  `json:"expires_in" validate:"required,gte=60,lte=2592000"`
+}
+
+type ListLgInvites struct {
+	UserID string `json:"user_id" validate:"required"`
+	LgId   string `json:"learning_group_id" validate:"required"`
+}
+
+type RevokeLgInvite struct {
+	UserID string `json:"user_id" validate:"required"`
+	LgId   string `json:"learning_group_id" validate:"required"`
+	Code   string `json:"code" validate:"required"`
+}
+
+type RedeemLgInvite struct {
+	UserID string `json:"user_id" validate:"required"`
+	Code   string `json:"code" validate:"required"`
+}
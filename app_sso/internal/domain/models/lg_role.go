@@ -0,0 +1,140 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// Permissions which are granted by roles of the learning group. They apply
+// to channels shared with the group, the gateway checks them.
+const (
+	PermChannelCreate  = "channel.create"
+	PermChannelView    = "channel.view"
+	PermChannelEdit    = "channel.edit"
+	PermChannelDelete  = "channel.delete"
+	PermChannelShare   = "channel.share"
+	PermPlanView       = "plan.view"
+	PermPlanEdit       = "plan.edit"
+	PermPlanDelete     = "plan.delete"
+	PermPlanShare      = "plan.share"
+	PermLessonView     = "lesson.view"
+	PermLessonEdit     = "lesson.edit"
+	PermAttemptCreate  = "attempt.create"
+	PermAttemptViewAll = "attempt.view_all"
+)
+
+// AllPermissions is the catalog of permissions, group admins have all of them.
+var AllPermissions = []string{
+	PermChannelCreate,
+	PermChannelView,
+	PermChannelEdit,
+	PermChannelDelete,
+	PermChannelShare,
+	PermPlanView,
+	PermPlanEdit,
+	PermPlanDelete,
+	PermPlanShare,
+	PermLessonView,
+	PermLessonEdit,
+	PermAttemptCreate,
+	PermAttemptViewAll,
+}
+
+// LearnerPermissions are permissions of learners of the group.
+var LearnerPermissions = []string{
+	PermChannelView,
+	PermPlanView,
+	PermLessonView,
+	PermAttemptCreate,
+}
+
+// BuiltinRolePermissions returns permissions of the built-in group role.
+func BuiltinRolePermissions(role string) []string {
+	switch role {
+	case GroupRoleGroupAdmin:
+		return AllPermissions
+	case GroupRoleLearner:
+		return LearnerPermissions
+	default:
+		return nil
+	}
+}
+
+// LgRole is a named set of permissions in the learning group.
+// The permissions are granted to the members of the role.
+type LgRole struct {
+	ID          string    `json:"id" bson:"_id"`
+	LgID        string    `json:"learning_group_id" bson:"learning_group_id"`
+	Name        string    `json:"name" bson:"name"`
+	Permissions []string  `json:"permissions" bson:"permissions"`
+	Members     []string  `json:"members" bson:"members"`
+	CreatedBy   string    `json:"created_by" bson:"created_by"`
+	Created     time.Time `json:"created" bson:"created"`
+	Updated     time.Time `json:"updated" bson:"updated"`
+}
+
+// LgPermissions are permissions of the user in the learning group
+// granted by built-in and custom roles.
+type LgPermissions struct {
+	LgID        string   `json:"learning_group_id"`
+	Permissions []string `json:"permissions"`
+}
+
+// AddPermissions adds permissions which the set doesn't have yet.
+func (p *LgPermissions) AddPermissions(perms []string) {
+	for _, perm := range perms {
+		if !slices.Contains(p.Permissions, perm) {
+			p.Permissions = append(p.Permissions, perm)
+		}
+	}
+}
+
+type CreateLgRole struct {
+	UserID      string   `json:"user_id" validate:"required"`
+	LgId        string   `json:"learning_group_id" validate:"required"`
+	Name        string   `json:"name" validate:"required,min=3,max=50,ne=learner,ne=group_admin"`
+	Permissions []string `json:"permissions" validate:"required,min=1,max=20,dive,oneof=channel.create channel.view channel.edit channel.delete channel.share plan.view plan.edit plan.delete plan.share lesson.view lesson.edit attempt.create attempt.view_all"`
+}
+
+type ListLgRoles struct {
+	UserID string `json:"user_id" validate:"required"`
+	LgId   string `json:"learning_group_id" validate:"required"`
+}
+
+// UpdateLgRole replaces name and permissions of the role.
+type UpdateLgRole struct {
+	UserID      string   `json:"user_id" validate:"required"`
+	LgId        string   `json:"learning_group_id" validate:"required"`
+	RoleID      string   `json:"role_id" validate:"required"`
+	Name        string   `json:"name" validate:"required,min=3,max=50,ne=learner,ne=group_admin"`
+	Permissions []string `json:"permissions" validate:"required,min=1,max=20,dive,oneof=channel.create channel.view channel.edit channel.delete channel.share plan.view plan.edit plan.delete plan.share lesson.view lesson.edit attempt.create attempt.view_all"`
+}
+
+type DBUpdateLgRole struct {
+	ID          string
+	LgID        string
+	Name        string
+	Permissions []string
+	Updated     time.Time
+}
+
+type DeleteLgRole struct {
+	UserID string `json:"user_id" validate:"required"`
+	LgId   string `json:"learning_group_id" validate:"required"`
+	RoleID string `json:"role_id" validate:"required"`
+}
+
+// ChangeLgRoleMembers assigns the role to the users or takes it away.
+type ChangeLgRoleMembers struct {
+	UserID  string   `json:"user_id" validate:"required"`
+	LgId    string   `json:"learning_group_id" validate:"required"`
+	RoleID  string   `json:"role_id" validate:"required"`
+	UserIDs []string `json:"user_ids" validate:"required,min=1,max=1000,dive,required"`
+}
+
+type DBChangeLgRoleMembers struct {
+	ID      string
+	LgID    string
+	UserIDs []string
+	Updated time.Time
+}
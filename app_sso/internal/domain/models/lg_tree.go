@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// GetLgTree is a request of the subtree of the learning group.
+type GetLgTree struct {
+	UserID string `json:"user_id" validate:"required"`
+	LgId   string `json:"learning_group_id" validate:"required"`
+}
+
+// LgTreeNode is the learning group with its child groups.
+type LgTreeNode struct {
+	ID       string        `json:"id"`
+	Name     string        `json:"name"`
+	ParentID string        `json:"parent_id"`
+	Children []*LgTreeNode `json:"children"`
+}
+
+// MoveLearningGroup moves the group with its subtree under the parent,
+// empty ParentID makes the group a root one. Version is the version
+// of the moved group, zero skips the check.
+type MoveLearningGroup struct {
+	UserID   string `json:"user_id" validate:"required"`
+	LgId     string `json:"learning_group_id" validate:"required"`
+	ParentID string `json:"parent_id,omitempty"`
+	Version  int64  `json:"version,omitempty" validate:"gte=0"`
+}
+
+// DBMoveLearningGroup Version is expected version of the group, zero skips the check.
+type DBMoveLearningGroup struct {
+	ID         string
+	ParentID   string
+	ModifiedBy string
+	Updated    time.Time
+	Version    int64
+}
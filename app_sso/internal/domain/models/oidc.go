@@ -0,0 +1,89 @@
+package models
+
+import "time"
+
+// RelyingParty is an OIDC client which logs users in with sso.
+// SecretHash is empty for public clients, they are authenticated by PKCE only.
+type RelyingParty struct {
+	ID           string    `json:"id" bson:"_id"`
+	Name         string    `json:"name" bson:"name"`
+	SecretHash   []byte    `json:"-" bson:"secret_hash,omitempty"`
+	RedirectURIs []string  `json:"redirect_uris" bson:"redirect_uris"`
+	CreatedBy    string    `json:"created_by" bson:"created_by"`
+	Created      time.Time `json:"created" bson:"created"`
+}
+
+type CreateRelyingParty struct {
+	UserID       string   `json:"user_id" validate:"required"`
+	Name         string   `json:"name" validate:"required,min=3,max=100"`
+	RedirectURIs []string `json:"redirect_uris" validate:"required,min=1,dive,url"`
+	Public       bool     `json:"public"`
+}
+
+type DBCreateRelyingParty struct {
+	ID           string    `json:"id" bson:"_id"`
+	Name         string    `json:"name" bson:"name"`
+	SecretHash   []byte    `json:"-" bson:"secret_hash,omitempty"`
+	RedirectURIs []string  `json:"redirect_uris" bson:"redirect_uris"`
+	CreatedBy    string    `json:"created_by" bson:"created_by"`
+	Created      time.Time `json:"created" bson:"created"`
+}
+
+// RelyingPartyCredentials are returned once on registration.
+// ClientSecret is empty for public clients.
+type RelyingPartyCredentials struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+// OIDCAuthorize is authorization request of the code flow with PKCE.
+type OIDCAuthorize struct {
+	ClientID            string `json:"client_id" validate:"required"`
+	RedirectURI         string `json:"redirect_uri" validate:"required"`
+	ResponseType        string `json:"response_type"`
+	Scope               string `json:"scope"`
+	State               string `json:"state"`
+	Nonce               string `json:"nonce"`
+	CodeChallenge       string `json:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method"`
+}
+
+// OIDCLogin is authorization request submitted with credentials of the user.
+type OIDCLogin struct {
+	Authorize   OIDCAuthorize
+	Credentials AuthenticateUser
+}
+
+type OIDCAuthCode struct {
+	ClientID      string    `json:"client_id"`
+	RedirectURI   string    `json:"redirect_uri"`
+	UserID        string    `json:"user_id"`
+	Scopes        []string  `json:"scopes"`
+	Nonce         string    `json:"nonce"`
+	CodeChallenge string    `json:"code_challenge"`
+	AuthTime      time.Time `json:"auth_time"`
+}
+
+type OIDCTokenRequest struct {
+	GrantType    string `json:"grant_type"`
+	Code         string `json:"code" validate:"required"`
+	RedirectURI  string `json:"redirect_uri" validate:"required"`
+	ClientID     string `json:"client_id" validate:"required"`
+	ClientSecret string `json:"client_secret"`
+	CodeVerifier string `json:"code_verifier" validate:"required"`
+}
+
+type OIDCTokens struct {
+	AccessToken string        `json:"access_token"`
+	IDToken     string        `json:"id_token"`
+	ExpiresIn   time.Duration `json:"expires_in"`
+	Scopes      []string      `json:"scopes"`
+}
+
+// OIDCUserInfo has standard claims, which are set depends on granted scopes.
+type OIDCUserInfo struct {
+	Sub           string `json:"sub"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+	Name          string `json:"name,omitempty"`
+}
@@ -7,14 +7,50 @@ import (
 )
 
 type RefreshToken struct {
-	Token  string             `json:"token" bson:"token"`
-	UserID primitive.ObjectID `json:"user_id" bson:"user_id"`
+	Token     string    `json:"token" bson:"token"`
+	UserID    string    `json:"user_id" bson:"user_id"`
+	FamilyID  string    `json:"family_id" bson:"family_id"`
+	Rotated   bool      `json:"rotated" bson:"rotated"`
+	Revoked   bool      `json:"revoked" bson:"revoked"`
+	ExpiresAt time.Time `json:"expires_at" bson:"expires_at"`
+	Created   time.Time `json:"created" bson:"created"`
+	// Session metadata, see Session
+	SessionCreated time.Time `json:"session_created" bson:"session_created"`
+	UserAgent      string    `json:"user_agent" bson:"user_agent"`
+	IP             string    `json:"ip" bson:"ip"`
+	// AccessJTI is jti of the access token issued together with the refresh token
+	AccessJTI string `json:"access_jti" bson:"access_jti"`
 }
 
 type CreateRefreshToken struct {
-	UserID    string    `json:"user_id" bson:"user_id" validate:"required"`
-	Token     string    `json:"token" bson:"token" validate:"required"`
-	ExpiresAt time.Time `json:"expires_at" bson:"expires_at" validate:"required"`
+	UserID         string    `json:"user_id" bson:"user_id" validate:"required"`
+	Token          string    `json:"token" bson:"token" validate:"required"`
+	FamilyID       string    `json:"family_id" bson:"family_id" validate:"required"`
+	Rotated        bool      `json:"rotated" bson:"rotated"`
+	Revoked        bool      `json:"revoked" bson:"revoked"`
+	ExpiresAt      time.Time `json:"expires_at" bson:"expires_at" validate:"required"`
+	Created        time.Time `json:"created" bson:"created"`
+	SessionCreated time.Time `json:"session_created" bson:"session_created"`
+	UserAgent      string    `json:"user_agent" bson:"user_agent"`
+	IP             string    `json:"ip" bson:"ip"`
+	AccessJTI      string    `json:"access_jti" bson:"access_jti"`
+}
+
+// Session is a chain of refresh tokens (token family) started by a single login.
+// Sessions are read from the active refresh token of the family:
+// its creation time is the last time the session was used.
+type Session struct {
+	ID        string    `json:"id"`
+	UserAgent string    `json:"user_agent"`
+	IP        string    `json:"ip"`
+	Created   time.Time `json:"created"`
+	LastUsed  time.Time `json:"last_used"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type RevokeSession struct {
+	UserID    string `json:"user_id" validate:"required"`
+	SessionID string `json:"session_id" validate:"required"`
 }
 
 type DBCreateRefreshToken struct {
@@ -26,9 +62,20 @@ type DBCreateRefreshToken struct {
 type LogInTokens struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
+	// MFAToken is set instead of the tokens above when the user has 2FA enabled.
+	MFAToken string `json:"mfa_token,omitempty"`
 }
 
 type AuthCheck struct {
-	IsValid bool   `json:"is_valid"`
-	UserId  string `json:"user_id"`
+	IsValid  bool     `json:"is_valid"`
+	UserId   string   `json:"user_id"`
+	ClientID string   `json:"client_id"`
+	Scopes   []string `json:"scopes"`
+	// ActorID is the admin who impersonates the user
+	ActorID string `json:"actor_id,omitempty"`
+}
+
+type Logout struct {
+	AccessToken  string `json:"access_token" validate:"required"`
+	RefreshToken string `json:"refresh_token,omitempty"`
 }
@@ -0,0 +1,114 @@
+package models
+
+import "time"
+
+// Requests of SCIM provisioning. They are sent by the gateway on behalf
+// of service clients, ClientID is the actor of the change.
+//
+// SCIM users are platform users, userName is the email. SCIM groups are
+// learning groups, members are learners of the group, group admins are
+// managed in the platform only.
+
+// ScimListUsers is a page request of users. Email is exact match, Active
+// is "true" or "false" if the filter is set. StartIndex is one-based.
+type ScimListUsers struct {
+	ClientID   string `json:"client_id" validate:"required"`
+	Email      string `json:"email,omitempty"`
+	Active     string `json:"active,omitempty" validate:"omitempty,oneof=true false"`
+	StartIndex int64  `json:"start_index,omitempty" validate:"gte=0"`
+	Count      int64  `json:"count,omitempty" validate:"gte=0"`
+}
+
+type ScimUsersFilter struct {
+	Email       string
+	Deactivated *bool
+	Offset      int64
+	Limit       int64
+}
+
+type ScimUsersPage struct {
+	Users []*User `json:"users"`
+	Total int64   `json:"total"`
+}
+
+// ScimCreateUser creates the user without password. Active user gets
+// invite to set the password, inactive user is created deactivated.
+type ScimCreateUser struct {
+	ClientID string `json:"client_id" validate:"required"`
+	Email    string `json:"email" validate:"required,email"`
+	Name     string `json:"name,omitempty" validate:"max=100"`
+	Active   bool   `json:"active"`
+}
+
+// ScimUpdateUser replaces email, name and active state of the user.
+// Empty name keeps the current one.
+type ScimUpdateUser struct {
+	ClientID string `json:"client_id" validate:"required"`
+	UserID   string `json:"user_id" validate:"required"`
+	Email    string `json:"email" validate:"required,email"`
+	Name     string `json:"name,omitempty" validate:"max=100"`
+	Active   bool   `json:"active"`
+}
+
+type ScimManageUser struct {
+	ClientID string `json:"client_id" validate:"required"`
+	UserID   string `json:"user_id" validate:"required"`
+}
+
+// ScimGroup is a learning group with ids of its learners.
+type ScimGroup struct {
+	ID      string    `json:"id" bson:"_id"`
+	Name    string    `json:"name" bson:"name"`
+	Members []string  `json:"members" bson:"learners"`
+	Created time.Time `json:"created" bson:"created"`
+	Updated time.Time `json:"updated" bson:"updated"`
+	Version int64     `json:"version" bson:"version"`
+}
+
+// ScimListGroups is a page request of learning groups. Name is exact match,
+// StartIndex is one-based.
+type ScimListGroups struct {
+	ClientID   string `json:"client_id" validate:"required"`
+	Name       string `json:"name,omitempty"`
+	StartIndex int64  `json:"start_index,omitempty" validate:"gte=0"`
+	Count      int64  `json:"count,omitempty" validate:"gte=0"`
+}
+
+type ScimGroupsFilter struct {
+	ID     string
+	Name   string
+	Offset int64
+	Limit  int64
+}
+
+type ScimGroupsPage struct {
+	Groups []*ScimGroup `json:"groups"`
+	Total  int64        `json:"total"`
+}
+
+type ScimCreateGroup struct {
+	ClientID string   `json:"client_id" validate:"required"`
+	Name     string   `json:"name" validate:"required,min=3,max=100"`
+	Members  []string `json:"members" validate:"max=1000,dive,required"`
+}
+
+// ScimUpdateGroup replaces name and members of the learning group.
+type ScimUpdateGroup struct {
+	ClientID string   `json:"client_id" validate:"required"`
+	LgID     string   `json:"learning_group_id" validate:"required"`
+	Name     string   `json:"name" validate:"required,min=3,max=100"`
+	Members  []string `json:"members" validate:"max=1000,dive,required"`
+}
+
+// ScimChangeGroupMembers adds and removes learners without touching other members.
+type ScimChangeGroupMembers struct {
+	ClientID string   `json:"client_id" validate:"required"`
+	LgID     string   `json:"learning_group_id" validate:"required"`
+	Add      []string `json:"add,omitempty" validate:"max=1000,dive,required"`
+	Remove   []string `json:"remove,omitempty" validate:"max=1000,dive,required"`
+}
+
+type ScimManageGroup struct {
+	ClientID string `json:"client_id" validate:"required"`
+	LgID     string `json:"learning_group_id" validate:"required"`
+}
@@ -1,5 +1,21 @@
 package models
 
+import "time"
+
+// Actions of the telegram bot with the chat of the user.
+const (
+	TgChatActionLink   = "link"
+	TgChatActionUnlink = "unlink"
+)
+
+// Statuses of TgChatResult.
+const (
+	TgChatStatusDone         = "done"
+	TgChatStatusInvalidToken = "invalid_token"
+	TgChatStatusNotLinked    = "not_linked"
+	TgChatStatusFailed       = "failed"
+)
+
 type LogInViaTg struct {
 	Email string `json:"email" validate:"required"`
 }
@@ -9,7 +25,27 @@ type LoginUserOTP struct {
 	Code  string `json:"code" validate:"required"`
 }
 
+// UserTg is a message of the telegram bot. Link action binds the chat
+// to the user who got the one-time Token, unlink action unbinds the chat.
+// Empty Action is link.
 type UserTg struct {
 	TgLink string `json:"tg_link"`
 	ChatID string `json:"chat_id"`
+	Token  string `json:"token"`
+	Action string `json:"action"`
+}
+
+// TgChatResult is sent back to the telegram bot once UserTg message
+// is handled, so the bot can tell the user if the chat was linked.
+type TgChatResult struct {
+	ChatID string `json:"chat_id"`
+	Action string `json:"action"`
+	Status string `json:"status"`
+}
+
+// TgLinkToken is a one-time token of the bot deep link, it binds
+// the telegram chat which starts the bot with it to the user.
+type TgLinkToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
 }
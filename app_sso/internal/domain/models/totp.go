@@ -0,0 +1,32 @@
+package models
+
+// UserTOTP is authenticator app 2FA state of the user.
+// Secret is set on enrollment, but 2FA is enabled only after
+// the first code is verified. LastCounter is the time step of the last
+// accepted code, older or equal steps are rejected as replays.
+type UserTOTP struct {
+	Secret        string   `bson:"secret,omitempty"`
+	Enabled       bool     `bson:"enabled"`
+	RecoveryCodes [][]byte `bson:"recovery_codes,omitempty"`
+	LastCounter   int64    `bson:"last_counter,omitempty"`
+}
+
+type TOTPEnrollment struct {
+	URI    string `json:"uri"`
+	Secret string `json:"secret"`
+}
+
+type VerifyTOTP struct {
+	UserID string `json:"user_id" validate:"required"`
+	Code   string `json:"code" validate:"required"`
+}
+
+type DisableTOTP struct {
+	UserID string `json:"user_id" validate:"required"`
+	Code   string `json:"code" validate:"required"`
+}
+
+type LoginMFA struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	Code     string `json:"code" validate:"required"`
+}
@@ -10,6 +10,13 @@ const (
 	UserRoleGroupAdmin = "group_admin"
 )
 
+const (
+	UserStatusPendingVerification = "pending_verification"
+	UserStatusActive              = "active"
+	// UserStatusDeactivated is set by platform admins, the user can't log in
+	UserStatusDeactivated = "deactivated"
+)
+
 type User struct {
 	ID       string    `json:"id" bson:"_id,omitempty"`
 	Email    string    `json:"email" bson:"email"`
@@ -17,6 +24,9 @@ type User struct {
 	Name     string    `json:"name" bson:"name"`
 	IsAdmin  bool      `json:"is_admin" bson:"is_admin"`
 	TgLink   string    `json:"tg_link" bson:"tg_link"`
+	ChatID   string    `json:"chat_id" bson:"chat_id"`
+	Status   string    `json:"status" bson:"status"`
+	TOTP     UserTOTP  `json:"-" bson:"totp"`
 	Created  time.Time `json:"created" bson:"created"`
 	Updated  time.Time `json:"updated" bson:"updated"`
 }
@@ -28,6 +38,9 @@ type DBUser struct {
 	Name     string    `bson:"name"`
 	IsAdmin  bool      `bson:"is_admin"`
 	TgLink   string    `bson:"tg_link"`
+	ChatID   string    `bson:"chat_id"`
+	Status   string    `bson:"status"`
+	TOTP     UserTOTP  `bson:"totp"`
 	Created  time.Time `bson:"created"`
 	Updated  time.Time `bson:"updated"`
 }
@@ -45,11 +58,34 @@ type CreateUser struct {
 }
 
 type UpdateUserInfo struct {
-	ID      string `json:"id" validate:"required"`
-	Email   string `json:"email,omitempty"`
-	Name    string `json:"name,omitempty"`
-	TgLink  string `json:"tg_link,omitempty"`
-	IsAdmin bool   `json:"is_admin,omitempty"`
+	ID     string `json:"id" validate:"required"`
+	Email  string `json:"email,omitempty"`
+	Name   string `json:"name,omitempty"`
+	TgLink string `json:"tg_link,omitempty"`
+}
+
+type AuthenticateUser struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+	Code     string `json:"code,omitempty"`
+}
+
+type RequestPasswordReset struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ConfirmPasswordReset struct {
+	Email       string `json:"email" validate:"required,email"`
+	Code        string `json:"code" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required"`
+}
+
+type VerifyEmail struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type ResendVerification struct {
+	Email string `json:"email" validate:"required,email"`
 }
 
 type DBCreateUser struct {
@@ -57,7 +93,9 @@ type DBCreateUser struct {
 	Email    string    `bson:"email" validate:"required,email"`
 	PassHash []byte    `bson:"pass_hash" validate:"required"`
 	Name     string    `bson:"name,omitempty"`
+	TgLink   string    `bson:"tg_link,omitempty"`
 	IsAdmin  bool      `bson:"is_admin"`
+	Status   string    `bson:"status" validate:"required"`
 	Created  time.Time `bson:"created" validate:"required"`
 	Updated  time.Time `bson:"updated" validate:"required"`
 }
@@ -66,12 +104,20 @@ type DBUpdateUserInfo struct {
 	ID      string    `bson:"_id,omitempty" validate:"required"`
 	Email   string    `bson:"email,omitempty"`
 	Name    string    `bson:"name,omitempty"`
-	IsAdmin *bool     `bson:"is_admin"`
 	TgLink  string    `bson:"tg_link,omitempty"`
 	ChatID  string    `bson:"chat_id,omitempty"`
 	Updated time.Time `bson:"updated,omitempty"`
 }
 
+type UserInfo struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	TgLink   string `json:"tg_link"`
+	TgLinked bool   `json:"tg_linked"`
+	IsAdmin  bool   `json:"is_admin"`
+}
+
 type UserRoles struct {
 	IsAdmin bool `json:"is_admin" bson:"is_admin"`
 }
@@ -86,3 +132,39 @@ type UserNotification struct {
 	TgLink string `bson:"tg_link"`
 	ChatID string `bson:"chat_id"`
 }
+
+// ListUsers is a page request of the admin user directory.
+// Query is searched in email, name and tg_link. Cursor is ID of the last
+// user of the previous page.
+type ListUsers struct {
+	AdminID string `json:"admin_id" validate:"required"`
+	Query   string `json:"query,omitempty"`
+	Status  string `json:"status,omitempty" validate:"omitempty,oneof=pending_verification active deactivated"`
+	Cursor  string `json:"cursor,omitempty"`
+	Limit   int64  `json:"limit,omitempty" validate:"gte=0,lte=100"`
+}
+
+type UsersFilter struct {
+	Query   string
+	Status  string
+	AfterID string
+	Limit   int64
+}
+
+type UsersPage struct {
+	Users []*User `json:"users"`
+	// NextCursor is empty on the last page
+	NextCursor string `json:"next_cursor"`
+}
+
+type SetUserAdmin struct {
+	AdminID string `json:"admin_id" validate:"required"`
+	UserID  string `json:"user_id" validate:"required"`
+	IsAdmin bool   `json:"is_admin"`
+}
+
+// ManageUser is an action of the platform admin on the user account.
+type ManageUser struct {
+	AdminID string `json:"admin_id" validate:"required"`
+	UserID  string `json:"user_id" validate:"required"`
+}
@@ -15,12 +15,27 @@ type AuthHandlers interface {
 		password string,
 	) (*models.LogInTokens, error)
 	RegisterUser(ctx context.Context, user models.CreateUser) error
-	RefreshToken(ctx context.Context, refreshToken string) (string, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*models.LogInTokens, error)
+	Logout(ctx context.Context, logout *models.Logout) error
+	GetUserInfo(ctx context.Context, userID string) (*models.UserInfo, error)
 	IsAdmin(ctx context.Context, userID string) (bool, error)
 	AuthCheck(ctx context.Context, accessToken string) (*models.AuthCheck, error)
 	LogInViaTg(ctx context.Context, login *models.LogInViaTg) error
 	CheckOTP(ctx context.Context, checkOTP *models.LoginUserOTP) (*models.LogInTokens, error)
 	UpdateUserInfo(ctx context.Context, userInfo *models.UpdateUserInfo) error
+	VerifyEmail(ctx context.Context, verify *models.VerifyEmail) error
+	ResendVerification(ctx context.Context, resend *models.ResendVerification) error
+	LoginMFA(ctx context.Context, login *models.LoginMFA) (*models.LogInTokens, error)
+	EnrollTOTP(ctx context.Context, userID string) (*models.TOTPEnrollment, error)
+	VerifyTOTP(ctx context.Context, verify *models.VerifyTOTP) ([]string, error)
+	DisableTOTP(ctx context.Context, disable *models.DisableTOTP) error
+	RequestPasswordReset(ctx context.Context, reset *models.RequestPasswordReset) error
+	ConfirmPasswordReset(ctx context.Context, reset *models.ConfirmPasswordReset) error
+	ListSessions(ctx context.Context, userID string) ([]*models.Session, error)
+	RevokeSession(ctx context.Context, revoke *models.RevokeSession) error
+	AcceptInvite(ctx context.Context, accept *models.AcceptInvite) error
+	IssueTgLinkToken(ctx context.Context, userID string) (*models.TgLinkToken, error)
+	UnlinkTelegram(ctx context.Context, userID string) error
 }
 
 type LGHAndlers interface {
@@ -33,15 +48,83 @@ type LGHAndlers interface {
 	UserIsGroupAdminIn(ctx context.Context, user *models.UserIsGroupAdminIn) ([]string, error)
 	UserIsLearnerIn(ctx context.Context, user *models.UserIsLearnerIn) ([]string, error)
 	GetLearners(ctx context.Context, lgID *models.GetLearners) ([]string, error)
+	GetLearningGroupTree(ctx context.Context, get *models.GetLgTree) (*models.LgTreeNode, error)
+	MoveLearningGroup(ctx context.Context, move *models.MoveLearningGroup) error
+	CreateLgInvite(ctx context.Context, create *models.CreateLgInvite) (*models.LgInvite, error)
+	ListLgInvites(ctx context.Context, list *models.ListLgInvites) ([]*models.LgInvite, error)
+	RevokeLgInvite(ctx context.Context, revoke *models.RevokeLgInvite) error
+	RedeemLgInvite(ctx context.Context, redeem *models.RedeemLgInvite) (string, error)
+	AddMembers(ctx context.Context, change *models.ChangeLgMembers) error
+	RemoveMembers(ctx context.Context, change *models.ChangeLgMembers) error
+	CreateLgRole(ctx context.Context, create *models.CreateLgRole) (*models.LgRole, error)
+	ListLgRoles(ctx context.Context, list *models.ListLgRoles) ([]*models.LgRole, error)
+	UpdateLgRole(ctx context.Context, upd *models.UpdateLgRole) error
+	DeleteLgRole(ctx context.Context, del *models.DeleteLgRole) error
+	AssignLgRole(ctx context.Context, change *models.ChangeLgRoleMembers) error
+	UnassignLgRole(ctx context.Context, change *models.ChangeLgRoleMembers) error
+	GetUserPermissions(ctx context.Context, userID string) ([]*models.LgPermissions, error)
+	ScimListGroups(ctx context.Context, list *models.ScimListGroups) (*models.ScimGroupsPage, error)
+	ScimGetGroup(ctx context.Context, get *models.ScimManageGroup) (*models.ScimGroup, error)
+	ScimCreateGroup(ctx context.Context, create *models.ScimCreateGroup) (*models.ScimGroup, error)
+	ScimUpdateGroup(ctx context.Context, upd *models.ScimUpdateGroup) (*models.ScimGroup, error)
+	ScimChangeGroupMembers(ctx context.Context, change *models.ScimChangeGroupMembers) error
+	ScimDeleteGroup(ctx context.Context, del *models.ScimManageGroup) error
+}
+
+type AppHandlers interface {
+	RegisterApp(ctx context.Context, app *models.CreateApp) (*models.AppCredentials, error)
+	IssueClientToken(ctx context.Context, creds *models.ClientCredentials) (*models.ClientToken, error)
+}
+
+type OIDCHandlers interface {
+	RegisterRelyingParty(ctx context.Context, rp *models.CreateRelyingParty) (*models.RelyingPartyCredentials, error)
+}
+
+type UserHandlers interface {
+	ListUsers(ctx context.Context, list *models.ListUsers) (*models.UsersPage, error)
+	SetUserAdmin(ctx context.Context, set *models.SetUserAdmin) error
+	DeactivateUser(ctx context.Context, manage *models.ManageUser) error
+	ReactivateUser(ctx context.Context, manage *models.ManageUser) error
+	ImportUsers(ctx context.Context, imp *models.ImportUsers) (*models.ImportReport, error)
+	ImpersonateUser(ctx context.Context, imp *models.ImpersonateUser) (*models.ImpersonationToken, error)
+	ScimListUsers(ctx context.Context, list *models.ScimListUsers) (*models.ScimUsersPage, error)
+	ScimGetUser(ctx context.Context, get *models.ScimManageUser) (*models.User, error)
+	ScimCreateUser(ctx context.Context, create *models.ScimCreateUser) (*models.User, error)
+	ScimUpdateUser(ctx context.Context, upd *models.ScimUpdateUser) (*models.User, error)
+	ScimDeleteUser(ctx context.Context, del *models.ScimManageUser) error
+}
+
+type GDPRHandlers interface {
+	RequestExport(ctx context.Context, req *models.GDPRRequest) (string, error)
+	RequestErasure(ctx context.Context, req *models.GDPRRequest) (string, error)
+	GetJob(ctx context.Context, get *models.GetGDPRJob) (*models.GDPRJob, error)
+}
+
+type AuditHandlers interface {
+	ListAuditEvents(ctx context.Context, list *models.ListAuditEvents) (*models.AuditEventsPage, error)
 }
 
 type serverAPI struct {
-	auth AuthHandlers
-	lgh  LGHAndlers
+	auth  AuthHandlers
+	lgh   LGHAndlers
+	apps  AppHandlers
+	oidc  OIDCHandlers
+	users UserHandlers
+	gdpr  GDPRHandlers
+	audit AuditHandlers
 
 	ssov1.UnimplementedSsoServer
 }
 
-func RegisterSsoServiceServer(gRPC *grpc.Server, auth AuthHandlers, lgh LGHAndlers) {
-	ssov1.RegisterSsoServer(gRPC, &serverAPI{auth: auth, lgh: lgh})
+func RegisterSsoServiceServer(
+	gRPC *grpc.Server,
+	auth AuthHandlers,
+	lgh LGHAndlers,
+	apps AppHandlers,
+	oidc OIDCHandlers,
+	users UserHandlers,
+	gdpr GDPRHandlers,
+	audit AuditHandlers,
+) {
+	ssov1.RegisterSsoServer(gRPC, &serverAPI{auth: auth, lgh: lgh, apps: apps, oidc: oidc, users: users, gdpr: gdpr, audit: audit})
 }
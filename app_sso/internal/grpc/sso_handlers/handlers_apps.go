@@ -0,0 +1,70 @@
+package ssohandlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/apps"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) RegisterApp(ctx context.Context, req *ssov1.RegisterAppRequest) (*ssov1.RegisterAppResponse, error) {
+	app := &models.CreateApp{
+		UserID: req.GetUserId(),
+		Name:   req.GetName(),
+		Scopes: req.GetScopes(),
+	}
+
+	creds, err := s.apps.RegisterApp(ctx, app)
+	if err != nil {
+		switch {
+		case errors.Is(err, apps.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, apps.ErrInvalidScope):
+			return nil, status.Error(codes.InvalidArgument, "invalid scope")
+		case errors.Is(err, apps.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, apps.ErrPermissionDenied):
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		case errors.Is(err, apps.ErrAppExists):
+			return nil, status.Error(codes.AlreadyExists, "app already exists")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.RegisterAppResponse{
+		ClientId:     creds.ClientID,
+		ClientSecret: creds.ClientSecret,
+	}, nil
+}
+
+func (s *serverAPI) IssueClientToken(ctx context.Context, req *ssov1.IssueClientTokenRequest) (*ssov1.IssueClientTokenResponse, error) {
+	creds := &models.ClientCredentials{
+		ClientID:     req.GetClientId(),
+		ClientSecret: req.GetClientSecret(),
+		Scopes:       req.GetScopes(),
+	}
+
+	token, err := s.apps.IssueClientToken(ctx, creds)
+	if err != nil {
+		switch {
+		case errors.Is(err, apps.ErrInvalidClient):
+			return nil, status.Error(codes.Unauthenticated, "invalid client")
+		case errors.Is(err, apps.ErrInvalidScope):
+			return nil, status.Error(codes.InvalidArgument, "invalid scope")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.IssueClientTokenResponse{
+		AccessToken: token.AccessToken,
+		ExpiresIn:   int64(token.ExpiresIn.Seconds()),
+		Scope:       strings.Join(token.Scopes, " "),
+	}, nil
+}
@@ -0,0 +1,73 @@
+package ssohandlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/audit"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) ListAuditEvents(ctx context.Context, req *ssov1.ListAuditEventsRequest) (*ssov1.ListAuditEventsResponse, error) {
+	list := &models.ListAuditEvents{
+		AdminID:   req.GetAdminId(),
+		UserID:    req.GetUserId(),
+		EventType: req.GetEventType(),
+		Cursor:    req.GetCursor(),
+		Limit:     int64(req.GetLimit()),
+	}
+
+	var err error
+	if req.GetFrom() != "" {
+		list.From, err = time.Parse(time.RFC3339, req.GetFrom())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid from time")
+		}
+	}
+	if req.GetTo() != "" {
+		list.To, err = time.Parse(time.RFC3339, req.GetTo())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid to time")
+		}
+	}
+
+	page, err := s.audit.ListAuditEvents(ctx, list)
+	if err != nil {
+		switch {
+		case errors.Is(err, audit.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, audit.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, audit.ErrPermissionDenied):
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	var respEvents []*ssov1.AuditEvent
+	for _, event := range page.Events {
+		respEvents = append(respEvents, &ssov1.AuditEvent{
+			Id:        event.ID,
+			Type:      event.Type,
+			UserId:    event.UserID,
+			ActorId:   event.ActorID,
+			Email:     event.Email,
+			Method:    event.Method,
+			TargetId:  event.TargetID,
+			Details:   event.Details,
+			Ip:        event.IP,
+			UserAgent: event.UserAgent,
+			Created:   event.Created.Format(time.RFC3339),
+		})
+	}
+
+	return &ssov1.ListAuditEventsResponse{
+		Events:     respEvents,
+		NextCursor: page.NextCursor,
+	}, nil
+}
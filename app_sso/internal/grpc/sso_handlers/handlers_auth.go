@@ -25,6 +25,12 @@ func (s *serverAPI) LoginUser(ctx context.Context, req *ssov1.LoginUserRequest)
 			return nil, status.Error(codes.Unauthenticated, "invalid email or password")
 		case errors.Is(err, auth.ErrUserNotFound):
 			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, auth.ErrEmailNotVerified):
+			return nil, status.Error(codes.FailedPrecondition, "email is not verified")
+		case errors.Is(err, auth.ErrUserDeactivated):
+			return nil, status.Error(codes.PermissionDenied, "user is deactivated")
+		case errors.Is(err, auth.ErrTooManyAttempts):
+			return nil, status.Error(codes.ResourceExhausted, "too many failed attempts, try again later")
 		}
 
 		return nil, status.Error(codes.Internal, "internal error")
@@ -33,6 +39,8 @@ func (s *serverAPI) LoginUser(ctx context.Context, req *ssov1.LoginUserRequest)
 	return &ssov1.LoginUserResponse{
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
+		MfaRequired:  tokens.MFAToken != "",
+		MfaToken:     tokens.MFAToken,
 	}, nil
 }
 
@@ -47,6 +55,10 @@ func (s *serverAPI) LoginViaTg(ctx context.Context, req *ssov1.LoginViaTgRequest
 			return nil, status.Error(codes.InvalidArgument, "invalid email")
 		case errors.Is(err, auth.ErrUserNotFound):
 			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, auth.ErrEmailNotVerified):
+			return nil, status.Error(codes.FailedPrecondition, "email is not verified")
+		case errors.Is(err, auth.ErrUserDeactivated):
+			return nil, status.Error(codes.PermissionDenied, "user is deactivated")
 		}
 
 		return nil, status.Error(codes.Internal, "internal error")
@@ -71,6 +83,14 @@ func (s *serverAPI) CheckOTPAndLogIn(ctx context.Context, req *ssov1.CheckOTPAnd
 			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
 		case errors.Is(err, auth.ErrUserNotFound):
 			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, auth.ErrTooManyAttempts):
+			return nil, status.Error(codes.ResourceExhausted, "too many failed attempts, try again later")
+		case errors.Is(err, auth.ErrOTPAttemptsExceeded):
+			return nil, status.Error(codes.ResourceExhausted, "otp attempts exceeded, request a new code")
+		case errors.Is(err, auth.ErrEmailNotVerified):
+			return nil, status.Error(codes.FailedPrecondition, "email is not verified")
+		case errors.Is(err, auth.ErrUserDeactivated):
+			return nil, status.Error(codes.PermissionDenied, "user is deactivated")
 		}
 
 		return nil, status.Error(codes.Internal, "internal error")
@@ -79,16 +99,135 @@ func (s *serverAPI) CheckOTPAndLogIn(ctx context.Context, req *ssov1.CheckOTPAnd
 	return &ssov1.CheckOTPAndLogInResponse{
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
+		MfaRequired:  tokens.MFAToken != "",
+		MfaToken:     tokens.MFAToken,
+	}, nil
+}
+
+func (s *serverAPI) VerifyEmail(ctx context.Context, req *ssov1.VerifyEmailRequest) (*ssov1.VerifyEmailResponse, error) {
+	verify := &models.VerifyEmail{
+		Token: req.GetToken(),
+	}
+
+	if err := s.auth.VerifyEmail(ctx, verify); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidVerifyToken):
+			return nil, status.Error(codes.InvalidArgument, "invalid verification token")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.VerifyEmailResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) ResendVerification(ctx context.Context, req *ssov1.ResendVerificationRequest) (*ssov1.ResendVerificationResponse, error) {
+	if err := validator.ValidateResendVerification(req); err != nil {
+		return nil, err
+	}
+
+	resend := &models.ResendVerification{
+		Email: req.GetEmail(),
+	}
+
+	if err := s.auth.ResendVerification(ctx, resend); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid email")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.ResendVerificationResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) AcceptInvite(ctx context.Context, req *ssov1.AcceptInviteRequest) (*ssov1.AcceptInviteResponse, error) {
+	accept := &models.AcceptInvite{
+		Token:    req.GetToken(),
+		Password: req.GetPassword(),
+	}
+
+	if err := s.auth.AcceptInvite(ctx, accept); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidInviteToken):
+			return nil, status.Error(codes.InvalidArgument, "invalid invite token")
+		case errors.Is(err, auth.ErrWeakPassword):
+			return nil, status.Error(codes.FailedPrecondition, "password doesn't meet policy")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.AcceptInviteResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) RequestPasswordReset(ctx context.Context, req *ssov1.RequestPasswordResetRequest) (*ssov1.RequestPasswordResetResponse, error) {
+	if err := validator.ValidateRequestPasswordReset(req); err != nil {
+		return nil, err
+	}
+
+	reset := &models.RequestPasswordReset{
+		Email: req.GetEmail(),
+	}
+
+	if err := s.auth.RequestPasswordReset(ctx, reset); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid email")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.RequestPasswordResetResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) ConfirmPasswordReset(ctx context.Context, req *ssov1.ConfirmPasswordResetRequest) (*ssov1.ConfirmPasswordResetResponse, error) {
+	if err := validator.ValidateConfirmPasswordReset(req); err != nil {
+		return nil, err
+	}
+
+	reset := &models.ConfirmPasswordReset{
+		Email:       req.GetEmail(),
+		Code:        req.GetCode(),
+		NewPassword: req.GetNewPassword(),
+	}
+
+	if err := s.auth.ConfirmPasswordReset(ctx, reset); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, auth.ErrWeakPassword):
+			return nil, status.Error(codes.FailedPrecondition, "password doesn't meet policy")
+		case errors.Is(err, auth.ErrTooManyAttempts):
+			return nil, status.Error(codes.ResourceExhausted, "too many failed attempts, try again later")
+		case errors.Is(err, auth.ErrOTPAttemptsExceeded):
+			return nil, status.Error(codes.ResourceExhausted, "otp attempts exceeded, request a new code")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.ConfirmPasswordResetResponse{
+		Success: true,
 	}, nil
 }
 
 func (s *serverAPI) UpdateUserInfo(ctx context.Context, req *ssov1.UpdateUserInfoRequest) (*ssov1.UpdateUserInfoResponse, error) {
 	userInfo := &models.UpdateUserInfo{
-		ID:      req.GetId(),
-		Email:   req.GetEmail(),
-		Name:    req.GetName(),
-		TgLink:  req.GetTgLink(),
-		IsAdmin: req.GetIsAdmin(),
+		ID:     req.GetId(),
+		Email:  req.GetEmail(),
+		Name:   req.GetName(),
+		TgLink: req.GetTgLink(),
 	}
 
 	if err := s.auth.UpdateUserInfo(ctx, userInfo); err != nil {
@@ -122,6 +261,8 @@ func (s *serverAPI) RegisterUser(ctx context.Context, req *ssov1.RegisterUserReq
 			return nil, status.Error(codes.AlreadyExists, "user already exists")
 		case errors.Is(err, auth.ErrInvalidCredentials):
 			return nil, status.Error(codes.InvalidArgument, "invalid credentinals")
+		case errors.Is(err, auth.ErrWeakPassword):
+			return nil, status.Error(codes.FailedPrecondition, "password doesn't meet policy")
 		default:
 			return nil, status.Error(codes.Internal, "internal error")
 		}
@@ -137,17 +278,67 @@ func (s *serverAPI) RefreshToken(ctx context.Context, req *ssov1.RefreshTokenReq
 		return nil, err
 	}
 
-	accessToken, err := s.auth.RefreshToken(ctx, req.GetRefreshToken())
+	tokens, err := s.auth.RefreshToken(ctx, req.GetRefreshToken())
 	if err != nil {
-		if errors.Is(err, auth.ErrInvalidRefreshToken) {
+		switch {
+		case errors.Is(err, auth.ErrInvalidRefreshToken):
 			return nil, status.Error(codes.InvalidArgument, "wrong token")
+		case errors.Is(err, auth.ErrRefreshTokenReused):
+			return nil, status.Error(codes.Unauthenticated, "refresh token reuse detected")
 		}
 
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
 	return &ssov1.RefreshTokenResponse{
-		AccessToken: accessToken,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+func (s *serverAPI) Logout(ctx context.Context, req *ssov1.LogoutRequest) (*ssov1.LogoutResponse, error) {
+	logout := &models.Logout{
+		AccessToken:  req.GetAccessToken(),
+		RefreshToken: req.GetRefreshToken(),
+	}
+
+	if err := s.auth.Logout(ctx, logout); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidAccessToken):
+			return nil, status.Error(codes.Unauthenticated, "unauth")
+		case errors.Is(err, auth.ErrInvalidRefreshToken):
+			return nil, status.Error(codes.InvalidArgument, "wrong token")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.LogoutResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) GetUserInfo(ctx context.Context, req *ssov1.GetUserInfoRequest) (*ssov1.GetUserInfoResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	user, err := s.auth.GetUserInfo(ctx, req.GetUserId())
+	if err != nil {
+		if errors.Is(err, auth.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.GetUserInfoResponse{
+		Id:       user.ID,
+		Email:    user.Email,
+		Name:     user.Name,
+		TgLink:   user.TgLink,
+		TgLinked: user.TgLinked,
+		IsAdmin:  user.IsAdmin,
 	}, nil
 }
 
@@ -177,7 +368,10 @@ func (s *serverAPI) AuthCheck(ctx context.Context, req *ssov1.AuthCheckRequest)
 	}
 
 	return &ssov1.AuthCheckResponse{
-		IsValid: resp.IsValid,
-		UserId:  resp.UserId,
+		IsValid:  resp.IsValid,
+		UserId:   resp.UserId,
+		ClientId: resp.ClientID,
+		Scopes:   resp.Scopes,
+		ActorId:  resp.ActorID,
 	}, nil
 }
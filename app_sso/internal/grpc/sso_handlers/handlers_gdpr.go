@@ -0,0 +1,88 @@
+package ssohandlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/gdpr"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) RequestUserExport(ctx context.Context, req *ssov1.RequestUserExportRequest) (*ssov1.RequestUserExportResponse, error) {
+	export := &models.GDPRRequest{
+		AdminID: req.GetAdminId(),
+		UserID:  req.GetUserId(),
+	}
+
+	jobID, err := s.gdpr.RequestExport(ctx, export)
+	if err != nil {
+		return nil, gdprError(err)
+	}
+
+	return &ssov1.RequestUserExportResponse{
+		JobId: jobID,
+	}, nil
+}
+
+func (s *serverAPI) RequestUserErasure(ctx context.Context, req *ssov1.RequestUserErasureRequest) (*ssov1.RequestUserErasureResponse, error) {
+	erase := &models.GDPRRequest{
+		AdminID: req.GetAdminId(),
+		UserID:  req.GetUserId(),
+	}
+
+	jobID, err := s.gdpr.RequestErasure(ctx, erase)
+	if err != nil {
+		return nil, gdprError(err)
+	}
+
+	return &ssov1.RequestUserErasureResponse{
+		JobId: jobID,
+	}, nil
+}
+
+func (s *serverAPI) GetGDPRJob(ctx context.Context, req *ssov1.GetGDPRJobRequest) (*ssov1.GetGDPRJobResponse, error) {
+	get := &models.GetGDPRJob{
+		AdminID: req.GetAdminId(),
+		JobID:   req.GetJobId(),
+	}
+
+	job, err := s.gdpr.GetJob(ctx, get)
+	if err != nil {
+		return nil, gdprError(err)
+	}
+
+	return &ssov1.GetGDPRJobResponse{
+		JobId:   job.ID,
+		Type:    job.Type,
+		UserId:  job.UserID,
+		Status:  job.Status,
+		Error:   job.Error,
+		Archive: job.Archive,
+		Created: job.Created.Format(time.RFC3339),
+		Updated: job.Updated.Format(time.RFC3339),
+	}, nil
+}
+
+// gdprError maps errors of gdpr jobs to grpc status.
+func gdprError(err error) error {
+	switch {
+	case errors.Is(err, gdpr.ErrInvalidCredentials):
+		return status.Error(codes.InvalidArgument, "invalid credentials")
+	case errors.Is(err, gdpr.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, gdpr.ErrJobNotFound):
+		return status.Error(codes.NotFound, "gdpr job not found")
+	case errors.Is(err, gdpr.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "permission denied")
+	case errors.Is(err, gdpr.ErrSelfModification):
+		return status.Error(codes.PermissionDenied, "admin can't change own account")
+	case errors.Is(err, gdpr.ErrUserNotDeactivated):
+		return status.Error(codes.FailedPrecondition, "user is not deactivated")
+	}
+
+	return status.Error(codes.Internal, "internal error")
+}
@@ -15,6 +15,7 @@ import (
 func (s *serverAPI) CreateLearningGroup(ctx context.Context, req *ssov1.CreateLearningGroupRequest) (*ssov1.CreateLearningGroupResponse, error) {
 	lg := models.CreateLearningGroup{
 		Name:        req.GetName(),
+		ParentID:    req.GetParentId(),
 		CreatedBy:   req.GetCreatedBy(),
 		ModifiedBy:  req.GetModifiedBy(),
 		GroupAdmins: req.GetGroupAdmins(),
@@ -26,6 +27,8 @@ func (s *serverAPI) CreateLearningGroup(ctx context.Context, req *ssov1.CreateLe
 			return nil, status.Error(codes.InvalidArgument, "bad request")
 		case errors.Is(err, learninggroup.ErrGroupExists):
 			return nil, status.Error(codes.AlreadyExists, "learning group exists")
+		case errors.Is(err, learninggroup.ErrPermissionDenied):
+			return nil, status.Error(codes.PermissionDenied, "permissions denied")
 		default:
 			return nil, status.Error(codes.Internal, "internal error")
 		}
@@ -57,10 +60,12 @@ func (s *serverAPI) GetLearningGroupByID(ctx context.Context, req *ssov1.GetLear
 	response := &ssov1.GetLearningGroupByIDResponse{
 		Id:          lg.ID,
 		Name:        lg.Name,
+		ParentId:    lg.ParentID,
 		CreatedBy:   lg.CreatedBy,
 		ModifiedBy:  lg.ModifiedBy,
 		Learners:    make([]*ssov1.Learner, len(lg.Learners)),
 		GroupAdmins: make([]*ssov1.GroupAdmins, len(lg.GroupAdmins)),
+		Version:     lg.Version,
 	}
 
 	for i, learner := range lg.Learners {
@@ -90,6 +95,7 @@ func (s *serverAPI) UpdateLearningGroup(ctx context.Context, req *ssov1.UpdateLe
 		ModifiedBy:  req.GetModifiedBy(),
 		GroupAdmins: req.GetGroupAdmins(),
 		Learners:    req.GetLearners(),
+		Version:     req.GetVersion(),
 	}
 	if err := s.lgh.UpdateLearningGroup(ctx, &lg); err != nil {
 		switch {
@@ -97,6 +103,9 @@ func (s *serverAPI) UpdateLearningGroup(ctx context.Context, req *ssov1.UpdateLe
 			return nil, status.Error(codes.PermissionDenied, "permissions denied")
 		case errors.Is(err, learninggroup.ErrGroupNotFound):
 			return nil, status.Error(codes.NotFound, "learning group not found")
+		case errors.Is(err, learninggroup.ErrVersionConflict):
+			// Not Aborted: clients retry it, but the same version conflicts again
+			return nil, status.Error(codes.FailedPrecondition, "learning group was changed, reload it and retry")
 		case errors.Is(err, learninggroup.ErrInvalidCredentials):
 			return nil, status.Error(codes.InvalidArgument, "bad request")
 		default:
@@ -148,6 +157,7 @@ func (s *serverAPI) GetLearningGroups(ctx context.Context, req *ssov1.GetLearnin
 		response.LearningGroups[i] = &ssov1.LearningGroup{
 			Id:         group.ID,
 			Name:       group.Name,
+			ParentId:   group.ParentID,
 			CreatedBy:  group.CreatedBy,
 			ModifiedBy: group.ModifiedBy,
 			Created:    group.Created.Format(time.RFC3339),
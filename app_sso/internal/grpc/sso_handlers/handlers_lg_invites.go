@@ -0,0 +1,114 @@
+package ssohandlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	learninggroup "github.com/DimTur/lp_auth/internal/services/learning_group"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) CreateLgInvite(ctx context.Context, req *ssov1.CreateLgInviteRequest) (*ssov1.CreateLgInviteResponse, error) {
+	create := &models.CreateLgInvite{
+		UserID:    req.GetUserId(),
+		LgId:      req.GetLearningGroupId(),
+		MaxUses:   req.GetMaxUses(),
+		ExpiresIn: req.GetExpiresIn(),
+	}
+
+	invite, err := s.lgh.CreateLgInvite(ctx, create)
+	if err != nil {
+		return nil, lgInviteError(err)
+	}
+
+	return &ssov1.CreateLgInviteResponse{
+		Invite: lgInviteToProto(invite),
+	}, nil
+}
+
+func (s *serverAPI) ListLgInvites(ctx context.Context, req *ssov1.ListLgInvitesRequest) (*ssov1.ListLgInvitesResponse, error) {
+	list := &models.ListLgInvites{
+		UserID: req.GetUserId(),
+		LgId:   req.GetLearningGroupId(),
+	}
+
+	invites, err := s.lgh.ListLgInvites(ctx, list)
+	if err != nil {
+		return nil, lgInviteError(err)
+	}
+
+	var respInvites []*ssov1.LgInvite
+	for _, invite := range invites {
+		respInvites = append(respInvites, lgInviteToProto(invite))
+	}
+
+	return &ssov1.ListLgInvitesResponse{
+		Invites: respInvites,
+	}, nil
+}
+
+func (s *serverAPI) RevokeLgInvite(ctx context.Context, req *ssov1.RevokeLgInviteRequest) (*ssov1.RevokeLgInviteResponse, error) {
+	revoke := &models.RevokeLgInvite{
+		UserID: req.GetUserId(),
+		LgId:   req.GetLearningGroupId(),
+		Code:   req.GetCode(),
+	}
+
+	if err := s.lgh.RevokeLgInvite(ctx, revoke); err != nil {
+		return nil, lgInviteError(err)
+	}
+
+	return &ssov1.RevokeLgInviteResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) RedeemLgInvite(ctx context.Context, req *ssov1.RedeemLgInviteRequest) (*ssov1.RedeemLgInviteResponse, error) {
+	redeem := &models.RedeemLgInvite{
+		UserID: req.GetUserId(),
+		Code:   req.GetCode(),
+	}
+
+	lgID, err := s.lgh.RedeemLgInvite(ctx, redeem)
+	if err != nil {
+		return nil, lgInviteError(err)
+	}
+
+	return &ssov1.RedeemLgInviteResponse{
+		LearningGroupId: lgID,
+	}, nil
+}
+
+func lgInviteToProto(invite *models.LgInvite) *ssov1.LgInvite {
+	return &ssov1.LgInvite{
+		Code:            invite.Code,
+		LearningGroupId: invite.LgID,
+		CreatedBy:       invite.CreatedBy,
+		MaxUses:         invite.MaxUses,
+		Uses:            invite.Uses,
+		ExpiresAt:       invite.ExpiresAt.Format(time.RFC3339),
+		Created:         invite.Created.Format(time.RFC3339),
+	}
+}
+
+// lgInviteError maps errors of learning group invites to grpc status.
+func lgInviteError(err error) error {
+	switch {
+	case errors.Is(err, learninggroup.ErrInvalidCredentials):
+		return status.Error(codes.InvalidArgument, "invalid credentials")
+	case errors.Is(err, learninggroup.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "permission denied")
+	case errors.Is(err, learninggroup.ErrInviteNotFound):
+		return status.Error(codes.NotFound, "invite not found")
+	case errors.Is(err, learninggroup.ErrGroupNotFound):
+		return status.Error(codes.NotFound, "learning group not found")
+	case errors.Is(err, learninggroup.ErrAlreadyLearner):
+		return status.Error(codes.AlreadyExists, "user is already a learner")
+	}
+
+	return status.Error(codes.Internal, "internal error")
+}
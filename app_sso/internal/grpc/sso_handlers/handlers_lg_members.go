@@ -0,0 +1,62 @@
+package ssohandlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	learninggroup "github.com/DimTur/lp_auth/internal/services/learning_group"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) AddMembers(ctx context.Context, req *ssov1.AddMembersRequest) (*ssov1.AddMembersResponse, error) {
+	change := &models.ChangeLgMembers{
+		UserID:  req.GetUserId(),
+		LgId:    req.GetLearningGroupId(),
+		Role:    req.GetRole(),
+		UserIDs: req.GetUserIds(),
+	}
+
+	if err := s.lgh.AddMembers(ctx, change); err != nil {
+		return nil, lgMembersError(err)
+	}
+
+	return &ssov1.AddMembersResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) RemoveMembers(ctx context.Context, req *ssov1.RemoveMembersRequest) (*ssov1.RemoveMembersResponse, error) {
+	change := &models.ChangeLgMembers{
+		UserID:  req.GetUserId(),
+		LgId:    req.GetLearningGroupId(),
+		Role:    req.GetRole(),
+		UserIDs: req.GetUserIds(),
+	}
+
+	if err := s.lgh.RemoveMembers(ctx, change); err != nil {
+		return nil, lgMembersError(err)
+	}
+
+	return &ssov1.RemoveMembersResponse{
+		Success: true,
+	}, nil
+}
+
+// lgMembersError maps errors of learning group members change to grpc status.
+func lgMembersError(err error) error {
+	switch {
+	case errors.Is(err, learninggroup.ErrInvalidCredentials):
+		return status.Error(codes.InvalidArgument, "invalid credentials")
+	case errors.Is(err, learninggroup.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "permission denied")
+	case errors.Is(err, learninggroup.ErrGroupNotFound):
+		return status.Error(codes.NotFound, "learning group not found")
+	case errors.Is(err, learninggroup.ErrLastGroupAdmin):
+		return status.Error(codes.FailedPrecondition, "group must keep a group admin")
+	}
+
+	return status.Error(codes.Internal, "internal error")
+}
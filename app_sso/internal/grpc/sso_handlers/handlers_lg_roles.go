@@ -0,0 +1,168 @@
+package ssohandlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	learninggroup "github.com/DimTur/lp_auth/internal/services/learning_group"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) CreateLgRole(ctx context.Context, req *ssov1.CreateLgRoleRequest) (*ssov1.CreateLgRoleResponse, error) {
+	create := &models.CreateLgRole{
+		UserID:      req.GetUserId(),
+		LgId:        req.GetLearningGroupId(),
+		Name:        req.GetName(),
+		Permissions: req.GetPermissions(),
+	}
+
+	role, err := s.lgh.CreateLgRole(ctx, create)
+	if err != nil {
+		return nil, lgRoleError(err)
+	}
+
+	return &ssov1.CreateLgRoleResponse{
+		Role: lgRoleToProto(role),
+	}, nil
+}
+
+func (s *serverAPI) ListLgRoles(ctx context.Context, req *ssov1.ListLgRolesRequest) (*ssov1.ListLgRolesResponse, error) {
+	list := &models.ListLgRoles{
+		UserID: req.GetUserId(),
+		LgId:   req.GetLearningGroupId(),
+	}
+
+	roles, err := s.lgh.ListLgRoles(ctx, list)
+	if err != nil {
+		return nil, lgRoleError(err)
+	}
+
+	var respRoles []*ssov1.LgRole
+	for _, role := range roles {
+		respRoles = append(respRoles, lgRoleToProto(role))
+	}
+
+	return &ssov1.ListLgRolesResponse{
+		Roles: respRoles,
+	}, nil
+}
+
+func (s *serverAPI) UpdateLgRole(ctx context.Context, req *ssov1.UpdateLgRoleRequest) (*ssov1.UpdateLgRoleResponse, error) {
+	upd := &models.UpdateLgRole{
+		UserID:      req.GetUserId(),
+		LgId:        req.GetLearningGroupId(),
+		RoleID:      req.GetRoleId(),
+		Name:        req.GetName(),
+		Permissions: req.GetPermissions(),
+	}
+
+	if err := s.lgh.UpdateLgRole(ctx, upd); err != nil {
+		return nil, lgRoleError(err)
+	}
+
+	return &ssov1.UpdateLgRoleResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) DeleteLgRole(ctx context.Context, req *ssov1.DeleteLgRoleRequest) (*ssov1.DeleteLgRoleResponse, error) {
+	del := &models.DeleteLgRole{
+		UserID: req.GetUserId(),
+		LgId:   req.GetLearningGroupId(),
+		RoleID: req.GetRoleId(),
+	}
+
+	if err := s.lgh.DeleteLgRole(ctx, del); err != nil {
+		return nil, lgRoleError(err)
+	}
+
+	return &ssov1.DeleteLgRoleResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) AssignLgRole(ctx context.Context, req *ssov1.AssignLgRoleRequest) (*ssov1.AssignLgRoleResponse, error) {
+	change := &models.ChangeLgRoleMembers{
+		UserID:  req.GetUserId(),
+		LgId:    req.GetLearningGroupId(),
+		RoleID:  req.GetRoleId(),
+		UserIDs: req.GetUserIds(),
+	}
+
+	if err := s.lgh.AssignLgRole(ctx, change); err != nil {
+		return nil, lgRoleError(err)
+	}
+
+	return &ssov1.AssignLgRoleResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) UnassignLgRole(ctx context.Context, req *ssov1.UnassignLgRoleRequest) (*ssov1.UnassignLgRoleResponse, error) {
+	change := &models.ChangeLgRoleMembers{
+		UserID:  req.GetUserId(),
+		LgId:    req.GetLearningGroupId(),
+		RoleID:  req.GetRoleId(),
+		UserIDs: req.GetUserIds(),
+	}
+
+	if err := s.lgh.UnassignLgRole(ctx, change); err != nil {
+		return nil, lgRoleError(err)
+	}
+
+	return &ssov1.UnassignLgRoleResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) GetUserPermissions(ctx context.Context, req *ssov1.GetUserPermissionsRequest) (*ssov1.GetUserPermissionsResponse, error) {
+	perms, err := s.lgh.GetUserPermissions(ctx, req.GetUserId())
+	if err != nil {
+		return nil, lgRoleError(err)
+	}
+
+	var groups []*ssov1.LgPermissions
+	for _, p := range perms {
+		groups = append(groups, &ssov1.LgPermissions{
+			LearningGroupId: p.LgID,
+			Permissions:     p.Permissions,
+		})
+	}
+
+	return &ssov1.GetUserPermissionsResponse{
+		Groups: groups,
+	}, nil
+}
+
+func lgRoleToProto(role *models.LgRole) *ssov1.LgRole {
+	return &ssov1.LgRole{
+		Id:              role.ID,
+		LearningGroupId: role.LgID,
+		Name:            role.Name,
+		Permissions:     role.Permissions,
+		Members:         role.Members,
+		CreatedBy:       role.CreatedBy,
+		Created:         role.Created.Format(time.RFC3339),
+		Updated:         role.Updated.Format(time.RFC3339),
+	}
+}
+
+// lgRoleError maps errors of learning group roles to grpc status.
+func lgRoleError(err error) error {
+	switch {
+	case errors.Is(err, learninggroup.ErrInvalidCredentials):
+		return status.Error(codes.InvalidArgument, "invalid credentials")
+	case errors.Is(err, learninggroup.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "permission denied")
+	case errors.Is(err, learninggroup.ErrRoleNotFound):
+		return status.Error(codes.NotFound, "role not found")
+	case errors.Is(err, learninggroup.ErrRoleExists):
+		return status.Error(codes.AlreadyExists, "role already exists")
+	}
+
+	return status.Error(codes.Internal, "internal error")
+}
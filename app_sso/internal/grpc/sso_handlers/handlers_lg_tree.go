@@ -0,0 +1,80 @@
+package ssohandlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	learninggroup "github.com/DimTur/lp_auth/internal/services/learning_group"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) GetLearningGroupTree(ctx context.Context, req *ssov1.GetLearningGroupTreeRequest) (*ssov1.GetLearningGroupTreeResponse, error) {
+	get := &models.GetLgTree{
+		UserID: req.GetUserId(),
+		LgId:   req.GetLearningGroupId(),
+	}
+
+	tree, err := s.lgh.GetLearningGroupTree(ctx, get)
+	if err != nil {
+		return nil, lgTreeError(err)
+	}
+
+	return &ssov1.GetLearningGroupTreeResponse{
+		Root: lgTreeNodeToProto(tree),
+	}, nil
+}
+
+func (s *serverAPI) MoveLearningGroup(ctx context.Context, req *ssov1.MoveLearningGroupRequest) (*ssov1.MoveLearningGroupResponse, error) {
+	move := &models.MoveLearningGroup{
+		UserID:   req.GetUserId(),
+		LgId:     req.GetLearningGroupId(),
+		ParentID: req.GetParentId(),
+		Version:  req.GetVersion(),
+	}
+
+	if err := s.lgh.MoveLearningGroup(ctx, move); err != nil {
+		return nil, lgTreeError(err)
+	}
+
+	return &ssov1.MoveLearningGroupResponse{
+		Success: true,
+	}, nil
+}
+
+func lgTreeNodeToProto(node *models.LgTreeNode) *ssov1.LearningGroupNode {
+	children := make([]*ssov1.LearningGroupNode, 0, len(node.Children))
+	for _, child := range node.Children {
+		children = append(children, lgTreeNodeToProto(child))
+	}
+
+	return &ssov1.LearningGroupNode{
+		Id:       node.ID,
+		Name:     node.Name,
+		ParentId: node.ParentID,
+		Children: children,
+	}
+}
+
+// lgTreeError maps errors of learning group tree operations to grpc status.
+// Cycle and version conflict are both failed precondition, the gateway
+// tells them apart by the message. Not Aborted: clients retry it,
+// but the same version conflicts again.
+func lgTreeError(err error) error {
+	switch {
+	case errors.Is(err, learninggroup.ErrInvalidCredentials):
+		return status.Error(codes.InvalidArgument, "invalid credentials")
+	case errors.Is(err, learninggroup.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "permission denied")
+	case errors.Is(err, learninggroup.ErrGroupNotFound):
+		return status.Error(codes.NotFound, "learning group not found")
+	case errors.Is(err, learninggroup.ErrGroupCycle):
+		return status.Error(codes.FailedPrecondition, "learning group can't be moved into its own subtree")
+	case errors.Is(err, learninggroup.ErrVersionConflict):
+		return status.Error(codes.FailedPrecondition, "learning group was changed, reload it and retry")
+	}
+
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,42 @@
+package ssohandlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/oidc"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) RegisterRelyingParty(ctx context.Context, req *ssov1.RegisterRelyingPartyRequest) (*ssov1.RegisterRelyingPartyResponse, error) {
+	rp := &models.CreateRelyingParty{
+		UserID:       req.GetUserId(),
+		Name:         req.GetName(),
+		RedirectURIs: req.GetRedirectUris(),
+		Public:       req.GetPublic(),
+	}
+
+	creds, err := s.oidc.RegisterRelyingParty(ctx, rp)
+	if err != nil {
+		switch {
+		case errors.Is(err, oidc.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, oidc.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, oidc.ErrPermissionDenied):
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		case errors.Is(err, oidc.ErrRelyingPartyExists):
+			return nil, status.Error(codes.AlreadyExists, "relying party already exists")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.RegisterRelyingPartyResponse{
+		ClientId:     creds.ClientID,
+		ClientSecret: creds.ClientSecret,
+	}, nil
+}
@@ -0,0 +1,262 @@
+package ssohandlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	learninggroup "github.com/DimTur/lp_auth/internal/services/learning_group"
+	"github.com/DimTur/lp_auth/internal/services/users"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) ScimListUsers(ctx context.Context, req *ssov1.ScimListUsersRequest) (*ssov1.ScimListUsersResponse, error) {
+	list := &models.ScimListUsers{
+		ClientID:   req.GetClientId(),
+		Email:      req.GetEmail(),
+		Active:     req.GetActive(),
+		StartIndex: req.GetStartIndex(),
+		Count:      req.GetCount(),
+	}
+
+	page, err := s.users.ScimListUsers(ctx, list)
+	if err != nil {
+		return nil, scimError(err)
+	}
+
+	var respUsers []*ssov1.ScimUser
+	for _, user := range page.Users {
+		respUsers = append(respUsers, scimUserToProto(user))
+	}
+
+	return &ssov1.ScimListUsersResponse{
+		Users: respUsers,
+		Total: page.Total,
+	}, nil
+}
+
+func (s *serverAPI) ScimGetUser(ctx context.Context, req *ssov1.ScimGetUserRequest) (*ssov1.ScimGetUserResponse, error) {
+	get := &models.ScimManageUser{
+		ClientID: req.GetClientId(),
+		UserID:   req.GetUserId(),
+	}
+
+	user, err := s.users.ScimGetUser(ctx, get)
+	if err != nil {
+		return nil, scimError(err)
+	}
+
+	return &ssov1.ScimGetUserResponse{
+		User: scimUserToProto(user),
+	}, nil
+}
+
+func (s *serverAPI) ScimCreateUser(ctx context.Context, req *ssov1.ScimCreateUserRequest) (*ssov1.ScimCreateUserResponse, error) {
+	create := &models.ScimCreateUser{
+		ClientID: req.GetClientId(),
+		Email:    req.GetEmail(),
+		Name:     req.GetName(),
+		Active:   req.GetActive(),
+	}
+
+	user, err := s.users.ScimCreateUser(ctx, create)
+	if err != nil {
+		return nil, scimError(err)
+	}
+
+	return &ssov1.ScimCreateUserResponse{
+		User: scimUserToProto(user),
+	}, nil
+}
+
+func (s *serverAPI) ScimUpdateUser(ctx context.Context, req *ssov1.ScimUpdateUserRequest) (*ssov1.ScimUpdateUserResponse, error) {
+	upd := &models.ScimUpdateUser{
+		ClientID: req.GetClientId(),
+		UserID:   req.GetUserId(),
+		Email:    req.GetEmail(),
+		Name:     req.GetName(),
+		Active:   req.GetActive(),
+	}
+
+	user, err := s.users.ScimUpdateUser(ctx, upd)
+	if err != nil {
+		return nil, scimError(err)
+	}
+
+	return &ssov1.ScimUpdateUserResponse{
+		User: scimUserToProto(user),
+	}, nil
+}
+
+func (s *serverAPI) ScimDeleteUser(ctx context.Context, req *ssov1.ScimDeleteUserRequest) (*ssov1.ScimDeleteUserResponse, error) {
+	del := &models.ScimManageUser{
+		ClientID: req.GetClientId(),
+		UserID:   req.GetUserId(),
+	}
+
+	if err := s.users.ScimDeleteUser(ctx, del); err != nil {
+		return nil, scimError(err)
+	}
+
+	return &ssov1.ScimDeleteUserResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) ScimListGroups(ctx context.Context, req *ssov1.ScimListGroupsRequest) (*ssov1.ScimListGroupsResponse, error) {
+	list := &models.ScimListGroups{
+		ClientID:   req.GetClientId(),
+		Name:       req.GetDisplayName(),
+		StartIndex: req.GetStartIndex(),
+		Count:      req.GetCount(),
+	}
+
+	page, err := s.lgh.ScimListGroups(ctx, list)
+	if err != nil {
+		return nil, scimError(err)
+	}
+
+	var respGroups []*ssov1.ScimGroup
+	for _, group := range page.Groups {
+		respGroups = append(respGroups, scimGroupToProto(group))
+	}
+
+	return &ssov1.ScimListGroupsResponse{
+		Groups: respGroups,
+		Total:  page.Total,
+	}, nil
+}
+
+func (s *serverAPI) ScimGetGroup(ctx context.Context, req *ssov1.ScimGetGroupRequest) (*ssov1.ScimGetGroupResponse, error) {
+	get := &models.ScimManageGroup{
+		ClientID: req.GetClientId(),
+		LgID:     req.GetGroupId(),
+	}
+
+	group, err := s.lgh.ScimGetGroup(ctx, get)
+	if err != nil {
+		return nil, scimError(err)
+	}
+
+	return &ssov1.ScimGetGroupResponse{
+		Group: scimGroupToProto(group),
+	}, nil
+}
+
+func (s *serverAPI) ScimCreateGroup(ctx context.Context, req *ssov1.ScimCreateGroupRequest) (*ssov1.ScimCreateGroupResponse, error) {
+	create := &models.ScimCreateGroup{
+		ClientID: req.GetClientId(),
+		Name:     req.GetDisplayName(),
+		Members:  req.GetMembers(),
+	}
+
+	group, err := s.lgh.ScimCreateGroup(ctx, create)
+	if err != nil {
+		return nil, scimError(err)
+	}
+
+	return &ssov1.ScimCreateGroupResponse{
+		Group: scimGroupToProto(group),
+	}, nil
+}
+
+func (s *serverAPI) ScimUpdateGroup(ctx context.Context, req *ssov1.ScimUpdateGroupRequest) (*ssov1.ScimUpdateGroupResponse, error) {
+	upd := &models.ScimUpdateGroup{
+		ClientID: req.GetClientId(),
+		LgID:     req.GetGroupId(),
+		Name:     req.GetDisplayName(),
+		Members:  req.GetMembers(),
+	}
+
+	group, err := s.lgh.ScimUpdateGroup(ctx, upd)
+	if err != nil {
+		return nil, scimError(err)
+	}
+
+	return &ssov1.ScimUpdateGroupResponse{
+		Group: scimGroupToProto(group),
+	}, nil
+}
+
+func (s *serverAPI) ScimChangeGroupMembers(ctx context.Context, req *ssov1.ScimChangeGroupMembersRequest) (*ssov1.ScimChangeGroupMembersResponse, error) {
+	change := &models.ScimChangeGroupMembers{
+		ClientID: req.GetClientId(),
+		LgID:     req.GetGroupId(),
+		Add:      req.GetAdd(),
+		Remove:   req.GetRemove(),
+	}
+
+	if err := s.lgh.ScimChangeGroupMembers(ctx, change); err != nil {
+		return nil, scimError(err)
+	}
+
+	return &ssov1.ScimChangeGroupMembersResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) ScimDeleteGroup(ctx context.Context, req *ssov1.ScimDeleteGroupRequest) (*ssov1.ScimDeleteGroupResponse, error) {
+	del := &models.ScimManageGroup{
+		ClientID: req.GetClientId(),
+		LgID:     req.GetGroupId(),
+	}
+
+	if err := s.lgh.ScimDeleteGroup(ctx, del); err != nil {
+		return nil, scimError(err)
+	}
+
+	return &ssov1.ScimDeleteGroupResponse{
+		Success: true,
+	}, nil
+}
+
+func scimUserToProto(user *models.User) *ssov1.ScimUser {
+	return &ssov1.ScimUser{
+		Id:      user.ID,
+		Email:   user.Email,
+		Name:    user.Name,
+		Status:  user.Status,
+		Active:  user.Status != models.UserStatusDeactivated,
+		Created: user.Created.Format(time.RFC3339),
+		Updated: user.Updated.Format(time.RFC3339),
+	}
+}
+
+func scimGroupToProto(group *models.ScimGroup) *ssov1.ScimGroup {
+	return &ssov1.ScimGroup{
+		Id:          group.ID,
+		DisplayName: group.Name,
+		Members:     group.Members,
+		Created:     group.Created.Format(time.RFC3339),
+		Updated:     group.Updated.Format(time.RFC3339),
+		Version:     group.Version,
+	}
+}
+
+// scimError maps errors of SCIM provisioning to grpc status.
+// Unknown group member is invalid argument, not found is left for
+// the requested user or group.
+func scimError(err error) error {
+	switch {
+	case errors.Is(err, users.ErrInvalidCredentials),
+		errors.Is(err, learninggroup.ErrInvalidCredentials):
+		return status.Error(codes.InvalidArgument, "invalid credentials")
+	case errors.Is(err, learninggroup.ErrUserNotFound):
+		return status.Error(codes.InvalidArgument, "member not found")
+	case errors.Is(err, users.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, learninggroup.ErrGroupNotFound):
+		return status.Error(codes.NotFound, "group not found")
+	case errors.Is(err, users.ErrUserExists):
+		return status.Error(codes.AlreadyExists, "user already exists")
+	case errors.Is(err, users.ErrAdminNotProvisioned):
+		return status.Error(codes.PermissionDenied, "admin can't be changed by scim client")
+	case errors.Is(err, learninggroup.ErrGroupExists):
+		return status.Error(codes.AlreadyExists, "group already exists")
+	}
+
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,62 @@
+package ssohandlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/auth"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) ListSessions(ctx context.Context, req *ssov1.ListSessionsRequest) (*ssov1.ListSessionsResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	sessions, err := s.auth.ListSessions(ctx, req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	var respSessions []*ssov1.Session
+	for _, session := range sessions {
+		respSessions = append(respSessions, &ssov1.Session{
+			Id:        session.ID,
+			UserAgent: session.UserAgent,
+			Ip:        session.IP,
+			Created:   session.Created.Format(time.RFC3339),
+			LastUsed:  session.LastUsed.Format(time.RFC3339),
+			ExpiresAt: session.ExpiresAt.Format(time.RFC3339),
+		})
+	}
+
+	return &ssov1.ListSessionsResponse{
+		Sessions: respSessions,
+	}, nil
+}
+
+func (s *serverAPI) RevokeSession(ctx context.Context, req *ssov1.RevokeSessionRequest) (*ssov1.RevokeSessionResponse, error) {
+	revoke := &models.RevokeSession{
+		UserID:    req.GetUserId(),
+		SessionID: req.GetSessionId(),
+	}
+
+	if err := s.auth.RevokeSession(ctx, revoke); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, auth.ErrSessionNotFound):
+			return nil, status.Error(codes.NotFound, "session not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.RevokeSessionResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package ssohandlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/services/auth"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) CreateTgLinkToken(ctx context.Context, req *ssov1.CreateTgLinkTokenRequest) (*ssov1.CreateTgLinkTokenResponse, error) {
+	token, err := s.auth.IssueTgLinkToken(ctx, req.GetUserId())
+	if err != nil {
+		return nil, telegramError(err)
+	}
+
+	return &ssov1.CreateTgLinkTokenResponse{
+		Token:     token.Token,
+		ExpiresAt: token.ExpiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (s *serverAPI) UnlinkTelegram(ctx context.Context, req *ssov1.UnlinkTelegramRequest) (*ssov1.UnlinkTelegramResponse, error) {
+	if err := s.auth.UnlinkTelegram(ctx, req.GetUserId()); err != nil {
+		return nil, telegramError(err)
+	}
+
+	return &ssov1.UnlinkTelegramResponse{
+		Success: true,
+	}, nil
+}
+
+// telegramError maps errors of telegram linking to grpc status.
+func telegramError(err error) error {
+	switch {
+	case errors.Is(err, auth.ErrInvalidCredentials):
+		return status.Error(codes.InvalidArgument, "invalid credentials")
+	case errors.Is(err, auth.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, auth.ErrTelegramNotLinked):
+		return status.Error(codes.FailedPrecondition, "telegram is not linked")
+	case errors.Is(err, auth.ErrUserDeactivated):
+		return status.Error(codes.PermissionDenied, "user is deactivated")
+	}
+
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,114 @@
+package ssohandlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/auth"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) LoginMFA(ctx context.Context, req *ssov1.LoginMFARequest) (*ssov1.LoginMFAResponse, error) {
+	login := &models.LoginMFA{
+		MFAToken: req.GetMfaToken(),
+		Code:     req.GetCode(),
+	}
+
+	tokens, err := s.auth.LoginMFA(ctx, login)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidMFAToken):
+			return nil, status.Error(codes.Unauthenticated, "invalid mfa token")
+		case errors.Is(err, auth.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid code")
+		case errors.Is(err, auth.ErrTooManyAttempts):
+			return nil, status.Error(codes.ResourceExhausted, "too many failed attempts, try again later")
+		case errors.Is(err, auth.ErrUserDeactivated):
+			return nil, status.Error(codes.PermissionDenied, "user is deactivated")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.LoginMFAResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+func (s *serverAPI) EnrollTOTP(ctx context.Context, req *ssov1.EnrollTOTPRequest) (*ssov1.EnrollTOTPResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	enrollment, err := s.auth.EnrollTOTP(ctx, req.GetUserId())
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, auth.ErrTOTPAlreadyEnabled):
+			return nil, status.Error(codes.AlreadyExists, "totp is already enabled")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.EnrollTOTPResponse{
+		OtpauthUri: enrollment.URI,
+		Secret:     enrollment.Secret,
+	}, nil
+}
+
+func (s *serverAPI) VerifyTOTP(ctx context.Context, req *ssov1.VerifyTOTPRequest) (*ssov1.VerifyTOTPResponse, error) {
+	verify := &models.VerifyTOTP{
+		UserID: req.GetUserId(),
+		Code:   req.GetCode(),
+	}
+
+	recoveryCodes, err := s.auth.VerifyTOTP(ctx, verify)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid code")
+		case errors.Is(err, auth.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, auth.ErrTOTPAlreadyEnabled):
+			return nil, status.Error(codes.AlreadyExists, "totp is already enabled")
+		case errors.Is(err, auth.ErrTOTPNotEnabled):
+			return nil, status.Error(codes.FailedPrecondition, "totp is not enrolled")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.VerifyTOTPResponse{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (s *serverAPI) DisableTOTP(ctx context.Context, req *ssov1.DisableTOTPRequest) (*ssov1.DisableTOTPResponse, error) {
+	disable := &models.DisableTOTP{
+		UserID: req.GetUserId(),
+		Code:   req.GetCode(),
+	}
+
+	if err := s.auth.DisableTOTP(ctx, disable); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid code")
+		case errors.Is(err, auth.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, auth.ErrTOTPNotEnabled):
+			return nil, status.Error(codes.FailedPrecondition, "totp is not enabled")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ssov1.DisableTOTPResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,183 @@
+package ssohandlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/users"
+	ssov1 "github.com/DimTur/lp_protos/gen/go/sso"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) ListUsers(ctx context.Context, req *ssov1.ListUsersRequest) (*ssov1.ListUsersResponse, error) {
+	list := &models.ListUsers{
+		AdminID: req.GetAdminId(),
+		Query:   req.GetQuery(),
+		Status:  req.GetStatus(),
+		Cursor:  req.GetCursor(),
+		Limit:   int64(req.GetLimit()),
+	}
+
+	page, err := s.users.ListUsers(ctx, list)
+	if err != nil {
+		switch {
+		case errors.Is(err, users.ErrInvalidCredentials):
+			return nil, status.Error(codes.InvalidArgument, "invalid credentials")
+		case errors.Is(err, users.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, users.ErrPermissionDenied):
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	var respUsers []*ssov1.UserSummary
+	for _, user := range page.Users {
+		respUsers = append(respUsers, &ssov1.UserSummary{
+			Id:      user.ID,
+			Email:   user.Email,
+			Name:    user.Name,
+			TgLink:  user.TgLink,
+			IsAdmin: user.IsAdmin,
+			Status:  user.Status,
+			Created: user.Created.Format(time.RFC3339),
+		})
+	}
+
+	return &ssov1.ListUsersResponse{
+		Users:      respUsers,
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+func (s *serverAPI) SetUserAdmin(ctx context.Context, req *ssov1.SetUserAdminRequest) (*ssov1.SetUserAdminResponse, error) {
+	set := &models.SetUserAdmin{
+		AdminID: req.GetAdminId(),
+		UserID:  req.GetUserId(),
+		IsAdmin: req.GetIsAdmin(),
+	}
+
+	if err := s.users.SetUserAdmin(ctx, set); err != nil {
+		return nil, usersError(err)
+	}
+
+	return &ssov1.SetUserAdminResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) DeactivateUser(ctx context.Context, req *ssov1.DeactivateUserRequest) (*ssov1.DeactivateUserResponse, error) {
+	manage := &models.ManageUser{
+		AdminID: req.GetAdminId(),
+		UserID:  req.GetUserId(),
+	}
+
+	if err := s.users.DeactivateUser(ctx, manage); err != nil {
+		return nil, usersError(err)
+	}
+
+	return &ssov1.DeactivateUserResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) ReactivateUser(ctx context.Context, req *ssov1.ReactivateUserRequest) (*ssov1.ReactivateUserResponse, error) {
+	manage := &models.ManageUser{
+		AdminID: req.GetAdminId(),
+		UserID:  req.GetUserId(),
+	}
+
+	if err := s.users.ReactivateUser(ctx, manage); err != nil {
+		return nil, usersError(err)
+	}
+
+	return &ssov1.ReactivateUserResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *serverAPI) ImportUsers(ctx context.Context, req *ssov1.ImportUsersRequest) (*ssov1.ImportUsersResponse, error) {
+	rows := make([]*models.ImportUserRow, 0, len(req.GetRows()))
+	for _, row := range req.GetRows() {
+		rows = append(rows, &models.ImportUserRow{
+			Row:    row.GetRow(),
+			Email:  row.GetEmail(),
+			Name:   row.GetName(),
+			TgLink: row.GetTgLink(),
+		})
+	}
+
+	imp := &models.ImportUsers{
+		AdminID:           req.GetAdminId(),
+		Rows:              rows,
+		LearningGroupID:   req.GetLearningGroupId(),
+		LearningGroupName: req.GetLearningGroupName(),
+	}
+
+	report, err := s.users.ImportUsers(ctx, imp)
+	if err != nil {
+		return nil, usersError(err)
+	}
+
+	results := make([]*ssov1.ImportUserResult, 0, len(report.Results))
+	for _, res := range report.Results {
+		results = append(results, &ssov1.ImportUserResult{
+			Row:    res.Row,
+			Email:  res.Email,
+			Status: res.Status,
+			UserId: res.UserID,
+			Error:  res.Error,
+		})
+	}
+
+	return &ssov1.ImportUsersResponse{
+		Results:            results,
+		LearningGroupError: report.LearningGroupError,
+	}, nil
+}
+
+// usersError maps errors of admin actions on user accounts to grpc status.
+func (s *serverAPI) ImpersonateUser(ctx context.Context, req *ssov1.ImpersonateUserRequest) (*ssov1.ImpersonateUserResponse, error) {
+	imp := &models.ImpersonateUser{
+		AdminID: req.GetAdminId(),
+		UserID:  req.GetUserId(),
+		Reason:  req.GetReason(),
+	}
+
+	token, err := s.users.ImpersonateUser(ctx, imp)
+	if err != nil {
+		return nil, usersError(err)
+	}
+
+	return &ssov1.ImpersonateUserResponse{
+		AccessToken: token.AccessToken,
+		ExpiresAt:   token.ExpiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+func usersError(err error) error {
+	switch {
+	case errors.Is(err, users.ErrInvalidCredentials):
+		return status.Error(codes.InvalidArgument, "invalid credentials")
+	case errors.Is(err, users.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, users.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, "permission denied")
+	case errors.Is(err, users.ErrSelfModification):
+		return status.Error(codes.PermissionDenied, "admin can't change own account")
+	case errors.Is(err, users.ErrUserAlreadyDisabled):
+		return status.Error(codes.FailedPrecondition, "user is already deactivated")
+	case errors.Is(err, users.ErrUserNotDeactivated):
+		return status.Error(codes.FailedPrecondition, "user is not deactivated")
+	case errors.Is(err, users.ErrCantImpersonate):
+		return status.Error(codes.FailedPrecondition, "user can't be impersonated")
+	case errors.Is(err, users.ErrTooManyImportRows):
+		return status.Error(codes.InvalidArgument, "too many rows to import")
+	}
+
+	return status.Error(codes.Internal, "internal error")
+}
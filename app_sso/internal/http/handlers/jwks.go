@@ -0,0 +1,27 @@
+package httphandlers
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/DimTur/lp_auth/pkg/jwt"
+)
+
+type JWKSProvider interface {
+	JWKS() jwt.JWKS
+}
+
+// JWKS publishes public keys which sso tokens are signed with.
+func JWKS(log *slog.Logger, provider JWKSProvider) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.http.JWKS"
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+
+		if err := json.NewEncoder(w).Encode(provider.JWKS()); err != nil {
+			log.Error("failed to encode jwks", slog.String("op", op), slog.String("err", err.Error()))
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package httphandlers
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DimTur/lp_auth/pkg/jwt"
+)
+
+func TestJWKSHandler(t *testing.T) {
+	var keys []jwt.Key
+	for _, kid := range []string{"2024-11", "2024-12"} {
+		_, privKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, jwt.Key{ID: kid, PrivateKey: privKey})
+	}
+
+	jwtManager, err := jwt.NewJWTManager("sso.test", time.Minute, time.Hour, time.Hour, keys, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	rec := httptest.NewRecorder()
+	JWKS(log, jwtManager).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+	if cc := rec.Header().Get("Cache-Control"); cc == "" {
+		t.Error("Cache-Control is not set")
+	}
+
+	var got jwt.JWKS
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("decode jwks: %v", err)
+	}
+
+	want := jwtManager.JWKS()
+	if len(got.Keys) != len(want.Keys) {
+		t.Fatalf("keys = %d, want %d", len(got.Keys), len(want.Keys))
+	}
+	for i := range want.Keys {
+		if got.Keys[i] != want.Keys[i] {
+			t.Errorf("key %d = %+v, want %+v", i, got.Keys[i], want.Keys[i])
+		}
+	}
+}
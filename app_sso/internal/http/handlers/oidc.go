@@ -0,0 +1,347 @@
+package httphandlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/oidc"
+)
+
+type OIDCProvider interface {
+	Issuer() string
+	ValidateAuthorize(ctx context.Context, req *models.OIDCAuthorize) error
+	Authorize(ctx context.Context, login *models.OIDCLogin) (string, error)
+	Exchange(ctx context.Context, req *models.OIDCTokenRequest) (*models.OIDCTokens, error)
+	UserInfo(ctx context.Context, accessToken string) (*models.OIDCUserInfo, error)
+}
+
+type discoveryDocument struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	IDToken     string `json:"id_token"`
+	Scope       string `json:"scope,omitempty"`
+}
+
+type errorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// OIDCDiscovery publishes OpenID Provider metadata (OpenID Connect Discovery 1.0).
+func OIDCDiscovery(log *slog.Logger, provider OIDCProvider) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.http.OIDCDiscovery"
+
+		issuer := strings.TrimSuffix(provider.Issuer(), "/")
+
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		writeJSON(w, log.With(slog.String("op", op)), http.StatusOK, discoveryDocument{
+			Issuer:                            provider.Issuer(),
+			AuthorizationEndpoint:             issuer + "/authorize",
+			TokenEndpoint:                     issuer + "/token",
+			UserinfoEndpoint:                  issuer + "/userinfo",
+			JWKSURI:                           issuer + "/.well-known/jwks.json",
+			ScopesSupported:                   oidc.ScopesSupported,
+			ResponseTypesSupported:            []string{oidc.ResponseTypeCode},
+			GrantTypesSupported:               []string{oidc.GrantTypeAuthorizationCode},
+			SubjectTypesSupported:             []string{"public"},
+			IDTokenSigningAlgValuesSupported:  []string{"EdDSA"},
+			TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+			CodeChallengeMethodsSupported:     []string{oidc.CodeChallengeMethodS256},
+			ClaimsSupported:                   []string{"sub", "iss", "aud", "exp", "iat", "auth_time", "nonce", "email", "email_verified", "name"},
+		})
+	}
+}
+
+// OIDCAuthorize validates authorization request and shows the login form.
+func OIDCAuthorize(log *slog.Logger, provider OIDCProvider) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.http.OIDCAuthorize"
+
+		log := log.With(slog.String("op", op))
+
+		req := authorizeRequest(r.URL.Query())
+		if err := provider.ValidateAuthorize(r.Context(), req); err != nil {
+			handleAuthorizeError(w, r, log, req, err)
+			return
+		}
+
+		renderLogin(w, log, http.StatusOK, req, "")
+	}
+}
+
+// OIDCLogin checks credentials submitted with the login form
+// and redirects the user back to the relying party with authorization code.
+func OIDCLogin(log *slog.Logger, provider OIDCProvider) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.http.OIDCLogin"
+
+		log := log.With(slog.String("op", op))
+
+		if err := r.ParseForm(); err != nil {
+			log.Warn("failed to parse form", slog.String("err", err.Error()))
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+
+		req := authorizeRequest(r.PostForm)
+		code, err := provider.Authorize(r.Context(), &models.OIDCLogin{
+			Authorize: *req,
+			Credentials: models.AuthenticateUser{
+				Email:    r.PostForm.Get("email"),
+				Password: r.PostForm.Get("password"),
+				Code:     r.PostForm.Get("code"),
+			},
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, oidc.ErrInvalidCredentials):
+				renderLogin(w, log, http.StatusUnauthorized, req, "Invalid email or password.")
+			case errors.Is(err, oidc.ErrMFARequired):
+				renderLogin(w, log, http.StatusUnauthorized, req, "Enter the code from your authenticator app.")
+			case errors.Is(err, oidc.ErrEmailNotVerified):
+				renderLogin(w, log, http.StatusForbidden, req, "Confirm your email first.")
+			case errors.Is(err, oidc.ErrTooManyAttempts):
+				renderLogin(w, log, http.StatusTooManyRequests, req, "Too many failed attempts, try again later.")
+			case errors.Is(err, oidc.ErrUserDeactivated):
+				renderLogin(w, log, http.StatusForbidden, req, "Your account is deactivated.")
+			default:
+				handleAuthorizeError(w, r, log, req, err)
+			}
+			return
+		}
+
+		redirectWithParams(w, r, req.RedirectURI, url.Values{
+			"code":  {code},
+			"state": {req.State},
+		})
+	}
+}
+
+// OIDCToken exchanges authorization code to tokens. Client credentials
+// are accepted in HTTP Basic auth or in the form.
+func OIDCToken(log *slog.Logger, provider OIDCProvider) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.http.OIDCToken"
+
+		log := log.With(slog.String("op", op))
+
+		w.Header().Set("Cache-Control", "no-store")
+
+		if err := r.ParseForm(); err != nil {
+			log.Warn("failed to parse form", slog.String("err", err.Error()))
+			writeJSON(w, log, http.StatusBadRequest, errorResponse{Error: "invalid_request"})
+			return
+		}
+
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok {
+			clientID = r.PostForm.Get("client_id")
+			clientSecret = r.PostForm.Get("client_secret")
+		}
+
+		tokens, err := provider.Exchange(r.Context(), &models.OIDCTokenRequest{
+			GrantType:    r.PostForm.Get("grant_type"),
+			Code:         r.PostForm.Get("code"),
+			RedirectURI:  r.PostForm.Get("redirect_uri"),
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			CodeVerifier: r.PostForm.Get("code_verifier"),
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, oidc.ErrInvalidClient):
+				w.Header().Set("WWW-Authenticate", `Basic realm="oidc"`)
+				writeJSON(w, log, http.StatusUnauthorized, errorResponse{Error: "invalid_client"})
+			case errors.Is(err, oidc.ErrInvalidGrant):
+				writeJSON(w, log, http.StatusBadRequest, errorResponse{Error: "invalid_grant"})
+			case errors.Is(err, oidc.ErrUnsupportedGrantType):
+				writeJSON(w, log, http.StatusBadRequest, errorResponse{Error: "unsupported_grant_type"})
+			case errors.Is(err, oidc.ErrInvalidRequest):
+				writeJSON(w, log, http.StatusBadRequest, errorResponse{Error: "invalid_request"})
+			default:
+				log.Error("failed to exchange authorization code", slog.String("err", err.Error()))
+				writeJSON(w, log, http.StatusInternalServerError, errorResponse{Error: "server_error"})
+			}
+			return
+		}
+
+		writeJSON(w, log, http.StatusOK, tokenResponse{
+			AccessToken: tokens.AccessToken,
+			TokenType:   "Bearer",
+			ExpiresIn:   int64(tokens.ExpiresIn.Seconds()),
+			IDToken:     tokens.IDToken,
+			Scope:       strings.Join(tokens.Scopes, " "),
+		})
+	}
+}
+
+// OIDCUserInfo returns claims of the user by access token issued by OIDCToken.
+func OIDCUserInfo(log *slog.Logger, provider OIDCProvider) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handlers.http.OIDCUserInfo"
+
+		log := log.With(slog.String("op", op))
+
+		accessToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || accessToken == "" {
+			w.Header().Set("WWW-Authenticate", `Bearer`)
+			writeJSON(w, log, http.StatusUnauthorized, errorResponse{Error: "invalid_token"})
+			return
+		}
+
+		userInfo, err := provider.UserInfo(r.Context(), accessToken)
+		if err != nil {
+			if errors.Is(err, oidc.ErrInvalidAccessToken) {
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				writeJSON(w, log, http.StatusUnauthorized, errorResponse{Error: "invalid_token"})
+				return
+			}
+
+			log.Error("failed to get user info", slog.String("err", err.Error()))
+			writeJSON(w, log, http.StatusInternalServerError, errorResponse{Error: "server_error"})
+			return
+		}
+
+		w.Header().Set("Cache-Control", "no-store")
+		writeJSON(w, log, http.StatusOK, userInfo)
+	}
+}
+
+func authorizeRequest(values url.Values) *models.OIDCAuthorize {
+	return &models.OIDCAuthorize{
+		ClientID:            values.Get("client_id"),
+		RedirectURI:         values.Get("redirect_uri"),
+		ResponseType:        values.Get("response_type"),
+		Scope:               values.Get("scope"),
+		State:               values.Get("state"),
+		Nonce:               values.Get("nonce"),
+		CodeChallenge:       values.Get("code_challenge"),
+		CodeChallengeMethod: values.Get("code_challenge_method"),
+	}
+}
+
+// handleAuthorizeError sends authorization error to the relying party (RFC 6749, section 4.1.2.1).
+// If client or redirect uri is invalid the error is shown to the user instead.
+func handleAuthorizeError(w http.ResponseWriter, r *http.Request, log *slog.Logger, req *models.OIDCAuthorize, err error) {
+	var code string
+	switch {
+	case errors.Is(err, oidc.ErrInvalidClient), errors.Is(err, oidc.ErrInvalidRedirectURI):
+		log.Warn("invalid client or redirect uri", slog.String("err", err.Error()))
+		http.Error(w, "invalid client or redirect uri", http.StatusBadRequest)
+		return
+	case errors.Is(err, oidc.ErrUnsupportedResponse):
+		code = "unsupported_response_type"
+	case errors.Is(err, oidc.ErrInvalidScope):
+		code = "invalid_scope"
+	case errors.Is(err, oidc.ErrInvalidRequest):
+		code = "invalid_request"
+	default:
+		log.Error("authorization failed", slog.String("err", err.Error()))
+		code = "server_error"
+	}
+
+	redirectWithParams(w, r, req.RedirectURI, url.Values{
+		"error": {code},
+		"state": {req.State},
+	})
+}
+
+func redirectWithParams(w http.ResponseWriter, r *http.Request, redirectURI string, params url.Values) {
+	target, err := url.Parse(redirectURI)
+	if err != nil {
+		http.Error(w, "invalid redirect uri", http.StatusBadRequest)
+		return
+	}
+
+	query := target.Query()
+	for key, values := range params {
+		if len(values) > 0 && values[0] != "" {
+			query.Set(key, values[0])
+		}
+	}
+	target.RawQuery = query.Encode()
+
+	http.Redirect(w, r, target.String(), http.StatusFound)
+}
+
+func writeJSON(w http.ResponseWriter, log *slog.Logger, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Error("failed to encode response", slog.String("err", err.Error()))
+	}
+}
+
+var loginTemplate = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Sign in</title>
+</head>
+<body>
+<h1>Sign in to the Learning Platform</h1>
+{{if .Error}}<p role="alert">{{.Error}}</p>{{end}}
+<form method="post" action="authorize">
+<input type="hidden" name="client_id" value="{{.Request.ClientID}}">
+<input type="hidden" name="redirect_uri" value="{{.Request.RedirectURI}}">
+<input type="hidden" name="response_type" value="{{.Request.ResponseType}}">
+<input type="hidden" name="scope" value="{{.Request.Scope}}">
+<input type="hidden" name="state" value="{{.Request.State}}">
+<input type="hidden" name="nonce" value="{{.Request.Nonce}}">
+<input type="hidden" name="code_challenge" value="{{.Request.CodeChallenge}}">
+<input type="hidden" name="code_challenge_method" value="{{.Request.CodeChallengeMethod}}">
+<p><label>Email <input type="email" name="email" required autofocus></label></p>
+<p><label>Password <input type="password" name="password" required></label></p>
+<p><label>2FA code <input type="text" name="code" autocomplete="one-time-code"></label></p>
+<p><button type="submit">Sign in</button></p>
+</form>
+</body>
+</html>
+`))
+
+func renderLogin(w http.ResponseWriter, log *slog.Logger, status int, req *models.OIDCAuthorize, errMsg string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	// Login form must not be framed by other sites
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Header().Set("Content-Security-Policy", "frame-ancestors 'none'")
+	w.WriteHeader(status)
+
+	err := loginTemplate.Execute(w, struct {
+		Request *models.OIDCAuthorize
+		Error   string
+	}{
+		Request: req,
+		Error:   errMsg,
+	})
+	if err != nil {
+		log.Error("failed to render login form", slog.String("err", err.Error()))
+	}
+}
@@ -0,0 +1,237 @@
+package apps
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/DimTur/lp_auth/pkg/crypto"
+	"github.com/go-playground/validator/v10"
+)
+
+// Scopes which can be granted to service clients.
+const (
+	ScopeUsersRead     = "users:read"
+	ScopeUsersImport   = "users:import"
+	ScopeScimProvision = "scim:provision"
+)
+
+var allowedScopes = []string{
+	ScopeUsersRead,
+	ScopeUsersImport,
+	ScopeScimProvision,
+}
+
+const (
+	clientSecretLength   = 32
+	clientTokenExpiresIn = time.Hour // TODO: transfer to config
+)
+
+type AppSaver interface {
+	SaveApp(ctx context.Context, app *models.DBCreateApp) error
+}
+
+type AppProvider interface {
+	FindAppByID(ctx context.Context, appID string) (*models.App, error)
+}
+
+type UserProvider interface {
+	GetUserRoles(ctx context.Context, userID string) (*models.UserRoles, error)
+}
+
+type JWTManager interface {
+	IssueClientToken(clientID string, scopes []string, expiresIn time.Duration) (string, error)
+}
+
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrInvalidClient      = errors.New("invalid client")
+	ErrInvalidScope       = errors.New("invalid scope")
+	ErrAppExists          = errors.New("app already exists")
+	ErrPermissionDenied   = errors.New("you don't have permissions")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrTokenGen           = errors.New("failed to generate client token")
+)
+
+type AppHandlers struct {
+	log            *slog.Logger
+	validator      *validator.Validate
+	appSaver       AppSaver
+	appProvider    AppProvider
+	userProvider   UserProvider
+	passwordHasher crypto.PasswordHasher
+	jwtManager     JWTManager
+}
+
+func New(
+	log *slog.Logger,
+	validator *validator.Validate,
+	appSaver AppSaver,
+	appProvider AppProvider,
+	userProvider UserProvider,
+	passwordHasher crypto.PasswordHasher,
+	jwtManager JWTManager,
+) *AppHandlers {
+	return &AppHandlers{
+		log:            log,
+		validator:      validator,
+		appSaver:       appSaver,
+		appProvider:    appProvider,
+		userProvider:   userProvider,
+		passwordHasher: passwordHasher,
+		jwtManager:     jwtManager,
+	}
+}
+
+// RegisterApp registers new service client. Only platform admins can do it.
+// The client secret is returned only once, just its hash is stored.
+func (ah *AppHandlers) RegisterApp(ctx context.Context, app *models.CreateApp) (*models.AppCredentials, error) {
+	const op = "apps.RegisterApp"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("app_name", app.Name),
+		slog.String("creating_by", app.UserID),
+	)
+
+	// Validation
+	err := ah.validator.Struct(app)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	for _, scope := range app.Scopes {
+		if !slices.Contains(allowedScopes, scope) {
+			log.Warn("unknown scope", slog.String("scope", scope))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidScope)
+		}
+	}
+
+	roles, err := ah.userProvider.GetUserRoles(ctx, app.UserID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to get user roles", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !roles.IsAdmin {
+		log.Warn("permissions denied")
+		return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	log.Info("registering app")
+
+	secret, err := newClientSecret()
+	if err != nil {
+		log.Error("failed to generate client secret", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	secretHash, err := ah.passwordHasher.HashPassword(secret)
+	if err != nil {
+		log.Error("failed to hash client secret", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	dbApp := &models.DBCreateApp{
+		Name:       app.Name,
+		SecretHash: secretHash,
+		Scopes:     slices.Compact(slices.Sorted(slices.Values(app.Scopes))),
+		CreatedBy:  app.UserID,
+		Created:    time.Now(),
+	}
+	if err := ah.appSaver.SaveApp(ctx, dbApp); err != nil {
+		if errors.Is(err, storage.ErrAppExists) {
+			log.Warn("app already exists", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrAppExists)
+		}
+
+		log.Error("failed to save app", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("app registered successfully", slog.String("client_id", dbApp.ID))
+
+	return &models.AppCredentials{
+		ClientID:     dbApp.ID,
+		ClientSecret: secret,
+	}, nil
+}
+
+// IssueClientToken implements the OAuth2 client credentials grant.
+// Requested scopes must be granted to the app, if none are requested
+// the token gets all scopes of the app.
+func (ah *AppHandlers) IssueClientToken(ctx context.Context, creds *models.ClientCredentials) (*models.ClientToken, error) {
+	const op = "apps.IssueClientToken"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("client_id", creds.ClientID),
+	)
+
+	// Validation
+	err := ah.validator.Struct(creds)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidClient)
+	}
+
+	app, err := ah.appProvider.FindAppByID(ctx, creds.ClientID)
+	if err != nil {
+		if errors.Is(err, storage.ErrAppNotFound) {
+			log.Warn("app not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidClient)
+		}
+
+		log.Error("failed to get app", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !ah.passwordHasher.ComparePassword(app.SecretHash, creds.ClientSecret) {
+		log.Warn("invalid client secret")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidClient)
+	}
+
+	scopes := app.Scopes
+	if len(creds.Scopes) > 0 {
+		for _, scope := range creds.Scopes {
+			if !slices.Contains(app.Scopes, scope) {
+				log.Warn("scope isn't granted to the app", slog.String("scope", scope))
+				return nil, fmt.Errorf("%s: %w", op, ErrInvalidScope)
+			}
+		}
+		scopes = slices.Compact(slices.Sorted(slices.Values(creds.Scopes)))
+	}
+
+	accessToken, err := ah.jwtManager.IssueClientToken(app.ID, scopes, clientTokenExpiresIn)
+	if err != nil {
+		log.Error("failed to generate client token", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrTokenGen)
+	}
+
+	log.Info("client token issued")
+
+	return &models.ClientToken{
+		AccessToken: accessToken,
+		ExpiresIn:   clientTokenExpiresIn,
+		Scopes:      scopes,
+	}, nil
+}
+
+func newClientSecret() (string, error) {
+	b := make([]byte, clientSecretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
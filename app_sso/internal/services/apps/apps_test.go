@@ -0,0 +1,186 @@
+package apps
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/DimTur/lp_auth/pkg/crypto"
+	"github.com/DimTur/lp_auth/pkg/jwt"
+	"github.com/go-playground/validator/v10"
+	gojwt "github.com/golang-jwt/jwt/v5"
+)
+
+// memStore keeps apps and admins in memory instead of Mongo.
+type memStore struct {
+	apps   map[string]*models.App
+	admins map[string]bool
+}
+
+func (m *memStore) SaveApp(_ context.Context, app *models.DBCreateApp) error {
+	app.ID = fmt.Sprintf("app-%d", len(m.apps)+1)
+	m.apps[app.ID] = &models.App{
+		ID:         app.ID,
+		Name:       app.Name,
+		SecretHash: app.SecretHash,
+		Scopes:     app.Scopes,
+		CreatedBy:  app.CreatedBy,
+		Created:    app.Created,
+	}
+	return nil
+}
+
+func (m *memStore) FindAppByID(_ context.Context, appID string) (*models.App, error) {
+	app, ok := m.apps[appID]
+	if !ok {
+		return nil, storage.ErrAppNotFound
+	}
+	return app, nil
+}
+
+func (m *memStore) GetUserRoles(_ context.Context, userID string) (*models.UserRoles, error) {
+	return &models.UserRoles{IsAdmin: m.admins[userID]}, nil
+}
+
+func newTestApps(t *testing.T) (*AppHandlers, *jwt.JWTManager) {
+	t.Helper()
+
+	_, privKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwtManager, err := jwt.NewJWTManager("sso.test", time.Minute, time.Hour, time.Hour, []jwt.Key{{ID: "test", PrivateKey: privKey}}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := &memStore{apps: make(map[string]*models.App), admins: map[string]bool{"admin": true}}
+	ah := New(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		validator.New(),
+		store,
+		store,
+		store,
+		crypto.NewPasswordHasher(crypto.Argon2Params{Memory: 8 * 1024, Iterations: 1, Parallelism: 1}),
+		jwtManager,
+	)
+
+	return ah, jwtManager
+}
+
+func TestRegisterApp(t *testing.T) {
+	ah, _ := newTestApps(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		app     models.CreateApp
+		wantErr error
+	}{
+		{name: "admin", app: models.CreateApp{UserID: "admin", Name: "crm", Scopes: []string{ScopeUsersRead}}},
+		{name: "not admin", app: models.CreateApp{UserID: "user", Name: "crm", Scopes: []string{ScopeUsersRead}}, wantErr: ErrPermissionDenied},
+		{name: "unknown scope", app: models.CreateApp{UserID: "admin", Name: "crm", Scopes: []string{"users:write"}}, wantErr: ErrInvalidScope},
+		{name: "without scopes", app: models.CreateApp{UserID: "admin", Name: "crm"}, wantErr: ErrInvalidCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creds, err := ah.RegisterApp(ctx, &tt.app)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RegisterApp() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (creds.ClientID == "" || creds.ClientSecret == "") {
+				t.Errorf("RegisterApp() = %+v, want credentials", creds)
+			}
+		})
+	}
+}
+
+func TestIssueClientToken(t *testing.T) {
+	ah, jwtManager := newTestApps(t)
+	ctx := context.Background()
+
+	creds, err := ah.RegisterApp(ctx, &models.CreateApp{
+		UserID: "admin",
+		Name:   "crm",
+		Scopes: []string{ScopeUsersRead, ScopeUsersImport},
+	})
+	if err != nil {
+		t.Fatalf("RegisterApp() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		clientID   string
+		secret     string
+		scopes     []string
+		wantScopes []string
+		wantErr    error
+	}{
+		{
+			name:       "all granted scopes by default",
+			clientID:   creds.ClientID,
+			secret:     creds.ClientSecret,
+			wantScopes: []string{ScopeUsersImport, ScopeUsersRead},
+		},
+		{
+			name:       "requested scopes",
+			clientID:   creds.ClientID,
+			secret:     creds.ClientSecret,
+			scopes:     []string{ScopeUsersRead, ScopeUsersRead},
+			wantScopes: []string{ScopeUsersRead},
+		},
+		{name: "bad secret", clientID: creds.ClientID, secret: "bad secret", wantErr: ErrInvalidClient},
+		{name: "unknown client", clientID: "unknown", secret: creds.ClientSecret, wantErr: ErrInvalidClient},
+		{name: "empty secret", clientID: creds.ClientID, wantErr: ErrInvalidClient},
+		{
+			name:     "scope not granted",
+			clientID: creds.ClientID,
+			secret:   creds.ClientSecret,
+			scopes:   []string{ScopeUsersRead, ScopeScimProvision},
+			wantErr:  ErrInvalidScope,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := ah.IssueClientToken(ctx, &models.ClientCredentials{
+				ClientID:     tt.clientID,
+				ClientSecret: tt.secret,
+				Scopes:       tt.scopes,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("IssueClientToken() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if !slices.Equal(token.Scopes, tt.wantScopes) {
+				t.Errorf("IssueClientToken() scopes = %v, want %v", token.Scopes, tt.wantScopes)
+			}
+
+			parsed, err := jwtManager.VerifyToken(token.AccessToken)
+			if err != nil {
+				t.Fatalf("VerifyToken() error = %v", err)
+			}
+			claims := parsed.Claims.(gojwt.MapClaims)
+			if claims["type"] != "client" || claims["sub"] != creds.ClientID {
+				t.Errorf("token claims type = %v, sub = %v, want client of %s", claims["type"], claims["sub"], creds.ClientID)
+			}
+			if scope := claims["scope"]; scope != strings.Join(tt.wantScopes, " ") {
+				t.Errorf("token scope = %v, want %v", scope, tt.wantScopes)
+			}
+		})
+	}
+}
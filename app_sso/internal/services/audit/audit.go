@@ -0,0 +1,162 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/DimTur/lp_auth/internal/utils/clientinfo"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	defaultEventsLimit = 50 // TODO: transfer to config
+	maxEventsLimit     = 100
+)
+
+type EventSaver interface {
+	SaveAuditEvent(ctx context.Context, event *models.AuditEvent) error
+}
+
+type EventProvider interface {
+	FindAuditEvents(ctx context.Context, auditFilter *models.AuditFilter) ([]*models.AuditEvent, error)
+}
+
+type UserProvider interface {
+	GetUserRoles(ctx context.Context, userID string) (*models.UserRoles, error)
+}
+
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrPermissionDenied   = errors.New("you don't have permissions")
+	ErrUserNotFound       = errors.New("user not found")
+)
+
+type AuditHandlers struct {
+	log           *slog.Logger
+	validator     *validator.Validate
+	eventSaver    EventSaver
+	eventProvider EventProvider
+	usrProvider   UserProvider
+}
+
+func New(
+	log *slog.Logger,
+	validator *validator.Validate,
+	eventSaver EventSaver,
+	eventProvider EventProvider,
+	usrProvider UserProvider,
+) *AuditHandlers {
+	return &AuditHandlers{
+		log:           log,
+		validator:     validator,
+		eventSaver:    eventSaver,
+		eventProvider: eventProvider,
+		usrProvider:   usrProvider,
+	}
+}
+
+// Record appends the event to the audit log. Client IP and user agent
+// are taken from the request context. Events of impersonation sessions
+// get the impersonating admin as the actor.
+//
+// Failure is only logged: the audited action is already done
+// and its result is returned to the caller anyway.
+func (ah *AuditHandlers) Record(ctx context.Context, event *models.AuditEvent) {
+	const op = "audit.Record"
+
+	client := clientinfo.FromContext(ctx)
+	event.IP = client.IP
+	event.UserAgent = client.UserAgent
+	// the impersonating admin is the actor even if the service
+	// has put the impersonated user there
+	if client.ActorID != "" {
+		event.ActorID = client.ActorID
+	}
+	event.Created = time.Now()
+
+	if err := ah.eventSaver.SaveAuditEvent(ctx, event); err != nil {
+		ah.log.Error("failed to save audit event",
+			slog.String("op", op),
+			slog.String("type", event.Type),
+			slog.String("user_id", event.UserID),
+			slog.String("err", err.Error()),
+		)
+	}
+}
+
+// ListAuditEvents returns page of the audit log. Only platform admins can do it.
+// Events are ordered from the newest, next page starts after NextCursor.
+func (ah *AuditHandlers) ListAuditEvents(ctx context.Context, list *models.ListAuditEvents) (*models.AuditEventsPage, error) {
+	const op = "audit.ListAuditEvents"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("admin_id", list.AdminID),
+	)
+
+	// Validation
+	err := ah.validator.Struct(list)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	if !list.From.IsZero() && !list.To.IsZero() && list.To.Before(list.From) {
+		log.Warn("invalid time range")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := ah.checkAdmin(ctx, list.AdminID); err != nil {
+		log.Warn("failed to check admin", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	limit := list.Limit
+	if limit == 0 {
+		limit = defaultEventsLimit
+	}
+	limit = min(limit, maxEventsLimit)
+
+	log.Info("listing audit events")
+
+	// One more event is requested to know whether the next page exists
+	events, err := ah.eventProvider.FindAuditEvents(ctx, &models.AuditFilter{
+		UserID:    list.UserID,
+		EventType: list.EventType,
+		From:      list.From,
+		To:        list.To,
+		BeforeID:  list.Cursor,
+		Limit:     limit + 1,
+	})
+	if err != nil {
+		log.Error("failed to find audit events", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	page := &models.AuditEventsPage{Events: events}
+	if int64(len(events)) > limit {
+		page.Events = events[:limit]
+		page.NextCursor = page.Events[limit-1].ID
+	}
+
+	return page, nil
+}
+
+func (ah *AuditHandlers) checkAdmin(ctx context.Context, userID string) error {
+	roles, err := ah.usrProvider.GetUserRoles(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	if !roles.IsAdmin {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package audit
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/utils/clientinfo"
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRecordActor(t *testing.T) {
+	tests := []struct {
+		name       string
+		mdActorID  string
+		eventActor string
+		wantActor  string
+	}{
+		{name: "own action", eventActor: "user", wantActor: "user"},
+		{name: "impersonation", mdActorID: "admin", eventActor: "user", wantActor: "admin"},
+		{name: "impersonation without event actor", mdActorID: "admin", wantActor: "admin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saver := &memEventSaver{}
+			ah := New(slog.New(slog.NewTextHandler(io.Discard, nil)), validator.New(), saver, nil, nil)
+
+			ctx := actorContext(t, tt.mdActorID)
+			ah.Record(ctx, &models.AuditEvent{Type: "test", UserID: "user", ActorID: tt.eventActor})
+
+			if len(saver.events) != 1 {
+				t.Fatalf("saved events = %d, want 1", len(saver.events))
+			}
+			if got := saver.events[0].ActorID; got != tt.wantActor {
+				t.Errorf("actor = %q, want %q", got, tt.wantActor)
+			}
+		})
+	}
+}
+
+func actorContext(t *testing.T, actorID string) context.Context {
+	t.Helper()
+
+	md := metadata.New(map[string]string{"x-client-ip": "10.0.0.1"})
+	if actorID != "" {
+		md.Set("x-actor-id", actorID)
+	}
+	ctx, err := clientinfo.UnaryServerInterceptor(
+		metadata.NewIncomingContext(context.Background(), md),
+		nil,
+		nil,
+		func(ctx context.Context, _ any) (any, error) { return ctx, nil },
+	)
+	if err != nil {
+		t.Fatalf("client info interceptor: %v", err)
+	}
+
+	return ctx.(context.Context)
+}
+
+type memEventSaver struct {
+	events []*models.AuditEvent
+}
+
+func (s *memEventSaver) SaveAuditEvent(_ context.Context, event *models.AuditEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+)
+
+// recordLogin writes login attempt to the audit log. loginErr is nil
+// for successful login, otherwise it is the reason of the failure.
+// userID is empty if there is no user with the email.
+func (ah *AuthHandlers) recordLogin(ctx context.Context, userID, email, method string, loginErr error) {
+	event := &models.AuditEvent{
+		Type:    models.AuditEventLoginSuccess,
+		UserID:  userID,
+		ActorID: userID,
+		Email:   email,
+		Method:  method,
+	}
+	if loginErr != nil {
+		event.Type = models.AuditEventLoginFailure
+		event.Details = loginErr.Error()
+	}
+
+	ah.auditRecorder.Record(ctx, event)
+}
+
+// recordUserEvent writes action of the user on own account to the audit log.
+func (ah *AuthHandlers) recordUserEvent(ctx context.Context, eventType, userID, targetID, details string) {
+	ah.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:     eventType,
+		UserID:   userID,
+		ActorID:  userID,
+		TargetID: targetID,
+		Details:  details,
+	})
+}
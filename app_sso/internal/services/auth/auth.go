@@ -7,32 +7,61 @@ import (
 	"fmt"
 	"log/slog"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/DimTur/lp_auth/internal/domain/models"
 	"github.com/DimTur/lp_auth/internal/services/rabbitmq"
 	"github.com/DimTur/lp_auth/internal/services/storage"
 	"github.com/DimTur/lp_auth/internal/services/storage/redis"
+	"github.com/DimTur/lp_auth/internal/utils/clientinfo"
 	"github.com/DimTur/lp_auth/internal/utils/otp"
 	"github.com/DimTur/lp_auth/pkg/crypto"
 	"github.com/go-playground/validator/v10"
 	"github.com/golang-jwt/jwt/v5"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 const (
 	exchangeOTP   = "otp"
 	queueOTP      = "otp"
 	otpRoutingKey = "otp"
+
+	exchangeEmailVerification   = "share"
+	emailVerificationRoutingKey = "email_verification"
+	emailVerificationExpiresIn  = 24 * time.Hour // TODO: transfer to config
+	// Verification email can be resent verificationResendLimit times
+	// in verificationResendWindow.
+	verificationResendLimit  = 3
+	verificationResendWindow = time.Hour
+
+	totpIssuer         = "Learning Platform"
+	mfaTokenExpiresIn  = 5 * time.Minute // TODO: transfer to config
+	recoveryCodesCount = 10
+
+	tgLinkTokenLength    = 16
+	tgLinkTokenExpiresIn = 15 * time.Minute // TODO: transfer to config
 )
 
 type UserSaver interface {
 	SaveUser(ctx context.Context, user *models.DBCreateUser) error
 	UpdateUserInfo(ctx context.Context, userInfo *models.DBUpdateUserInfo) error
+	UpdatePassword(ctx context.Context, userID string, passHash []byte) error
+	UpdateUserStatus(ctx context.Context, userID, status string) error
+	SaveTOTPSecret(ctx context.Context, userID, secret string) error
+	EnableTOTP(ctx context.Context, userID string, recoveryCodes [][]byte) error
+	DisableTOTP(ctx context.Context, userID string) error
+	DeleteRecoveryCode(ctx context.Context, userID string, codeHash []byte) error
+	UseTOTPCounter(ctx context.Context, userID string, counter int64) error
+	SetUserChatID(ctx context.Context, userID, chatID string) error
+	UnsetUserChatID(ctx context.Context, userID string) error
 }
 
 type UserProvider interface {
 	FindUserByEmail(ctx context.Context, email string) (*models.User, error)
+	FindUserByID(ctx context.Context, userID string) (*models.User, error)
 	FindUserByTgLink(ctx context.Context, tgLink string) (*models.User, error)
+	FindUserByChatID(ctx context.Context, chatID string) (*models.User, error)
 	GetUserRoles(ctx context.Context, userID string) (*models.UserRoles, error)
 	GetExistChatID(ctx context.Context, userID string) (string, error)
 	GetUsersInfoBatch(ctx context.Context, userIDs []string) ([]models.UserNotification, error)
@@ -40,17 +69,43 @@ type UserProvider interface {
 
 type TokenProvider interface {
 	SaveRefreshTokenToDB(ctx context.Context, token *models.CreateRefreshToken) error
+	FindRefreshTokenInDB(ctx context.Context, token string) (*models.RefreshToken, error)
+	RotateRefreshTokenInDB(ctx context.Context, token string) (*models.RefreshToken, error)
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+	RevokeUserRefreshTokens(ctx context.Context, userID string) error
+	FindUserSessions(ctx context.Context, userID string) ([]*models.Session, error)
+	FindSessionToken(ctx context.Context, userID, sessionID string) (*models.RefreshToken, error)
 }
 
 type TokenRedisStore interface {
 	SaveRefreshTokenToRedis(ctx context.Context, token *redis.CreateRefreshToken) error
-	FindRefreshToken(ctx context.Context, userID string) (*redis.RefreshTokenFromRedis, error)
+	GetRefreshToken(ctx context.Context, userID, token string) (*redis.RefreshTokenFromRedis, error)
+	DeleteRefreshToken(ctx context.Context, userID, token string) error
+	DeleteRefreshTokenFamily(ctx context.Context, userID, familyID string) error
+	DeleteUserRefreshTokens(ctx context.Context, userID string) error
+	DenyAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+	IsAccessTokenDenied(ctx context.Context, jti string) (bool, error)
+	IsUserDenied(ctx context.Context, userID string) (bool, error)
 }
 
 type OTPRedisStore interface {
 	SaveOTPToRedis(ctx context.Context, otp *redis.CreateOTP) error
-	FindOTPCode(ctx context.Context, code string) (*redis.UserOTPFromRedis, error)
-	DeleteUserOTP(ctx context.Context, code string) error
+	GetUserOTP(ctx context.Context, purpose, userID string) (*redis.UserOTPFromRedis, error)
+	IncrOTPAttempts(ctx context.Context, purpose, userID string) (int64, error)
+	DeleteUserOTP(ctx context.Context, purpose, userID string) error
+}
+
+type LoginLimiterStore interface {
+	IncrLoginFailures(ctx context.Context, key string, window time.Duration) (int64, error)
+	ResetLoginFailures(ctx context.Context, key string) error
+	LockLogin(ctx context.Context, key string, ttl time.Duration) error
+	GetLoginLock(ctx context.Context, key string) (time.Duration, error)
+}
+
+// TgLinkTokenStore keeps one-time tokens of telegram deep links
+type TgLinkTokenStore interface {
+	SaveTgLinkToken(ctx context.Context, token, userID string, ttl time.Duration) error
+	PopTgLinkToken(ctx context.Context, token string) (string, error)
 }
 
 type RabbitMQQueues interface {
@@ -58,11 +113,23 @@ type RabbitMQQueues interface {
 	PublishToQueue(ctx context.Context, queueName string, body []byte) error
 }
 
+type PasswordPolicy interface {
+	Check(password, email string) error
+}
+
 type JWTManager interface {
-	IssueAccessToken(userID string) (string, error)
-	IssueRefreshToken(userID string) (string, error)
+	IssueAccessToken(userID string) (string, string, error)
+	IssueRefreshToken(userID, familyID string) (string, error)
+	IssueVerificationToken(userID, email string, expiresIn time.Duration) (string, error)
+	IssueMFAToken(userID string, expiresIn time.Duration) (string, error)
 	VerifyToken(tokenString string) (*jwt.Token, error)
 	GetRefreshExpiresIn() time.Duration
+	GetAccessExpiresIn() time.Duration
+}
+
+// AuditRecorder writes security events to the audit log
+type AuditRecorder interface {
+	Record(ctx context.Context, event *models.AuditEvent)
 }
 
 var (
@@ -73,12 +140,27 @@ var (
 	ErrAppExists              = errors.New("app already exists")
 	ErrInvalidUserID          = errors.New("invalid user id")
 	ErrInvalidRefreshToken    = errors.New("invalid refresh token")
+	ErrRefreshTokenReused     = errors.New("refresh token reuse detected")
 	ErrInvalidAccessToken     = errors.New("invalid access token")
 	ErrAccessTokenGen         = errors.New("generation err access token")
 	ErrRefreshTokenGen        = errors.New("generation err refresh token")
 	ErrRefreshTokenStoreDB    = errors.New("store err refresh token to db")
 	ErrRefreshTokenStoreRedis = errors.New("store err refresh token to redis")
 	ErrOtpNotFound            = errors.New("otp not found")
+	ErrEmailNotVerified       = errors.New("email is not verified")
+	ErrInvalidVerifyToken     = errors.New("invalid verification token")
+	ErrInvalidMFAToken        = errors.New("invalid mfa token")
+	ErrTOTPAlreadyEnabled     = errors.New("totp is already enabled")
+	ErrTOTPNotEnabled         = errors.New("totp is not enabled")
+	ErrTooManyAttempts        = errors.New("too many failed login attempts")
+	ErrOTPAttemptsExceeded    = errors.New("otp attempts exceeded")
+	ErrMFARequired            = errors.New("second factor is required")
+	ErrWeakPassword           = errors.New("password doesn't meet policy")
+	ErrSessionNotFound        = errors.New("session not found")
+	ErrUserDeactivated        = errors.New("user is deactivated")
+	ErrInvalidInviteToken     = errors.New("invalid invite token")
+	ErrInvalidTgLinkToken     = errors.New("invalid telegram link token")
+	ErrTelegramNotLinked      = errors.New("telegram is not linked")
 )
 
 type AuthHandlers struct {
@@ -89,9 +171,13 @@ type AuthHandlers struct {
 	tokenProvider   TokenProvider
 	tokenRedisStore TokenRedisStore
 	otpRedisStore   OTPRedisStore
+	loginLimiter    LoginLimiterStore
+	tgLinkStore     TgLinkTokenStore
 	rabbitMQQueues  RabbitMQQueues
 	passwordHasher  crypto.PasswordHasher
+	passwordPolicy  PasswordPolicy
 	jwtManager      JWTManager
+	auditRecorder   AuditRecorder
 }
 
 // New returns a new instance of the Auth service.
@@ -103,9 +189,13 @@ func New(
 	tokenProvider TokenProvider,
 	tokenRedisStore TokenRedisStore,
 	otpRedisStore OTPRedisStore,
+	loginLimiter LoginLimiterStore,
+	tgLinkStore TgLinkTokenStore,
 	rabbitMQQueues RabbitMQQueues,
 	passwordHasher crypto.PasswordHasher,
+	passwordPolicy PasswordPolicy,
 	jwtManager JWTManager,
+	auditRecorder AuditRecorder,
 ) *AuthHandlers {
 	return &AuthHandlers{
 		log:             log,
@@ -115,9 +205,13 @@ func New(
 		tokenProvider:   tokenProvider,
 		tokenRedisStore: tokenRedisStore,
 		otpRedisStore:   otpRedisStore,
+		loginLimiter:    loginLimiter,
+		tgLinkStore:     tgLinkStore,
 		rabbitMQQueues:  rabbitMQQueues,
 		passwordHasher:  passwordHasher,
+		passwordPolicy:  passwordPolicy,
 		jwtManager:      jwtManager,
+		auditRecorder:   auditRecorder,
 	}
 }
 
@@ -125,6 +219,7 @@ func New(
 //
 // If user exists, but password is incorrect, returns error.
 // If user doesn't exist, returns error.
+// If there are too many failed attempts for the account or client IP, returns error.
 func (ah *AuthHandlers) LoginUser(
 	ctx context.Context,
 	email string,
@@ -139,10 +234,20 @@ func (ah *AuthHandlers) LoginUser(
 
 	log.Info("attempting to login user")
 
+	if err := ah.checkLoginLock(ctx, email); err != nil {
+		log.Warn("login is locked", slog.String("err", err.Error()))
+		if errors.Is(err, ErrTooManyAttempts) {
+			ah.recordLogin(ctx, "", email, models.AuthMethodPassword, err)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	user, err := ah.usrProvider.FindUserByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, storage.ErrUserNotFound) {
 			log.Warn("user not found", slog.String("err", err.Error()))
+			ah.handleLoginFailure(ctx, log, email)
+			ah.recordLogin(ctx, "", email, models.AuthMethodPassword, ErrUserNotFound)
 			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
 		}
 		log.Error("failed to get user", slog.String("err", err.Error()))
@@ -151,10 +256,52 @@ func (ah *AuthHandlers) LoginUser(
 
 	if !ah.passwordHasher.ComparePassword(user.PassHash, password) {
 		log.Info("invalid credentials")
+		ah.handleLoginFailure(ctx, log, email)
+		ah.recordLogin(ctx, user.ID, email, models.AuthMethodPassword, ErrInvalidCredentials)
 		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 	}
 
-	return ah.generateTokens(ctx, log, user.ID)
+	ah.rehashPassword(ctx, log, user, password)
+
+	if user.Status == models.UserStatusPendingVerification {
+		log.Info("email is not verified")
+		ah.recordLogin(ctx, user.ID, email, models.AuthMethodPassword, ErrEmailNotVerified)
+		return nil, fmt.Errorf("%s: %w", op, ErrEmailNotVerified)
+	}
+
+	if user.Status == models.UserStatusDeactivated {
+		log.Info("user is deactivated")
+		ah.recordLogin(ctx, user.ID, email, models.AuthMethodPassword, ErrUserDeactivated)
+		return nil, fmt.Errorf("%s: %w", op, ErrUserDeactivated)
+	}
+
+	if user.TOTP.Enabled {
+		log.Info("second factor is required")
+
+		mfaToken, err := ah.jwtManager.IssueMFAToken(user.ID, mfaTokenExpiresIn)
+		if err != nil {
+			log.Error("failed to issue mfa token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		return &models.LogInTokens{
+			MFAToken: mfaToken,
+		}, nil
+	}
+
+	// Failures of the account with 2FA are reset by LoginMFA
+	if err := ah.resetLoginFailures(ctx, email); err != nil {
+		log.Error("failed to reset login failures", slog.String("err", err.Error()))
+	}
+
+	tokens, err := ah.generateTokens(ctx, log, user.ID, newTokenFamilyID(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	ah.recordLogin(ctx, user.ID, email, models.AuthMethodPassword, nil)
+
+	return tokens, nil
 }
 
 func (ah *AuthHandlers) LogInViaTg(ctx context.Context, login *models.LogInViaTg) error {
@@ -185,6 +332,16 @@ func (ah *AuthHandlers) LogInViaTg(ctx context.Context, login *models.LogInViaTg
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if user.Status == models.UserStatusPendingVerification {
+		log.Info("email is not verified")
+		return fmt.Errorf("%s: %w", op, ErrEmailNotVerified)
+	}
+
+	if user.Status == models.UserStatusDeactivated {
+		log.Info("user is deactivated")
+		return fmt.Errorf("%s: %w", op, ErrUserDeactivated)
+	}
+
 	chatID, err := ah.usrProvider.GetExistChatID(ctx, user.ID)
 	if err != nil {
 		if errors.Is(err, storage.ErrUserNotFound) {
@@ -196,41 +353,52 @@ func (ah *AuthHandlers) LogInViaTg(ctx context.Context, login *models.LogInViaTg
 	}
 
 	if chatID != "" {
-		otp := &redis.CreateOTP{
-			UserID:    user.ID,
-			Code:      otp.RandOTP(),
-			ExpiresAt: time.Now().Add(time.Minute), // TODO: transfer to config
-			Used:      false,
-		}
-
-		if err = ah.otpRedisStore.SaveOTPToRedis(ctx, otp); err != nil {
-			log.Error("failed to save otp to redis", slog.String("err", err.Error()))
+		if err := ah.sendOTP(ctx, redis.OTPPurposeLogin, user.ID, chatID); err != nil {
+			log.Error("failed to send otp", slog.String("err", err.Error()))
 			return fmt.Errorf("%s: %w", op, err)
 		}
+	}
 
-		chatIDInt, err := strconv.Atoi(chatID)
-		if err != nil {
-			ah.log.Error("err to convert chat_id to int", slog.String("err", err.Error()))
-			return fmt.Errorf("%s: %w", op, err)
-		}
+	return nil
+}
 
-		msgOTP := &rabbitmq.MsgOTP{
-			Otp:    *otp,
-			ChatID: chatIDInt,
-		}
+// sendOTP saves new one-time code of the user for the purpose and sends it
+// to the user's telegram chat through otp exchange. The purpose is sent
+// with the code, so the bot tells the user what the code is for.
+func (ah *AuthHandlers) sendOTP(ctx context.Context, purpose, userID, chatID string) error {
+	otp := &redis.CreateOTP{
+		Purpose:   purpose,
+		UserID:    userID,
+		Code:      otp.RandOTP(),
+		ExpiresAt: time.Now().Add(time.Minute), // TODO: transfer to config
+		Used:      false,
+	}
 
-		msgBody, err := json.Marshal(msgOTP)
-		if err != nil {
-			ah.log.Error("err to marshal otp", slog.String("err", err.Error()))
-			return fmt.Errorf("%s: %w", op, err)
-		}
+	if err := ah.otpRedisStore.SaveOTPToRedis(ctx, otp); err != nil {
+		return fmt.Errorf("save otp: %w", err)
+	}
 
-		if err = ah.rabbitMQQueues.Publish(ctx, exchangeOTP, otpRoutingKey, msgBody); err != nil {
-			ah.log.Error("err send otp to exchange", slog.String("err", err.Error()))
-			return fmt.Errorf("%s: %w", op, err)
-		}
+	chatIDInt, err := strconv.Atoi(chatID)
+	if err != nil {
+		return fmt.Errorf("convert chat_id: %w", err)
+	}
+
+	msgOTP := &rabbitmq.MsgOTP{
+		Otp:    *otp,
+		ChatID: chatIDInt,
+	}
+
+	msgBody, err := json.Marshal(msgOTP)
+	if err != nil {
+		return fmt.Errorf("marshal otp: %w", err)
+	}
+
+	if err = ah.rabbitMQQueues.Publish(ctx, exchangeOTP, otpRoutingKey, msgBody); err != nil {
+		return fmt.Errorf("publish otp: %w", err)
 	}
 
+	ah.recordUserEvent(ctx, models.AuditEventOTPIssued, userID, "", "")
+
 	return nil
 }
 
@@ -244,28 +412,78 @@ func (ah *AuthHandlers) CheckOTP(ctx context.Context, checkOTP *models.LoginUser
 
 	log.Info("attempting to login user via OTP")
 
+	if err := ah.checkLoginLock(ctx, checkOTP.Email); err != nil {
+		log.Warn("login is locked", slog.String("err", err.Error()))
+		if errors.Is(err, ErrTooManyAttempts) {
+			ah.recordLogin(ctx, "", checkOTP.Email, models.AuthMethodOTP, err)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	user, err := ah.usrProvider.FindUserByEmail(ctx, checkOTP.Email)
 	if err != nil {
 		if errors.Is(err, storage.ErrUserNotFound) {
 			log.Warn("user not found", slog.String("err", err.Error()))
+			ah.handleLoginFailure(ctx, log, checkOTP.Email)
+			ah.recordLogin(ctx, "", checkOTP.Email, models.AuthMethodOTP, ErrUserNotFound)
 			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
 		}
 		log.Error("failed to get user", slog.String("err", err.Error()))
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	otp, err := ah.otpRedisStore.FindOTPCode(ctx, checkOTP.Code)
-	if err != nil || user.ID != otp.UserID {
-		log.Info("invalid credentials")
-		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	if err := ah.checkOTP(ctx, redis.OTPPurposeLogin, user.ID, checkOTP.Code); err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidCredentials), errors.Is(err, ErrOTPAttemptsExceeded):
+			log.Info("invalid otp", slog.String("err", err.Error()))
+			ah.handleLoginFailure(ctx, log, checkOTP.Email)
+			ah.recordLogin(ctx, user.ID, checkOTP.Email, models.AuthMethodOTP, err)
+		default:
+			log.Error("failed to check otp", slog.String("err", err.Error()))
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	if err := ah.otpRedisStore.DeleteUserOTP(ctx, checkOTP.Code); err != nil {
-		log.Info("invalid credentials")
-		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	if user.Status == models.UserStatusPendingVerification {
+		log.Info("email is not verified")
+		ah.recordLogin(ctx, user.ID, checkOTP.Email, models.AuthMethodOTP, ErrEmailNotVerified)
+		return nil, fmt.Errorf("%s: %w", op, ErrEmailNotVerified)
+	}
+
+	if user.Status == models.UserStatusDeactivated {
+		log.Info("user is deactivated")
+		ah.recordLogin(ctx, user.ID, checkOTP.Email, models.AuthMethodOTP, ErrUserDeactivated)
+		return nil, fmt.Errorf("%s: %w", op, ErrUserDeactivated)
+	}
+
+	// Telegram code replaces the password, not the second factor
+	if user.TOTP.Enabled {
+		log.Info("second factor is required")
+
+		mfaToken, err := ah.jwtManager.IssueMFAToken(user.ID, mfaTokenExpiresIn)
+		if err != nil {
+			log.Error("failed to issue mfa token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		return &models.LogInTokens{
+			MFAToken: mfaToken,
+		}, nil
+	}
+
+	// Failures of the account with 2FA are reset by LoginMFA
+	if err := ah.resetLoginFailures(ctx, checkOTP.Email); err != nil {
+		log.Error("failed to reset login failures", slog.String("err", err.Error()))
+	}
+
+	tokens, err := ah.generateTokens(ctx, log, user.ID, newTokenFamilyID(), time.Now())
+	if err != nil {
+		return nil, err
 	}
 
-	return ah.generateTokens(ctx, log, user.ID)
+	ah.recordLogin(ctx, user.ID, checkOTP.Email, models.AuthMethodOTP, nil)
+
+	return tokens, nil
 }
 
 // RegisterNewUser registers new user in the system and returns user ID.
@@ -286,6 +504,11 @@ func (ah *AuthHandlers) RegisterUser(ctx context.Context, user models.CreateUser
 		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 	}
 
+	if err := ah.passwordPolicy.Check(user.Password, user.Email); err != nil {
+		log.Warn("password doesn't meet policy", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w: %w", op, ErrWeakPassword, err)
+	}
+
 	log.Info("registering user")
 
 	passHash, err := ah.passwordHasher.HashPassword(user.Password)
@@ -299,6 +522,7 @@ func (ah *AuthHandlers) RegisterUser(ctx context.Context, user models.CreateUser
 		PassHash: passHash,
 		Name:     user.Name,
 		IsAdmin:  false,
+		Status:   models.UserStatusPendingVerification,
 		Created:  time.Now(),
 		Updated:  time.Now(),
 	}
@@ -313,12 +537,21 @@ func (ah *AuthHandlers) RegisterUser(ctx context.Context, user models.CreateUser
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	// The user is saved, so the failure is only logged:
+	// the email can be sent again with ResendVerification
+	if err := ah.sendVerificationToken(ctx, newUser.ID, newUser.Email); err != nil {
+		log.Error("failed to send verification token", slog.String("err", err.Error()))
+	}
+
 	log.Info("user registered in successfully")
 
 	return nil
 }
 
-// UpdateUserInfo updates user info
+// UpdateUserInfo updates user info.
+//
+// Changed email has to be verified again: the user gets pending verification
+// status and verification email is sent to the new address.
 func (ah *AuthHandlers) UpdateUserInfo(ctx context.Context, userInfo *models.UpdateUserInfo) error {
 	const op = "auth.UpdateUserInfo"
 
@@ -336,12 +569,23 @@ func (ah *AuthHandlers) UpdateUserInfo(ctx context.Context, userInfo *models.Upd
 
 	log.Info("updating user_info")
 
+	user, err := ah.usrProvider.FindUserByID(ctx, userInfo.ID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		}
+
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	emailChanged := userInfo.Email != "" && userInfo.Email != user.Email
+
 	newUserInfo := &models.DBUpdateUserInfo{
 		ID:      userInfo.ID,
 		Email:   userInfo.Email,
 		Name:    userInfo.Name,
 		TgLink:  userInfo.TgLink,
-		IsAdmin: &userInfo.IsAdmin,
 		Updated: time.Now(),
 	}
 	err = ah.usrSaver.UpdateUserInfo(ctx, newUserInfo)
@@ -355,44 +599,284 @@ func (ah *AuthHandlers) UpdateUserInfo(ctx context.Context, userInfo *models.Upd
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	ah.recordUserEvent(ctx, models.AuditEventProfileUpdate, userInfo.ID, "", changedFields(userInfo))
+
+	if emailChanged {
+		if err := ah.usrSaver.UpdateUserStatus(ctx, userInfo.ID, models.UserStatusPendingVerification); err != nil {
+			log.Error("failed to update user status", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := ah.sendVerificationToken(ctx, userInfo.ID, userInfo.Email); err != nil {
+			log.Error("failed to send verification token", slog.String("err", err.Error()))
+		}
+
+		log.Info("changed email is waiting for verification")
+	}
+
 	return nil
 }
 
-func (ah *AuthHandlers) RefreshToken(ctx context.Context, refreshToken string) (string, error) {
+// changedFields lists profile fields set in the update, values aren't
+// written to the audit log.
+func changedFields(userInfo *models.UpdateUserInfo) string {
+	var fields []string
+	if userInfo.Email != "" {
+		fields = append(fields, "email")
+	}
+	if userInfo.Name != "" {
+		fields = append(fields, "name")
+	}
+	if userInfo.TgLink != "" {
+		fields = append(fields, "tg_link")
+	}
+
+	return strings.Join(fields, ",")
+}
+
+// RefreshToken rotates refresh token: the presented token is invalidated
+// and a new pair of tokens from the same token family is issued.
+//
+// If already rotated token is presented again, the whole token family is revoked.
+func (ah *AuthHandlers) RefreshToken(ctx context.Context, refreshToken string) (*models.LogInTokens, error) {
 	const op = "auth.RefreshToken"
 
-	// TODO: find user by refresh token
 	log := ah.log.With(
 		slog.String("op", op),
-		// slog.String("user_id", userIDByToken),
 	)
 
-	log.Info("changing access token")
+	log.Info("rotating refresh token")
 
 	token, err := ah.jwtManager.VerifyToken(refreshToken)
 	if err != nil {
 		log.Error("token verification failed: %v", slog.String("err", err.Error()))
-		return "", fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok || !token.Valid || claims["type"] != "refresh" {
 		log.Error("invalid token claims or type")
-		return "", fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
 	}
 
 	userID, ok := claims["sub"].(string)
 	if !ok {
 		log.Error("invalid userID claim")
-		return "", fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+	}
+
+	familyID, ok := claims["fid"].(string)
+	if !ok {
+		log.Error("invalid family claim")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
 	}
 
-	accessToken, err := ah.jwtManager.IssueAccessToken(userID)
+	log = log.With(slog.String("user_id", userID))
+
+	// Active token is stored in redis, drop it first
+	err = ah.tokenRedisStore.DeleteRefreshToken(ctx, userID, refreshToken)
+	if err != nil && !errors.Is(err, storage.ErrTokenNotFound) {
+		log.Error("failed to delete refresh token from redis", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// DB is the source of truth: only one caller can rotate the token
+	rotated, err := ah.tokenProvider.RotateRefreshTokenInDB(ctx, refreshToken)
 	if err != nil {
-		return "", fmt.Errorf("%s: %w", op, err)
+		if !errors.Is(err, storage.ErrTokenNotFound) {
+			log.Error("failed to rotate refresh token", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		return nil, ah.handleInactiveRefreshToken(ctx, log, op, userID, familyID, refreshToken)
+	}
+
+	sessionCreated := rotated.SessionCreated
+	// Tokens issued before sessions were introduced
+	if sessionCreated.IsZero() {
+		sessionCreated = rotated.Created
 	}
 
-	return accessToken, nil
+	tokens, err := ah.generateTokens(ctx, log, userID, familyID, sessionCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	ah.recordUserEvent(ctx, models.AuditEventTokenRefresh, userID, familyID, "")
+
+	return tokens, nil
+}
+
+// handleInactiveRefreshToken detects refresh token reuse.
+// Rotated token can be presented only by somebody who stole it,
+// so the whole token family is revoked.
+func (ah *AuthHandlers) handleInactiveRefreshToken(
+	ctx context.Context,
+	log *slog.Logger,
+	op string,
+	userID string,
+	familyID string,
+	refreshToken string,
+) error {
+	storedToken, err := ah.tokenProvider.FindRefreshTokenInDB(ctx, refreshToken)
+	if err != nil {
+		if errors.Is(err, storage.ErrTokenNotFound) {
+			log.Warn("refresh token not found")
+			return fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+		}
+
+		log.Error("failed to get refresh token", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !storedToken.Rotated || storedToken.Revoked {
+		log.Warn("refresh token is revoked")
+		return fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+	}
+
+	log.Warn("refresh token reuse detected, revoking token family", slog.String("family_id", familyID))
+	ah.recordUserEvent(ctx, models.AuditEventTokenRefresh, userID, familyID, ErrRefreshTokenReused.Error())
+
+	if err := ah.revokeTokenFamily(ctx, userID, familyID); err != nil {
+		log.Error("failed to revoke token family", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return fmt.Errorf("%s: %w", op, ErrRefreshTokenReused)
+}
+
+func (ah *AuthHandlers) revokeTokenFamily(ctx context.Context, userID, familyID string) error {
+	if err := ah.tokenProvider.RevokeRefreshTokenFamily(ctx, familyID); err != nil {
+		return err
+	}
+
+	return ah.tokenRedisStore.DeleteRefreshTokenFamily(ctx, userID, familyID)
+}
+
+// Logout revokes access token of the user.
+//
+// Access token goes to denylist till it expires. If refresh token is given,
+// the whole token family of the session is revoked too.
+func (ah *AuthHandlers) Logout(ctx context.Context, logout *models.Logout) error {
+	const op = "auth.Logout"
+
+	log := ah.log.With(
+		slog.String("op", op),
+	)
+
+	// Validation
+	err := ah.validator.Struct(logout)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+	}
+
+	token, err := ah.jwtManager.VerifyToken(logout.AccessToken)
+	if err != nil {
+		log.Error("token verification failed", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid || claims["type"] != "access" {
+		log.Error("invalid token claims or type")
+		return fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+	}
+
+	userID, ok := claims["sub"].(string)
+	if !ok {
+		log.Error("invalid subject claim")
+		return fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+	}
+
+	jti, ok := claims["jti"].(string)
+	if !ok {
+		log.Error("invalid jti claim")
+		return fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+	}
+
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		log.Error("invalid exp claim")
+		return fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+	}
+
+	log = log.With(slog.String("user_id", userID))
+
+	log.Info("logging out user")
+
+	if err := ah.tokenRedisStore.DenyAccessToken(ctx, jti, exp.Time); err != nil {
+		log.Error("failed to deny access token", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if logout.RefreshToken != "" {
+		familyID, err := ah.refreshTokenFamily(logout.RefreshToken, userID)
+		if err != nil {
+			log.Warn("invalid refresh token", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrInvalidRefreshToken)
+		}
+
+		if err := ah.revokeTokenFamily(ctx, userID, familyID); err != nil {
+			log.Error("failed to revoke token family", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	log.Info("user logged out successfully")
+
+	return nil
+}
+
+// refreshTokenFamily returns family id of refresh token issued for the user.
+func (ah *AuthHandlers) refreshTokenFamily(refreshToken, userID string) (string, error) {
+	token, err := ah.jwtManager.VerifyToken(refreshToken)
+	if err != nil {
+		return "", err
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid || claims["type"] != "refresh" || claims["sub"] != userID {
+		return "", ErrInvalidRefreshToken
+	}
+
+	familyID, ok := claims["fid"].(string)
+	if !ok {
+		return "", ErrInvalidRefreshToken
+	}
+
+	return familyID, nil
+}
+
+// GetUserInfo returns profile of the user.
+func (ah *AuthHandlers) GetUserInfo(ctx context.Context, userID string) (*models.UserInfo, error) {
+	const op = "auth.GetUserInfo"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("user_id", userID),
+	)
+
+	log.Info("getting user info")
+
+	user, err := ah.usrProvider.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &models.UserInfo{
+		ID:       user.ID,
+		Email:    user.Email,
+		Name:     user.Name,
+		TgLink:   user.TgLink,
+		TgLinked: user.ChatID != "",
+		IsAdmin:  user.IsAdmin,
+	}, nil
 }
 
 // IsAdmin checks if user is admin.
@@ -443,60 +927,96 @@ func (ah *AuthHandlers) AuthCheck(ctx context.Context, accessToken string) (*mod
 		return nil, fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
 	}
 
+	// Service clients use access tokens issued with the client credentials grant
 	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok || !token.Valid || claims["type"] != "access" {
+	if !ok || !token.Valid || (claims["type"] != "access" && claims["type"] != "client") {
 		log.Error("invalid token claims or type")
 		return nil, fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
 	}
 
-	userID, ok := claims["sub"].(string)
+	subject, ok := claims["sub"].(string)
 	if !ok {
 		log.Error("invalid subject claim")
 		return nil, fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
 	}
 
+	jti, ok := claims["jti"].(string)
+	if !ok {
+		log.Error("invalid jti claim")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+	}
+
+	denied, err := ah.tokenRedisStore.IsAccessTokenDenied(ctx, jti)
+	if err != nil {
+		log.Error("failed to check access token denylist", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if denied {
+		log.Warn("access token is revoked", slog.String("sub", subject))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+	}
+
+	if claims["type"] == "client" {
+		scope, _ := claims["scope"].(string)
+		return &models.AuthCheck{
+			IsValid:  token.Valid,
+			ClientID: subject,
+			Scopes:   strings.Fields(scope),
+		}, nil
+	}
+
+	// Impersonation token keeps the admin in the "act" claim
+	var actorID string
+	if act, ok := claims["act"].(map[string]interface{}); ok {
+		actorID, _ = act["sub"].(string)
+		if actorID == "" {
+			log.Error("invalid act claim")
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+		}
+	}
+
+	// Deactivated users are denied until their last access token expires.
+	// Impersonation ends too when the admin is deactivated.
+	for _, userID := range []string{subject, actorID} {
+		if userID == "" {
+			continue
+		}
+		userDenied, err := ah.tokenRedisStore.IsUserDenied(ctx, userID)
+		if err != nil {
+			log.Error("failed to check user denylist", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if userDenied {
+			log.Warn("user is deactivated", slog.String("sub", userID))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+		}
+	}
+
 	return &models.AuthCheck{
 		IsValid: token.Valid,
-		UserId:  userID,
+		UserId:  subject,
+		ActorID: actorID,
 	}, nil
 }
 
+// generateTokens issues tokens of the session. Refresh token records
+// the client from which the session is used.
 func (ah *AuthHandlers) generateTokens(
 	ctx context.Context,
 	log *slog.Logger,
 	userID string,
+	familyID string,
+	sessionCreated time.Time,
 ) (*models.LogInTokens, error) {
-	// Checks refresh-token exists
-	existingRefreshToken, err := ah.tokenRedisStore.FindRefreshToken(ctx, userID)
-	if err != nil {
-		switch {
-		case errors.Is(err, storage.ErrNoTokensFound):
-			log.Info("refresh token not found", slog.String("err", err.Error()))
-		case errors.Is(err, storage.ErrUserIdConversion):
-			log.Warn("invalid user id", slog.String("err", err.Error()))
-		default:
-			log.Error("failed to get refresh token", slog.String("err", err.Error()))
-		}
-	}
-
 	// Generate new access-token
-	accessToken, err := ah.jwtManager.IssueAccessToken(userID)
+	accessToken, accessJTI, err := ah.jwtManager.IssueAccessToken(userID)
 	if err != nil {
 		log.Info("failed to generate access token", slog.String("err", err.Error()))
 		return nil, fmt.Errorf("%w", ErrAccessTokenGen)
 	}
 
-	// If refresh-token exists, return it
-	if existingRefreshToken != nil {
-		log.Info("refresh token found in redis")
-		return &models.LogInTokens{
-			AccessToken:  accessToken,
-			RefreshToken: existingRefreshToken.Token,
-		}, nil
-	}
-
 	// Generate new refresh-token
-	refreshToken, err := ah.jwtManager.IssueRefreshToken(userID)
+	refreshToken, err := ah.jwtManager.IssueRefreshToken(userID, familyID)
 	if err != nil {
 		log.Info("failed to generate refresh token", slog.String("err", err.Error()))
 		return nil, fmt.Errorf("%w", ErrRefreshTokenGen)
@@ -505,10 +1025,17 @@ func (ah *AuthHandlers) generateTokens(
 	expireRefresh := time.Now().Add(ah.jwtManager.GetRefreshExpiresIn())
 
 	// Store refresh-token to DB
+	client := clientinfo.FromContext(ctx)
 	refToken := &models.CreateRefreshToken{
-		UserID:    userID,
-		Token:     refreshToken,
-		ExpiresAt: expireRefresh,
+		UserID:         userID,
+		Token:          refreshToken,
+		FamilyID:       familyID,
+		ExpiresAt:      expireRefresh,
+		Created:        time.Now(),
+		SessionCreated: sessionCreated,
+		UserAgent:      client.UserAgent,
+		IP:             client.IP,
+		AccessJTI:      accessJTI,
 	}
 	if err := ah.tokenProvider.SaveRefreshTokenToDB(ctx, refToken); err != nil {
 		log.Error("failed to save refresh token to database", slog.String("err", err.Error()))
@@ -519,6 +1046,7 @@ func (ah *AuthHandlers) generateTokens(
 	refTokenToRedis := &redis.CreateRefreshToken{
 		UserID:    userID,
 		Token:     refreshToken,
+		FamilyID:  familyID,
 		ExpiresAt: expireRefresh,
 	}
 	if err := ah.tokenRedisStore.SaveRefreshTokenToRedis(ctx, refTokenToRedis); err != nil {
@@ -531,3 +1059,31 @@ func (ah *AuthHandlers) generateTokens(
 		RefreshToken: refreshToken,
 	}, nil
 }
+
+// newTokenFamilyID returns id for the chain of refresh tokens
+// started by a single login.
+func newTokenFamilyID() string {
+	return primitive.NewObjectID().Hex()
+}
+
+// rehashPassword replaces hash made with outdated algorithm or parameters
+// while the plain password is known. Failure doesn't fail the login,
+// the next one tries again.
+func (ah *AuthHandlers) rehashPassword(ctx context.Context, log *slog.Logger, user *models.User, password string) {
+	if !ah.passwordHasher.NeedsRehash(user.PassHash) {
+		return
+	}
+
+	passHash, err := ah.passwordHasher.HashPassword(password)
+	if err != nil {
+		log.Error("failed to rehash password", slog.String("err", err.Error()))
+		return
+	}
+
+	if err := ah.usrSaver.UpdatePassword(ctx, user.ID, passHash); err != nil {
+		log.Error("failed to save rehashed password", slog.String("err", err.Error()))
+		return
+	}
+
+	log.Info("password rehashed")
+}
@@ -0,0 +1,558 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/rabbitmq"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/DimTur/lp_auth/internal/services/storage/redis"
+	"github.com/DimTur/lp_auth/internal/services/storage/sqlite"
+	"github.com/DimTur/lp_auth/internal/utils/passpolicy"
+	"github.com/DimTur/lp_auth/pkg/crypto"
+	"github.com/DimTur/lp_auth/pkg/jwt"
+	"github.com/go-playground/validator/v10"
+)
+
+// testSuite is the auth service on top of SQLite storage. Redis and
+// RabbitMQ are replaced with in-memory fakes.
+type testSuite struct {
+	auth      *AuthHandlers
+	storage   *sqlite.SQLiteStorage
+	redis     *memRedis
+	publisher *memPublisher
+	audit     *memAudit
+}
+
+func newTestSuite(t *testing.T) *testSuite {
+	t.Helper()
+
+	ctx := context.Background()
+
+	dbPath := filepath.Join(t.TempDir(), "sso.db")
+	applyMigrations(t, dbPath)
+
+	db, err := sqlite.New(ctx, dbPath)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { db.Close(ctx) })
+
+	_, privKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	jwtManager, err := jwt.NewJWTManager("http://sso.test", time.Minute, time.Hour, time.Hour, []jwt.Key{{ID: "test", PrivateKey: privKey}}, "")
+	if err != nil {
+		t.Fatalf("new jwt manager: %v", err)
+	}
+
+	policy, err := passpolicy.New(8, 64, "")
+	if err != nil {
+		t.Fatalf("new password policy: %v", err)
+	}
+
+	s := &testSuite{
+		storage:   db,
+		redis:     newMemRedis(),
+		publisher: &memPublisher{},
+		audit:     &memAudit{},
+	}
+	s.auth = New(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		validator.New(),
+		db,
+		db,
+		db,
+		s.redis,
+		s.redis,
+		s.redis,
+		s.redis,
+		s.publisher,
+		crypto.NewPasswordHasher(crypto.Argon2Params{Memory: 8 * 1024, Iterations: 1, Parallelism: 1}),
+		policy,
+		jwtManager,
+		s.audit,
+	)
+
+	return s
+}
+
+// applyMigrations creates the schema like cmd/migrator does.
+func applyMigrations(t *testing.T, dbPath string) {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join("..", "..", "..", "migrations", "sqlite", "*.up.sql"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("find migrations: %v", err)
+	}
+	sort.Strings(files)
+
+	// driver is registered by the sqlite storage package
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	defer db.Close()
+
+	for _, file := range files {
+		query, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("read migration: %v", err)
+		}
+		if _, err := db.Exec(string(query)); err != nil {
+			t.Fatalf("apply migration %s: %v", filepath.Base(file), err)
+		}
+	}
+}
+
+// registerUser registers and verifies the user.
+func (s *testSuite) registerUser(t *testing.T, email, password string) {
+	t.Helper()
+
+	ctx := context.Background()
+	if err := s.auth.RegisterUser(ctx, models.CreateUser{Email: email, Password: password, Name: "Test"}); err != nil {
+		t.Fatalf("register user: %v", err)
+	}
+	if err := s.auth.VerifyEmail(ctx, &models.VerifyEmail{Token: s.publisher.lastVerificationToken(t)}); err != nil {
+		t.Fatalf("verify email: %v", err)
+	}
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	s := newTestSuite(t)
+	ctx := context.Background()
+
+	const (
+		email    = "student@example.com"
+		password = "long enough password"
+	)
+
+	if err := s.auth.RegisterUser(ctx, models.CreateUser{Email: email, Password: password}); err != nil {
+		t.Fatalf("RegisterUser() error = %v", err)
+	}
+
+	if _, err := s.auth.LoginUser(ctx, email, password); !errors.Is(err, ErrEmailNotVerified) {
+		t.Fatalf("LoginUser() before verification error = %v, want %v", err, ErrEmailNotVerified)
+	}
+
+	if err := s.auth.VerifyEmail(ctx, &models.VerifyEmail{Token: s.publisher.lastVerificationToken(t)}); err != nil {
+		t.Fatalf("VerifyEmail() error = %v", err)
+	}
+
+	tokens, err := s.auth.LoginUser(ctx, email, password)
+	if err != nil {
+		t.Fatalf("LoginUser() error = %v", err)
+	}
+	if tokens.AccessToken == "" || tokens.RefreshToken == "" {
+		t.Fatalf("LoginUser() returned empty tokens: %+v", tokens)
+	}
+
+	check, err := s.auth.AuthCheck(ctx, tokens.AccessToken)
+	if err != nil {
+		t.Fatalf("AuthCheck() error = %v", err)
+	}
+	if !check.IsValid {
+		t.Error("AuthCheck() access token is not valid")
+	}
+
+	if err := s.auth.RegisterUser(ctx, models.CreateUser{Email: email, Password: password}); !errors.Is(err, ErrUserExists) {
+		t.Errorf("RegisterUser() for existing email error = %v, want %v", err, ErrUserExists)
+	}
+}
+
+func TestRegisterUserErrors(t *testing.T) {
+	s := newTestSuite(t)
+
+	tests := []struct {
+		name    string
+		user    models.CreateUser
+		wantErr error
+	}{
+		{name: "invalid email", user: models.CreateUser{Email: "not an email", Password: "long enough password"}, wantErr: ErrInvalidCredentials},
+		{name: "empty password", user: models.CreateUser{Email: "user@example.com"}, wantErr: ErrInvalidCredentials},
+		{name: "short password", user: models.CreateUser{Email: "user@example.com", Password: "short"}, wantErr: ErrWeakPassword},
+		{name: "password contains email", user: models.CreateUser{Email: "student@example.com", Password: "student-password"}, wantErr: ErrWeakPassword},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := s.auth.RegisterUser(context.Background(), tt.user); !errors.Is(err, tt.wantErr) {
+				t.Errorf("RegisterUser() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoginUserErrors(t *testing.T) {
+	s := newTestSuite(t)
+	s.registerUser(t, "student@example.com", "long enough password")
+
+	tests := []struct {
+		name     string
+		email    string
+		password string
+		wantErr  error
+	}{
+		{name: "unknown email", email: "nobody@example.com", password: "long enough password", wantErr: ErrUserNotFound},
+		{name: "wrong password", email: "student@example.com", password: "wrong password", wantErr: ErrInvalidCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.auth.LoginUser(context.Background(), tt.email, tt.password); !errors.Is(err, tt.wantErr) {
+				t.Errorf("LoginUser() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRefreshTokenRotation(t *testing.T) {
+	s := newTestSuite(t)
+	ctx := context.Background()
+
+	s.registerUser(t, "student@example.com", "long enough password")
+
+	first, err := s.auth.LoginUser(ctx, "student@example.com", "long enough password")
+	if err != nil {
+		t.Fatalf("LoginUser() error = %v", err)
+	}
+
+	second, err := s.auth.RefreshToken(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshToken() error = %v", err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Fatal("RefreshToken() didn't rotate refresh token")
+	}
+
+	// Rotated token is presented again, the family is revoked
+	if _, err := s.auth.RefreshToken(ctx, first.RefreshToken); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("RefreshToken() with rotated token error = %v, want %v", err, ErrRefreshTokenReused)
+	}
+	if _, err := s.auth.RefreshToken(ctx, second.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("RefreshToken() with revoked family error = %v, want %v", err, ErrInvalidRefreshToken)
+	}
+	if _, err := s.auth.RefreshToken(ctx, "garbage"); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("RefreshToken() with invalid token error = %v, want %v", err, ErrInvalidRefreshToken)
+	}
+}
+
+// memRedis implements redis stores of the service in memory.
+type memRedis struct {
+	mu            sync.Mutex
+	refreshTokens map[string]*redis.RefreshTokenFromRedis
+	deniedJTIs    map[string]struct{}
+	otps          map[string]*redis.UserOTPFromRedis
+	failures      map[string]int64
+	locks         map[string]time.Time
+	tgLinkTokens  map[string]string
+	tgLinkTTLs    map[string]time.Duration
+}
+
+func TestRefreshTokenReuseRevokesFamily(t *testing.T) {
+	s := newTestSuite(t)
+	ctx := context.Background()
+
+	s.registerUser(t, "student@example.com", "long enough password")
+
+	// Two logins are two sessions with own token families
+	laptop, err := s.auth.LoginUser(ctx, "student@example.com", "long enough password")
+	if err != nil {
+		t.Fatalf("LoginUser() error = %v", err)
+	}
+	phone, err := s.auth.LoginUser(ctx, "student@example.com", "long enough password")
+	if err != nil {
+		t.Fatalf("LoginUser() error = %v", err)
+	}
+
+	rotated, err := s.auth.RefreshToken(ctx, laptop.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshToken() error = %v", err)
+	}
+	rotatedAgain, err := s.auth.RefreshToken(ctx, rotated.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshToken() error = %v", err)
+	}
+
+	// The stolen first token of the family is replayed
+	if _, err := s.auth.RefreshToken(ctx, laptop.RefreshToken); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("RefreshToken() with rotated token error = %v, want %v", err, ErrRefreshTokenReused)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{name: "rotated token of the family", token: rotated.RefreshToken, wantErr: ErrInvalidRefreshToken},
+		{name: "current token of the family", token: rotatedAgain.RefreshToken, wantErr: ErrInvalidRefreshToken},
+		{name: "other session", token: phone.RefreshToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.auth.RefreshToken(ctx, tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RefreshToken() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func newMemRedis() *memRedis {
+	return &memRedis{
+		refreshTokens: make(map[string]*redis.RefreshTokenFromRedis),
+		deniedJTIs:    make(map[string]struct{}),
+		otps:          make(map[string]*redis.UserOTPFromRedis),
+		failures:      make(map[string]int64),
+		locks:         make(map[string]time.Time),
+		tgLinkTokens:  make(map[string]string),
+		tgLinkTTLs:    make(map[string]time.Duration),
+	}
+}
+
+func (r *memRedis) SaveRefreshTokenToRedis(_ context.Context, token *redis.CreateRefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.refreshTokens[token.Token] = &redis.RefreshTokenFromRedis{
+		UserID:   token.UserID,
+		Token:    token.Token,
+		FamilyID: token.FamilyID,
+	}
+	return nil
+}
+
+func (r *memRedis) GetRefreshToken(_ context.Context, userID, token string) (*redis.RefreshTokenFromRedis, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.refreshTokens[token]
+	if !ok || stored.UserID != userID {
+		return nil, storage.ErrTokenNotFound
+	}
+	return stored, nil
+}
+
+func (r *memRedis) DeleteRefreshToken(_ context.Context, userID, token string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.refreshTokens[token]
+	if !ok || stored.UserID != userID {
+		return storage.ErrTokenNotFound
+	}
+	delete(r.refreshTokens, token)
+	return nil
+}
+
+func (r *memRedis) DeleteRefreshTokenFamily(_ context.Context, userID, familyID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, stored := range r.refreshTokens {
+		if stored.UserID == userID && stored.FamilyID == familyID {
+			delete(r.refreshTokens, key)
+		}
+	}
+	return nil
+}
+
+func (r *memRedis) DeleteUserRefreshTokens(_ context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, stored := range r.refreshTokens {
+		if stored.UserID == userID {
+			delete(r.refreshTokens, key)
+		}
+	}
+	return nil
+}
+
+func (r *memRedis) DenyAccessToken(_ context.Context, jti string, _ time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deniedJTIs[jti] = struct{}{}
+	return nil
+}
+
+func (r *memRedis) IsAccessTokenDenied(_ context.Context, jti string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.deniedJTIs[jti]
+	return ok, nil
+}
+
+func (r *memRedis) IsUserDenied(_ context.Context, _ string) (bool, error) {
+	return false, nil
+}
+
+func (r *memRedis) SaveOTPToRedis(_ context.Context, otp *redis.CreateOTP) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.otps[otp.Purpose+otp.UserID] = &redis.UserOTPFromRedis{UserID: otp.UserID, Code: otp.Code}
+	return nil
+}
+
+func (r *memRedis) GetUserOTP(_ context.Context, purpose, userID string) (*redis.UserOTPFromRedis, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	otp, ok := r.otps[purpose+userID]
+	if !ok {
+		return nil, storage.ErrOTPNotFound
+	}
+	return otp, nil
+}
+
+func (r *memRedis) IncrOTPAttempts(_ context.Context, purpose, userID string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	otp, ok := r.otps[purpose+userID]
+	if !ok {
+		return 0, storage.ErrOTPNotFound
+	}
+	otp.Attempts++
+	return otp.Attempts, nil
+}
+
+func (r *memRedis) DeleteUserOTP(_ context.Context, purpose, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.otps[purpose+userID]; !ok {
+		return storage.ErrOTPNotFound
+	}
+	delete(r.otps, purpose+userID)
+	return nil
+}
+
+func (r *memRedis) IncrLoginFailures(_ context.Context, key string, _ time.Duration) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.failures[key]++
+	return r.failures[key], nil
+}
+
+func (r *memRedis) ResetLoginFailures(_ context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.failures, key)
+	return nil
+}
+
+func (r *memRedis) LockLogin(_ context.Context, key string, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.locks[key] = time.Now().Add(ttl)
+	return nil
+}
+
+func (r *memRedis) GetLoginLock(_ context.Context, key string) (time.Duration, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return max(time.Until(r.locks[key]), 0), nil
+}
+
+func (r *memRedis) SaveTgLinkToken(_ context.Context, token, userID string, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tgLinkTokens[token] = userID
+	r.tgLinkTTLs[token] = ttl
+	return nil
+}
+
+func (r *memRedis) PopTgLinkToken(_ context.Context, token string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	userID, ok := r.tgLinkTokens[token]
+	if !ok {
+		return "", storage.ErrTgLinkTokenNotFound
+	}
+	delete(r.tgLinkTokens, token)
+	return userID, nil
+}
+
+type publishedMessage struct {
+	exchange   string
+	routingKey string
+	body       []byte
+}
+
+// memPublisher records messages instead of sending them to RabbitMQ.
+type memPublisher struct {
+	mu       sync.Mutex
+	messages []publishedMessage
+}
+
+func (p *memPublisher) Publish(_ context.Context, exchange, routingKey string, body []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.messages = append(p.messages, publishedMessage{exchange: exchange, routingKey: routingKey, body: body})
+	return nil
+}
+
+func (p *memPublisher) PublishToQueue(_ context.Context, queueName string, body []byte) error {
+	return p.Publish(context.Background(), "", queueName, body)
+}
+
+// lastVerificationToken returns token of the last email verification message.
+func (p *memPublisher) lastVerificationToken(t *testing.T) string {
+	t.Helper()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i := len(p.messages) - 1; i >= 0; i-- {
+		if p.messages[i].routingKey != emailVerificationRoutingKey {
+			continue
+		}
+		var msg rabbitmq.MsgEmailVerification
+		if err := json.Unmarshal(p.messages[i].body, &msg); err != nil {
+			t.Fatalf("unmarshal verification message: %v", err)
+		}
+		return msg.Token
+	}
+
+	t.Fatal("no email verification message was published")
+	return ""
+}
+
+// memAudit keeps recorded audit events.
+type memAudit struct {
+	mu     sync.Mutex
+	events []models.AuditEvent
+}
+
+func (a *memAudit) Record(_ context.Context, event *models.AuditEvent) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.events = append(a.events, *event)
+}
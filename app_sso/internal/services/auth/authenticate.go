@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+// AuthenticateUser checks credentials of the user in one step without issuing tokens,
+// it is used by login forms of sso itself, e.g. OIDC authorization endpoint.
+// Users with 2FA have to pass TOTP or recovery code together with the password,
+// ErrMFARequired is returned if it is missing.
+func (ah *AuthHandlers) AuthenticateUser(ctx context.Context, creds *models.AuthenticateUser) (*models.User, error) {
+	const op = "auth.AuthenticateUser"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("username", creds.Email),
+	)
+
+	// Validation
+	err := ah.validator.Struct(creds)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("authenticating user")
+
+	if err := ah.checkLoginLock(ctx, creds.Email); err != nil {
+		log.Warn("login is locked", slog.String("err", err.Error()))
+		if errors.Is(err, ErrTooManyAttempts) {
+			ah.recordLogin(ctx, "", creds.Email, models.AuthMethodPassword, err)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	user, err := ah.usrProvider.FindUserByEmail(ctx, creds.Email)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			ah.handleLoginFailure(ctx, log, creds.Email)
+			ah.recordLogin(ctx, "", creds.Email, models.AuthMethodPassword, ErrUserNotFound)
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		}
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !ah.passwordHasher.ComparePassword(user.PassHash, creds.Password) {
+		log.Info("invalid credentials")
+		ah.handleLoginFailure(ctx, log, creds.Email)
+		ah.recordLogin(ctx, user.ID, creds.Email, models.AuthMethodPassword, ErrInvalidCredentials)
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	ah.rehashPassword(ctx, log, user, creds.Password)
+
+	if user.Status == models.UserStatusPendingVerification {
+		log.Info("email is not verified")
+		ah.recordLogin(ctx, user.ID, creds.Email, models.AuthMethodPassword, ErrEmailNotVerified)
+		return nil, fmt.Errorf("%s: %w", op, ErrEmailNotVerified)
+	}
+
+	if user.Status == models.UserStatusDeactivated {
+		log.Info("user is deactivated")
+		ah.recordLogin(ctx, user.ID, creds.Email, models.AuthMethodPassword, ErrUserDeactivated)
+		return nil, fmt.Errorf("%s: %w", op, ErrUserDeactivated)
+	}
+
+	method := models.AuthMethodPassword
+	if user.TOTP.Enabled {
+		if creds.Code == "" {
+			log.Info("second factor is required")
+			return nil, fmt.Errorf("%s: %w", op, ErrMFARequired)
+		}
+
+		method, err = ah.checkSecondFactor(ctx, user, creds.Code)
+		if err != nil {
+			log.Info("invalid second factor", slog.String("err", err.Error()))
+			if errors.Is(err, ErrInvalidCredentials) {
+				ah.handleLoginFailure(ctx, log, creds.Email)
+				ah.recordLogin(ctx, user.ID, creds.Email, models.AuthMethodTOTP, err)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := ah.resetLoginFailures(ctx, creds.Email); err != nil {
+		log.Error("failed to reset login failures", slog.String("err", err.Error()))
+	}
+
+	log.Info("user authenticated")
+	ah.recordLogin(ctx, user.ID, creds.Email, method, nil)
+
+	return user, nil
+}
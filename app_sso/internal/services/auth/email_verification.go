@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/rabbitmq"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// VerifyEmail checks verification token and activates the user.
+//
+// Token is bound to the email it was issued for, so it becomes invalid
+// once the user changes the email.
+func (ah *AuthHandlers) VerifyEmail(ctx context.Context, verify *models.VerifyEmail) error {
+	const op = "auth.VerifyEmail"
+
+	log := ah.log.With(
+		slog.String("op", op),
+	)
+
+	// Validation
+	err := ah.validator.Struct(verify)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidVerifyToken)
+	}
+
+	token, err := ah.jwtManager.VerifyToken(verify.Token)
+	if err != nil {
+		log.Warn("token verification failed", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidVerifyToken)
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid || claims["type"] != "email_verification" {
+		log.Warn("invalid token claims or type")
+		return fmt.Errorf("%s: %w", op, ErrInvalidVerifyToken)
+	}
+
+	userID, ok := claims["sub"].(string)
+	if !ok {
+		log.Warn("invalid subject claim")
+		return fmt.Errorf("%s: %w", op, ErrInvalidVerifyToken)
+	}
+
+	email, ok := claims["email"].(string)
+	if !ok {
+		log.Warn("invalid email claim")
+		return fmt.Errorf("%s: %w", op, ErrInvalidVerifyToken)
+	}
+
+	log = log.With(slog.String("user_id", userID))
+
+	log.Info("verifying email")
+
+	user, err := ah.usrProvider.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrInvalidVerifyToken)
+		}
+
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.Email != email {
+		log.Warn("token was issued for another email")
+		return fmt.Errorf("%s: %w", op, ErrInvalidVerifyToken)
+	}
+
+	if user.Status != models.UserStatusPendingVerification {
+		log.Info("email is already verified")
+		return nil
+	}
+
+	if err := ah.usrSaver.UpdateUserStatus(ctx, user.ID, models.UserStatusActive); err != nil {
+		log.Error("failed to update user status", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("email verified successfully")
+
+	return nil
+}
+
+// ResendVerification sends new verification email to the user
+// who hasn't verified the email yet. Tokens sent before stay valid
+// till they expire.
+//
+// Unknown email and verified users are not reported to the caller,
+// so the method can't be used to enumerate accounts. Emails over
+// verificationResendLimit in verificationResendWindow are dropped the same way.
+func (ah *AuthHandlers) ResendVerification(ctx context.Context, resend *models.ResendVerification) error {
+	const op = "auth.ResendVerification"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("login", resend.Email),
+	)
+
+	// Validation
+	err := ah.validator.Struct(resend)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("attempting to resend verification email")
+
+	user, err := ah.usrProvider.FindUserByEmail(ctx, resend.Email)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return nil
+		}
+
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.Status != models.UserStatusPendingVerification {
+		log.Info("email is already verified, verification email is not sent")
+		return nil
+	}
+
+	sent, err := ah.loginLimiter.IncrLoginFailures(ctx, verificationResendKey(user.Email), verificationResendWindow)
+	if err != nil {
+		log.Error("failed to count verification emails", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if sent > verificationResendLimit {
+		log.Warn("too many verification emails, verification email is not sent")
+		return nil
+	}
+
+	if err := ah.sendVerificationToken(ctx, user.ID, user.Email); err != nil {
+		log.Error("failed to send verification token", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("verification email resent")
+
+	return nil
+}
+
+// verificationResendKey is the counter of resent verification emails,
+// it is kept by the login limiter store.
+func verificationResendKey(email string) string {
+	return fmt.Sprintf("verification_resend_%s", strings.ToLower(email))
+}
+
+// sendVerificationToken issues verification token and sends it
+// to notification service.
+func (ah *AuthHandlers) sendVerificationToken(ctx context.Context, userID, email string) error {
+	token, err := ah.jwtManager.IssueVerificationToken(userID, email, emailVerificationExpiresIn)
+	if err != nil {
+		return fmt.Errorf("issue verification token: %w", err)
+	}
+
+	msgBody, err := json.Marshal(&rabbitmq.MsgEmailVerification{
+		UserID: userID,
+		Email:  email,
+		Token:  token,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal verification token: %w", err)
+	}
+
+	if err := ah.rabbitMQQueues.Publish(ctx, exchangeEmailVerification, emailVerificationRoutingKey, msgBody); err != nil {
+		return fmt.Errorf("publish verification token: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+)
+
+func TestResendVerification(t *testing.T) {
+	s := newTestSuite(t)
+	ctx := context.Background()
+
+	const email = "student@example.com"
+	if err := s.auth.RegisterUser(ctx, models.CreateUser{Email: email, Password: "long enough password", Name: "Test"}); err != nil {
+		t.Fatalf("RegisterUser() error = %v", err)
+	}
+
+	for i := 0; i < verificationResendLimit+2; i++ {
+		if err := s.auth.ResendVerification(ctx, &models.ResendVerification{Email: email}); err != nil {
+			t.Fatalf("ResendVerification() error = %v", err)
+		}
+	}
+	if got := s.publisher.count(emailVerificationRoutingKey); got != verificationResendLimit+1 {
+		t.Errorf("verification emails = %d, want %d", got, verificationResendLimit+1)
+	}
+
+	// Unknown emails aren't reported
+	if err := s.auth.ResendVerification(ctx, &models.ResendVerification{Email: "nobody@example.com"}); err != nil {
+		t.Errorf("ResendVerification() of unknown email error = %v", err)
+	}
+	if err := s.auth.ResendVerification(ctx, &models.ResendVerification{Email: "not an email"}); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("ResendVerification() of invalid email error = %v, want %v", err, ErrInvalidCredentials)
+	}
+
+	if err := s.auth.VerifyEmail(ctx, &models.VerifyEmail{Token: s.publisher.lastVerificationToken(t)}); err != nil {
+		t.Fatalf("VerifyEmail() with resent token error = %v", err)
+	}
+	if _, err := s.auth.LoginUser(ctx, email, "long enough password"); err != nil {
+		t.Errorf("LoginUser() error = %v", err)
+	}
+}
+
+func TestUpdateUserInfoEmail(t *testing.T) {
+	tests := []struct {
+		name       string
+		email      string
+		wantStatus string
+	}{
+		{name: "email changed", email: "new@example.com", wantStatus: models.UserStatusPendingVerification},
+		{name: "same email", email: "student@example.com", wantStatus: models.UserStatusActive},
+		{name: "email not set", wantStatus: models.UserStatusActive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestSuite(t)
+			ctx := context.Background()
+
+			s.registerUser(t, "student@example.com", "long enough password")
+			user, err := s.storage.FindUserByEmail(ctx, "student@example.com")
+			if err != nil {
+				t.Fatalf("FindUserByEmail() error = %v", err)
+			}
+			sent := s.publisher.count(emailVerificationRoutingKey)
+
+			if err := s.auth.UpdateUserInfo(ctx, &models.UpdateUserInfo{ID: user.ID, Email: tt.email, Name: "Renamed"}); err != nil {
+				t.Fatalf("UpdateUserInfo() error = %v", err)
+			}
+
+			user, err = s.storage.FindUserByID(ctx, user.ID)
+			if err != nil {
+				t.Fatalf("FindUserByID() error = %v", err)
+			}
+			if user.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", user.Status, tt.wantStatus)
+			}
+
+			wantSent := sent
+			if tt.wantStatus == models.UserStatusPendingVerification {
+				wantSent++
+			}
+			if got := s.publisher.count(emailVerificationRoutingKey); got != wantSent {
+				t.Errorf("verification emails = %d, want %d", got, wantSent)
+			}
+		})
+	}
+}
+
+// count returns the number of published messages with the routing key.
+func (p *memPublisher) count(routingKey string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	n := 0
+	for _, msg := range p.messages {
+		if msg.routingKey == routingKey {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// AcceptInvite sets the first password of the user imported by admin
+// and activates the user.
+//
+// Imported users have no password, so the token can't be used again
+// once the password is set.
+func (ah *AuthHandlers) AcceptInvite(ctx context.Context, accept *models.AcceptInvite) error {
+	const op = "auth.AcceptInvite"
+
+	log := ah.log.With(
+		slog.String("op", op),
+	)
+
+	// Validation
+	err := ah.validator.Struct(accept)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidInviteToken)
+	}
+
+	token, err := ah.jwtManager.VerifyToken(accept.Token)
+	if err != nil {
+		log.Warn("token verification failed", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidInviteToken)
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid || claims["type"] != "invite" {
+		log.Warn("invalid token claims or type")
+		return fmt.Errorf("%s: %w", op, ErrInvalidInviteToken)
+	}
+
+	userID, ok := claims["sub"].(string)
+	if !ok {
+		log.Warn("invalid subject claim")
+		return fmt.Errorf("%s: %w", op, ErrInvalidInviteToken)
+	}
+
+	email, ok := claims["email"].(string)
+	if !ok {
+		log.Warn("invalid email claim")
+		return fmt.Errorf("%s: %w", op, ErrInvalidInviteToken)
+	}
+
+	log = log.With(slog.String("user_id", userID))
+
+	if err := ah.passwordPolicy.Check(accept.Password, email); err != nil {
+		log.Warn("password doesn't meet policy", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w: %w", op, ErrWeakPassword, err)
+	}
+
+	log.Info("accepting invite")
+
+	user, err := ah.usrProvider.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrInvalidInviteToken)
+		}
+
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.Email != email {
+		log.Warn("token was issued for another email")
+		return fmt.Errorf("%s: %w", op, ErrInvalidInviteToken)
+	}
+
+	if user.Status != models.UserStatusPendingVerification || len(user.PassHash) != 0 {
+		log.Warn("invite is already accepted")
+		return fmt.Errorf("%s: %w", op, ErrInvalidInviteToken)
+	}
+
+	passHash, err := ah.passwordHasher.HashPassword(accept.Password)
+	if err != nil {
+		log.Error("failed to generate password hash", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := ah.usrSaver.UpdatePassword(ctx, user.ID, passHash); err != nil {
+		log.Error("failed to update password", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Invite was received by email, so the email is verified
+	if err := ah.usrSaver.UpdateUserStatus(ctx, user.ID, models.UserStatusActive); err != nil {
+		log.Error("failed to update user status", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("invite accepted")
+
+	return nil
+}
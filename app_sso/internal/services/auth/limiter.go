@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/DimTur/lp_auth/internal/utils/clientinfo"
+)
+
+// Brute-force protection. TODO: transfer to config
+//
+// Only the account is locked. Many clients can share the IP seen by sso
+// (NAT, proxies), so failures from the IP over ipFailuresLimit only slow
+// down logins from it by ipThrottleDelay, correct passwords are still accepted.
+const (
+	accountFailuresLimit = 5
+	ipFailuresLimit      = 20
+	// failuresWindow is how long failures counter lives after the last failure.
+	failuresWindow = 24 * time.Hour
+	// Lockout starts from lockoutBase and doubles with every next failure
+	// over the limit, but is never longer than lockoutMax.
+	lockoutBase = time.Minute
+	lockoutMax  = 24 * time.Hour
+
+	// otpAttemptsLimit is how many times a one-time code can be checked
+	// before it is burned.
+	otpAttemptsLimit = 5
+)
+
+// ipThrottleDelay is a var, so tests don't wait for it.
+var ipThrottleDelay = 2 * time.Second
+
+// checkLoginLock returns ErrTooManyAttempts if login to the account
+// is temporarily locked. Login from the throttled client IP
+// is delayed by ipThrottleDelay.
+func (ah *AuthHandlers) checkLoginLock(ctx context.Context, email string) error {
+	for _, key := range loginLimiterKeys(ctx, email) {
+		ttl, err := ah.loginLimiter.GetLoginLock(ctx, key)
+		if err != nil {
+			return fmt.Errorf("check login lock: %w", err)
+		}
+		if ttl <= 0 {
+			continue
+		}
+		if !isIPLimiterKey(key) {
+			return ErrTooManyAttempts
+		}
+
+		select {
+		case <-time.After(ipThrottleDelay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// handleLoginFailure increments failures counters of the account
+// and the client IP. Once the limit is exceeded, the account is locked
+// and the IP is throttled.
+// Storage errors are only logged, so the caller gets the original error.
+func (ah *AuthHandlers) handleLoginFailure(ctx context.Context, log *slog.Logger, email string) {
+	if err := ah.registerLoginFailure(ctx, email); err != nil {
+		log.Error("failed to register login failure", slog.String("err", err.Error()))
+	}
+}
+
+func (ah *AuthHandlers) registerLoginFailure(ctx context.Context, email string) error {
+	for _, key := range loginLimiterKeys(ctx, email) {
+		failures, err := ah.loginLimiter.IncrLoginFailures(ctx, key, failuresWindow)
+		if err != nil {
+			return fmt.Errorf("register login failure: %w", err)
+		}
+
+		limit := int64(accountFailuresLimit)
+		if isIPLimiterKey(key) {
+			limit = ipFailuresLimit
+		}
+		if failures < limit {
+			continue
+		}
+
+		if err := ah.loginLimiter.LockLogin(ctx, key, lockoutDuration(failures-limit)); err != nil {
+			return fmt.Errorf("lock login: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// resetLoginFailures resets failures counter of the account after successful login.
+// Counter of the client IP is kept, so it can't be reset with own account.
+func (ah *AuthHandlers) resetLoginFailures(ctx context.Context, email string) error {
+	if err := ah.loginLimiter.ResetLoginFailures(ctx, accountLimiterKey(email)); err != nil {
+		return fmt.Errorf("reset login failures: %w", err)
+	}
+
+	return nil
+}
+
+// checkOTP checks one-time code of the user for the purpose. The code is single use
+// and it is burned after otpAttemptsLimit failed checks.
+func (ah *AuthHandlers) checkOTP(ctx context.Context, purpose, userID, code string) error {
+	userOTP, err := ah.otpRedisStore.GetUserOTP(ctx, purpose, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrOTPNotFound) {
+			return ErrInvalidCredentials
+		}
+		return err
+	}
+
+	attempts, err := ah.otpRedisStore.IncrOTPAttempts(ctx, purpose, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrOTPNotFound) {
+			return ErrInvalidCredentials
+		}
+		return err
+	}
+
+	valid := subtle.ConstantTimeCompare([]byte(userOTP.Code), []byte(code)) == 1
+	if attempts > otpAttemptsLimit || (!valid && attempts == otpAttemptsLimit) {
+		if err := ah.otpRedisStore.DeleteUserOTP(ctx, purpose, userID); err != nil && !errors.Is(err, storage.ErrOTPNotFound) {
+			return err
+		}
+		return ErrOTPAttemptsExceeded
+	}
+	if !valid {
+		return ErrInvalidCredentials
+	}
+
+	if err := ah.otpRedisStore.DeleteUserOTP(ctx, purpose, userID); err != nil {
+		// Code was used by concurrent request
+		if errors.Is(err, storage.ErrOTPNotFound) {
+			return ErrInvalidCredentials
+		}
+		return err
+	}
+
+	ah.recordUserEvent(ctx, models.AuditEventOTPVerified, userID, "", "")
+
+	return nil
+}
+
+// lockoutDuration returns lockout for the given number of failures over the limit.
+func lockoutDuration(excess int64) time.Duration {
+	lockout := lockoutBase
+	for i := int64(0); i < excess && lockout < lockoutMax; i++ {
+		lockout *= 2
+	}
+
+	return min(lockout, lockoutMax)
+}
+
+func loginLimiterKeys(ctx context.Context, email string) []string {
+	keys := []string{accountLimiterKey(email)}
+	if ip := clientinfo.FromContext(ctx).IP; ip != "" {
+		keys = append(keys, fmt.Sprintf("ip_%s", ip))
+	}
+
+	return keys
+}
+
+func isIPLimiterKey(key string) bool {
+	return strings.HasPrefix(key, "ip_")
+}
+
+func accountLimiterKey(email string) string {
+	return fmt.Sprintf("user_%s", strings.ToLower(email))
+}
@@ -0,0 +1,188 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/services/storage/redis"
+	"github.com/DimTur/lp_auth/internal/utils/clientinfo"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestLockoutDuration(t *testing.T) {
+	tests := []struct {
+		excess int64
+		want   time.Duration
+	}{
+		{excess: 0, want: time.Minute},
+		{excess: 1, want: 2 * time.Minute},
+		{excess: 3, want: 8 * time.Minute},
+		{excess: 10, want: 1024 * time.Minute},
+		{excess: 11, want: lockoutMax},
+		{excess: 1000, want: lockoutMax},
+	}
+
+	for _, tt := range tests {
+		if got := lockoutDuration(tt.excess); got != tt.want {
+			t.Errorf("lockoutDuration(%d) = %v, want %v", tt.excess, got, tt.want)
+		}
+	}
+}
+
+func TestLoginLimiterKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		email string
+		want  []string
+	}{
+		{name: "without client ip", ctx: context.Background(), email: "User@Example.com", want: []string{"user_user@example.com"}},
+		{name: "with client ip", ctx: clientContext(t, "10.0.0.1"), email: "user@example.com", want: []string{"user_user@example.com", "ip_10.0.0.1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := loginLimiterKeys(tt.ctx, tt.email)
+			if len(got) != len(tt.want) {
+				t.Fatalf("loginLimiterKeys() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("loginLimiterKeys() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestLoginLockout(t *testing.T) {
+	s := newTestSuite(t)
+	ctx := context.Background()
+
+	const (
+		email    = "student@example.com"
+		password = "long enough password"
+	)
+	s.registerUser(t, email, password)
+
+	for i := 0; i < accountFailuresLimit; i++ {
+		if _, err := s.auth.LoginUser(ctx, email, "wrong password"); !errors.Is(err, ErrInvalidCredentials) {
+			t.Fatalf("attempt %d: LoginUser() error = %v, want %v", i+1, err, ErrInvalidCredentials)
+		}
+	}
+
+	// Correct password doesn't help while the account is locked
+	if _, err := s.auth.LoginUser(ctx, email, password); !errors.Is(err, ErrTooManyAttempts) {
+		t.Fatalf("LoginUser() of locked account error = %v, want %v", err, ErrTooManyAttempts)
+	}
+
+	ttl, err := s.redis.GetLoginLock(ctx, accountLimiterKey(email))
+	if err != nil {
+		t.Fatalf("GetLoginLock() error = %v", err)
+	}
+	if ttl <= 0 || ttl > lockoutBase {
+		t.Errorf("lockout = %v, want up to %v", ttl, lockoutBase)
+	}
+
+	// Once the lock is gone, successful login resets the counter
+	s.redis.locks = make(map[string]time.Time)
+	if _, err := s.auth.LoginUser(ctx, email, password); err != nil {
+		t.Fatalf("LoginUser() after lockout error = %v", err)
+	}
+	if failures := s.redis.failures[accountLimiterKey(email)]; failures != 0 {
+		t.Errorf("account failures = %d after successful login, want 0", failures)
+	}
+}
+
+func TestLoginThrottleByIP(t *testing.T) {
+	s := newTestSuite(t)
+	ctx := clientContext(t, "10.0.0.1")
+
+	delay := ipThrottleDelay
+	ipThrottleDelay = 50 * time.Millisecond
+	t.Cleanup(func() { ipThrottleDelay = delay })
+
+	s.registerUser(t, "student@example.com", "long enough password")
+
+	// Failures for different accounts from the same ip
+	for i := 0; i < ipFailuresLimit; i++ {
+		if _, err := s.auth.LoginUser(ctx, "nobody@example.com", "wrong password"); err == nil {
+			t.Fatalf("attempt %d: LoginUser() of unknown user succeeded", i+1)
+		}
+		s.redis.failures[accountLimiterKey("nobody@example.com")] = 0
+		delete(s.redis.locks, accountLimiterKey("nobody@example.com"))
+	}
+
+	// Users behind the same ip aren't locked out, only slowed down
+	start := time.Now()
+	if _, err := s.auth.LoginUser(ctx, "student@example.com", "long enough password"); err != nil {
+		t.Fatalf("LoginUser() from throttled ip error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < ipThrottleDelay {
+		t.Errorf("LoginUser() from throttled ip took %v, want at least %v", elapsed, ipThrottleDelay)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := s.auth.LoginUser(canceled, "student@example.com", "long enough password"); !errors.Is(err, context.Canceled) {
+		t.Errorf("LoginUser() with canceled context error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestCheckOTP(t *testing.T) {
+	const userID = "user"
+
+	tests := []struct {
+		name string
+		// codes are checked one by one, the last one gives wantErr
+		codes   []string
+		wantErr error
+	}{
+		{name: "valid code", codes: []string{"123456"}},
+		{name: "invalid code", codes: []string{"000000"}, wantErr: ErrInvalidCredentials},
+		{name: "valid after failures", codes: []string{"000000", "000000", "123456"}},
+		{name: "burned on last attempt", codes: []string{"0", "0", "0", "0", "000000"}, wantErr: ErrOTPAttemptsExceeded},
+		{name: "valid code after burn", codes: []string{"0", "0", "0", "0", "0", "123456"}, wantErr: ErrInvalidCredentials},
+		{name: "code is single use", codes: []string{"123456", "123456"}, wantErr: ErrInvalidCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestSuite(t)
+			ctx := context.Background()
+
+			if err := s.redis.SaveOTPToRedis(ctx, &redis.CreateOTP{Purpose: redis.OTPPurposeLogin, UserID: userID, Code: "123456"}); err != nil {
+				t.Fatalf("SaveOTPToRedis() error = %v", err)
+			}
+
+			var err error
+			for _, code := range tt.codes {
+				err = s.auth.checkOTP(ctx, redis.OTPPurposeLogin, userID, code)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkOTP() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// clientContext returns context with client info like gRPC requests
+// from api gateway have.
+func clientContext(t *testing.T, ip string) context.Context {
+	t.Helper()
+
+	md := metadata.New(map[string]string{"x-client-ip": ip})
+	ctx, err := clientinfo.UnaryServerInterceptor(
+		metadata.NewIncomingContext(context.Background(), md),
+		nil,
+		nil,
+		func(ctx context.Context, _ any) (any, error) { return ctx, nil },
+	)
+	if err != nil {
+		t.Fatalf("client info interceptor: %v", err)
+	}
+
+	return ctx.(context.Context)
+}
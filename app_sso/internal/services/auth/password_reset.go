@@ -0,0 +1,155 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/DimTur/lp_auth/internal/services/storage/redis"
+)
+
+// RequestPasswordReset sends one-time code for password reset
+// to the telegram chat of the user. The code is kept apart from
+// login codes, it can't be used to log in.
+//
+// Unknown email and user without linked telegram are not reported
+// to the caller, so the method can't be used to enumerate accounts.
+func (ah *AuthHandlers) RequestPasswordReset(ctx context.Context, reset *models.RequestPasswordReset) error {
+	const op = "auth.RequestPasswordReset"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("login", reset.Email),
+	)
+
+	// Validation
+	err := ah.validator.Struct(reset)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("attempting to send password reset otp")
+
+	user, err := ah.usrProvider.FindUserByEmail(ctx, reset.Email)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return nil
+		}
+
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.ChatID == "" {
+		log.Warn("telegram is not linked, password reset otp is not sent")
+		return nil
+	}
+
+	if user.Status == models.UserStatusDeactivated {
+		log.Warn("user is deactivated, password reset otp is not sent")
+		return nil
+	}
+
+	if err := ah.sendOTP(ctx, redis.OTPPurposePasswordReset, user.ID, user.ChatID); err != nil {
+		log.Error("failed to send otp", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("password reset otp sent")
+
+	return nil
+}
+
+// ConfirmPasswordReset checks one-time code and sets new password of the user.
+//
+// All refresh tokens of the user are revoked after the password is changed.
+func (ah *AuthHandlers) ConfirmPasswordReset(ctx context.Context, reset *models.ConfirmPasswordReset) error {
+	const op = "auth.ConfirmPasswordReset"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("login", reset.Email),
+	)
+
+	// Validation
+	err := ah.validator.Struct(reset)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	// Checked before the code, so the code isn't spent on a rejected password
+	if err := ah.passwordPolicy.Check(reset.NewPassword, reset.Email); err != nil {
+		log.Warn("password doesn't meet policy", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w: %w", op, ErrWeakPassword, err)
+	}
+
+	log.Info("attempting to reset password")
+
+	if err := ah.checkLoginLock(ctx, reset.Email); err != nil {
+		log.Warn("login is locked", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	user, err := ah.usrProvider.FindUserByEmail(ctx, reset.Email)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			ah.handleLoginFailure(ctx, log, reset.Email)
+			return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		}
+
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := ah.checkOTP(ctx, redis.OTPPurposePasswordReset, user.ID, reset.Code); err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidCredentials), errors.Is(err, ErrOTPAttemptsExceeded):
+			log.Info("invalid otp", slog.String("err", err.Error()))
+			ah.handleLoginFailure(ctx, log, reset.Email)
+		default:
+			log.Error("failed to check otp", slog.String("err", err.Error()))
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	passHash, err := ah.passwordHasher.HashPassword(reset.NewPassword)
+	if err != nil {
+		log.Error("failed to generate password hash", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := ah.usrSaver.UpdatePassword(ctx, user.ID, passHash); err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		}
+
+		log.Error("failed to update password", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := ah.tokenProvider.RevokeUserRefreshTokens(ctx, user.ID); err != nil {
+		log.Error("failed to revoke refresh tokens", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := ah.tokenRedisStore.DeleteUserRefreshTokens(ctx, user.ID); err != nil {
+		log.Error("failed to delete refresh tokens from redis", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := ah.resetLoginFailures(ctx, reset.Email); err != nil {
+		log.Error("failed to reset login failures", slog.String("err", err.Error()))
+	}
+
+	log.Info("password reset successfully")
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/rabbitmq"
+	"github.com/DimTur/lp_auth/internal/services/storage/redis"
+)
+
+func TestPasswordResetCode(t *testing.T) {
+	s := newTestSuite(t)
+	ctx := context.Background()
+
+	const email = "student@example.com"
+	s.registerUser(t, email, "long enough password")
+
+	user, err := s.storage.FindUserByEmail(ctx, email)
+	if err != nil {
+		t.Fatalf("FindUserByEmail() error = %v", err)
+	}
+	if err := s.storage.SetUserChatID(ctx, user.ID, "12345"); err != nil {
+		t.Fatalf("SetUserChatID() error = %v", err)
+	}
+
+	if err := s.auth.RequestPasswordReset(ctx, &models.RequestPasswordReset{Email: email}); err != nil {
+		t.Fatalf("RequestPasswordReset() error = %v", err)
+	}
+	resetCode := s.publisher.lastOTP(t, redis.OTPPurposePasswordReset)
+
+	// Login code doesn't replace pending reset code
+	if err := s.auth.LogInViaTg(ctx, &models.LogInViaTg{Email: email}); err != nil {
+		t.Fatalf("LogInViaTg() error = %v", err)
+	}
+	loginCode := s.publisher.lastOTP(t, redis.OTPPurposeLogin)
+
+	if resetCode == loginCode {
+		t.Skip("random codes are equal")
+	}
+
+	if _, err := s.auth.CheckOTP(ctx, &models.LoginUserOTP{Email: email, Code: resetCode}); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("CheckOTP() with reset code error = %v, want %v", err, ErrInvalidCredentials)
+	}
+	if err := s.auth.ConfirmPasswordReset(ctx, &models.ConfirmPasswordReset{Email: email, Code: loginCode, NewPassword: "new long password"}); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("ConfirmPasswordReset() with login code error = %v, want %v", err, ErrInvalidCredentials)
+	}
+
+	if err := s.auth.ConfirmPasswordReset(ctx, &models.ConfirmPasswordReset{Email: email, Code: resetCode, NewPassword: "new long password"}); err != nil {
+		t.Fatalf("ConfirmPasswordReset() error = %v", err)
+	}
+	if _, err := s.auth.LoginUser(ctx, email, "new long password"); err != nil {
+		t.Errorf("LoginUser() with new password error = %v", err)
+	}
+}
+
+// lastOTP returns the code of the last published one-time code message of the purpose.
+func (p *memPublisher) lastOTP(t *testing.T, purpose string) string {
+	t.Helper()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i := len(p.messages) - 1; i >= 0; i-- {
+		if p.messages[i].routingKey != otpRoutingKey {
+			continue
+		}
+		var msg rabbitmq.MsgOTP
+		if err := json.Unmarshal(p.messages[i].body, &msg); err != nil {
+			t.Fatalf("unmarshal otp message: %v", err)
+		}
+		if msg.Otp.Purpose == purpose {
+			return msg.Otp.Code
+		}
+	}
+
+	t.Fatalf("no %s otp message was published", purpose)
+	return ""
+}
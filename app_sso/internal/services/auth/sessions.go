@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+// ListSessions returns active sessions of the user, most recently used first.
+func (ah *AuthHandlers) ListSessions(ctx context.Context, userID string) ([]*models.Session, error) {
+	const op = "auth.ListSessions"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("user_id", userID),
+	)
+
+	log.Info("listing sessions")
+
+	sessions, err := ah.tokenProvider.FindUserSessions(ctx, userID)
+	if err != nil {
+		log.Error("failed to get sessions", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession finishes the session of the user on another device.
+//
+// Refresh tokens of the session are revoked and its last access token
+// goes to denylist. Access tokens issued to the session before the last
+// refresh aren't tracked, they stay valid until they expire (at most
+// access token lifetime).
+func (ah *AuthHandlers) RevokeSession(ctx context.Context, revoke *models.RevokeSession) error {
+	const op = "auth.RevokeSession"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("user_id", revoke.UserID),
+		slog.String("session_id", revoke.SessionID),
+	)
+
+	// Validation
+	err := ah.validator.Struct(revoke)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("revoking session")
+
+	token, err := ah.tokenProvider.FindSessionToken(ctx, revoke.UserID, revoke.SessionID)
+	if err != nil {
+		if errors.Is(err, storage.ErrTokenNotFound) {
+			log.Warn("session not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrSessionNotFound)
+		}
+
+		log.Error("failed to get session", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := ah.revokeTokenFamily(ctx, revoke.UserID, revoke.SessionID); err != nil {
+		log.Error("failed to revoke token family", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Tokens issued before sessions were introduced have no access jti.
+	// Access token is issued together with the refresh token, so the
+	// denylist entry is kept only until the access token expires.
+	accessExpiresAt := token.Created.Add(ah.jwtManager.GetAccessExpiresIn())
+	if token.AccessJTI != "" && time.Now().Before(accessExpiresAt) {
+		if err := ah.tokenRedisStore.DenyAccessToken(ctx, token.AccessJTI, accessExpiresAt); err != nil {
+			log.Error("failed to deny access token", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	log.Info("session revoked")
+
+	return nil
+}
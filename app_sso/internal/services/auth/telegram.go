@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+// IssueTgLinkToken issues one-time token which links telegram chat to the user.
+// The bot gets the token from /start deep link and sends it back with the chat ID,
+// so the chat is linked only by the user who has the token.
+func (ah *AuthHandlers) IssueTgLinkToken(ctx context.Context, userID string) (*models.TgLinkToken, error) {
+	const op = "auth.IssueTgLinkToken"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("user_id", userID),
+	)
+
+	if userID == "" {
+		log.Warn("empty user id")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	user, err := ah.usrProvider.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if user.Status == models.UserStatusDeactivated {
+		log.Warn("user is deactivated")
+		return nil, fmt.Errorf("%s: %w", op, ErrUserDeactivated)
+	}
+
+	log.Info("issuing telegram link token")
+
+	token, err := newTgLinkToken()
+	if err != nil {
+		log.Error("failed to generate telegram link token", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := ah.tgLinkStore.SaveTgLinkToken(ctx, token, userID, tgLinkTokenExpiresIn); err != nil {
+		log.Error("failed to save telegram link token", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &models.TgLinkToken{
+		Token:     token,
+		ExpiresAt: time.Now().Add(tgLinkTokenExpiresIn),
+	}, nil
+}
+
+// LinkTelegram links the chat to the user of the token and burns the token.
+// The chat is unlinked from the account it was linked to before.
+func (ah *AuthHandlers) LinkTelegram(ctx context.Context, token, chatID string) error {
+	const op = "auth.LinkTelegram"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("chat_id", chatID),
+	)
+
+	if token == "" || chatID == "" {
+		log.Warn("empty token or chat id")
+		return fmt.Errorf("%s: %w", op, ErrInvalidTgLinkToken)
+	}
+
+	userID, err := ah.tgLinkStore.PopTgLinkToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, storage.ErrTgLinkTokenNotFound) {
+			log.Warn("telegram link token is used or expired", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrInvalidTgLinkToken)
+		}
+
+		log.Error("failed to get telegram link token", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log = log.With(slog.String("user_id", userID))
+	log.Info("linking telegram")
+
+	if err := ah.usrSaver.SetUserChatID(ctx, userID, chatID); err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to save user chat id", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	ah.recordUserEvent(ctx, models.AuditEventTelegramLink, userID, "", "")
+
+	log.Info("telegram linked")
+
+	return nil
+}
+
+// UnlinkTelegram unlinks telegram chat of the user. OTPs can't be sent
+// to the user until the chat is linked again.
+func (ah *AuthHandlers) UnlinkTelegram(ctx context.Context, userID string) error {
+	const op = "auth.UnlinkTelegram"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("user_id", userID),
+	)
+
+	if userID == "" {
+		log.Warn("empty user id")
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	chatID, err := ah.usrProvider.GetExistChatID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to get user chat id", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if chatID == "" {
+		log.Info("telegram is not linked")
+		return fmt.Errorf("%s: %w", op, ErrTelegramNotLinked)
+	}
+
+	if err := ah.unlinkTelegram(ctx, log, userID, "profile"); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// UnlinkTelegramChat unlinks the chat from its user on /unlink command of the bot.
+func (ah *AuthHandlers) UnlinkTelegramChat(ctx context.Context, chatID string) error {
+	const op = "auth.UnlinkTelegramChat"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("chat_id", chatID),
+	)
+
+	if chatID == "" {
+		log.Warn("empty chat id")
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	user, err := ah.usrProvider.FindUserByChatID(ctx, chatID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Info("chat is not linked", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrTelegramNotLinked)
+		}
+
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := ah.unlinkTelegram(ctx, log.With(slog.String("user_id", user.ID)), user.ID, "bot"); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (ah *AuthHandlers) unlinkTelegram(ctx context.Context, log *slog.Logger, userID, source string) error {
+	log.Info("unlinking telegram")
+
+	if err := ah.usrSaver.UnsetUserChatID(ctx, userID); err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return ErrUserNotFound
+		}
+
+		log.Error("failed to unset user chat id", slog.String("err", err.Error()))
+		return err
+	}
+
+	ah.recordUserEvent(ctx, models.AuditEventTelegramUnlink, userID, "", source)
+
+	log.Info("telegram unlinked")
+
+	return nil
+}
+
+func newTgLinkToken() (string, error) {
+	b := make([]byte, tgLinkTokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
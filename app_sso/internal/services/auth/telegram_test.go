@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+)
+
+func TestLinkTelegram(t *testing.T) {
+	s := newTestSuite(t)
+	ctx := context.Background()
+
+	var userIDs []string
+	for _, email := range []string{"first@example.com", "second@example.com"} {
+		s.registerUser(t, email, "long enough password")
+		user, err := s.storage.FindUserByEmail(ctx, email)
+		if err != nil {
+			t.Fatalf("FindUserByEmail() error = %v", err)
+		}
+		userIDs = append(userIDs, user.ID)
+	}
+	first, second := userIDs[0], userIDs[1]
+
+	link := func(userID string) string {
+		t.Helper()
+
+		token, err := s.auth.IssueTgLinkToken(ctx, userID)
+		if err != nil {
+			t.Fatalf("IssueTgLinkToken() error = %v", err)
+		}
+		if ttl := s.redis.tgLinkTTLs[token.Token]; ttl != tgLinkTokenExpiresIn {
+			t.Errorf("telegram link token ttl = %v, want %v", ttl, tgLinkTokenExpiresIn)
+		}
+		if err := s.auth.LinkTelegram(ctx, token.Token, "chat"); err != nil {
+			t.Fatalf("LinkTelegram() error = %v", err)
+		}
+		return token.Token
+	}
+
+	token := link(first)
+	if err := s.auth.LinkTelegram(ctx, token, "other chat"); !errors.Is(err, ErrInvalidTgLinkToken) {
+		t.Errorf("LinkTelegram() with used token error = %v, want %v", err, ErrInvalidTgLinkToken)
+	}
+
+	// The chat is moved to the second account
+	link(second)
+
+	tests := []struct {
+		name   string
+		userID string
+		want   string
+	}{
+		{name: "previous account", userID: first, want: ""},
+		{name: "new account", userID: second, want: "chat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatID, err := s.storage.GetExistChatID(ctx, tt.userID)
+			if err != nil {
+				t.Fatalf("GetExistChatID() error = %v", err)
+			}
+			if chatID != tt.want {
+				t.Errorf("chat id = %q, want %q", chatID, tt.want)
+			}
+		})
+	}
+
+	if got := s.audit.count(models.AuditEventTelegramLink); got != 2 {
+		t.Errorf("telegram link audit events = %d, want 2", got)
+	}
+}
+
+// count returns the number of recorded audit events of the type.
+func (a *memAudit) count(eventType string) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	n := 0
+	for _, event := range a.events {
+		if event.Type == eventType {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,339 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/DimTur/lp_auth/internal/utils/otp"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// EnrollTOTP generates new TOTP secret of the user.
+//
+// 2FA stays disabled until the first code is checked by VerifyTOTP.
+func (ah *AuthHandlers) EnrollTOTP(ctx context.Context, userID string) (*models.TOTPEnrollment, error) {
+	const op = "auth.EnrollTOTP"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("user_id", userID),
+	)
+
+	log.Info("enrolling totp")
+
+	user, err := ah.usrProvider.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.TOTP.Enabled {
+		log.Warn("totp is already enabled")
+		return nil, fmt.Errorf("%s: %w", op, ErrTOTPAlreadyEnabled)
+	}
+
+	secret, err := otp.NewTOTPSecret()
+	if err != nil {
+		log.Error("failed to generate totp secret", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := ah.usrSaver.SaveTOTPSecret(ctx, user.ID, secret); err != nil {
+		log.Error("failed to save totp secret", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("totp secret generated")
+
+	return &models.TOTPEnrollment{
+		URI:    otp.TOTPURI(totpIssuer, user.Email, secret),
+		Secret: secret,
+	}, nil
+}
+
+// VerifyTOTP checks the first code from authenticator app, enables 2FA
+// and returns recovery codes. Only hashes of recovery codes are stored.
+func (ah *AuthHandlers) VerifyTOTP(ctx context.Context, verify *models.VerifyTOTP) ([]string, error) {
+	const op = "auth.VerifyTOTP"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("user_id", verify.UserID),
+	)
+
+	// Validation
+	err := ah.validator.Struct(verify)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("verifying totp enrollment")
+
+	user, err := ah.usrProvider.FindUserByID(ctx, verify.UserID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.TOTP.Enabled {
+		log.Warn("totp is already enabled")
+		return nil, fmt.Errorf("%s: %w", op, ErrTOTPAlreadyEnabled)
+	}
+
+	if user.TOTP.Secret == "" {
+		log.Warn("totp is not enrolled")
+		return nil, fmt.Errorf("%s: %w", op, ErrTOTPNotEnabled)
+	}
+
+	counter, ok := otp.MatchTOTP(user.TOTP.Secret, verify.Code, time.Now())
+	if !ok {
+		log.Info("invalid totp code")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := ah.usrSaver.UseTOTPCounter(ctx, user.ID, counter); err != nil {
+		if errors.Is(err, storage.ErrInvalidCredentials) {
+			log.Info("totp code is already used")
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		}
+
+		log.Error("failed to save totp counter", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	recoveryCodes, err := otp.NewRecoveryCodes(recoveryCodesCount)
+	if err != nil {
+		log.Error("failed to generate recovery codes", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	hashes := make([][]byte, 0, len(recoveryCodes))
+	for _, code := range recoveryCodes {
+		hashes = append(hashes, otp.HashRecoveryCode(code))
+	}
+
+	if err := ah.usrSaver.EnableTOTP(ctx, user.ID, hashes); err != nil {
+		log.Error("failed to enable totp", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("totp enabled")
+
+	return recoveryCodes, nil
+}
+
+// DisableTOTP disables 2FA of the user. Current TOTP code
+// or one of recovery codes is required.
+func (ah *AuthHandlers) DisableTOTP(ctx context.Context, disable *models.DisableTOTP) error {
+	const op = "auth.DisableTOTP"
+
+	log := ah.log.With(
+		slog.String("op", op),
+		slog.String("user_id", disable.UserID),
+	)
+
+	// Validation
+	err := ah.validator.Struct(disable)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	log.Info("disabling totp")
+
+	user, err := ah.usrProvider.FindUserByID(ctx, disable.UserID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !user.TOTP.Enabled {
+		log.Warn("totp is not enabled")
+		return fmt.Errorf("%s: %w", op, ErrTOTPNotEnabled)
+	}
+
+	if _, err := ah.checkSecondFactor(ctx, user, disable.Code); err != nil {
+		log.Info("invalid second factor", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := ah.usrSaver.DisableTOTP(ctx, user.ID); err != nil {
+		log.Error("failed to disable totp", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("totp disabled")
+
+	return nil
+}
+
+// LoginMFA finishes two-step login: checks mfa token issued by LoginUser
+// and TOTP or recovery code, then issues the final tokens.
+func (ah *AuthHandlers) LoginMFA(ctx context.Context, login *models.LoginMFA) (*models.LogInTokens, error) {
+	const op = "auth.LoginMFA"
+
+	log := ah.log.With(
+		slog.String("op", op),
+	)
+
+	// Validation
+	err := ah.validator.Struct(login)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	token, err := ah.jwtManager.VerifyToken(login.MFAToken)
+	if err != nil {
+		log.Warn("token verification failed", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidMFAToken)
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid || claims["type"] != "mfa_pending" {
+		log.Warn("invalid token claims or type")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidMFAToken)
+	}
+
+	userID, ok := claims["sub"].(string)
+	if !ok {
+		log.Warn("invalid subject claim")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidMFAToken)
+	}
+
+	jti, ok := claims["jti"].(string)
+	if !ok {
+		log.Warn("invalid jti claim")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidMFAToken)
+	}
+
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		log.Warn("invalid exp claim")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidMFAToken)
+	}
+
+	log = log.With(slog.String("user_id", userID))
+
+	denied, err := ah.tokenRedisStore.IsAccessTokenDenied(ctx, jti)
+	if err != nil {
+		log.Error("failed to check denylist", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if denied {
+		log.Warn("mfa token is already used")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidMFAToken)
+	}
+
+	log.Info("checking second factor")
+
+	user, err := ah.usrProvider.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidMFAToken)
+		}
+
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !user.TOTP.Enabled {
+		log.Warn("totp is not enabled")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidMFAToken)
+	}
+
+	if user.Status == models.UserStatusDeactivated {
+		log.Info("user is deactivated")
+		return nil, fmt.Errorf("%s: %w", op, ErrUserDeactivated)
+	}
+
+	if err := ah.checkLoginLock(ctx, user.Email); err != nil {
+		log.Warn("login is locked", slog.String("err", err.Error()))
+		if errors.Is(err, ErrTooManyAttempts) {
+			ah.recordLogin(ctx, user.ID, user.Email, models.AuthMethodTOTP, err)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	method, err := ah.checkSecondFactor(ctx, user, login.Code)
+	if err != nil {
+		log.Info("invalid second factor", slog.String("err", err.Error()))
+		if errors.Is(err, ErrInvalidCredentials) {
+			ah.handleLoginFailure(ctx, log, user.Email)
+			ah.recordLogin(ctx, user.ID, user.Email, models.AuthMethodTOTP, err)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := ah.resetLoginFailures(ctx, user.Email); err != nil {
+		log.Error("failed to reset login failures", slog.String("err", err.Error()))
+	}
+
+	// MFA token is single use
+	if err := ah.tokenRedisStore.DenyAccessToken(ctx, jti, exp.Time); err != nil {
+		log.Error("failed to deny mfa token", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tokens, err := ah.generateTokens(ctx, log, user.ID, newTokenFamilyID(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	ah.recordLogin(ctx, user.ID, user.Email, method, nil)
+
+	return tokens, nil
+}
+
+// checkSecondFactor accepts current TOTP code or unused recovery code.
+// It returns the login method of the accepted code.
+// TOTP code is accepted once, recovery code is removed once it is used.
+// Recovery code is found by its hash, so a check costs one hash
+// whatever the number of stored codes.
+func (ah *AuthHandlers) checkSecondFactor(ctx context.Context, user *models.User, code string) (string, error) {
+	if counter, ok := otp.MatchTOTP(user.TOTP.Secret, code, time.Now()); ok {
+		if err := ah.usrSaver.UseTOTPCounter(ctx, user.ID, counter); err != nil {
+			if errors.Is(err, storage.ErrInvalidCredentials) {
+				return "", ErrInvalidCredentials
+			}
+			return "", err
+		}
+
+		return models.AuthMethodTOTP, nil
+	}
+
+	if !otp.IsRecoveryCode(code) {
+		return "", ErrInvalidCredentials
+	}
+
+	if err := ah.usrSaver.DeleteRecoveryCode(ctx, user.ID, otp.HashRecoveryCode(code)); err != nil {
+		if errors.Is(err, storage.ErrInvalidCredentials) {
+			return "", ErrInvalidCredentials
+		}
+		return "", err
+	}
+
+	return models.AuthMethodRecoveryCode, nil
+}
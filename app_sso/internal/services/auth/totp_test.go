@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/utils/otp"
+)
+
+func TestLoginMFA(t *testing.T) {
+	s := newTestSuite(t)
+	ctx := context.Background()
+
+	const (
+		email    = "student@example.com"
+		password = "long enough password"
+	)
+	s.registerUser(t, email, password)
+
+	user, err := s.storage.FindUserByEmail(ctx, email)
+	if err != nil {
+		t.Fatalf("FindUserByEmail() error = %v", err)
+	}
+
+	enrollment, err := s.auth.EnrollTOTP(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("EnrollTOTP() error = %v", err)
+	}
+	enrollCode, err := otp.TOTPCode(enrollment.Secret, time.Now())
+	if err != nil {
+		t.Fatalf("TOTPCode() error = %v", err)
+	}
+	recoveryCodes, err := s.auth.VerifyTOTP(ctx, &models.VerifyTOTP{UserID: user.ID, Code: enrollCode})
+	if err != nil {
+		t.Fatalf("VerifyTOTP() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		code    string
+		wantErr error
+	}{
+		{name: "recovery code", code: recoveryCodes[0]},
+		{name: "used recovery code", code: recoveryCodes[0], wantErr: ErrInvalidCredentials},
+		{name: "recovery code in upper case", code: strings.ToUpper(recoveryCodes[1])},
+		{name: "totp code used for enrollment", code: enrollCode, wantErr: ErrInvalidCredentials},
+		{name: "unknown recovery code", code: strings.Repeat("0", len(recoveryCodes[0])), wantErr: ErrInvalidCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, err := s.auth.LoginUser(ctx, email, password)
+			if err != nil {
+				t.Fatalf("LoginUser() error = %v", err)
+			}
+			if first.MFAToken == "" {
+				t.Fatal("LoginUser() didn't ask for second factor")
+			}
+
+			tokens, err := s.auth.LoginMFA(ctx, &models.LoginMFA{MFAToken: first.MFAToken, Code: tt.code})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("LoginMFA() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && tokens.AccessToken == "" {
+				t.Error("LoginMFA() returned empty access token")
+			}
+		})
+	}
+}
@@ -0,0 +1,396 @@
+package gdpr
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	exchangeShare  = "share"
+	gdprRoutingKey = "gdpr"
+
+	// archiveExpiresIn is how long export archive can be downloaded
+	archiveExpiresIn = 7 * 24 * time.Hour // TODO: transfer to config
+)
+
+type GDPRJobSaver interface {
+	SaveGDPRJob(ctx context.Context, job *models.DBCreateGDPRJob) (string, error)
+	UpdateGDPRJob(ctx context.Context, job *models.DBUpdateGDPRJob) error
+}
+
+type GDPRJobProvider interface {
+	FindGDPRJob(ctx context.Context, jobID string) (*models.GDPRJob, error)
+}
+
+type UserProvider interface {
+	FindUserByID(ctx context.Context, userID string) (*models.User, error)
+	GetUserRoles(ctx context.Context, userID string) (*models.UserRoles, error)
+	GetUserLearningGroups(ctx context.Context, userID string) ([]models.UserLearningGroup, error)
+}
+
+type UserEraser interface {
+	RemoveUserFromGroups(ctx context.Context, userID, pseudonym string) error
+	EraseUserProfile(ctx context.Context, userID string) error
+}
+
+type RabbitMQQueues interface {
+	Publish(ctx context.Context, exchange, routingKey string, body []byte) error
+}
+
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrPermissionDenied   = errors.New("you don't have permissions")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrSelfModification   = errors.New("admin can't change own account")
+	ErrUserNotDeactivated = errors.New("user is not deactivated")
+	ErrJobNotFound        = errors.New("gdpr job not found")
+)
+
+type GDPRHandlers struct {
+	log            *slog.Logger
+	validator      *validator.Validate
+	jobSaver       GDPRJobSaver
+	jobProvider    GDPRJobProvider
+	usrProvider    UserProvider
+	usrEraser      UserEraser
+	rabbitMQQueues RabbitMQQueues
+}
+
+func New(
+	log *slog.Logger,
+	validator *validator.Validate,
+	jobSaver GDPRJobSaver,
+	jobProvider GDPRJobProvider,
+	usrProvider UserProvider,
+	usrEraser UserEraser,
+	rabbitMQQueues RabbitMQQueues,
+) *GDPRHandlers {
+	return &GDPRHandlers{
+		log:            log,
+		validator:      validator,
+		jobSaver:       jobSaver,
+		jobProvider:    jobProvider,
+		usrProvider:    usrProvider,
+		usrEraser:      usrEraser,
+		rabbitMQQueues: rabbitMQQueues,
+	}
+}
+
+// RequestExport starts export of all user data. Learning platform sends
+// attempts history back and the job is completed with JSON archive.
+func (gh *GDPRHandlers) RequestExport(ctx context.Context, req *models.GDPRRequest) (string, error) {
+	const op = "gdpr.RequestExport"
+
+	log := gh.log.With(
+		slog.String("op", op),
+		slog.String("admin_id", req.AdminID),
+		slog.String("user_id", req.UserID),
+	)
+
+	// Validation
+	err := gh.validator.Struct(req)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := gh.checkAdmin(ctx, req.AdminID); err != nil {
+		log.Warn("failed to check admin", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := gh.findUser(ctx, req.UserID); err != nil {
+		log.Warn("failed to get user", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("requesting user data export")
+
+	jobID, err := gh.startJob(ctx, models.GDPRJobTypeExport, req, "")
+	if err != nil {
+		log.Error("failed to start export job", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("export job started", slog.String("job_id", jobID))
+
+	return jobID, nil
+}
+
+// RequestErasure starts erasure of the user data. Only deactivated
+// users can be erased. The user is removed from learning groups at once,
+// learning platform replaces user id with pseudonym in its data and then
+// the profile is erased.
+func (gh *GDPRHandlers) RequestErasure(ctx context.Context, req *models.GDPRRequest) (string, error) {
+	const op = "gdpr.RequestErasure"
+
+	log := gh.log.With(
+		slog.String("op", op),
+		slog.String("admin_id", req.AdminID),
+		slog.String("user_id", req.UserID),
+	)
+
+	// Validation
+	err := gh.validator.Struct(req)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	if req.AdminID == req.UserID {
+		log.Warn("self modification")
+		return "", fmt.Errorf("%s: %w", op, ErrSelfModification)
+	}
+
+	if err := gh.checkAdmin(ctx, req.AdminID); err != nil {
+		log.Warn("failed to check admin", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	user, err := gh.findUser(ctx, req.UserID)
+	if err != nil {
+		log.Warn("failed to get user", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	if user.Status != models.UserStatusDeactivated {
+		log.Warn("user is not deactivated")
+		return "", fmt.Errorf("%s: %w", op, ErrUserNotDeactivated)
+	}
+
+	log.Info("requesting user data erasure")
+
+	pseudonym, err := newPseudonym()
+	if err != nil {
+		log.Error("failed to generate pseudonym", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := gh.usrEraser.RemoveUserFromGroups(ctx, user.ID, pseudonym); err != nil {
+		log.Error("failed to remove user from learning groups", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	jobID, err := gh.startJob(ctx, models.GDPRJobTypeErase, req, pseudonym)
+	if err != nil {
+		log.Error("failed to start erasure job", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("erasure job started", slog.String("job_id", jobID))
+
+	return jobID, nil
+}
+
+// CompleteJob finishes the job with result from learning platform.
+// Finished jobs are skipped, so redelivered results are harmless.
+func (gh *GDPRHandlers) CompleteJob(ctx context.Context, result *models.GDPRJobResult) error {
+	const op = "gdpr.CompleteJob"
+
+	log := gh.log.With(
+		slog.String("op", op),
+		slog.String("job_id", result.JobID),
+	)
+
+	// Validation
+	err := gh.validator.Struct(result)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	job, err := gh.jobProvider.FindGDPRJob(ctx, result.JobID)
+	if err != nil {
+		if errors.Is(err, storage.ErrGDPRJobNotFound) {
+			log.Warn("job not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrJobNotFound)
+		}
+
+		log.Error("failed to get job", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if job.Status != models.GDPRJobStatusPending {
+		log.Info("job is already finished", slog.String("status", job.Status))
+		return nil
+	}
+
+	update := &models.DBUpdateGDPRJob{
+		ID:      job.ID,
+		Status:  models.GDPRJobStatusCompleted,
+		Updated: time.Now(),
+	}
+
+	switch {
+	case result.Error != "":
+		update.Status = models.GDPRJobStatusFailed
+		update.Error = result.Error
+	case job.Type == models.GDPRJobTypeExport:
+		update.Archive, err = gh.buildArchive(ctx, job.UserID, result.Attempts)
+		if err != nil {
+			log.Error("failed to build archive", slog.String("err", err.Error()))
+			update.Status = models.GDPRJobStatusFailed
+			update.Error = "failed to build archive"
+			break
+		}
+		update.ExpiresAt = update.Updated.Add(archiveExpiresIn)
+	case job.Type == models.GDPRJobTypeErase:
+		if err := gh.usrEraser.EraseUserProfile(ctx, job.UserID); err != nil {
+			log.Error("failed to erase user profile", slog.String("err", err.Error()))
+			update.Status = models.GDPRJobStatusFailed
+			update.Error = "failed to erase user profile"
+		}
+	}
+
+	if err := gh.jobSaver.UpdateGDPRJob(ctx, update); err != nil {
+		log.Error("failed to update job", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("job finished", slog.String("status", update.Status))
+
+	return nil
+}
+
+// GetJob returns the job with archive, if export is completed.
+func (gh *GDPRHandlers) GetJob(ctx context.Context, get *models.GetGDPRJob) (*models.GDPRJob, error) {
+	const op = "gdpr.GetJob"
+
+	log := gh.log.With(
+		slog.String("op", op),
+		slog.String("admin_id", get.AdminID),
+		slog.String("job_id", get.JobID),
+	)
+
+	// Validation
+	err := gh.validator.Struct(get)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := gh.checkAdmin(ctx, get.AdminID); err != nil {
+		log.Warn("failed to check admin", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	job, err := gh.jobProvider.FindGDPRJob(ctx, get.JobID)
+	if err != nil {
+		if errors.Is(err, storage.ErrGDPRJobNotFound) {
+			log.Warn("job not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrJobNotFound)
+		}
+
+		log.Error("failed to get job", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return job, nil
+}
+
+func (gh *GDPRHandlers) startJob(ctx context.Context, jobType string, req *models.GDPRRequest, pseudonym string) (string, error) {
+	now := time.Now()
+	jobID, err := gh.jobSaver.SaveGDPRJob(ctx, &models.DBCreateGDPRJob{
+		Type:        jobType,
+		UserID:      req.UserID,
+		RequestedBy: req.AdminID,
+		Status:      models.GDPRJobStatusPending,
+		Created:     now,
+		Updated:     now,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	msgBody, err := json.Marshal(models.GDPRJobMsg{
+		JobID:     jobID,
+		Type:      jobType,
+		UserID:    req.UserID,
+		Pseudonym: pseudonym,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err := gh.rabbitMQQueues.Publish(ctx, exchangeShare, gdprRoutingKey, msgBody); err != nil {
+		return "", err
+	}
+
+	return jobID, nil
+}
+
+func (gh *GDPRHandlers) buildArchive(ctx context.Context, userID string, attempts json.RawMessage) ([]byte, error) {
+	user, err := gh.usrProvider.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := gh.usrProvider.GetUserLearningGroups(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(attempts) == 0 {
+		attempts = json.RawMessage("[]")
+	}
+
+	return json.Marshal(models.UserDataArchive{
+		UserID:     user.ID,
+		ExportedAt: time.Now(),
+		Profile: models.UserDataProfile{
+			Email:       user.Email,
+			Name:        user.Name,
+			TgLink:      user.TgLink,
+			IsAdmin:     user.IsAdmin,
+			Status:      user.Status,
+			TOTPEnabled: user.TOTP.Enabled,
+			Created:     user.Created,
+			Updated:     user.Updated,
+		},
+		LearningGroups: groups,
+		Attempts:       attempts,
+	})
+}
+
+func (gh *GDPRHandlers) checkAdmin(ctx context.Context, userID string) error {
+	roles, err := gh.usrProvider.GetUserRoles(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	if !roles.IsAdmin {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+func (gh *GDPRHandlers) findUser(ctx context.Context, userID string) (*models.User, error) {
+	user, err := gh.usrProvider.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// newPseudonym has the same format as user id
+func newPseudonym() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
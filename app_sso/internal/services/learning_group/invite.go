@@ -0,0 +1,247 @@
+package learninggroup
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+// CreateLgInvite issues invite code of the learning group. Only group admins can do it.
+func (lgh *LgHanglers) CreateLgInvite(ctx context.Context, create *models.CreateLgInvite) (*models.LgInvite, error) {
+	const op = "learning_group.CreateLgInvite"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("user_id", create.UserID),
+		slog.String("learning_group_id", create.LgId),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(create)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := lgh.checkGroupAdmin(ctx, create.UserID, create.LgId); err != nil {
+		log.Warn("failed to check group admin", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("creating invite")
+
+	code, err := newInviteCode()
+	if err != nil {
+		log.Error("failed to generate invite code", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now()
+	invite := &models.LgInvite{
+		Code:      code,
+		LgID:      create.LgId,
+		CreatedBy: create.UserID,
+		MaxUses:   create.MaxUses,
+		ExpiresAt: now.Add(time.Duration(create.ExpiresIn) * time.Second),
+		Created:   now,
+	}
+	if err := lgh.inviteStore.SaveLgInvite(ctx, invite); err != nil {
+		log.Error("failed to save invite", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("invite created")
+
+	return invite, nil
+}
+
+// ListLgInvites returns invites of the learning group which can still be redeemed.
+// Only group admins can do it.
+func (lgh *LgHanglers) ListLgInvites(ctx context.Context, list *models.ListLgInvites) ([]*models.LgInvite, error) {
+	const op = "learning_group.ListLgInvites"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("user_id", list.UserID),
+		slog.String("learning_group_id", list.LgId),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(list)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := lgh.checkGroupAdmin(ctx, list.UserID, list.LgId); err != nil {
+		log.Warn("failed to check group admin", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("listing invites")
+
+	invites, err := lgh.inviteStore.GetActiveLgInvites(ctx, list.LgId)
+	if err != nil {
+		log.Error("failed to get invites", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return invites, nil
+}
+
+// RevokeLgInvite deletes invite code of the learning group. Only group admins can do it.
+func (lgh *LgHanglers) RevokeLgInvite(ctx context.Context, revoke *models.RevokeLgInvite) error {
+	const op = "learning_group.RevokeLgInvite"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("user_id", revoke.UserID),
+		slog.String("learning_group_id", revoke.LgId),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(revoke)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := lgh.checkGroupAdmin(ctx, revoke.UserID, revoke.LgId); err != nil {
+		log.Warn("failed to check group admin", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("revoking invite")
+
+	if err := lgh.inviteStore.DeleteLgInvite(ctx, revoke.LgId, revoke.Code); err != nil {
+		if errors.Is(err, storage.ErrLgInviteNotFound) {
+			log.Warn("invite not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrInviteNotFound)
+		}
+
+		log.Error("failed to delete invite", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("invite revoked")
+
+	return nil
+}
+
+// RedeemLgInvite adds the user to the learning group of the invite as a learner.
+// It returns ID of the learning group.
+func (lgh *LgHanglers) RedeemLgInvite(ctx context.Context, redeem *models.RedeemLgInvite) (string, error) {
+	const op = "learning_group.RedeemLgInvite"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("user_id", redeem.UserID),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(redeem)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	invite, err := lgh.inviteStore.GetLgInvite(ctx, redeem.Code)
+	if err != nil {
+		if errors.Is(err, storage.ErrLgInviteNotFound) {
+			log.Warn("invite not found", slog.String("err", err.Error()))
+			return "", fmt.Errorf("%s: %w", op, ErrInviteNotFound)
+		}
+
+		log.Error("failed to get invite", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	log = log.With(slog.String("learning_group_id", invite.LgID))
+
+	// Use isn't spent by members of the group
+	isLearner, err := lgh.groupeProvider.IsLearner(ctx, &models.GetLgByID{
+		UserID: redeem.UserID,
+		LgId:   invite.LgID,
+	})
+	if err != nil {
+		log.Error("failed to check learner", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	if isLearner {
+		log.Info("user is already a learner")
+		return "", fmt.Errorf("%s: %w", op, ErrAlreadyLearner)
+	}
+
+	log.Info("redeeming invite")
+
+	if _, err := lgh.inviteStore.UseLgInvite(ctx, redeem.Code); err != nil {
+		if errors.Is(err, storage.ErrLgInviteNotFound) {
+			log.Warn("invite is spent or expired", slog.String("err", err.Error()))
+			return "", fmt.Errorf("%s: %w", op, ErrInviteNotFound)
+		}
+
+		log.Error("failed to use invite", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = lgh.groupSaver.UpdateLgByID(ctx, &models.DBUpdateLearningGroup{
+		ID:       invite.LgID,
+		Updated:  time.Now(),
+		Learners: []string{redeem.UserID},
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrLgNotFound) {
+			log.Warn("learning_group not found", slog.String("err", err.Error()))
+			return "", fmt.Errorf("%s: %w", op, ErrGroupNotFound)
+		}
+
+		log.Error("failed to add learner", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:     models.AuditEventLearningGroupUpdate,
+		UserID:   redeem.UserID,
+		ActorID:  redeem.UserID,
+		TargetID: invite.LgID,
+		Details:  "joined by invite",
+	})
+
+	// The user is already a learner, failed sharing is only logged
+	// to not make the user redeem a spent invite again
+	_ = lgh.shareWithLearners(ctx, log, invite.LgID, []string{redeem.UserID}, invite.CreatedBy)
+
+	log.Info("invite redeemed")
+
+	return invite.LgID, nil
+}
+
+func (lgh *LgHanglers) checkGroupAdmin(ctx context.Context, userID, lgID string) error {
+	isAdmin, err := lgh.groupeProvider.IsGroupAdmin(ctx, &models.IsGroupAdmin{
+		UserID: userID,
+		LgId:   lgID,
+	})
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+func newInviteCode() (string, error) {
+	b := make([]byte, inviteCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
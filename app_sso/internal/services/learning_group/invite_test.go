@@ -0,0 +1,215 @@
+package learninggroup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage/sqlite"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestRedeemLgInvite(t *testing.T) {
+	lgh, db := newTestLgHandlers(t)
+	ctx := context.Background()
+
+	lgID := saveTestLg(t, db, "admin")
+	invite, err := lgh.CreateLgInvite(ctx, &models.CreateLgInvite{UserID: "admin", LgId: lgID, MaxUses: 2, ExpiresIn: 3600})
+	if err != nil {
+		t.Fatalf("CreateLgInvite() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		userID  string
+		wantErr error
+	}{
+		{name: "first use", userID: "learner1"},
+		{name: "learner again", userID: "learner1", wantErr: ErrAlreadyLearner},
+		{name: "last use", userID: "learner2"},
+		{name: "spent", userID: "learner3", wantErr: ErrInviteNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotLgID, err := lgh.RedeemLgInvite(ctx, &models.RedeemLgInvite{UserID: tt.userID, Code: invite.Code})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RedeemLgInvite() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && gotLgID != lgID {
+				t.Errorf("RedeemLgInvite() = %s, want %s", gotLgID, lgID)
+			}
+		})
+	}
+
+	isLearner, err := db.IsLearner(ctx, &models.GetLgByID{UserID: "learner3", LgId: lgID})
+	if err != nil {
+		t.Fatalf("IsLearner() error = %v", err)
+	}
+	if isLearner {
+		t.Error("user became a learner by spent invite")
+	}
+}
+
+func TestRedeemLgInviteConcurrent(t *testing.T) {
+	lgh, db := newTestLgHandlers(t)
+	ctx := context.Background()
+
+	const maxUses, users = 3, 10
+
+	lgID := saveTestLg(t, db, "admin")
+	invite, err := lgh.CreateLgInvite(ctx, &models.CreateLgInvite{UserID: "admin", LgId: lgID, MaxUses: maxUses, ExpiresIn: 3600})
+	if err != nil {
+		t.Fatalf("CreateLgInvite() error = %v", err)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		redeemed int
+	)
+	for i := 0; i < users; i++ {
+		wg.Add(1)
+		go func(userID string) {
+			defer wg.Done()
+
+			_, err := lgh.RedeemLgInvite(ctx, &models.RedeemLgInvite{UserID: userID, Code: invite.Code})
+			switch {
+			case err == nil:
+				mu.Lock()
+				redeemed++
+				mu.Unlock()
+			case !errors.Is(err, ErrInviteNotFound):
+				t.Errorf("RedeemLgInvite() error = %v", err)
+			}
+		}(string(rune('a' + i)))
+	}
+	wg.Wait()
+
+	if redeemed != maxUses {
+		t.Errorf("redeemed = %d, want %d", redeemed, maxUses)
+	}
+}
+
+func TestRedeemLgInviteExpired(t *testing.T) {
+	lgh, db := newTestLgHandlers(t)
+	ctx := context.Background()
+
+	lgID := saveTestLg(t, db, "admin")
+	err := db.SaveLgInvite(ctx, &models.LgInvite{
+		Code:      "expired",
+		LgID:      lgID,
+		CreatedBy: "admin",
+		MaxUses:   10,
+		ExpiresAt: time.Now().Add(-time.Second),
+		Created:   time.Now().Add(-time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("SaveLgInvite() error = %v", err)
+	}
+
+	if _, err := lgh.RedeemLgInvite(ctx, &models.RedeemLgInvite{UserID: "learner", Code: "expired"}); !errors.Is(err, ErrInviteNotFound) {
+		t.Errorf("RedeemLgInvite() error = %v, want %v", err, ErrInviteNotFound)
+	}
+
+	invites, err := lgh.ListLgInvites(ctx, &models.ListLgInvites{UserID: "admin", LgId: lgID})
+	if err != nil {
+		t.Fatalf("ListLgInvites() error = %v", err)
+	}
+	if len(invites) != 0 {
+		t.Errorf("ListLgInvites() = %d invites, want expired invite hidden", len(invites))
+	}
+}
+
+func newTestLgHandlers(t *testing.T) (*LgHanglers, *sqlite.SQLiteStorage) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	dbPath := filepath.Join(t.TempDir(), "sso.db")
+	applyMigrations(t, dbPath)
+
+	db, err := sqlite.New(ctx, dbPath)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { db.Close(ctx) })
+
+	lgh := New(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		validator.New(),
+		db,
+		db,
+		db,
+		db,
+		db,
+		nopPublisher{},
+		nopAudit{},
+	)
+
+	return lgh, db
+}
+
+// applyMigrations creates the schema like cmd/migrator does.
+func applyMigrations(t *testing.T, dbPath string) {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join("..", "..", "..", "migrations", "sqlite", "*.up.sql"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("find migrations: %v", err)
+	}
+	sort.Strings(files)
+
+	// driver is registered by the sqlite storage package
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	defer db.Close()
+
+	for _, file := range files {
+		query, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("read migration: %v", err)
+		}
+		if _, err := db.Exec(string(query)); err != nil {
+			t.Fatalf("apply migration %s: %v", filepath.Base(file), err)
+		}
+	}
+}
+
+func saveTestLg(t *testing.T, db *sqlite.SQLiteStorage, groupAdmins ...string) string {
+	t.Helper()
+
+	lg := &models.DBCreateLearningGroup{
+		Name:        "Test group",
+		GroupAdmins: groupAdmins,
+		CreatedBy:   groupAdmins[0],
+		ModifiedBy:  groupAdmins[0],
+		Created:     time.Now(),
+		Updated:     time.Now(),
+	}
+	if err := db.SaveLg(context.Background(), lg); err != nil {
+		t.Fatalf("SaveLg() error = %v", err)
+	}
+
+	return lg.ID
+}
+
+type nopPublisher struct{}
+
+func (nopPublisher) Publish(context.Context, string, string, []byte) error { return nil }
+func (nopPublisher) PublishToQueue(context.Context, string, []byte) error  { return nil }
+
+type nopAudit struct{}
+
+func (nopAudit) Record(context.Context, *models.AuditEvent) {}
@@ -16,11 +16,16 @@ import (
 const (
 	exchangeShare  = "share"
 	spfuRoutingKey = "spfu"
+
+	inviteCodeLength = 8
 )
 
 type GroupSaver interface {
 	SaveLg(ctx context.Context, lg *models.DBCreateLearningGroup) error
 	UpdateLgByID(ctx context.Context, lg *models.DBUpdateLearningGroup) error
+	AddLgMembers(ctx context.Context, change *models.DBChangeLgMembers) error
+	RemoveLgMembers(ctx context.Context, change *models.DBChangeLgMembers) error
+	MoveLg(ctx context.Context, move *models.DBMoveLearningGroup) error
 	UpdateUserInfo(ctx context.Context, userInfo *models.DBUpdateUserInfo) error
 }
 
@@ -32,6 +37,10 @@ type GroupeProvider interface {
 	GetUserIsGroupAdminIn(ctx context.Context, user *models.UserIsGroupAdminIn) ([]string, error)
 	GetUserIsLearnerIn(ctx context.Context, user *models.UserIsLearnerIn) ([]string, error)
 	GetLearners(ctx context.Context, lgID *models.GetLearners) ([]string, error)
+	GetLgAncestorIDs(ctx context.Context, lgIDs []string) ([]string, error)
+	GetLgSubtree(ctx context.Context, lgID string) ([]*models.LearningGroupShort, error)
+	FindLgsPage(ctx context.Context, groupsFilter *models.ScimGroupsFilter) ([]*models.ScimGroup, int64, error)
+	GetUsersInfoBatch(ctx context.Context, userIDs []string) ([]models.UserNotification, error)
 }
 
 type RabbitMQQueues interface {
@@ -43,6 +52,31 @@ type GroupeDel interface {
 	DeleteLgByID(ctx context.Context, delG *models.DelGroup) error
 }
 
+// InviteStore keeps invite codes of learning groups
+type InviteStore interface {
+	SaveLgInvite(ctx context.Context, invite *models.LgInvite) error
+	GetLgInvite(ctx context.Context, code string) (*models.LgInvite, error)
+	GetActiveLgInvites(ctx context.Context, lgID string) ([]*models.LgInvite, error)
+	UseLgInvite(ctx context.Context, code string) (*models.LgInvite, error)
+	DeleteLgInvite(ctx context.Context, lgID, code string) error
+}
+
+// RoleStore keeps custom roles of learning groups
+type RoleStore interface {
+	SaveLgRole(ctx context.Context, role *models.LgRole) error
+	GetLgRoles(ctx context.Context, lgID string) ([]*models.LgRole, error)
+	GetUserLgRoles(ctx context.Context, userID string) ([]*models.LgRole, error)
+	UpdateLgRole(ctx context.Context, upd *models.DBUpdateLgRole) error
+	DeleteLgRole(ctx context.Context, lgID, roleID string) error
+	AddLgRoleMembers(ctx context.Context, change *models.DBChangeLgRoleMembers) error
+	RemoveLgRoleMembers(ctx context.Context, change *models.DBChangeLgRoleMembers) error
+}
+
+// AuditRecorder writes learning group changes to the audit log
+type AuditRecorder interface {
+	Record(ctx context.Context, event *models.AuditEvent)
+}
+
 var (
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrInvalidGroupID     = errors.New("invalid group id")
@@ -50,6 +84,13 @@ var (
 	ErrGroupNotFound      = errors.New("group not found")
 	ErrPermissionDenied   = errors.New("you don't have permissions")
 	ErrUserNotFound       = errors.New("user not found")
+	ErrInviteNotFound     = errors.New("invite not found")
+	ErrAlreadyLearner     = errors.New("user is already a learner")
+	ErrVersionConflict    = errors.New("group was changed by another request")
+	ErrLastGroupAdmin     = errors.New("group must keep a group admin")
+	ErrRoleNotFound       = errors.New("role not found")
+	ErrRoleExists         = errors.New("role already exists")
+	ErrGroupCycle         = errors.New("group can't be moved into its own subtree")
 )
 
 type LgHanglers struct {
@@ -58,7 +99,10 @@ type LgHanglers struct {
 	groupSaver     GroupSaver
 	groupeProvider GroupeProvider
 	groupeDel      GroupeDel
+	inviteStore    InviteStore
+	roleStore      RoleStore
 	rabbitMQQueues RabbitMQQueues
+	auditRecorder  AuditRecorder
 }
 
 func New(
@@ -67,7 +111,10 @@ func New(
 	groupSaver GroupSaver,
 	groupeProvider GroupeProvider,
 	groupeDel GroupeDel,
+	inviteStore InviteStore,
+	roleStore RoleStore,
 	rabbitMQQueues RabbitMQQueues,
+	auditRecorder AuditRecorder,
 ) *LgHanglers {
 	return &LgHanglers{
 		log:            log,
@@ -75,7 +122,10 @@ func New(
 		groupSaver:     groupSaver,
 		groupeProvider: groupeProvider,
 		groupeDel:      groupeDel,
+		inviteStore:    inviteStore,
+		roleStore:      roleStore,
 		rabbitMQQueues: rabbitMQQueues,
+		auditRecorder:  auditRecorder,
 	}
 }
 
@@ -96,16 +146,26 @@ func (lgh *LgHanglers) CreateLearningGroup(ctx context.Context, lg *models.Creat
 		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 	}
 
+	// Only group admins of the parent subtree can create child groups
+	if lg.ParentID != "" {
+		if err := lgh.checkTreeAdmin(ctx, lg.CreatedBy, lg.ParentID); err != nil {
+			log.Warn("failed to check group admin of parent", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
 	log.Info("creating learning_group")
 
 	dbGroup := &models.DBCreateLearningGroup{
 		Name:        lg.Name,
+		ParentID:    lg.ParentID,
 		GroupAdmins: lg.GroupAdmins,
 		CreatedBy:   lg.CreatedBy,
 		ModifiedBy:  lg.ModifiedBy,
 		Created:     time.Now(),
 		Updated:     time.Now(),
 		Learners:    lg.Learners,
+		Version:     1,
 	}
 
 	if err = lgh.groupSaver.SaveLg(ctx, dbGroup); err != nil {
@@ -118,6 +178,13 @@ func (lgh *LgHanglers) CreateLearningGroup(ctx context.Context, lg *models.Creat
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	lgh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:     models.AuditEventLearningGroupCreate,
+		UserID:   lg.CreatedBy,
+		ActorID:  lg.CreatedBy,
+		TargetID: dbGroup.ID,
+	})
+
 	newRole := &models.DBUpdateUserInfo{
 		ID:      lg.CreatedBy,
 		Updated: time.Now(),
@@ -132,6 +199,13 @@ func (lgh *LgHanglers) CreateLearningGroup(ctx context.Context, lg *models.Creat
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	// Learners of the child group get content shared with its ancestors
+	if lg.ParentID != "" && len(lg.Learners) != 0 {
+		if err := lgh.shareWithLearners(ctx, log, dbGroup.ID, lg.Learners, lg.CreatedBy); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
 	log.Info("learning_group created successfully")
 
 	return nil
@@ -239,40 +313,69 @@ func (lgh *LgHanglers) UpdateLearningGroup(ctx context.Context, lg *models.Updat
 		Updated:     time.Now(),
 		GroupAdmins: lg.GroupAdmins,
 		Learners:    lg.Learners,
+		Version:     lg.Version,
 	}
 	if err = lgh.groupSaver.UpdateLgByID(ctx, updLgGroup); err != nil {
 		switch {
 		case errors.Is(err, storage.ErrLgNotFound):
 			log.Warn("learning_group not found", slog.String("err", err.Error()))
 			return fmt.Errorf("%s: %w", op, ErrGroupNotFound)
+		case errors.Is(err, storage.ErrLgVersionConflict):
+			log.Warn("learning_group version conflict", slog.Int64("version", lg.Version))
+			return fmt.Errorf("%s: %w", op, ErrVersionConflict)
 		default:
 			log.Error("failed to update learning_group", slog.String("err", err.Error()))
 			return fmt.Errorf("%s: %w", op, err)
 		}
 	}
 
+	lgh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:     models.AuditEventLearningGroupUpdate,
+		UserID:   lg.UserID,
+		ActorID:  lg.UserID,
+		TargetID: lg.LgId,
+	})
+
 	if len(lg.Learners) != 0 {
+		if err := lgh.shareWithLearners(ctx, log, lg.LgId, lg.Learners, lg.ModifiedBy); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return nil
+}
+
+// shareWithLearners asks learning platform to share content
+// of the learning group and all its ancestors with new learners.
+func (lgh *LgHanglers) shareWithLearners(ctx context.Context, log *slog.Logger, lgID string, learners []string, createdBy string) error {
+	ancestorIDs, err := lgh.groupeProvider.GetLgAncestorIDs(ctx, []string{lgID})
+	if err != nil {
+		log.Error("failed to get ancestors of learning_group", slog.String("err", err.Error()))
+		return err
+	}
+
+	for _, id := range append([]string{lgID}, ancestorIDs...) {
 		msg := models.Spfu{
-			LearningGroupID: lg.LgId,
-			UserIDs:         lg.Learners,
-			CreatedBy:       lg.ModifiedBy,
+			LearningGroupID: id,
+			UserIDs:         learners,
+			CreatedBy:       createdBy,
 		}
 
 		// Serialization and publication message
 		msgBody, err := json.Marshal(msg)
 		if err != nil {
 			log.Error("failed to marshal msg request", slog.String("err", err.Error()))
-			return fmt.Errorf("%s: %w", op, err)
+			return err
 		}
 
 		if err = lgh.rabbitMQQueues.Publish(ctx, exchangeShare, spfuRoutingKey, msgBody); err != nil {
 			log.Error("failed to publish batch request to spfu queue", slog.String("err", err.Error()))
-			return fmt.Errorf("%s: %w", op, err)
+			return err
 		}
 
 		log.Info("learners sent to share with learning group id",
-			slog.Any("user_ids", lg.Learners),
-			slog.String("learning_group_id", lg.LgId),
+			slog.Any("user_ids", learners),
+			slog.String("learning_group_id", id),
 		)
 	}
 
@@ -310,6 +413,13 @@ func (lgh *LgHanglers) DeleteLearningGroup(ctx context.Context, lgUser *models.D
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	lgh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:     models.AuditEventLearningGroupDelete,
+		UserID:   lgUser.UserID,
+		ActorID:  lgUser.UserID,
+		TargetID: lgUser.LgId,
+	})
+
 	return nil
 }
 
@@ -373,7 +483,9 @@ func (lgh *LgHanglers) UserIsGroupAdminIn(ctx context.Context, user *models.User
 	return lgIDs, nil
 }
 
-// UserIsLearnerIn returns id array where user is learner
+// UserIsLearnerIn returns id array where user is learner.
+// Learner of the group is a learner of all its ancestors too,
+// so content shared with the parent group is available in child groups.
 func (lgh *LgHanglers) UserIsLearnerIn(ctx context.Context, user *models.UserIsLearnerIn) ([]string, error) {
 	const op = "learning_group.UserIsLearnerIn"
 
@@ -384,7 +496,7 @@ func (lgh *LgHanglers) UserIsLearnerIn(ctx context.Context, user *models.UserIsL
 
 	log.Info("checkin learner permissions")
 
-	lgIDs, err := lgh.groupeProvider.GetUserIsLearnerIn(ctx, user)
+	lgIDs, err := lgh.learnerGroupIDs(ctx, user.UserID)
 	if err != nil {
 		switch {
 		case errors.Is(err, storage.ErrUserNotFound):
@@ -399,6 +511,8 @@ func (lgh *LgHanglers) UserIsLearnerIn(ctx context.Context, user *models.UserIsL
 	return lgIDs, nil
 }
 
+// GetLearners returns learners of the group and all its descendants,
+// so content shared with the parent group is shared with child groups.
 func (lgh *LgHanglers) GetLearners(ctx context.Context, lgID *models.GetLearners) ([]string, error) {
 	const op = "learning_group.GetLearners"
 
@@ -409,7 +523,17 @@ func (lgh *LgHanglers) GetLearners(ctx context.Context, lgID *models.GetLearners
 
 	log.Info("getting learners")
 
-	learners, err := lgh.groupeProvider.GetLearners(ctx, lgID)
+	groups, err := lgh.groupeProvider.GetLgSubtree(ctx, lgID.LgId)
+	if err != nil {
+		if errors.Is(err, storage.ErrLgNotFound) {
+			return nil, nil
+		}
+
+		log.Error("can't get learning_group subtree", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	learners, err := lgh.subtreeLearners(ctx, groups)
 	if err != nil {
 		log.Error("can't get learners", slog.String("err", err.Error()))
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -0,0 +1,136 @@
+package learninggroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+// AddMembers adds users with the role to the learning group. Only group admins can do it.
+// Unlike UpdateLearningGroup it doesn't touch other members, so concurrent changes are kept.
+func (lgh *LgHanglers) AddMembers(ctx context.Context, change *models.ChangeLgMembers) error {
+	const op = "learning_group.AddMembers"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("user_id", change.UserID),
+		slog.String("learning_group_id", change.LgId),
+		slog.String("role", change.Role),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(change)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := lgh.checkGroupAdmin(ctx, change.UserID, change.LgId); err != nil {
+		log.Warn("failed to check group admin", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("adding members")
+
+	if err := lgh.groupSaver.AddLgMembers(ctx, dbChangeLgMembers(change)); err != nil {
+		if errors.Is(err, storage.ErrLgNotFound) {
+			log.Warn("learning_group not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrGroupNotFound)
+		}
+
+		log.Error("failed to add members", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgh.recordMembersChange(ctx, change, "added")
+
+	if change.Role == models.GroupRoleLearner {
+		if err := lgh.shareWithLearners(ctx, log, change.LgId, change.UserIDs, change.UserID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	log.Info("members added")
+
+	return nil
+}
+
+// RemoveMembers removes users with the role from the learning group. Only group admins can do it.
+// Group admin can't remove own admin role and the storage refuses to remove
+// the last group admins, so the group always keeps an admin.
+func (lgh *LgHanglers) RemoveMembers(ctx context.Context, change *models.ChangeLgMembers) error {
+	const op = "learning_group.RemoveMembers"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("user_id", change.UserID),
+		slog.String("learning_group_id", change.LgId),
+		slog.String("role", change.Role),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(change)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := lgh.checkGroupAdmin(ctx, change.UserID, change.LgId); err != nil {
+		log.Warn("failed to check group admin", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if change.Role == models.GroupRoleGroupAdmin && slices.Contains(change.UserIDs, change.UserID) {
+		log.Warn("group admin tried to remove themselves")
+		return fmt.Errorf("%s: %w", op, ErrLastGroupAdmin)
+	}
+
+	log.Info("removing members")
+
+	if err := lgh.groupSaver.RemoveLgMembers(ctx, dbChangeLgMembers(change)); err != nil {
+		if errors.Is(err, storage.ErrLgNotFound) {
+			log.Warn("learning_group not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrGroupNotFound)
+		}
+		if errors.Is(err, storage.ErrLgLastAdmin) {
+			log.Warn("group admins would be removed", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrLastGroupAdmin)
+		}
+
+		log.Error("failed to remove members", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgh.recordMembersChange(ctx, change, "removed")
+
+	log.Info("members removed")
+
+	return nil
+}
+
+func (lgh *LgHanglers) recordMembersChange(ctx context.Context, change *models.ChangeLgMembers, action string) {
+	lgh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:     models.AuditEventLearningGroupUpdate,
+		UserID:   change.UserID,
+		ActorID:  change.UserID,
+		TargetID: change.LgId,
+		Details:  fmt.Sprintf("%s %s: %s", action, change.Role, strings.Join(change.UserIDs, ",")),
+	})
+}
+
+func dbChangeLgMembers(change *models.ChangeLgMembers) *models.DBChangeLgMembers {
+	return &models.DBChangeLgMembers{
+		LgID:       change.LgId,
+		Role:       change.Role,
+		UserIDs:    change.UserIDs,
+		ModifiedBy: change.UserID,
+		Updated:    time.Now(),
+	}
+}
@@ -0,0 +1,111 @@
+package learninggroup
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/DimTur/lp_auth/internal/services/storage/sqlite"
+)
+
+func TestRemoveMembersKeepsGroupAdmin(t *testing.T) {
+	lgh, db := newTestLgHandlers(t)
+	ctx := context.Background()
+
+	lgID := saveTestLg(t, db, "admin1", "admin2")
+
+	tests := []struct {
+		name    string
+		change  models.ChangeLgMembers
+		wantErr error
+	}{
+		{
+			name:    "own admin role",
+			change:  models.ChangeLgMembers{UserID: "admin1", LgId: lgID, Role: models.GroupRoleGroupAdmin, UserIDs: []string{"admin1"}},
+			wantErr: ErrLastGroupAdmin,
+		},
+		{
+			name:    "all group admins",
+			change:  models.ChangeLgMembers{UserID: "admin1", LgId: lgID, Role: models.GroupRoleGroupAdmin, UserIDs: []string{"admin2", "admin1"}},
+			wantErr: ErrLastGroupAdmin,
+		},
+		{
+			name:   "other group admin",
+			change: models.ChangeLgMembers{UserID: "admin1", LgId: lgID, Role: models.GroupRoleGroupAdmin, UserIDs: []string{"admin2"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := lgh.RemoveMembers(ctx, &tt.change); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RemoveMembers() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// The storage refuses it too, so admins removed by a stale check are kept
+	err := db.RemoveLgMembers(ctx, &models.DBChangeLgMembers{
+		LgID:       lgID,
+		Role:       models.GroupRoleGroupAdmin,
+		UserIDs:    []string{"admin1"},
+		ModifiedBy: "admin2",
+		Updated:    time.Now(),
+	})
+	if !errors.Is(err, storage.ErrLgLastAdmin) {
+		t.Errorf("RemoveLgMembers() error = %v, want %v", err, storage.ErrLgLastAdmin)
+	}
+	if admins := countGroupAdmins(t, db, lgID, "admin1", "admin2"); admins != 1 {
+		t.Errorf("group admins = %d, want 1", admins)
+	}
+}
+
+func TestRemoveMembersConcurrent(t *testing.T) {
+	lgh, db := newTestLgHandlers(t)
+	ctx := context.Background()
+
+	lgID := saveTestLg(t, db, "admin1", "admin2")
+
+	// Group admins remove each other at the same time
+	var wg sync.WaitGroup
+	for _, pair := range [][2]string{{"admin1", "admin2"}, {"admin2", "admin1"}} {
+		wg.Add(1)
+		go func(userID, removeID string) {
+			defer wg.Done()
+
+			err := lgh.RemoveMembers(ctx, &models.ChangeLgMembers{
+				UserID:  userID,
+				LgId:    lgID,
+				Role:    models.GroupRoleGroupAdmin,
+				UserIDs: []string{removeID},
+			})
+			if err != nil && !errors.Is(err, ErrLastGroupAdmin) && !errors.Is(err, ErrPermissionDenied) {
+				t.Errorf("RemoveMembers() error = %v", err)
+			}
+		}(pair[0], pair[1])
+	}
+	wg.Wait()
+
+	if admins := countGroupAdmins(t, db, lgID, "admin1", "admin2"); admins != 1 {
+		t.Errorf("group admins = %d, want 1", admins)
+	}
+}
+
+func countGroupAdmins(t *testing.T, db *sqlite.SQLiteStorage, lgID string, userIDs ...string) int {
+	t.Helper()
+
+	var admins int
+	for _, userID := range userIDs {
+		isAdmin, err := db.IsGroupAdmin(context.Background(), &models.IsGroupAdmin{UserID: userID, LgId: lgID})
+		if err != nil {
+			t.Fatalf("IsGroupAdmin() error = %v", err)
+		}
+		if isAdmin {
+			admins++
+		}
+	}
+	return admins
+}
@@ -0,0 +1,349 @@
+package learninggroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+// CreateLgRole creates named set of permissions in the learning group.
+// Only group admins can do it.
+func (lgh *LgHanglers) CreateLgRole(ctx context.Context, create *models.CreateLgRole) (*models.LgRole, error) {
+	const op = "learning_group.CreateLgRole"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("user_id", create.UserID),
+		slog.String("learning_group_id", create.LgId),
+		slog.String("role", create.Name),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(create)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := lgh.checkGroupAdmin(ctx, create.UserID, create.LgId); err != nil {
+		log.Warn("failed to check group admin", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("creating role")
+
+	now := time.Now()
+	role := &models.LgRole{
+		LgID:        create.LgId,
+		Name:        create.Name,
+		Permissions: uniquePermissions(create.Permissions),
+		Members:     []string{},
+		CreatedBy:   create.UserID,
+		Created:     now,
+		Updated:     now,
+	}
+	if err := lgh.roleStore.SaveLgRole(ctx, role); err != nil {
+		if errors.Is(err, storage.ErrLgRoleExists) {
+			log.Warn("role already exists", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrRoleExists)
+		}
+
+		log.Error("failed to save role", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgh.recordRoleChange(ctx, create.UserID, create.LgId, fmt.Sprintf("role %s created: %s", role.Name, strings.Join(role.Permissions, ",")))
+
+	log.Info("role created")
+
+	return role, nil
+}
+
+// ListLgRoles returns custom roles of the learning group with their members.
+// Only group admins can do it.
+func (lgh *LgHanglers) ListLgRoles(ctx context.Context, list *models.ListLgRoles) ([]*models.LgRole, error) {
+	const op = "learning_group.ListLgRoles"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("user_id", list.UserID),
+		slog.String("learning_group_id", list.LgId),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(list)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := lgh.checkGroupAdmin(ctx, list.UserID, list.LgId); err != nil {
+		log.Warn("failed to check group admin", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("listing roles")
+
+	roles, err := lgh.roleStore.GetLgRoles(ctx, list.LgId)
+	if err != nil {
+		log.Error("failed to get roles", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return roles, nil
+}
+
+// UpdateLgRole replaces name and permissions of the role. Members of the role
+// get new permissions with the next check. Only group admins can do it.
+func (lgh *LgHanglers) UpdateLgRole(ctx context.Context, upd *models.UpdateLgRole) error {
+	const op = "learning_group.UpdateLgRole"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("user_id", upd.UserID),
+		slog.String("learning_group_id", upd.LgId),
+		slog.String("role_id", upd.RoleID),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(upd)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := lgh.checkGroupAdmin(ctx, upd.UserID, upd.LgId); err != nil {
+		log.Warn("failed to check group admin", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("updating role")
+
+	permissions := uniquePermissions(upd.Permissions)
+	err = lgh.roleStore.UpdateLgRole(ctx, &models.DBUpdateLgRole{
+		ID:          upd.RoleID,
+		LgID:        upd.LgId,
+		Name:        upd.Name,
+		Permissions: permissions,
+		Updated:     time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, roleStoreError(log, err, "failed to update role"))
+	}
+
+	lgh.recordRoleChange(ctx, upd.UserID, upd.LgId, fmt.Sprintf("role %s updated: %s", upd.RoleID, strings.Join(permissions, ",")))
+
+	log.Info("role updated")
+
+	return nil
+}
+
+// DeleteLgRole deletes the role, its members lose its permissions.
+// Only group admins can do it.
+func (lgh *LgHanglers) DeleteLgRole(ctx context.Context, del *models.DeleteLgRole) error {
+	const op = "learning_group.DeleteLgRole"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("user_id", del.UserID),
+		slog.String("learning_group_id", del.LgId),
+		slog.String("role_id", del.RoleID),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(del)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := lgh.checkGroupAdmin(ctx, del.UserID, del.LgId); err != nil {
+		log.Warn("failed to check group admin", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("deleting role")
+
+	if err := lgh.roleStore.DeleteLgRole(ctx, del.LgId, del.RoleID); err != nil {
+		return fmt.Errorf("%s: %w", op, roleStoreError(log, err, "failed to delete role"))
+	}
+
+	lgh.recordRoleChange(ctx, del.UserID, del.LgId, fmt.Sprintf("role %s deleted", del.RoleID))
+
+	log.Info("role deleted")
+
+	return nil
+}
+
+// AssignLgRole assigns the role to the users. Only group admins can do it.
+func (lgh *LgHanglers) AssignLgRole(ctx context.Context, change *models.ChangeLgRoleMembers) error {
+	const op = "learning_group.AssignLgRole"
+
+	log, err := lgh.checkRoleMembersChange(ctx, op, change)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("assigning role")
+
+	if err := lgh.roleStore.AddLgRoleMembers(ctx, dbChangeLgRoleMembers(change)); err != nil {
+		return fmt.Errorf("%s: %w", op, roleStoreError(log, err, "failed to assign role"))
+	}
+
+	lgh.recordRoleChange(ctx, change.UserID, change.LgId, fmt.Sprintf("role %s assigned: %s", change.RoleID, strings.Join(change.UserIDs, ",")))
+
+	log.Info("role assigned")
+
+	return nil
+}
+
+// UnassignLgRole takes the role away from the users. Only group admins can do it.
+func (lgh *LgHanglers) UnassignLgRole(ctx context.Context, change *models.ChangeLgRoleMembers) error {
+	const op = "learning_group.UnassignLgRole"
+
+	log, err := lgh.checkRoleMembersChange(ctx, op, change)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("unassigning role")
+
+	if err := lgh.roleStore.RemoveLgRoleMembers(ctx, dbChangeLgRoleMembers(change)); err != nil {
+		return fmt.Errorf("%s: %w", op, roleStoreError(log, err, "failed to unassign role"))
+	}
+
+	lgh.recordRoleChange(ctx, change.UserID, change.LgId, fmt.Sprintf("role %s unassigned: %s", change.RoleID, strings.Join(change.UserIDs, ",")))
+
+	log.Info("role unassigned")
+
+	return nil
+}
+
+// GetUserPermissions returns permissions of the user in every learning group
+// where the user is a member or has a custom role. Learners of a group
+// get learner permissions in its ancestors as well, like in UserIsLearnerIn.
+func (lgh *LgHanglers) GetUserPermissions(ctx context.Context, userID string) ([]*models.LgPermissions, error) {
+	const op = "learning_group.GetUserPermissions"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("user_id", userID),
+	)
+
+	if userID == "" {
+		log.Warn("empty user id")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	adminIn, err := lgh.groupeProvider.GetUserIsGroupAdminIn(ctx, &models.UserIsGroupAdminIn{UserID: userID})
+	if err != nil {
+		log.Error("failed to get groups where user is group admin", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	learnerIn, err := lgh.learnerGroupIDs(ctx, userID)
+	if err != nil {
+		log.Error("failed to get groups where user is learner", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	roles, err := lgh.roleStore.GetUserLgRoles(ctx, userID)
+	if err != nil {
+		log.Error("failed to get user roles", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var perms []*models.LgPermissions
+	byGroup := make(map[string]*models.LgPermissions)
+	grant := func(lgID string, permissions []string) {
+		p, ok := byGroup[lgID]
+		if !ok {
+			p = &models.LgPermissions{LgID: lgID}
+			byGroup[lgID] = p
+			perms = append(perms, p)
+		}
+		p.AddPermissions(permissions)
+	}
+
+	for _, lgID := range adminIn {
+		grant(lgID, models.BuiltinRolePermissions(models.GroupRoleGroupAdmin))
+	}
+	for _, lgID := range learnerIn {
+		grant(lgID, models.BuiltinRolePermissions(models.GroupRoleLearner))
+	}
+	for _, role := range roles {
+		grant(role.LgID, role.Permissions)
+	}
+
+	return perms, nil
+}
+
+// checkRoleMembersChange validates the change and checks that the user is group admin.
+func (lgh *LgHanglers) checkRoleMembersChange(ctx context.Context, op string, change *models.ChangeLgRoleMembers) (*slog.Logger, error) {
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("user_id", change.UserID),
+		slog.String("learning_group_id", change.LgId),
+		slog.String("role_id", change.RoleID),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(change)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return log, ErrInvalidCredentials
+	}
+
+	if err := lgh.checkGroupAdmin(ctx, change.UserID, change.LgId); err != nil {
+		log.Warn("failed to check group admin", slog.String("err", err.Error()))
+		return log, err
+	}
+
+	return log, nil
+}
+
+func (lgh *LgHanglers) recordRoleChange(ctx context.Context, userID, lgID, details string) {
+	lgh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:     models.AuditEventLgRoleChange,
+		UserID:   userID,
+		ActorID:  userID,
+		TargetID: lgID,
+		Details:  details,
+	})
+}
+
+// roleStoreError maps storage errors of role changes to service errors.
+func roleStoreError(log *slog.Logger, err error, failedMsg string) error {
+	switch {
+	case errors.Is(err, storage.ErrLgRoleNotFound):
+		log.Warn("role not found", slog.String("err", err.Error()))
+		return ErrRoleNotFound
+	case errors.Is(err, storage.ErrLgRoleExists):
+		log.Warn("role already exists", slog.String("err", err.Error()))
+		return ErrRoleExists
+	default:
+		log.Error(failedMsg, slog.String("err", err.Error()))
+		return err
+	}
+}
+
+func dbChangeLgRoleMembers(change *models.ChangeLgRoleMembers) *models.DBChangeLgRoleMembers {
+	return &models.DBChangeLgRoleMembers{
+		ID:      change.RoleID,
+		LgID:    change.LgId,
+		UserIDs: change.UserIDs,
+		Updated: time.Now(),
+	}
+}
+
+// uniquePermissions returns sorted permissions without duplicates.
+func uniquePermissions(permissions []string) []string {
+	return slices.Compact(slices.Sorted(slices.Values(permissions)))
+}
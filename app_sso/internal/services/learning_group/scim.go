@@ -0,0 +1,411 @@
+package learninggroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+const (
+	defaultScimGroupsLimit = 20 // TODO: transfer to config
+	maxScimGroupsLimit     = 100
+)
+
+// ScimListGroups returns page of learning groups for SCIM client.
+// Groups are ordered by creation, StartIndex is one-based.
+func (lgh *LgHanglers) ScimListGroups(ctx context.Context, list *models.ScimListGroups) (*models.ScimGroupsPage, error) {
+	const op = "learning_group.ScimListGroups"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("client_id", list.ClientID),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(list)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	limit := list.Count
+	if limit == 0 {
+		limit = defaultScimGroupsLimit
+	}
+	limit = min(limit, maxScimGroupsLimit)
+
+	log.Info("listing learning groups")
+
+	groups, total, err := lgh.groupeProvider.FindLgsPage(ctx, &models.ScimGroupsFilter{
+		Name:   list.Name,
+		Offset: max(list.StartIndex-1, 0),
+		Limit:  limit,
+	})
+	if err != nil {
+		log.Error("failed to list learning groups", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &models.ScimGroupsPage{
+		Groups: groups,
+		Total:  total,
+	}, nil
+}
+
+func (lgh *LgHanglers) ScimGetGroup(ctx context.Context, get *models.ScimManageGroup) (*models.ScimGroup, error) {
+	const op = "learning_group.ScimGetGroup"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("client_id", get.ClientID),
+		slog.String("learning_group_id", get.LgID),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(get)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	group, err := lgh.findScimGroup(ctx, get.LgID)
+	if err != nil {
+		log.Warn("failed to get learning group", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return group, nil
+}
+
+// ScimCreateGroup creates learning group with the learners. The group has
+// no group admins, they are assigned in the platform.
+func (lgh *LgHanglers) ScimCreateGroup(ctx context.Context, create *models.ScimCreateGroup) (*models.ScimGroup, error) {
+	const op = "learning_group.ScimCreateGroup"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("client_id", create.ClientID),
+		slog.String("learning_group", create.Name),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(create)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	members := uniqueIDs(create.Members)
+	if err := lgh.checkUsersExist(ctx, members); err != nil {
+		log.Warn("failed to check members", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("creating learning_group")
+
+	now := time.Now()
+	dbGroup := &models.DBCreateLearningGroup{
+		Name:        create.Name,
+		GroupAdmins: []string{},
+		CreatedBy:   create.ClientID,
+		ModifiedBy:  create.ClientID,
+		Created:     now,
+		Updated:     now,
+		Learners:    members,
+		Version:     1,
+	}
+	if err := lgh.groupSaver.SaveLg(ctx, dbGroup); err != nil {
+		if errors.Is(err, storage.ErrLgExitsts) {
+			log.Warn("learning_group already exists", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrGroupExists)
+		}
+
+		log.Error("failed to save learning_group", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:     models.AuditEventLearningGroupCreate,
+		ActorID:  create.ClientID,
+		TargetID: dbGroup.ID,
+	})
+
+	if len(members) > 0 {
+		if err := lgh.shareWithLearners(ctx, log, dbGroup.ID, members, create.ClientID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	log.Info("learning_group created successfully")
+
+	return &models.ScimGroup{
+		ID:      dbGroup.ID,
+		Name:    dbGroup.Name,
+		Members: members,
+		Created: dbGroup.Created,
+		Updated: dbGroup.Updated,
+		Version: dbGroup.Version,
+	}, nil
+}
+
+// ScimUpdateGroup replaces name and learners of the learning group.
+// Only the difference is applied, so learners which stay aren't touched.
+func (lgh *LgHanglers) ScimUpdateGroup(ctx context.Context, upd *models.ScimUpdateGroup) (*models.ScimGroup, error) {
+	const op = "learning_group.ScimUpdateGroup"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("client_id", upd.ClientID),
+		slog.String("learning_group_id", upd.LgID),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(upd)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	group, err := lgh.findScimGroup(ctx, upd.LgID)
+	if err != nil {
+		log.Warn("failed to get learning group", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	members := uniqueIDs(upd.Members)
+	if err := lgh.checkUsersExist(ctx, members); err != nil {
+		log.Warn("failed to check members", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("updating learning_group")
+
+	if upd.Name != group.Name {
+		err := lgh.groupSaver.UpdateLgByID(ctx, &models.DBUpdateLearningGroup{
+			ID:         group.ID,
+			Name:       upd.Name,
+			ModifiedBy: upd.ClientID,
+			Updated:    time.Now(),
+		})
+		if err != nil {
+			log.Error("failed to update learning_group", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, scimGroupError(err))
+		}
+
+		lgh.auditRecorder.Record(ctx, &models.AuditEvent{
+			Type:     models.AuditEventLearningGroupUpdate,
+			ActorID:  upd.ClientID,
+			TargetID: group.ID,
+			Details:  "renamed",
+		})
+	}
+
+	var add, remove []string
+	for _, userID := range members {
+		if !slices.Contains(group.Members, userID) {
+			add = append(add, userID)
+		}
+	}
+	for _, userID := range group.Members {
+		if !slices.Contains(members, userID) {
+			remove = append(remove, userID)
+		}
+	}
+
+	if err := lgh.changeScimMembers(ctx, log, upd.ClientID, group.ID, add, remove); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	group, err = lgh.findScimGroup(ctx, group.ID)
+	if err != nil {
+		log.Error("failed to get updated learning group", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("learning_group updated successfully")
+
+	return group, nil
+}
+
+// ScimChangeGroupMembers adds and removes learners of the learning group.
+// Existing learners are skipped on add, missing ones are skipped on remove.
+func (lgh *LgHanglers) ScimChangeGroupMembers(ctx context.Context, change *models.ScimChangeGroupMembers) error {
+	const op = "learning_group.ScimChangeGroupMembers"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("client_id", change.ClientID),
+		slog.String("learning_group_id", change.LgID),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(change)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	if len(change.Add) == 0 && len(change.Remove) == 0 {
+		log.Warn("no members to change")
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if _, err := lgh.findScimGroup(ctx, change.LgID); err != nil {
+		log.Warn("failed to get learning group", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	add := uniqueIDs(change.Add)
+	if err := lgh.checkUsersExist(ctx, add); err != nil {
+		log.Warn("failed to check members", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := lgh.changeScimMembers(ctx, log, change.ClientID, change.LgID, add, uniqueIDs(change.Remove)); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ScimDeleteGroup deletes the learning group with its invites and roles.
+func (lgh *LgHanglers) ScimDeleteGroup(ctx context.Context, del *models.ScimManageGroup) error {
+	const op = "learning_group.ScimDeleteGroup"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("client_id", del.ClientID),
+		slog.String("learning_group_id", del.LgID),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(del)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if _, err := lgh.findScimGroup(ctx, del.LgID); err != nil {
+		log.Warn("failed to get learning group", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("deleting learning_group")
+
+	if err := lgh.groupeDel.DeleteLgByID(ctx, &models.DelGroup{
+		UserID: del.ClientID,
+		LgId:   del.LgID,
+	}); err != nil {
+		log.Error("failed to delete learning_group", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	lgh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:     models.AuditEventLearningGroupDelete,
+		ActorID:  del.ClientID,
+		TargetID: del.LgID,
+	})
+
+	log.Info("learning_group deleted successfully")
+
+	return nil
+}
+
+func (lgh *LgHanglers) changeScimMembers(ctx context.Context, log *slog.Logger, clientID, lgID string, add, remove []string) error {
+	if len(add) > 0 {
+		change := &models.DBChangeLgMembers{
+			LgID:       lgID,
+			Role:       models.GroupRoleLearner,
+			UserIDs:    add,
+			ModifiedBy: clientID,
+			Updated:    time.Now(),
+		}
+		if err := lgh.groupSaver.AddLgMembers(ctx, change); err != nil {
+			log.Error("failed to add members", slog.String("err", err.Error()))
+			return scimGroupError(err)
+		}
+		lgh.recordScimMembersChange(ctx, clientID, lgID, "added", add)
+
+		if err := lgh.shareWithLearners(ctx, log, lgID, add, clientID); err != nil {
+			return err
+		}
+	}
+
+	if len(remove) > 0 {
+		change := &models.DBChangeLgMembers{
+			LgID:       lgID,
+			Role:       models.GroupRoleLearner,
+			UserIDs:    remove,
+			ModifiedBy: clientID,
+			Updated:    time.Now(),
+		}
+		if err := lgh.groupSaver.RemoveLgMembers(ctx, change); err != nil {
+			log.Error("failed to remove members", slog.String("err", err.Error()))
+			return scimGroupError(err)
+		}
+		lgh.recordScimMembersChange(ctx, clientID, lgID, "removed", remove)
+	}
+
+	return nil
+}
+
+func (lgh *LgHanglers) recordScimMembersChange(ctx context.Context, clientID, lgID, action string, userIDs []string) {
+	lgh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:     models.AuditEventLearningGroupUpdate,
+		ActorID:  clientID,
+		TargetID: lgID,
+		Details:  fmt.Sprintf("%s %s: %s", action, models.GroupRoleLearner, strings.Join(userIDs, ",")),
+	})
+}
+
+func (lgh *LgHanglers) findScimGroup(ctx context.Context, lgID string) (*models.ScimGroup, error) {
+	groups, _, err := lgh.groupeProvider.FindLgsPage(ctx, &models.ScimGroupsFilter{
+		ID:    lgID,
+		Limit: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, ErrGroupNotFound
+	}
+
+	return groups[0], nil
+}
+
+// checkUsersExist returns ErrUserNotFound if any of the users doesn't exist.
+func (lgh *LgHanglers) checkUsersExist(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	users, err := lgh.groupeProvider.GetUsersInfoBatch(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	if len(users) < len(userIDs) {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+func scimGroupError(err error) error {
+	if errors.Is(err, storage.ErrLgNotFound) {
+		return ErrGroupNotFound
+	}
+	if errors.Is(err, storage.ErrLgExitsts) {
+		return ErrGroupExists
+	}
+	return err
+}
+
+func uniqueIDs(ids []string) []string {
+	return slices.Compact(slices.Sorted(slices.Values(ids)))
+}
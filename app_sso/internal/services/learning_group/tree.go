@@ -0,0 +1,255 @@
+package learninggroup
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+// GetLearningGroupTree returns the learning group with its subtree.
+// Group admins of the group or of its ancestors and learners
+// of the subtree can get it.
+func (lgh *LgHanglers) GetLearningGroupTree(ctx context.Context, get *models.GetLgTree) (*models.LgTreeNode, error) {
+	const op = "learning_group.GetLearningGroupTree"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("user_id", get.UserID),
+		slog.String("learning_group_id", get.LgId),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(get)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	learnerIn, err := lgh.learnerGroupIDs(ctx, get.UserID)
+	if err != nil {
+		log.Error("failed to get groups where user is learner", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !slices.Contains(learnerIn, get.LgId) {
+		if err := lgh.checkTreeAdmin(ctx, get.UserID, get.LgId); err != nil {
+			log.Warn("failed to check group admin", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	log.Info("getting learning_group tree")
+
+	groups, err := lgh.groupeProvider.GetLgSubtree(ctx, get.LgId)
+	if err != nil {
+		if errors.Is(err, storage.ErrLgNotFound) {
+			log.Warn("learning_group not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrGroupNotFound)
+		}
+
+		log.Error("failed to get learning_group subtree", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return buildLgTree(groups), nil
+}
+
+// MoveLearningGroup moves the learning group with its subtree under the parent,
+// empty ParentID makes the group a root one. The user has to be group admin
+// of both the group and the parent or of their ancestors. The group can't be
+// moved under itself or its descendants. Learners of the subtree get content
+// shared with the new ancestors.
+func (lgh *LgHanglers) MoveLearningGroup(ctx context.Context, move *models.MoveLearningGroup) error {
+	const op = "learning_group.MoveLearningGroup"
+
+	log := lgh.log.With(
+		slog.String("op", op),
+		slog.String("user_id", move.UserID),
+		slog.String("learning_group_id", move.LgId),
+		slog.String("parent_id", move.ParentID),
+	)
+
+	// Validation
+	err := lgh.validator.Struct(move)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	if move.ParentID == move.LgId {
+		log.Warn("group can't be its own parent")
+		return fmt.Errorf("%s: %w", op, ErrGroupCycle)
+	}
+
+	if err := lgh.checkTreeAdmin(ctx, move.UserID, move.LgId); err != nil {
+		log.Warn("failed to check group admin", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if move.ParentID != "" {
+		if err := lgh.checkTreeAdmin(ctx, move.UserID, move.ParentID); err != nil {
+			log.Warn("failed to check group admin of parent", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	subtree, err := lgh.groupeProvider.GetLgSubtree(ctx, move.LgId)
+	if err != nil {
+		if errors.Is(err, storage.ErrLgNotFound) {
+			log.Warn("learning_group not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrGroupNotFound)
+		}
+
+		log.Error("failed to get learning_group subtree", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if slices.ContainsFunc(subtree, func(lg *models.LearningGroupShort) bool {
+		return lg.ID == move.ParentID
+	}) {
+		log.Warn("parent is a descendant of the group")
+		return fmt.Errorf("%s: %w", op, ErrGroupCycle)
+	}
+
+	log.Info("moving learning_group")
+
+	err = lgh.groupSaver.MoveLg(ctx, &models.DBMoveLearningGroup{
+		ID:         move.LgId,
+		ParentID:   move.ParentID,
+		ModifiedBy: move.UserID,
+		Updated:    time.Now(),
+		Version:    move.Version,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrLgNotFound):
+			log.Warn("learning_group not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrGroupNotFound)
+		case errors.Is(err, storage.ErrLgVersionConflict):
+			log.Warn("learning_group version conflict", slog.Int64("version", move.Version))
+			return fmt.Errorf("%s: %w", op, ErrVersionConflict)
+		case errors.Is(err, storage.ErrLgCycle):
+			log.Warn("learning_group was moved under the group concurrently", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrGroupCycle)
+		default:
+			log.Error("failed to move learning_group", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	lgh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:     models.AuditEventLearningGroupUpdate,
+		UserID:   move.UserID,
+		ActorID:  move.UserID,
+		TargetID: move.LgId,
+		Details:  fmt.Sprintf("parent_id=%s", move.ParentID),
+	})
+
+	if move.ParentID != "" {
+		learners, err := lgh.subtreeLearners(ctx, subtree)
+		if err != nil {
+			log.Error("failed to get learners of subtree", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if len(learners) != 0 {
+			if err := lgh.shareWithLearners(ctx, log, move.ParentID, learners, move.UserID); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+
+	log.Info("learning_group moved")
+
+	return nil
+}
+
+// checkTreeAdmin checks that the user is group admin of the group
+// or of one of its ancestors.
+func (lgh *LgHanglers) checkTreeAdmin(ctx context.Context, userID, lgID string) error {
+	adminIn, err := lgh.groupeProvider.GetUserIsGroupAdminIn(ctx, &models.UserIsGroupAdminIn{UserID: userID})
+	if err != nil {
+		return err
+	}
+	if slices.Contains(adminIn, lgID) {
+		return nil
+	}
+
+	ancestorIDs, err := lgh.groupeProvider.GetLgAncestorIDs(ctx, []string{lgID})
+	if err != nil {
+		return err
+	}
+	for _, id := range ancestorIDs {
+		if slices.Contains(adminIn, id) {
+			return nil
+		}
+	}
+
+	return ErrPermissionDenied
+}
+
+// learnerGroupIDs returns groups where the user is learner with their ancestors.
+func (lgh *LgHanglers) learnerGroupIDs(ctx context.Context, userID string) ([]string, error) {
+	lgIDs, err := lgh.groupeProvider.GetUserIsLearnerIn(ctx, &models.UserIsLearnerIn{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	if len(lgIDs) == 0 {
+		return lgIDs, nil
+	}
+
+	ancestorIDs, err := lgh.groupeProvider.GetLgAncestorIDs(ctx, lgIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return uniqueIDs(append(lgIDs, ancestorIDs...)), nil
+}
+
+// subtreeLearners returns learners of all the groups without duplicates.
+func (lgh *LgHanglers) subtreeLearners(ctx context.Context, groups []*models.LearningGroupShort) ([]string, error) {
+	var learners []string
+	for _, lg := range groups {
+		groupLearners, err := lgh.groupeProvider.GetLearners(ctx, &models.GetLearners{LgId: lg.ID})
+		if err != nil {
+			return nil, err
+		}
+		learners = append(learners, groupLearners...)
+	}
+	if len(learners) == 0 {
+		return learners, nil
+	}
+
+	return uniqueIDs(learners), nil
+}
+
+// buildLgTree builds the tree from the subtree returned by storage,
+// the root group is the first one. Children are ordered by name.
+func buildLgTree(groups []*models.LearningGroupShort) *models.LgTreeNode {
+	nodes := make(map[string]*models.LgTreeNode, len(groups))
+	for _, lg := range groups {
+		nodes[lg.ID] = &models.LgTreeNode{
+			ID:       lg.ID,
+			Name:     lg.Name,
+			ParentID: lg.ParentID,
+			Children: []*models.LgTreeNode{},
+		}
+	}
+
+	root := nodes[groups[0].ID]
+	for _, lg := range groups[1:] {
+		if parent, ok := nodes[lg.ParentID]; ok {
+			parent.Children = append(parent.Children, nodes[lg.ID])
+		}
+	}
+
+	for _, node := range nodes {
+		slices.SortFunc(node.Children, func(a, b *models.LgTreeNode) int {
+			return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+		})
+	}
+
+	return root
+}
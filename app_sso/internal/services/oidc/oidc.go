@@ -0,0 +1,539 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/auth"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/DimTur/lp_auth/pkg/crypto"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+
+	ResponseTypeCode           = "code"
+	GrantTypeAuthorizationCode = "authorization_code"
+	CodeChallengeMethodS256    = "S256"
+)
+
+// ScopesSupported are scopes which relying parties can request.
+var ScopesSupported = []string{ScopeOpenID, ScopeProfile, ScopeEmail}
+
+const (
+	authCodeExpiresIn  = time.Minute // TODO: transfer to config
+	idTokenExpiresIn   = time.Hour   // TODO: transfer to config
+	oidcTokenExpiresIn = time.Hour   // TODO: transfer to config
+	clientSecretLength = 32
+	authCodeLength     = 32
+)
+
+type RelyingPartySaver interface {
+	SaveRelyingParty(ctx context.Context, rp *models.DBCreateRelyingParty) error
+}
+
+type RelyingPartyProvider interface {
+	FindRelyingPartyByID(ctx context.Context, clientID string) (*models.RelyingParty, error)
+}
+
+type UserProvider interface {
+	FindUserByID(ctx context.Context, userID string) (*models.User, error)
+	GetUserRoles(ctx context.Context, userID string) (*models.UserRoles, error)
+}
+
+type AuthCodeStore interface {
+	SaveOIDCAuthCode(ctx context.Context, code string, authCode *models.OIDCAuthCode, ttl time.Duration) error
+	PopOIDCAuthCode(ctx context.Context, code string) (*models.OIDCAuthCode, error)
+}
+
+// UserAuthenticator checks credentials of the user, it is auth.AuthHandlers.
+type UserAuthenticator interface {
+	AuthenticateUser(ctx context.Context, creds *models.AuthenticateUser) (*models.User, error)
+}
+
+type JWTManager interface {
+	IssueIDToken(
+		issuer, clientID, userID, nonce string,
+		authTime time.Time,
+		expiresIn time.Duration,
+		extra map[string]interface{},
+	) (string, error)
+	IssueOIDCAccessToken(userID, clientID string, scopes []string, expiresIn time.Duration) (string, error)
+	VerifyToken(tokenString string) (*jwt.Token, error)
+}
+
+var (
+	ErrInvalidCredentials   = errors.New("invalid credentials")
+	ErrPermissionDenied     = errors.New("you don't have permissions")
+	ErrUserNotFound         = errors.New("user not found")
+	ErrRelyingPartyExists   = errors.New("relying party already exists")
+	ErrInvalidClient        = errors.New("invalid client")
+	ErrInvalidRedirectURI   = errors.New("invalid redirect uri")
+	ErrInvalidRequest       = errors.New("invalid request")
+	ErrUnsupportedResponse  = errors.New("unsupported response type")
+	ErrInvalidScope         = errors.New("invalid scope")
+	ErrUnsupportedGrantType = errors.New("unsupported grant type")
+	ErrInvalidGrant         = errors.New("invalid grant")
+	ErrInvalidAccessToken   = errors.New("invalid access token")
+	ErrMFARequired          = errors.New("second factor is required")
+	ErrEmailNotVerified     = errors.New("email is not verified")
+	ErrTooManyAttempts      = errors.New("too many failed login attempts")
+	ErrUserDeactivated      = errors.New("user is deactivated")
+)
+
+type OIDCHandlers struct {
+	log            *slog.Logger
+	validator      *validator.Validate
+	issuer         string
+	rpSaver        RelyingPartySaver
+	rpProvider     RelyingPartyProvider
+	usrProvider    UserProvider
+	authenticator  UserAuthenticator
+	authCodeStore  AuthCodeStore
+	passwordHasher crypto.PasswordHasher
+	jwtManager     JWTManager
+}
+
+func New(
+	log *slog.Logger,
+	validator *validator.Validate,
+	issuer string,
+	rpSaver RelyingPartySaver,
+	rpProvider RelyingPartyProvider,
+	usrProvider UserProvider,
+	authenticator UserAuthenticator,
+	authCodeStore AuthCodeStore,
+	passwordHasher crypto.PasswordHasher,
+	jwtManager JWTManager,
+) *OIDCHandlers {
+	return &OIDCHandlers{
+		log:            log,
+		validator:      validator,
+		issuer:         issuer,
+		rpSaver:        rpSaver,
+		rpProvider:     rpProvider,
+		usrProvider:    usrProvider,
+		authenticator:  authenticator,
+		authCodeStore:  authCodeStore,
+		passwordHasher: passwordHasher,
+		jwtManager:     jwtManager,
+	}
+}
+
+// Issuer returns OIDC issuer URL which endpoints of the provider are relative to.
+func (oh *OIDCHandlers) Issuer() string {
+	return oh.issuer
+}
+
+// RegisterRelyingParty registers new OIDC client. Only platform admins can do it.
+// Public clients (e.g. SPA or CLI tools) get no secret and must use PKCE only.
+func (oh *OIDCHandlers) RegisterRelyingParty(ctx context.Context, rp *models.CreateRelyingParty) (*models.RelyingPartyCredentials, error) {
+	const op = "oidc.RegisterRelyingParty"
+
+	log := oh.log.With(
+		slog.String("op", op),
+		slog.String("name", rp.Name),
+		slog.String("creating_by", rp.UserID),
+	)
+
+	// Validation
+	err := oh.validator.Struct(rp)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	roles, err := oh.usrProvider.GetUserRoles(ctx, rp.UserID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to get user roles", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !roles.IsAdmin {
+		log.Warn("permissions denied")
+		return nil, fmt.Errorf("%s: %w", op, ErrPermissionDenied)
+	}
+
+	log.Info("registering relying party")
+
+	dbRP := &models.DBCreateRelyingParty{
+		Name:         rp.Name,
+		RedirectURIs: rp.RedirectURIs,
+		CreatedBy:    rp.UserID,
+		Created:      time.Now(),
+	}
+
+	var secret string
+	if !rp.Public {
+		secret, err = randomString(clientSecretLength)
+		if err != nil {
+			log.Error("failed to generate client secret", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		dbRP.SecretHash, err = oh.passwordHasher.HashPassword(secret)
+		if err != nil {
+			log.Error("failed to hash client secret", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := oh.rpSaver.SaveRelyingParty(ctx, dbRP); err != nil {
+		if errors.Is(err, storage.ErrRelyingPartyExists) {
+			log.Warn("relying party already exists", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrRelyingPartyExists)
+		}
+
+		log.Error("failed to save relying party", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("relying party registered successfully", slog.String("client_id", dbRP.ID))
+
+	return &models.RelyingPartyCredentials{
+		ClientID:     dbRP.ID,
+		ClientSecret: secret,
+	}, nil
+}
+
+// ValidateAuthorize checks authorization request before the login form is shown.
+//
+// ErrInvalidClient and ErrInvalidRedirectURI mean that the user must not be
+// redirected back to the relying party, other errors are sent to redirect_uri.
+func (oh *OIDCHandlers) ValidateAuthorize(ctx context.Context, req *models.OIDCAuthorize) error {
+	const op = "oidc.ValidateAuthorize"
+
+	log := oh.log.With(
+		slog.String("op", op),
+		slog.String("client_id", req.ClientID),
+	)
+
+	if err := oh.validateAuthorize(ctx, req); err != nil {
+		log.Warn("invalid authorization request", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Authorize authenticates the user and issues authorization code
+// for the validated authorization request.
+func (oh *OIDCHandlers) Authorize(ctx context.Context, login *models.OIDCLogin) (string, error) {
+	const op = "oidc.Authorize"
+
+	log := oh.log.With(
+		slog.String("op", op),
+		slog.String("client_id", login.Authorize.ClientID),
+	)
+
+	if err := oh.validateAuthorize(ctx, &login.Authorize); err != nil {
+		log.Warn("invalid authorization request", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	user, err := oh.authenticator.AuthenticateUser(ctx, &login.Credentials)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidCredentials):
+			return "", fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		case errors.Is(err, auth.ErrMFARequired):
+			return "", fmt.Errorf("%s: %w", op, ErrMFARequired)
+		case errors.Is(err, auth.ErrEmailNotVerified):
+			return "", fmt.Errorf("%s: %w", op, ErrEmailNotVerified)
+		case errors.Is(err, auth.ErrTooManyAttempts):
+			return "", fmt.Errorf("%s: %w", op, ErrTooManyAttempts)
+		case errors.Is(err, auth.ErrUserDeactivated):
+			return "", fmt.Errorf("%s: %w", op, ErrUserDeactivated)
+		}
+
+		log.Error("failed to authenticate user", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	code, err := randomString(authCodeLength)
+	if err != nil {
+		log.Error("failed to generate authorization code", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	authCode := &models.OIDCAuthCode{
+		ClientID:      login.Authorize.ClientID,
+		RedirectURI:   login.Authorize.RedirectURI,
+		UserID:        user.ID,
+		Scopes:        strings.Fields(login.Authorize.Scope),
+		Nonce:         login.Authorize.Nonce,
+		CodeChallenge: login.Authorize.CodeChallenge,
+		AuthTime:      time.Now(),
+	}
+	if err := oh.authCodeStore.SaveOIDCAuthCode(ctx, code, authCode, authCodeExpiresIn); err != nil {
+		log.Error("failed to save authorization code", slog.String("err", err.Error()))
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("authorization code issued", slog.String("user_id", user.ID))
+
+	return code, nil
+}
+
+// Exchange exchanges authorization code to ID and access tokens.
+// Code verifier is always required, client secret only for confidential clients.
+func (oh *OIDCHandlers) Exchange(ctx context.Context, req *models.OIDCTokenRequest) (*models.OIDCTokens, error) {
+	const op = "oidc.Exchange"
+
+	log := oh.log.With(
+		slog.String("op", op),
+		slog.String("client_id", req.ClientID),
+	)
+
+	if req.GrantType != GrantTypeAuthorizationCode {
+		log.Warn("unsupported grant type", slog.String("grant_type", req.GrantType))
+		return nil, fmt.Errorf("%s: %w", op, ErrUnsupportedGrantType)
+	}
+
+	// Validation
+	err := oh.validator.Struct(req)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRequest)
+	}
+
+	rp, err := oh.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		log.Warn("client authentication failed", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Code is deleted before checks, so it can't be guessed with verifier brute force
+	authCode, err := oh.authCodeStore.PopOIDCAuthCode(ctx, req.Code)
+	if err != nil {
+		if errors.Is(err, storage.ErrAuthCodeNotFound) {
+			log.Warn("authorization code not found")
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidGrant)
+		}
+
+		log.Error("failed to get authorization code", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if authCode.ClientID != rp.ID || authCode.RedirectURI != req.RedirectURI {
+		log.Warn("authorization code was issued to another client or redirect uri")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidGrant)
+	}
+
+	if !verifyCodeChallenge(authCode.CodeChallenge, req.CodeVerifier) {
+		log.Warn("invalid code verifier")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidGrant)
+	}
+
+	user, err := oh.usrProvider.FindUserByID(ctx, authCode.UserID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidGrant)
+		}
+
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// User could be deactivated after the code was issued
+	if user.Status == models.UserStatusDeactivated {
+		log.Warn("user is deactivated", slog.String("user_id", user.ID))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidGrant)
+	}
+
+	idToken, err := oh.jwtManager.IssueIDToken(
+		oh.issuer,
+		rp.ID,
+		user.ID,
+		authCode.Nonce,
+		authCode.AuthTime,
+		idTokenExpiresIn,
+		userClaims(user, authCode.Scopes),
+	)
+	if err != nil {
+		log.Error("failed to issue id token", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	accessToken, err := oh.jwtManager.IssueOIDCAccessToken(user.ID, rp.ID, authCode.Scopes, oidcTokenExpiresIn)
+	if err != nil {
+		log.Error("failed to issue access token", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("authorization code exchanged", slog.String("user_id", user.ID))
+
+	return &models.OIDCTokens{
+		AccessToken: accessToken,
+		IDToken:     idToken,
+		ExpiresIn:   oidcTokenExpiresIn,
+		Scopes:      authCode.Scopes,
+	}, nil
+}
+
+// UserInfo returns claims of the user which access token was issued for.
+func (oh *OIDCHandlers) UserInfo(ctx context.Context, accessToken string) (*models.OIDCUserInfo, error) {
+	const op = "oidc.UserInfo"
+
+	log := oh.log.With(
+		slog.String("op", op),
+	)
+
+	token, err := oh.jwtManager.VerifyToken(accessToken)
+	if err != nil {
+		log.Warn("token verification failed", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid || claims["type"] != "oidc_access" {
+		log.Warn("invalid token claims or type")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+	}
+
+	userID, ok := claims["sub"].(string)
+	if !ok {
+		log.Warn("invalid subject claim")
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+	}
+	scope, _ := claims["scope"].(string)
+
+	user, err := oh.usrProvider.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+		}
+
+		log.Error("failed to get user", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Access token of deactivated user is revoked
+	if user.Status == models.UserStatusDeactivated {
+		log.Warn("user is deactivated", slog.String("user_id", user.ID))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidAccessToken)
+	}
+
+	scopes := strings.Fields(scope)
+	userInfo := &models.OIDCUserInfo{
+		Sub: user.ID,
+	}
+	if slices.Contains(scopes, ScopeEmail) {
+		verified := user.Status != models.UserStatusPendingVerification
+		userInfo.Email = user.Email
+		userInfo.EmailVerified = &verified
+	}
+	if slices.Contains(scopes, ScopeProfile) {
+		userInfo.Name = user.Name
+	}
+
+	return userInfo, nil
+}
+
+func (oh *OIDCHandlers) validateAuthorize(ctx context.Context, req *models.OIDCAuthorize) error {
+	if err := oh.validator.Struct(req); err != nil {
+		return ErrInvalidClient
+	}
+
+	rp, err := oh.rpProvider.FindRelyingPartyByID(ctx, req.ClientID)
+	if err != nil {
+		if errors.Is(err, storage.ErrRelyingPartyNotFound) {
+			return ErrInvalidClient
+		}
+		return err
+	}
+
+	// Redirect URI must match one of registered exactly
+	if !slices.Contains(rp.RedirectURIs, req.RedirectURI) {
+		return ErrInvalidRedirectURI
+	}
+
+	if req.ResponseType != ResponseTypeCode {
+		return ErrUnsupportedResponse
+	}
+
+	scopes := strings.Fields(req.Scope)
+	if !slices.Contains(scopes, ScopeOpenID) {
+		return ErrInvalidScope
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(ScopesSupported, scope) {
+			return ErrInvalidScope
+		}
+	}
+
+	if req.CodeChallenge == "" || req.CodeChallengeMethod != CodeChallengeMethodS256 {
+		return ErrInvalidRequest
+	}
+
+	return nil
+}
+
+// authenticateClient checks client secret of confidential relying party.
+// Public relying parties are authenticated only by PKCE.
+func (oh *OIDCHandlers) authenticateClient(ctx context.Context, clientID, clientSecret string) (*models.RelyingParty, error) {
+	rp, err := oh.rpProvider.FindRelyingPartyByID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, storage.ErrRelyingPartyNotFound) {
+			return nil, ErrInvalidClient
+		}
+		return nil, err
+	}
+
+	if len(rp.SecretHash) > 0 && !oh.passwordHasher.ComparePassword(rp.SecretHash, clientSecret) {
+		return nil, ErrInvalidClient
+	}
+
+	return rp, nil
+}
+
+// verifyCodeChallenge checks PKCE code verifier with S256 method (RFC 7636).
+func verifyCodeChallenge(challenge, verifier string) bool {
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+// userClaims returns ID token claims of the user granted by scopes.
+func userClaims(user *models.User, scopes []string) map[string]interface{} {
+	claims := make(map[string]interface{})
+	if slices.Contains(scopes, ScopeEmail) {
+		claims["email"] = user.Email
+		claims["email_verified"] = user.Status != models.UserStatusPendingVerification
+	}
+	if slices.Contains(scopes, ScopeProfile) {
+		claims["name"] = user.Name
+	}
+
+	return claims
+}
+
+func randomString(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
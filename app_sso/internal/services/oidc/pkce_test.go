@@ -0,0 +1,32 @@
+package oidc
+
+import "testing"
+
+func TestVerifyCodeChallenge(t *testing.T) {
+	// challenge is BASE64URL(SHA256(verifier)) computed with openssl
+	const (
+		verifier  = "dBjftJeZ4CVP-mJ92K9XT1WsNE5KHmnhaPDx7bnjlyU"
+		challenge = "vEyWY74E3X89xCVEJmfNz5tIYeovTHeuT54NXncMxUg"
+	)
+
+	tests := []struct {
+		name      string
+		challenge string
+		verifier  string
+		want      bool
+	}{
+		{name: "valid verifier", challenge: challenge, verifier: verifier, want: true},
+		{name: "wrong verifier", challenge: challenge, verifier: verifier + "x", want: false},
+		{name: "plain method value", challenge: verifier, verifier: verifier, want: false},
+		{name: "empty verifier", challenge: challenge, verifier: "", want: false},
+		{name: "empty challenge", challenge: "", verifier: verifier, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verifyCodeChallenge(tt.challenge, tt.verifier); got != tt.want {
+				t.Errorf("verifyCodeChallenge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -6,3 +6,17 @@ type MsgOTP struct {
 	Otp    redis.CreateOTP `json:"otp"`
 	ChatID int             `json:"chat_id"`
 }
+
+type MsgEmailVerification struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Token  string `json:"token"`
+}
+
+type MsgUserInvite struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+	TgLink string `json:"tg_link"`
+	Token  string `json:"token"`
+}
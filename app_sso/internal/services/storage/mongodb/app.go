@@ -0,0 +1,50 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const (
+	CollApps = "apps"
+)
+
+func (m *MClient) SaveApp(ctx context.Context, app *models.DBCreateApp) error {
+	const op = "storage.mongodb.SaveApp"
+
+	coll := m.client.Database(m.dbname).Collection(CollApps)
+	app.ID = primitive.NewObjectID().Hex()
+	_, err := coll.InsertOne(ctx, app)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrAppExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (m *MClient) FindAppByID(ctx context.Context, appID string) (*models.App, error) {
+	const op = "storage.mongodb.FindAppByID"
+
+	coll := m.client.Database(m.dbname).Collection(CollApps)
+
+	var app models.App
+	err := coll.FindOne(ctx, bson.M{"_id": appID}).Decode(&app)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrAppNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &app, nil
+}
@@ -0,0 +1,79 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	// CollAuditLog is append-only, events are never deleted. The only
+	// update is removal of personal data on erasure, see EraseUserProfile
+	CollAuditLog = "audit_log"
+)
+
+func (m *MClient) SaveAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	const op = "storage.mongodb.SaveAuditEvent"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuditLog)
+	event.ID = primitive.NewObjectID().Hex()
+	_, err := coll.InsertOne(ctx, event)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// FindAuditEvents returns events ordered by ID descending. IDs are hex
+// of ObjectID, so the newest events go first and ID works as a cursor.
+func (m *MClient) FindAuditEvents(ctx context.Context, auditFilter *models.AuditFilter) ([]*models.AuditEvent, error) {
+	const op = "storage.mongodb.FindAuditEvents"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuditLog)
+
+	filter := bson.M{}
+	if auditFilter.UserID != "" {
+		filter["$or"] = bson.A{
+			bson.M{"user_id": auditFilter.UserID},
+			bson.M{"actor_id": auditFilter.UserID},
+		}
+	}
+	if auditFilter.EventType != "" {
+		filter["type"] = auditFilter.EventType
+	}
+	created := bson.M{}
+	if !auditFilter.From.IsZero() {
+		created["$gte"] = auditFilter.From
+	}
+	if !auditFilter.To.IsZero() {
+		created["$lte"] = auditFilter.To
+	}
+	if len(created) > 0 {
+		filter["created"] = created
+	}
+	if auditFilter.BeforeID != "" {
+		filter["_id"] = bson.M{"$lt": auditFilter.BeforeID}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetLimit(auditFilter.Limit)
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var events []*models.AuditEvent
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return events, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/DimTur/lp_auth/internal/domain/models"
 	"github.com/DimTur/lp_auth/internal/services/storage"
@@ -57,6 +58,41 @@ func (m *MClient) FindUserByEmail(ctx context.Context, email string) (*models.Us
 		Name:     userDB.Name,
 		IsAdmin:  userDB.IsAdmin,
 		TgLink:   userDB.TgLink,
+		ChatID:   userDB.ChatID,
+		Status:   userDB.Status,
+		TOTP:     userDB.TOTP,
+		Created:  userDB.Created,
+		Updated:  userDB.Updated,
+	}, nil
+}
+
+func (m *MClient) FindUserByID(ctx context.Context, userID string) (*models.User, error) {
+	const op = "storage.mongodb.FindUserByID"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	filter := bson.M{"_id": userID}
+
+	var userDB models.DBUser
+	err := coll.FindOne(ctx, filter).Decode(&userDB)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &models.User{
+		ID:       userDB.ID,
+		Email:    userDB.Email,
+		PassHash: userDB.PassHash,
+		Name:     userDB.Name,
+		IsAdmin:  userDB.IsAdmin,
+		TgLink:   userDB.TgLink,
+		ChatID:   userDB.ChatID,
+		Status:   userDB.Status,
+		TOTP:     userDB.TOTP,
 		Created:  userDB.Created,
 		Updated:  userDB.Updated,
 	}, nil
@@ -94,9 +130,6 @@ func (m *MClient) UpdateUserInfo(ctx context.Context, userInfo *models.DBUpdateU
 	if userInfo.Name != "" {
 		update["name"] = userInfo.Name
 	}
-	if userInfo.IsAdmin != nil {
-		update["is_admin"] = userInfo.IsAdmin
-	}
 	if userInfo.TgLink != "" {
 		update["tg_link"] = userInfo.TgLink
 	}
@@ -115,6 +148,9 @@ func (m *MClient) UpdateUserInfo(ctx context.Context, userInfo *models.DBUpdateU
 			if errors.Is(err, mongo.ErrNoDocuments) {
 				return fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
 			}
+			if mongo.IsDuplicateKeyError(err) {
+				return fmt.Errorf("%s: %w", op, storage.ErrUserExitsts)
+			}
 			return fmt.Errorf("%s: %w", op, err)
 		}
 	}
@@ -122,6 +158,48 @@ func (m *MClient) UpdateUserInfo(ctx context.Context, userInfo *models.DBUpdateU
 	return nil
 }
 
+func (m *MClient) UpdatePassword(ctx context.Context, userID string, passHash []byte) error {
+	const op = "storage.mongodb.UpdatePassword"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	res, err := coll.UpdateByID(ctx, userID, bson.M{
+		"$set": bson.M{
+			"pass_hash": passHash,
+			"updated":   time.Now(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+func (m *MClient) UpdateUserStatus(ctx context.Context, userID, status string) error {
+	const op = "storage.mongodb.UpdateUserStatus"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	res, err := coll.UpdateByID(ctx, userID, bson.M{
+		"$set": bson.M{
+			"status":  status,
+			"updated": time.Now(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
+
 func (m *MClient) GetUserRoles(ctx context.Context, userID string) (*models.UserRoles, error) {
 	const op = "storage.mongodb.GetUserRole"
 
@@ -158,6 +236,85 @@ func (m *MClient) SaveRefreshTokenToDB(ctx context.Context, token *models.Create
 	return nil
 }
 
+func (m *MClient) FindRefreshTokenInDB(ctx context.Context, token string) (*models.RefreshToken, error) {
+	const op = "storage.mongodb.FindRefreshTokenInDB"
+
+	coll := m.client.Database(m.dbname).Collection(CollTokens)
+	filter := bson.M{"token": token}
+
+	var refToken models.RefreshToken
+	err := coll.FindOne(ctx, filter).Decode(&refToken)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrTokenNotFound)
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &refToken, nil
+}
+
+// RotateRefreshTokenInDB marks active refresh token as rotated and returns it.
+// Returns storage.ErrTokenNotFound if token is already rotated or revoked.
+func (m *MClient) RotateRefreshTokenInDB(ctx context.Context, token string) (*models.RefreshToken, error) {
+	const op = "storage.mongodb.RotateRefreshTokenInDB"
+
+	coll := m.client.Database(m.dbname).Collection(CollTokens)
+	filter := bson.M{
+		"token":   token,
+		"rotated": false,
+		"revoked": false,
+	}
+
+	var refToken models.RefreshToken
+	err := coll.FindOneAndUpdate(ctx, filter, bson.M{
+		"$set": bson.M{"rotated": true},
+	}).Decode(&refToken)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrTokenNotFound)
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &refToken, nil
+}
+
+func (m *MClient) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	const op = "storage.mongodb.RevokeRefreshTokenFamily"
+
+	coll := m.client.Database(m.dbname).Collection(CollTokens)
+	filter := bson.M{"family_id": familyID}
+
+	_, err := coll.UpdateMany(ctx, filter, bson.M{
+		"$set": bson.M{"revoked": true},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RevokeUserRefreshTokens revokes all refresh tokens of the user.
+func (m *MClient) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	const op = "storage.mongodb.RevokeUserRefreshTokens"
+
+	coll := m.client.Database(m.dbname).Collection(CollTokens)
+	filter := bson.M{"user_id": userID}
+
+	_, err := coll.UpdateMany(ctx, filter, bson.M{
+		"$set": bson.M{"revoked": true},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
 func (m *MClient) GetExistChatID(ctx context.Context, userID string) (string, error) {
 	const op = "storage.mongodb.GetExistChatID"
 
@@ -0,0 +1,246 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const (
+	CollGDPRJobs = "gdpr_jobs"
+)
+
+func (m *MClient) SaveGDPRJob(ctx context.Context, job *models.DBCreateGDPRJob) (string, error) {
+	const op = "storage.mongodb.SaveGDPRJob"
+
+	coll := m.client.Database(m.dbname).Collection(CollGDPRJobs)
+	job.ID = primitive.NewObjectID().Hex()
+	_, err := coll.InsertOne(ctx, job)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return job.ID, nil
+}
+
+func (m *MClient) UpdateGDPRJob(ctx context.Context, job *models.DBUpdateGDPRJob) error {
+	const op = "storage.mongodb.UpdateGDPRJob"
+
+	coll := m.client.Database(m.dbname).Collection(CollGDPRJobs)
+
+	update := bson.M{
+		"status":  job.Status,
+		"error":   job.Error,
+		"updated": job.Updated,
+	}
+	if job.Archive != nil {
+		update["archive"] = job.Archive
+	}
+	if !job.ExpiresAt.IsZero() {
+		update["expires_at"] = job.ExpiresAt
+	}
+
+	res, err := coll.UpdateByID(ctx, job.ID, bson.M{
+		"$set": update,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrGDPRJobNotFound)
+	}
+
+	return nil
+}
+
+func (m *MClient) FindGDPRJob(ctx context.Context, jobID string) (*models.GDPRJob, error) {
+	const op = "storage.mongodb.FindGDPRJob"
+
+	coll := m.client.Database(m.dbname).Collection(CollGDPRJobs)
+
+	var job models.GDPRJob
+	err := coll.FindOne(ctx, bson.M{"_id": jobID}).Decode(&job)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrGDPRJobNotFound)
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &job, nil
+}
+
+// GetUserLearningGroups returns learning groups where the user is learner
+// or group admin.
+func (m *MClient) GetUserLearningGroups(ctx context.Context, userID string) ([]models.UserLearningGroup, error) {
+	const op = "storage.mongodb.GetUserLearningGroups"
+
+	coll := m.client.Database(m.dbname).Collection(CollLearningGroup)
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"learners": userID},
+			bson.M{"group_admins": userID},
+		},
+	}
+
+	cursor, err := coll.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var groups []struct {
+		ID          string   `bson:"_id"`
+		Name        string   `bson:"name"`
+		GroupAdmins []string `bson:"group_admins"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	userGroups := make([]models.UserLearningGroup, 0, len(groups))
+	for _, group := range groups {
+		role := models.GroupRoleLearner
+		for _, admin := range group.GroupAdmins {
+			if admin == userID {
+				role = models.GroupRoleGroupAdmin
+				break
+			}
+		}
+		userGroups = append(userGroups, models.UserLearningGroup{
+			ID:   group.ID,
+			Name: group.Name,
+			Role: role,
+		})
+	}
+
+	return userGroups, nil
+}
+
+// RemoveUserFromGroups removes the user from all learning groups and their roles.
+// Groups created or modified by the user keep pseudonym instead of user id.
+func (m *MClient) RemoveUserFromGroups(ctx context.Context, userID, pseudonym string) error {
+	const op = "storage.mongodb.RemoveUserFromGroups"
+
+	coll := m.client.Database(m.dbname).Collection(CollLearningGroup)
+
+	_, err := coll.UpdateMany(ctx, bson.M{
+		"$or": bson.A{
+			bson.M{"learners": userID},
+			bson.M{"group_admins": userID},
+		},
+	}, bson.M{
+		"$pull": bson.M{
+			"learners":     userID,
+			"group_admins": userID,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, field := range []string{"created_by", "modified_by"} {
+		_, err := coll.UpdateMany(ctx, bson.M{field: userID}, bson.M{
+			"$set": bson.M{field: pseudonym},
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	roles := m.client.Database(m.dbname).Collection(CollLgRoles)
+	_, err = roles.UpdateMany(ctx, bson.M{"members": userID}, bson.M{
+		"$pull": bson.M{"members": userID},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	_, err = roles.UpdateMany(ctx, bson.M{"created_by": userID}, bson.M{
+		"$set": bson.M{"created_by": pseudonym},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// EraseUserProfile removes personal data of the user. The document is kept
+// with erased email, so the user id stays reserved. Sessions of the user
+// (they keep IP and user agent) and export jobs with archives of the user
+// data are deleted. Audit events are kept with ids, but email, IP
+// and user agent of the user are removed from them.
+// Profile is erased last, so a failed erasure can be retried.
+func (m *MClient) EraseUserProfile(ctx context.Context, userID string) error {
+	const op = "storage.mongodb.EraseUserProfile"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	var user struct {
+		Email string `bson:"email"`
+	}
+	err := coll.FindOne(ctx, bson.M{"_id": userID}).Decode(&user)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	audit := m.client.Database(m.dbname).Collection(CollAuditLog)
+	_, err = audit.UpdateMany(ctx, bson.M{
+		"$or": bson.A{bson.M{"user_id": userID}, bson.M{"email": user.Email}},
+	}, bson.M{
+		"$unset": bson.M{"email": "", "ip": "", "user_agent": ""},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	// Events of the user acting on others keep email of the subject
+	_, err = audit.UpdateMany(ctx, bson.M{"actor_id": userID}, bson.M{
+		"$unset": bson.M{"ip": "", "user_agent": ""},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	tokens := m.client.Database(m.dbname).Collection(CollTokens)
+	if _, err := tokens.DeleteMany(ctx, bson.M{"user_id": userID}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	jobs := m.client.Database(m.dbname).Collection(CollGDPRJobs)
+	_, err = jobs.DeleteMany(ctx, bson.M{"user_id": userID, "type": models.GDPRJobTypeExport})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := coll.UpdateByID(ctx, userID, bson.M{
+		"$set": bson.M{
+			"email":     fmt.Sprintf("erased_%s@erased.invalid", userID),
+			"name":      "",
+			"tg_link":   "",
+			"chat_id":   "",
+			"pass_hash": []byte{},
+			"is_admin":  false,
+			"totp":      models.UserTOTP{},
+			"updated":   time.Now(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
@@ -2,7 +2,6 @@ package mongodb
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/DimTur/lp_auth/internal/domain/models"
@@ -84,12 +83,14 @@ func (m *MClient) GetLgByID(ctx context.Context, userLG *models.GetLgByID) (*mod
 	return &models.LearningGroup{
 		ID:          lgDB.ID,
 		Name:        lgDB.Name,
+		ParentID:    lgDB.ParentID,
 		CreatedBy:   lgDB.CreatedBy,
 		ModifiedBy:  lgDB.ModifiedBy,
 		Created:     lgDB.Created,
 		Updated:     lgDB.Updated,
 		Learners:    learners,
 		GroupAdmins: groupAdmins,
+		Version:     lgDB.Version,
 	}, nil
 }
 
@@ -117,6 +118,9 @@ func (m *MClient) GetLGroupsByUserID(ctx context.Context, userID string) ([]*mod
 	return learningGroups, nil
 }
 
+// UpdateLgByID updates the learning group and increments its version.
+// If lg.Version is set, the group is updated only if it has this version,
+// otherwise storage.ErrLgVersionConflict is returned.
 func (m *MClient) UpdateLgByID(ctx context.Context, lg *models.DBUpdateLearningGroup) error {
 	const op = "storage.mongodb.UpdateLgByID"
 
@@ -148,25 +152,150 @@ func (m *MClient) UpdateLgByID(ctx context.Context, lg *models.DBUpdateLearningG
 		updateQuery["$addToSet"] = addToSet
 	}
 
-	if len(updateQuery) > 0 {
-		_, err := coll.UpdateByID(ctx, lg.ID, updateQuery)
+	if len(updateQuery) == 0 {
+		return nil
+	}
+	updateQuery["$inc"] = bson.M{"version": 1}
+
+	filter := bson.M{"_id": lg.ID}
+	if lg.Version > 0 {
+		filter["version"] = lg.Version
+	}
+
+	res, err := coll.UpdateOne(ctx, filter, updateQuery)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		if lg.Version == 0 {
+			return fmt.Errorf("%s: %w", op, storage.ErrLgNotFound)
+		}
+
+		count, err := coll.CountDocuments(ctx, bson.M{"_id": lg.ID})
 		if err != nil {
-			if errors.Is(err, mongo.ErrNoDocuments) {
-				return fmt.Errorf("%s: %w", op, storage.ErrLgNotFound)
-			}
 			return fmt.Errorf("%s: %w", op, err)
 		}
+		if count == 0 {
+			return fmt.Errorf("%s: %w", op, storage.ErrLgNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, storage.ErrLgVersionConflict)
+	}
+
+	return nil
+}
+
+// AddLgMembers adds users with the role to the learning group, existing members are skipped.
+func (m *MClient) AddLgMembers(ctx context.Context, change *models.DBChangeLgMembers) error {
+	const op = "storage.mongodb.AddLgMembers"
+
+	if err := m.changeLgMembers(ctx, change, "$addToSet", bson.M{"$each": change.UserIDs}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RemoveLgMembers removes users with the role from the learning group, missing users are skipped.
+// It returns ErrLgLastAdmin if no group admins would be left.
+func (m *MClient) RemoveLgMembers(ctx context.Context, change *models.DBChangeLgMembers) error {
+	const op = "storage.mongodb.RemoveLgMembers"
+
+	if err := m.changeLgMembers(ctx, change, "$pull", bson.M{"$in": change.UserIDs}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// changeLgMembers applies array operator to the members field of the role.
+// Other members are untouched, so concurrent changes don't overwrite each other.
+func (m *MClient) changeLgMembers(ctx context.Context, change *models.DBChangeLgMembers, operator string, value bson.M) error {
+	field := "learners"
+	if change.Role == models.GroupRoleGroupAdmin {
+		field = "group_admins"
+	}
+
+	filter := bson.M{"_id": change.LgID}
+	keepAdmin := operator == "$pull" && field == "group_admins"
+	if keepAdmin {
+		// Checked in the filter, so concurrent removals can't leave the group without admins
+		filter["$expr"] = bson.M{"$gt": bson.A{
+			bson.M{"$size": bson.M{"$setDifference": bson.A{
+				bson.M{"$ifNull": bson.A{"$group_admins", bson.A{}}},
+				change.UserIDs,
+			}}},
+			0,
+		}}
+	}
+
+	coll := m.client.Database(m.dbname).Collection(CollLearningGroup)
+	res, err := coll.UpdateOne(ctx, filter, bson.M{
+		operator: bson.M{field: value},
+		"$set": bson.M{
+			"modified_by": change.ModifiedBy,
+			"updated":     change.Updated,
+		},
+		"$inc": bson.M{"version": 1},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		if !keepAdmin {
+			return storage.ErrLgNotFound
+		}
+
+		count, err := coll.CountDocuments(ctx, bson.M{"_id": change.LgID})
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return storage.ErrLgNotFound
+		}
+		return storage.ErrLgLastAdmin
 	}
 
 	return nil
 }
 
+// DeleteLgByID deletes the learning group, its child groups are moved
+// to the parent of the deleted group.
 func (m *MClient) DeleteLgByID(ctx context.Context, delG *models.DelGroup) error {
 	const op = "storage.mongodb.DeleteLgByID"
 
 	coll := m.client.Database(m.dbname).Collection(CollLearningGroup)
+
+	var group struct {
+		ParentID string `bson:"parent_id"`
+	}
+	err := coll.FindOne(ctx, bson.M{"_id": delG.LgId}).Decode(&group)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	reparent := bson.M{"$unset": bson.M{"parent_id": ""}}
+	if group.ParentID != "" {
+		reparent = bson.M{"$set": bson.M{"parent_id": group.ParentID}}
+	}
+	_, err = coll.UpdateMany(ctx, bson.M{"parent_id": delG.LgId}, reparent)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	filter := bson.M{"_id": delG.LgId}
-	_, err := coll.DeleteOne(ctx, filter)
+	_, err = coll.DeleteOne(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	invites := m.client.Database(m.dbname).Collection(CollLgInvites)
+	_, err = invites.DeleteMany(ctx, bson.M{"learning_group_id": delG.LgId})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	roles := m.client.Database(m.dbname).Collection(CollLgRoles)
+	_, err = roles.DeleteMany(ctx, bson.M{"learning_group_id": delG.LgId})
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -184,13 +313,12 @@ func (m *MClient) IsGroupAdmin(ctx context.Context, lgUser *models.IsGroupAdmin)
 			"$in": []string{lgUser.UserID},
 		},
 	}
-	cursor, err := coll.Find(ctx, filter)
+	count, err := coll.CountDocuments(ctx, filter)
 	if err != nil {
-		return false, fmt.Errorf("%s: %w", op, storage.ErrLgNotFound)
+		return false, fmt.Errorf("%s: %w", op, err)
 	}
-	defer cursor.Close(ctx)
 
-	return true, nil
+	return count > 0, nil
 }
 
 func (m *MClient) IsLearner(ctx context.Context, lgUser *models.GetLgByID) (bool, error) {
@@ -203,13 +331,12 @@ func (m *MClient) IsLearner(ctx context.Context, lgUser *models.GetLgByID) (bool
 			"$in": []string{lgUser.UserID},
 		},
 	}
-	cursor, err := coll.Find(ctx, filter)
+	count, err := coll.CountDocuments(ctx, filter)
 	if err != nil {
-		return false, fmt.Errorf("%s: %w", op, storage.ErrLgNotFound)
+		return false, fmt.Errorf("%s: %w", op, err)
 	}
-	defer cursor.Close(ctx)
 
-	return true, nil
+	return count > 0, nil
 }
 
 func (m *MClient) GetUserIsGroupAdminIn(ctx context.Context, user *models.UserIsGroupAdminIn) ([]string, error) {
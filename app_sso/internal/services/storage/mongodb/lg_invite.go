@@ -0,0 +1,122 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	// CollLgInvites documents are removed by TTL index after expiration
+	CollLgInvites = "lg_invites"
+)
+
+// activeInviteFilter matches invites which are not expired and have uses left.
+func activeInviteFilter() bson.M {
+	return bson.M{
+		"expires_at": bson.M{"$gt": time.Now()},
+		"$expr":      bson.M{"$lt": bson.A{"$uses", "$max_uses"}},
+	}
+}
+
+func (m *MClient) SaveLgInvite(ctx context.Context, invite *models.LgInvite) error {
+	const op = "storage.mongodb.SaveLgInvite"
+
+	coll := m.client.Database(m.dbname).Collection(CollLgInvites)
+	_, err := coll.InsertOne(ctx, invite)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (m *MClient) GetLgInvite(ctx context.Context, code string) (*models.LgInvite, error) {
+	const op = "storage.mongodb.GetLgInvite"
+
+	coll := m.client.Database(m.dbname).Collection(CollLgInvites)
+	filter := activeInviteFilter()
+	filter["_id"] = code
+
+	var invite models.LgInvite
+	if err := coll.FindOne(ctx, filter).Decode(&invite); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrLgInviteNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &invite, nil
+}
+
+// GetActiveLgInvites returns invites of the learning group from the newest.
+func (m *MClient) GetActiveLgInvites(ctx context.Context, lgID string) ([]*models.LgInvite, error) {
+	const op = "storage.mongodb.GetActiveLgInvites"
+
+	coll := m.client.Database(m.dbname).Collection(CollLgInvites)
+	filter := activeInviteFilter()
+	filter["learning_group_id"] = lgID
+
+	opts := options.Find().SetSort(bson.D{{Key: "created", Value: -1}})
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var invites []*models.LgInvite
+	if err := cursor.All(ctx, &invites); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return invites, nil
+}
+
+// UseLgInvite spends one use of the active invite. Concurrent requests
+// can't spend more than max_uses, the check and increment are atomic.
+func (m *MClient) UseLgInvite(ctx context.Context, code string) (*models.LgInvite, error) {
+	const op = "storage.mongodb.UseLgInvite"
+
+	coll := m.client.Database(m.dbname).Collection(CollLgInvites)
+	filter := activeInviteFilter()
+	filter["_id"] = code
+
+	var invite models.LgInvite
+	err := coll.FindOneAndUpdate(ctx, filter,
+		bson.M{"$inc": bson.M{"uses": 1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&invite)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrLgInviteNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &invite, nil
+}
+
+func (m *MClient) DeleteLgInvite(ctx context.Context, lgID, code string) error {
+	const op = "storage.mongodb.DeleteLgInvite"
+
+	coll := m.client.Database(m.dbname).Collection(CollLgInvites)
+	res, err := coll.DeleteOne(ctx, bson.M{
+		"_id":               code,
+		"learning_group_id": lgID,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrLgInviteNotFound)
+	}
+
+	return nil
+}
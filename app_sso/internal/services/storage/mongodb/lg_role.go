@@ -0,0 +1,165 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	// CollLgRoles name of the role is unique in the learning group
+	CollLgRoles = "lg_roles"
+)
+
+func (m *MClient) SaveLgRole(ctx context.Context, role *models.LgRole) error {
+	const op = "storage.mongodb.SaveLgRole"
+
+	coll := m.client.Database(m.dbname).Collection(CollLgRoles)
+	role.ID = primitive.NewObjectID().Hex()
+	if role.Members == nil {
+		role.Members = []string{}
+	}
+	_, err := coll.InsertOne(ctx, role)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrLgRoleExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetLgRoles returns roles of the learning group sorted by name.
+func (m *MClient) GetLgRoles(ctx context.Context, lgID string) ([]*models.LgRole, error) {
+	const op = "storage.mongodb.GetLgRoles"
+
+	roles, err := m.findLgRoles(ctx, bson.M{"learning_group_id": lgID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return roles, nil
+}
+
+// GetUserLgRoles returns roles of all learning groups assigned to the user.
+func (m *MClient) GetUserLgRoles(ctx context.Context, userID string) ([]*models.LgRole, error) {
+	const op = "storage.mongodb.GetUserLgRoles"
+
+	roles, err := m.findLgRoles(ctx, bson.M{"members": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return roles, nil
+}
+
+func (m *MClient) findLgRoles(ctx context.Context, filter bson.M) ([]*models.LgRole, error) {
+	coll := m.client.Database(m.dbname).Collection(CollLgRoles)
+
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var roles []*models.LgRole
+	if err := cursor.All(ctx, &roles); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (m *MClient) UpdateLgRole(ctx context.Context, upd *models.DBUpdateLgRole) error {
+	const op = "storage.mongodb.UpdateLgRole"
+
+	coll := m.client.Database(m.dbname).Collection(CollLgRoles)
+	res, err := coll.UpdateOne(ctx, bson.M{
+		"_id":               upd.ID,
+		"learning_group_id": upd.LgID,
+	}, bson.M{
+		"$set": bson.M{
+			"name":        upd.Name,
+			"permissions": upd.Permissions,
+			"updated":     upd.Updated,
+		},
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrLgRoleExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrLgRoleNotFound)
+	}
+
+	return nil
+}
+
+func (m *MClient) DeleteLgRole(ctx context.Context, lgID, roleID string) error {
+	const op = "storage.mongodb.DeleteLgRole"
+
+	coll := m.client.Database(m.dbname).Collection(CollLgRoles)
+	res, err := coll.DeleteOne(ctx, bson.M{
+		"_id":               roleID,
+		"learning_group_id": lgID,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrLgRoleNotFound)
+	}
+
+	return nil
+}
+
+// AddLgRoleMembers assigns the role to the users, existing members are skipped.
+func (m *MClient) AddLgRoleMembers(ctx context.Context, change *models.DBChangeLgRoleMembers) error {
+	const op = "storage.mongodb.AddLgRoleMembers"
+
+	if err := m.changeLgRoleMembers(ctx, change, "$addToSet", bson.M{"$each": change.UserIDs}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RemoveLgRoleMembers takes the role away from the users, missing users are skipped.
+func (m *MClient) RemoveLgRoleMembers(ctx context.Context, change *models.DBChangeLgRoleMembers) error {
+	const op = "storage.mongodb.RemoveLgRoleMembers"
+
+	if err := m.changeLgRoleMembers(ctx, change, "$pull", bson.M{"$in": change.UserIDs}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (m *MClient) changeLgRoleMembers(ctx context.Context, change *models.DBChangeLgRoleMembers, operator string, value bson.M) error {
+	coll := m.client.Database(m.dbname).Collection(CollLgRoles)
+	res, err := coll.UpdateOne(ctx, bson.M{
+		"_id":               change.ID,
+		"learning_group_id": change.LgID,
+	}, bson.M{
+		operator: bson.M{"members": value},
+		"$set":   bson.M{"updated": change.Updated},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return storage.ErrLgRoleNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// GetLgAncestorIDs returns IDs of all ancestors of the learning groups,
+// the groups themselves aren't included unless they are ancestors of each other.
+func (m *MClient) GetLgAncestorIDs(ctx context.Context, lgIDs []string) ([]string, error) {
+	const op = "storage.mongodb.GetLgAncestorIDs"
+
+	if len(lgIDs) == 0 {
+		return nil, nil
+	}
+
+	coll := m.client.Database(m.dbname).Collection(CollLearningGroup)
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$in", Value: lgIDs}}},
+		}}},
+		{{Key: "$graphLookup", Value: bson.D{
+			{Key: "from", Value: CollLearningGroup},
+			{Key: "startWith", Value: "$parent_id"},
+			{Key: "connectFromField", Value: "parent_id"},
+			{Key: "connectToField", Value: "_id"},
+			{Key: "as", Value: "ancestors"},
+		}}},
+		{{Key: "$unwind", Value: "$ancestors"}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$ancestors._id"},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	}
+
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var ancestorIDs []string
+	for cursor.Next(ctx) {
+		var result struct {
+			ID string `bson:"_id"`
+		}
+		if err := cursor.Decode(&result); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		ancestorIDs = append(ancestorIDs, result.ID)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ancestorIDs, nil
+}
+
+// GetLgSubtree returns the learning group and all its descendants.
+// The group is the first one, descendants are in no particular order.
+func (m *MClient) GetLgSubtree(ctx context.Context, lgID string) ([]*models.LearningGroupShort, error) {
+	const op = "storage.mongodb.GetLgSubtree"
+
+	coll := m.client.Database(m.dbname).Collection(CollLearningGroup)
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: "_id", Value: lgID}}}},
+		{{Key: "$graphLookup", Value: bson.D{
+			{Key: "from", Value: CollLearningGroup},
+			{Key: "startWith", Value: "$_id"},
+			{Key: "connectFromField", Value: "_id"},
+			{Key: "connectToField", Value: "parent_id"},
+			{Key: "as", Value: "descendants"},
+		}}},
+	}
+
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	if !cursor.Next(ctx) {
+		if err := cursor.Err(); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrLgNotFound)
+	}
+
+	var result struct {
+		models.LearningGroupShort `bson:",inline"`
+		Descendants               []*models.LearningGroupShort `bson:"descendants"`
+	}
+	if err := cursor.Decode(&result); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return append([]*models.LearningGroupShort{&result.LearningGroupShort}, result.Descendants...), nil
+}
+
+// MoveLg sets the parent of the learning group and increments its version.
+// Empty ParentID makes the group a root one. If move.Version is set,
+// the group is moved only if it has this version, otherwise
+// storage.ErrLgVersionConflict is returned.
+//
+// Concurrent moves of groups under each other can make a cycle, so ancestors
+// are read again after the update. If the group became its own ancestor,
+// the move is rolled back and storage.ErrLgCycle is returned. When both moves
+// see the cycle, both are rolled back.
+func (m *MClient) MoveLg(ctx context.Context, move *models.DBMoveLearningGroup) error {
+	const op = "storage.mongodb.MoveLg"
+
+	coll := m.client.Database(m.dbname).Collection(CollLearningGroup)
+
+	if move.ParentID != "" {
+		count, err := coll.CountDocuments(ctx, bson.M{"_id": move.ParentID})
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if count == 0 {
+			return fmt.Errorf("%s: %w", op, storage.ErrLgNotFound)
+		}
+	}
+
+	set := bson.M{
+		"modified_by": move.ModifiedBy,
+		"updated":     move.Updated,
+	}
+	updateQuery := bson.M{
+		"$set": set,
+		"$inc": bson.M{"version": 1},
+	}
+	if move.ParentID != "" {
+		set["parent_id"] = move.ParentID
+	} else {
+		updateQuery["$unset"] = bson.M{"parent_id": ""}
+	}
+
+	filter := bson.M{"_id": move.ID}
+	if move.Version > 0 {
+		filter["version"] = move.Version
+	}
+
+	var before struct {
+		ParentID string `bson:"parent_id"`
+	}
+	err := coll.FindOneAndUpdate(ctx, filter, updateQuery,
+		options.FindOneAndUpdate().
+			SetReturnDocument(options.Before).
+			SetProjection(bson.M{"parent_id": 1}),
+	).Decode(&before)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if move.Version == 0 {
+			return fmt.Errorf("%s: %w", op, storage.ErrLgNotFound)
+		}
+
+		count, err := coll.CountDocuments(ctx, bson.M{"_id": move.ID})
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if count == 0 {
+			return fmt.Errorf("%s: %w", op, storage.ErrLgNotFound)
+		}
+		return fmt.Errorf("%s: %w", op, storage.ErrLgVersionConflict)
+	}
+
+	if move.ParentID == "" {
+		return nil
+	}
+
+	ancestorIDs, err := m.GetLgAncestorIDs(ctx, []string{move.ID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !slices.Contains(ancestorIDs, move.ID) {
+		return nil
+	}
+
+	if err := m.rollbackMoveLg(ctx, move, before.ParentID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return fmt.Errorf("%s: %w", op, storage.ErrLgCycle)
+}
+
+// rollbackMoveLg restores the parent of the group unless
+// it has been moved again after the move.
+func (m *MClient) rollbackMoveLg(ctx context.Context, move *models.DBMoveLearningGroup, parentID string) error {
+	coll := m.client.Database(m.dbname).Collection(CollLearningGroup)
+
+	set := bson.M{"updated": time.Now()}
+	updateQuery := bson.M{
+		"$set": set,
+		"$inc": bson.M{"version": 1},
+	}
+	if parentID != "" {
+		set["parent_id"] = parentID
+	} else {
+		updateQuery["$unset"] = bson.M{"parent_id": ""}
+	}
+
+	_, err := coll.UpdateOne(ctx, bson.M{"_id": move.ID, "parent_id": move.ParentID}, updateQuery)
+	return err
+}
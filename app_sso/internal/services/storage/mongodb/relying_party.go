@@ -0,0 +1,50 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const (
+	CollRelyingParties = "relying_parties"
+)
+
+func (m *MClient) SaveRelyingParty(ctx context.Context, rp *models.DBCreateRelyingParty) error {
+	const op = "storage.mongodb.SaveRelyingParty"
+
+	coll := m.client.Database(m.dbname).Collection(CollRelyingParties)
+	rp.ID = primitive.NewObjectID().Hex()
+	_, err := coll.InsertOne(ctx, rp)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrRelyingPartyExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (m *MClient) FindRelyingPartyByID(ctx context.Context, clientID string) (*models.RelyingParty, error) {
+	const op = "storage.mongodb.FindRelyingPartyByID"
+
+	coll := m.client.Database(m.dbname).Collection(CollRelyingParties)
+
+	var rp models.RelyingParty
+	err := coll.FindOne(ctx, bson.M{"_id": clientID}).Decode(&rp)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrRelyingPartyNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &rp, nil
+}
@@ -0,0 +1,112 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// FindUsersPage returns page of users ordered by ID and total number
+// of users matching the filter.
+func (m *MClient) FindUsersPage(ctx context.Context, usersFilter *models.ScimUsersFilter) ([]*models.User, int64, error) {
+	const op = "storage.mongodb.FindUsersPage"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	filter := bson.M{}
+	if usersFilter.Email != "" {
+		filter["email"] = usersFilter.Email
+	}
+	if usersFilter.Deactivated != nil {
+		if *usersFilter.Deactivated {
+			filter["status"] = models.UserStatusDeactivated
+		} else {
+			filter["status"] = bson.M{"$ne": models.UserStatusDeactivated}
+		}
+	}
+
+	total, err := coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetSkip(usersFilter.Offset).
+		SetLimit(usersFilter.Limit)
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var usersDB []models.DBUser
+	if err := cursor.All(ctx, &usersDB); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	users := make([]*models.User, 0, len(usersDB))
+	for _, userDB := range usersDB {
+		users = append(users, &models.User{
+			ID:      userDB.ID,
+			Email:   userDB.Email,
+			Name:    userDB.Name,
+			IsAdmin: userDB.IsAdmin,
+			TgLink:  userDB.TgLink,
+			ChatID:  userDB.ChatID,
+			Status:  userDB.Status,
+			Created: userDB.Created,
+			Updated: userDB.Updated,
+		})
+	}
+
+	return users, total, nil
+}
+
+// FindLgsPage returns page of learning groups with their learners ordered
+// by ID and total number of groups matching the filter.
+func (m *MClient) FindLgsPage(ctx context.Context, groupsFilter *models.ScimGroupsFilter) ([]*models.ScimGroup, int64, error) {
+	const op = "storage.mongodb.FindLgsPage"
+
+	coll := m.client.Database(m.dbname).Collection(CollLearningGroup)
+
+	filter := bson.M{}
+	if groupsFilter.ID != "" {
+		filter["_id"] = groupsFilter.ID
+	}
+	if groupsFilter.Name != "" {
+		filter["name"] = groupsFilter.Name
+	}
+
+	total, err := coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetSkip(groupsFilter.Offset).
+		SetLimit(groupsFilter.Limit)
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var groups []*models.ScimGroup
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, group := range groups {
+		if group.Members == nil {
+			group.Members = []string{}
+		}
+	}
+
+	return groups, total, nil
+}
@@ -0,0 +1,87 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// activeTokensFilter matches refresh tokens which can still be used.
+// Every session has exactly one such token.
+func activeTokensFilter(userID string) bson.M {
+	return bson.M{
+		"user_id":    userID,
+		"rotated":    false,
+		"revoked":    false,
+		"expires_at": bson.M{"$gt": time.Now()},
+	}
+}
+
+// FindUserSessions returns active sessions of the user, most recently used first.
+func (m *MClient) FindUserSessions(ctx context.Context, userID string) ([]*models.Session, error) {
+	const op = "storage.mongodb.FindUserSessions"
+
+	coll := m.client.Database(m.dbname).Collection(CollTokens)
+	opts := options.Find().SetSort(bson.D{{Key: "created", Value: -1}})
+
+	cursor, err := coll.Find(ctx, activeTokensFilter(userID), opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var tokens []models.RefreshToken
+	if err := cursor.All(ctx, &tokens); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	sessions := make([]*models.Session, 0, len(tokens))
+	for _, token := range tokens {
+		created := token.SessionCreated
+		// Tokens issued before sessions were introduced
+		if created.IsZero() {
+			created = token.Created
+		}
+
+		sessions = append(sessions, &models.Session{
+			ID:        token.FamilyID,
+			UserAgent: token.UserAgent,
+			IP:        token.IP,
+			Created:   created,
+			LastUsed:  token.Created,
+			ExpiresAt: token.ExpiresAt,
+		})
+	}
+
+	return sessions, nil
+}
+
+// FindSessionToken returns active refresh token of the user's session.
+// Returns storage.ErrTokenNotFound if the session doesn't exist, belongs
+// to another user or is already finished.
+func (m *MClient) FindSessionToken(ctx context.Context, userID, sessionID string) (*models.RefreshToken, error) {
+	const op = "storage.mongodb.FindSessionToken"
+
+	coll := m.client.Database(m.dbname).Collection(CollTokens)
+	filter := activeTokensFilter(userID)
+	filter["family_id"] = sessionID
+
+	var refToken models.RefreshToken
+	err := coll.FindOne(ctx, filter).Decode(&refToken)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrTokenNotFound)
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &refToken, nil
+}
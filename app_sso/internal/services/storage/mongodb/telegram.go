@@ -0,0 +1,89 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func (m *MClient) FindUserByChatID(ctx context.Context, chatID string) (*models.User, error) {
+	const op = "storage.mongodb.FindUserByChatID"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	var user models.User
+	err := coll.FindOne(ctx, bson.M{"chat_id": chatID}).Decode(&user)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &user, nil
+}
+
+// SetUserChatID links telegram chat to the user. The chat is unlinked
+// from other users, so OTPs of one account are sent only to its own chat.
+func (m *MClient) SetUserChatID(ctx context.Context, userID, chatID string) error {
+	const op = "storage.mongodb.SetUserChatID"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+	now := time.Now()
+
+	res, err := coll.UpdateByID(ctx, userID, bson.M{
+		"$set": bson.M{
+			"chat_id": chatID,
+			"updated": now,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	_, err = coll.UpdateMany(ctx,
+		bson.M{
+			"chat_id": chatID,
+			"_id":     bson.M{"$ne": userID},
+		},
+		bson.M{"$set": bson.M{
+			"chat_id": "",
+			"updated": now,
+		}},
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (m *MClient) UnsetUserChatID(ctx context.Context, userID string) error {
+	const op = "storage.mongodb.UnsetUserChatID"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	res, err := coll.UpdateByID(ctx, userID, bson.M{
+		"$set": bson.M{
+			"chat_id": "",
+			"updated": time.Now(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// SaveTOTPSecret saves not yet enabled TOTP secret of the user.
+func (m *MClient) SaveTOTPSecret(ctx context.Context, userID, secret string) error {
+	const op = "storage.mongodb.SaveTOTPSecret"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	res, err := coll.UpdateByID(ctx, userID, bson.M{
+		"$set": bson.M{
+			"totp":    bson.M{"secret": secret, "enabled": false},
+			"updated": time.Now(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+func (m *MClient) EnableTOTP(ctx context.Context, userID string, recoveryCodes [][]byte) error {
+	const op = "storage.mongodb.EnableTOTP"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	res, err := coll.UpdateByID(ctx, userID, bson.M{
+		"$set": bson.M{
+			"totp.enabled":        true,
+			"totp.recovery_codes": recoveryCodes,
+			"updated":             time.Now(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+func (m *MClient) DisableTOTP(ctx context.Context, userID string) error {
+	const op = "storage.mongodb.DisableTOTP"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	res, err := coll.UpdateByID(ctx, userID, bson.M{
+		"$unset": bson.M{"totp": ""},
+		"$set":   bson.M{"updated": time.Now()},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+// DeleteRecoveryCode removes used recovery code of the user.
+// Returns storage.ErrInvalidCredentials if the code was already used.
+func (m *MClient) DeleteRecoveryCode(ctx context.Context, userID string, codeHash []byte) error {
+	const op = "storage.mongodb.DeleteRecoveryCode"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	filter := bson.M{
+		"_id":                 userID,
+		"totp.recovery_codes": codeHash,
+	}
+
+	res, err := coll.UpdateOne(ctx, filter, bson.M{
+		"$pull": bson.M{"totp.recovery_codes": codeHash},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+	}
+
+	return nil
+}
+
+// UseTOTPCounter saves time step of the accepted TOTP code of the user.
+// Returns storage.ErrInvalidCredentials if the same or a later step was
+// already used.
+func (m *MClient) UseTOTPCounter(ctx context.Context, userID string, counter int64) error {
+	const op = "storage.mongodb.UseTOTPCounter"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	filter := bson.M{
+		"_id":               userID,
+		"totp.last_counter": bson.M{"$not": bson.M{"$gte": counter}},
+	}
+
+	res, err := coll.UpdateOne(ctx, filter, bson.M{
+		"$set": bson.M{"totp.last_counter": counter},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+	}
+
+	return nil
+}
@@ -0,0 +1,234 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// ListUsers returns users ordered by ID. IDs are hex of ObjectID,
+// so the order is the registration order and ID works as a cursor.
+func (m *MClient) ListUsers(ctx context.Context, usersFilter *models.UsersFilter) ([]*models.User, error) {
+	const op = "storage.mongodb.ListUsers"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	filter := bson.M{}
+	if usersFilter.Query != "" {
+		pattern := bson.M{"$regex": regexp.QuoteMeta(usersFilter.Query), "$options": "i"}
+		filter["$or"] = bson.A{
+			bson.M{"email": pattern},
+			bson.M{"name": pattern},
+			bson.M{"tg_link": pattern},
+		}
+	}
+	if usersFilter.Status != "" {
+		filter["status"] = usersFilter.Status
+	}
+	if usersFilter.AfterID != "" {
+		filter["_id"] = bson.M{"$gt": usersFilter.AfterID}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(usersFilter.Limit)
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var usersDB []models.DBUser
+	if err := cursor.All(ctx, &usersDB); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	users := make([]*models.User, 0, len(usersDB))
+	for _, userDB := range usersDB {
+		users = append(users, &models.User{
+			ID:      userDB.ID,
+			Email:   userDB.Email,
+			Name:    userDB.Name,
+			IsAdmin: userDB.IsAdmin,
+			TgLink:  userDB.TgLink,
+			ChatID:  userDB.ChatID,
+			Status:  userDB.Status,
+			Created: userDB.Created,
+			Updated: userDB.Updated,
+		})
+	}
+
+	return users, nil
+}
+
+func (m *MClient) SetUserAdmin(ctx context.Context, userID string, isAdmin bool) error {
+	const op = "storage.mongodb.SetUserAdmin"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	res, err := coll.UpdateByID(ctx, userID, bson.M{
+		"$set": bson.M{
+			"is_admin": isAdmin,
+			"updated":  time.Now(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+// DeactivateUser sets deactivated status and keeps the current one
+// in prev_status, so ReactivateUser can restore it. Status of the user
+// who is already deactivated isn't overwritten.
+func (m *MClient) DeactivateUser(ctx context.Context, userID string) error {
+	const op = "storage.mongodb.DeactivateUser"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	res, err := coll.UpdateByID(ctx, userID, bson.A{
+		bson.M{"$set": bson.M{
+			"prev_status": bson.M{"$cond": bson.A{
+				bson.M{"$eq": bson.A{"$status", models.UserStatusDeactivated}},
+				"$prev_status",
+				"$status",
+			}},
+			"status":  models.UserStatusDeactivated,
+			"updated": time.Now(),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+// ReactivateUser restores status the user had before deactivation
+// and returns it. Users deactivated before prev_status was kept become active.
+func (m *MClient) ReactivateUser(ctx context.Context, userID string) (string, error) {
+	const op = "storage.mongodb.ReactivateUser"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	var user models.User
+	err := coll.FindOneAndUpdate(ctx,
+		bson.M{"_id": userID, "status": models.UserStatusDeactivated},
+		bson.A{
+			bson.M{"$set": bson.M{
+				"status":  bson.M{"$ifNull": bson.A{"$prev_status", models.UserStatusActive}},
+				"updated": time.Now(),
+			}},
+			bson.M{"$unset": "prev_status"},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&user)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return "", fmt.Errorf("%s: %w", op, err)
+		}
+
+		count, err := coll.CountDocuments(ctx, bson.M{"_id": userID})
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", op, err)
+		}
+		if count == 0 {
+			return "", fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+		}
+		return "", fmt.Errorf("%s: %w", op, storage.ErrUserNotDeactivated)
+	}
+
+	return user.Status, nil
+}
+
+// FindUsersByEmails returns users with given emails. Emails without users
+// are skipped, so the result can be shorter than emails.
+func (m *MClient) FindUsersByEmails(ctx context.Context, emails []string) ([]*models.User, error) {
+	const op = "storage.mongodb.FindUsersByEmails"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	filter := bson.M{
+		"email": bson.M{"$in": emails},
+	}
+
+	cursor, err := coll.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer cursor.Close(ctx)
+
+	var usersDB []models.DBUser
+	if err := cursor.All(ctx, &usersDB); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	users := make([]*models.User, 0, len(usersDB))
+	for _, userDB := range usersDB {
+		users = append(users, &models.User{
+			ID:      userDB.ID,
+			Email:   userDB.Email,
+			Name:    userDB.Name,
+			IsAdmin: userDB.IsAdmin,
+			TgLink:  userDB.TgLink,
+			Status:  userDB.Status,
+			Created: userDB.Created,
+			Updated: userDB.Updated,
+		})
+	}
+
+	return users, nil
+}
+
+// SaveUsers inserts users in one unordered batch and sets their IDs.
+//
+// Users which break unique email or tg_link aren't saved, their indexes
+// in users are returned. Other users of the batch are saved anyway.
+func (m *MClient) SaveUsers(ctx context.Context, users []*models.DBCreateUser) ([]int, error) {
+	const op = "storage.mongodb.SaveUsers"
+
+	coll := m.client.Database(m.dbname).Collection(CollAuth)
+
+	docs := make([]any, 0, len(users))
+	for _, user := range users {
+		user.ID = primitive.NewObjectID().Hex()
+		docs = append(docs, user)
+	}
+
+	_, err := coll.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err == nil {
+		return nil, nil
+	}
+
+	var bwe mongo.BulkWriteException
+	if !errors.As(err, &bwe) || bwe.WriteConcernError != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	duplicates := make([]int, 0, len(bwe.WriteErrors))
+	for _, we := range bwe.WriteErrors {
+		if !mongo.IsDuplicateKeyError(we.WriteError) {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		duplicates = append(duplicates, we.Index)
+	}
+
+	return duplicates, nil
+}
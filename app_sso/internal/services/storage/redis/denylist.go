@@ -0,0 +1,86 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+// DenyAccessToken puts access token jti to the denylist.
+// The key lives until the token itself expires.
+func (r *RedisClient) DenyAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	const op = "storage.redis.DenyAccessToken"
+
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrTokenExpired)
+	}
+
+	err := r.client.Set(ctx, denylistKey(jti), true, ttl).Err()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *RedisClient) IsAccessTokenDenied(ctx context.Context, jti string) (bool, error) {
+	const op = "storage.redis.IsAccessTokenDenied"
+
+	exists, err := r.client.Exists(ctx, denylistKey(jti)).Result()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return exists > 0, nil
+}
+
+// Denylist keys are read by the gateway directly in local auth mode, see
+// app_api_gateway/internal/services/storage/redis/denylist.go.
+// The formats have to be changed in both services at once.
+func denylistKey(jti string) string {
+	return fmt.Sprintf("denylist_%s", jti)
+}
+
+// DenyUser puts the user to the denylist, so access tokens issued
+// to the user before are rejected. ttl should be not less than
+// access token lifetime.
+func (r *RedisClient) DenyUser(ctx context.Context, userID string, ttl time.Duration) error {
+	const op = "storage.redis.DenyUser"
+
+	err := r.client.Set(ctx, userDenylistKey(userID), true, ttl).Err()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *RedisClient) AllowUser(ctx context.Context, userID string) error {
+	const op = "storage.redis.AllowUser"
+
+	err := r.client.Del(ctx, userDenylistKey(userID)).Err()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *RedisClient) IsUserDenied(ctx context.Context, userID string) (bool, error) {
+	const op = "storage.redis.IsUserDenied"
+
+	exists, err := r.client.Exists(ctx, userDenylistKey(userID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return exists > 0, nil
+}
+
+// userDenylistKey is read by the gateway too, see denylistKey.
+func userDenylistKey(userID string) string {
+	return fmt.Sprintf("denylist_user_%s", userID)
+}
@@ -0,0 +1,76 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// incrFailures increments failures counter and prolongs its window.
+var incrFailures = redis.NewScript(`
+local failures = redis.call("INCR", KEYS[1])
+redis.call("PEXPIRE", KEYS[1], ARGV[1])
+return failures
+`)
+
+// IncrLoginFailures increments counter of failed login attempts by the key
+// and returns the new value. Counter is kept during window after the last failure.
+func (r *RedisClient) IncrLoginFailures(ctx context.Context, key string, window time.Duration) (int64, error) {
+	const op = "storage.redis.IncrLoginFailures"
+
+	failures, err := incrFailures.Run(ctx, r.client, []string{loginFailuresKey(key)}, window.Milliseconds()).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return failures, nil
+}
+
+// ResetLoginFailures deletes counter of failed login attempts by the key.
+func (r *RedisClient) ResetLoginFailures(ctx context.Context, key string) error {
+	const op = "storage.redis.ResetLoginFailures"
+
+	if err := r.client.Del(ctx, loginFailuresKey(key)).Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// LockLogin locks login by the key for the given duration.
+func (r *RedisClient) LockLogin(ctx context.Context, key string, ttl time.Duration) error {
+	const op = "storage.redis.LockLogin"
+
+	if err := r.client.Set(ctx, loginLockKey(key), true, ttl).Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetLoginLock returns time left until login by the key is unlocked.
+// Zero is returned if login isn't locked.
+func (r *RedisClient) GetLoginLock(ctx context.Context, key string) (time.Duration, error) {
+	const op = "storage.redis.GetLoginLock"
+
+	ttl, err := r.client.PTTL(ctx, loginLockKey(key)).Result()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	// Negative values mean the key doesn't exist or has no expiration
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
+
+func loginFailuresKey(key string) string {
+	return fmt.Sprintf("login_failures_%s", key)
+}
+
+func loginLockKey(key string) string {
+	return fmt.Sprintf("login_lock_%s", key)
+}
@@ -7,6 +7,7 @@ import (
 type CreateRefreshToken struct {
 	UserID    string    `json:"user_id" redis:"user_id" validate:"required"`
 	Token     string    `json:"token" redis:"token" validate:"required"`
+	FamilyID  string    `json:"family_id" redis:"family_id" validate:"required"`
 	ExpiresAt time.Time `json:"expires_at" redis:"expires_at" validate:"required"`
 }
 
@@ -16,16 +17,27 @@ type RefreshTokenToRedis struct {
 }
 
 type RefreshToken struct {
-	UserID string `json:"user_id" redis:"user_id"`
-	Token  string `json:"token" redis:"refresh_token"`
+	UserID   string `json:"user_id" redis:"user_id"`
+	Token    string `json:"token" redis:"refresh_token"`
+	FamilyID string `json:"family_id" redis:"family_id"`
 }
 
 type RefreshTokenFromRedis struct {
-	UserID string `json:"user_id"`
-	Token  string `json:"token"`
+	UserID   string `json:"user_id"`
+	Token    string `json:"token"`
+	FamilyID string `json:"family_id"`
 }
 
+// Purposes of one-time codes. Codes of different purposes are stored
+// under own keys, so a code can't be used for another purpose
+// and a new code doesn't replace a pending code of another purpose.
+const (
+	OTPPurposeLogin         = "login"
+	OTPPurposePasswordReset = "password_reset"
+)
+
 type CreateOTP struct {
+	Purpose   string    `json:"purpose" redis:"-" validate:"required"`
 	UserID    string    `json:"user_id" redis:"user_id" validate:"required"`
 	Code      string    `json:"code" redis:"code" validate:"required"`
 	ExpiresAt time.Time `json:"expires_at" redis:"expires_at" validate:"required"`
@@ -33,13 +45,15 @@ type CreateOTP struct {
 }
 
 type UserOTP struct {
-	UserID string `json:"user_id" redis:"user_id"`
-	Code   string `json:"code" redis:"code"`
-	Used   bool   `json:"used" redis:"used"`
+	UserID   string `json:"user_id" redis:"user_id"`
+	Code     string `json:"code" redis:"code"`
+	Used     bool   `json:"used" redis:"used"`
+	Attempts int64  `json:"attempts" redis:"attempts"`
 }
 
 type UserOTPFromRedis struct {
-	UserID string `json:"user_id" redis:"user_id"`
-	Code   string `json:"code" redis:"code"`
-	Used   bool   `json:"used" redis:"used"`
+	UserID   string `json:"user_id" redis:"user_id"`
+	Code     string `json:"code" redis:"code"`
+	Used     bool   `json:"used" redis:"used"`
+	Attempts int64  `json:"attempts" redis:"attempts"`
 }
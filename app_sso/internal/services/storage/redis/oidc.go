@@ -0,0 +1,55 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/redis/go-redis/v9"
+)
+
+// SaveOIDCAuthCode saves OIDC authorization code for ttl.
+func (r *RedisClient) SaveOIDCAuthCode(ctx context.Context, code string, authCode *models.OIDCAuthCode, ttl time.Duration) error {
+	const op = "storage.redis.SaveOIDCAuthCode"
+
+	data, err := json.Marshal(authCode)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := r.client.Set(ctx, oidcAuthCodeKey(code), data, ttl).Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// PopOIDCAuthCode returns OIDC authorization code and deletes it at once,
+// so the code can be exchanged only one time.
+// Returns storage.ErrAuthCodeNotFound if the code is used or expired.
+func (r *RedisClient) PopOIDCAuthCode(ctx context.Context, code string) (*models.OIDCAuthCode, error) {
+	const op = "storage.redis.PopOIDCAuthCode"
+
+	data, err := r.client.GetDel(ctx, oidcAuthCodeKey(code)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrAuthCodeNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var authCode models.OIDCAuthCode
+	if err := json.Unmarshal(data, &authCode); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &authCode, nil
+}
+
+func oidcAuthCodeKey(code string) string {
+	return fmt.Sprintf("oidc_code_%s", code)
+}
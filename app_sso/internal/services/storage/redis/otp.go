@@ -9,6 +9,18 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// incrOTPAttempts increments attempts counter only if the code still exists,
+// so an expired code isn't recreated without TTL.
+var incrOTPAttempts = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return -1
+end
+return redis.call("HINCRBY", KEYS[1], "attempts", 1)
+`)
+
+// SaveOTPToRedis saves one-time code of the user.
+// Previous code of the user with the same purpose
+// and its attempts counter are replaced.
 func (r *RedisClient) SaveOTPToRedis(ctx context.Context, otp *CreateOTP) error {
 	const op = "storage.redis.SaveOTPToRedis"
 
@@ -18,16 +30,19 @@ func (r *RedisClient) SaveOTPToRedis(ctx context.Context, otp *CreateOTP) error
 	}
 
 	hashFields := map[string]interface{}{
-		"user_id": otp.UserID,
-		"used":    otp.Used,
+		"user_id":  otp.UserID,
+		"code":     otp.Code,
+		"used":     otp.Used,
+		"attempts": 0,
 	}
 
-	err := r.client.HSet(ctx, otp.Code, hashFields).Err()
+	key := otpKey(otp.Purpose, otp.UserID)
+	err := r.client.HSet(ctx, key, hashFields).Err()
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	err = r.client.Expire(ctx, otp.Code, ttl).Err()
+	err = r.client.Expire(ctx, key, ttl).Err()
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -35,32 +50,66 @@ func (r *RedisClient) SaveOTPToRedis(ctx context.Context, otp *CreateOTP) error
 	return nil
 }
 
-func (r *RedisClient) FindOTPCode(ctx context.Context, code string) (*UserOTPFromRedis, error) {
-	const op = "storage.redis.FindOTPCode"
+// GetUserOTP returns active one-time code of the user for the purpose.
+// Returns storage.ErrOTPNotFound if there is no code or it is expired.
+func (r *RedisClient) GetUserOTP(ctx context.Context, purpose, userID string) (*UserOTPFromRedis, error) {
+	const op = "storage.redis.GetUserOTP"
 
 	var otp UserOTP
-	err := r.client.HGetAll(ctx, code).Scan(&otp)
-	if err != nil {
+	res := r.client.HGetAll(ctx, otpKey(purpose, userID))
+	if err := res.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(res.Val()) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrOTPNotFound)
+	}
+	if err := res.Scan(&otp); err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	return &UserOTPFromRedis{
-		UserID: otp.UserID,
-		Code:   otp.Code,
-		Used:   otp.Used,
+		UserID:   otp.UserID,
+		Code:     otp.Code,
+		Used:     otp.Used,
+		Attempts: otp.Attempts,
 	}, nil
 }
 
-func (r *RedisClient) DeleteUserOTP(ctx context.Context, code string) error {
-	const op = "storage.redis.DeleteUserOTP"
+// IncrOTPAttempts increments counter of verification attempts
+// of the user's one-time code for the purpose and returns the new value.
+func (r *RedisClient) IncrOTPAttempts(ctx context.Context, purpose, userID string) (int64, error) {
+	const op = "storage.redis.IncrOTPAttempts"
 
-	_, err := r.client.Del(ctx, code).Result()
-	if err == redis.Nil {
-		return fmt.Errorf("%s: %w", op, storage.ErrOTPNotFound)
+	attempts, err := incrOTPAttempts.Run(ctx, r.client, []string{otpKey(purpose, userID)}).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
 	}
+	if attempts < 0 {
+		return 0, fmt.Errorf("%s: %w", op, storage.ErrOTPNotFound)
+	}
+
+	return attempts, nil
+}
+
+func (r *RedisClient) DeleteUserOTP(ctx context.Context, purpose, userID string) error {
+	const op = "storage.redis.DeleteUserOTP"
+
+	deleted, err := r.client.Del(ctx, otpKey(purpose, userID)).Result()
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
+	if deleted == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrOTPNotFound)
+	}
 
 	return nil
 }
+
+// otpKey returns key of the code. Login codes keep the key
+// they had before codes got purposes.
+func otpKey(purpose, userID string) string {
+	if purpose == OTPPurposePasswordReset {
+		return fmt.Sprintf("otp_reset_%s", userID)
+	}
+	return fmt.Sprintf("otp_%s", userID)
+}
@@ -0,0 +1,43 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/redis/go-redis/v9"
+)
+
+// SaveTgLinkToken saves telegram link token of the user for ttl.
+func (r *RedisClient) SaveTgLinkToken(ctx context.Context, token, userID string, ttl time.Duration) error {
+	const op = "storage.redis.SaveTgLinkToken"
+
+	if err := r.client.Set(ctx, tgLinkTokenKey(token), userID, ttl).Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// PopTgLinkToken returns user ID of telegram link token and deletes the token at once,
+// so the token links only one chat.
+// Returns storage.ErrTgLinkTokenNotFound if the token is used or expired.
+func (r *RedisClient) PopTgLinkToken(ctx context.Context, token string) (string, error) {
+	const op = "storage.redis.PopTgLinkToken"
+
+	userID, err := r.client.GetDel(ctx, tgLinkTokenKey(token)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", fmt.Errorf("%s: %w", op, storage.ErrTgLinkTokenNotFound)
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return userID, nil
+}
+
+func tgLinkTokenKey(token string) string {
+	return fmt.Sprintf("tg_link_%s", token)
+}
@@ -0,0 +1,120 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/redis/go-redis/v9"
+)
+
+// memHook answers SET and GETDEL commands from memory instead of
+// the redis server, commands are recorded so the test can check them.
+type memHook struct {
+	mu       sync.Mutex
+	values   map[string]string
+	expires  map[string]time.Time
+	commands []string
+}
+
+func (h *memHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return nil, errors.New("memHook: no redis server")
+	}
+}
+
+func (h *memHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		return errors.New("memHook: pipelines aren't supported")
+	}
+}
+
+func (h *memHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		args := cmd.Args()
+		command := make([]string, len(args))
+		for i, arg := range args {
+			command[i] = fmt.Sprint(arg)
+		}
+		h.commands = append(h.commands, strings.Join(command, " "))
+
+		key, _ := args[1].(string)
+		switch c := cmd.(type) {
+		case *redis.StatusCmd:
+			h.values[key], _ = args[2].(string)
+			if len(args) == 5 && args[3] == "ex" {
+				h.expires[key] = time.Now().Add(time.Duration(args[4].(int64)) * time.Second)
+			}
+			c.SetVal("OK")
+		case *redis.StringCmd:
+			value, ok := h.values[key]
+			if !ok || time.Now().After(h.expires[key]) {
+				c.SetErr(redis.Nil)
+				return redis.Nil
+			}
+			delete(h.values, key)
+			c.SetVal(value)
+		}
+		return nil
+	}
+}
+
+func newTestRedis(t *testing.T) (*RedisClient, *memHook) {
+	t.Helper()
+
+	client := redis.NewClient(&redis.Options{Addr: "redis.test:6379"})
+	t.Cleanup(func() { client.Close() })
+
+	hook := &memHook{values: make(map[string]string), expires: make(map[string]time.Time)}
+	client.AddHook(hook)
+
+	return &RedisClient{client: client}, hook
+}
+
+func TestTgLinkToken(t *testing.T) {
+	r, hook := newTestRedis(t)
+	ctx := context.Background()
+
+	if err := r.SaveTgLinkToken(ctx, "token", "user", 15*time.Minute); err != nil {
+		t.Fatalf("SaveTgLinkToken() error = %v", err)
+	}
+	if got := hook.commands[0]; got != "set tg_link_token user ex 900" {
+		t.Errorf("SaveTgLinkToken() command = %q, want token saved with ttl", got)
+	}
+
+	userID, err := r.PopTgLinkToken(ctx, "token")
+	if err != nil {
+		t.Fatalf("PopTgLinkToken() error = %v", err)
+	}
+	if userID != "user" {
+		t.Errorf("PopTgLinkToken() = %s, want user", userID)
+	}
+	// Token is read and deleted by one command, so it can't be used twice
+	if got := hook.commands[1]; got != "getdel tg_link_token" {
+		t.Errorf("PopTgLinkToken() command = %q, want getdel", got)
+	}
+
+	if _, err := r.PopTgLinkToken(ctx, "token"); !errors.Is(err, storage.ErrTgLinkTokenNotFound) {
+		t.Errorf("PopTgLinkToken() of used token error = %v, want %v", err, storage.ErrTgLinkTokenNotFound)
+	}
+
+	if err := r.SaveTgLinkToken(ctx, "expired", "user", time.Second); err != nil {
+		t.Fatalf("SaveTgLinkToken() error = %v", err)
+	}
+	hook.mu.Lock()
+	hook.expires["tg_link_expired"] = time.Now().Add(-time.Second)
+	hook.mu.Unlock()
+
+	if _, err := r.PopTgLinkToken(ctx, "expired"); !errors.Is(err, storage.ErrTgLinkTokenNotFound) {
+		t.Errorf("PopTgLinkToken() of expired token error = %v, want %v", err, storage.ErrTgLinkTokenNotFound)
+	}
+}
@@ -21,15 +21,20 @@ func (r *RedisClient) SaveRefreshTokenToRedis(ctx context.Context, token *Create
 	hashFields := map[string]interface{}{
 		"user_id":       token.UserID,
 		"refresh_token": token.Token,
+		"family_id":     token.FamilyID,
 	}
 
-	key := fmt.Sprintf("%s_%s", token.Token, token.UserID)
-	err := r.client.HSet(ctx, key, hashFields).Err()
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-
-	err = r.client.Expire(ctx, token.Token, ttl).Err()
+	key := refreshTokenKey(token.UserID, token.Token)
+	setKey := userRefreshTokensKey(token.UserID)
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, hashFields)
+		pipe.Expire(ctx, key, ttl)
+		// All refresh tokens share the same lifetime, so the newest one
+		// always outlives the rest of the index.
+		pipe.SAdd(ctx, setKey, key)
+		pipe.Expire(ctx, setKey, ttl)
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -37,30 +42,27 @@ func (r *RedisClient) SaveRefreshTokenToRedis(ctx context.Context, token *Create
 	return nil
 }
 
-func (r *RedisClient) FindRefreshToken(ctx context.Context, userID string) (*RefreshTokenFromRedis, error) {
-	const op = "storage.redis.FindRefreshToken"
+// GetRefreshToken returns active refresh token of the user.
+// Returns storage.ErrTokenNotFound if token was rotated, revoked or expired.
+func (r *RedisClient) GetRefreshToken(ctx context.Context, userID, token string) (*RefreshTokenFromRedis, error) {
+	const op = "storage.redis.GetRefreshToken"
 
-	pattern := fmt.Sprintf("*_%s", userID)
-	tokens, err := r.client.Keys(ctx, pattern).Result()
-	if err != nil {
+	var refToken RefreshToken
+	res := r.client.HGetAll(ctx, refreshTokenKey(userID, token))
+	if err := res.Err(); err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-
-	if len(tokens) == 0 {
-		return nil, fmt.Errorf("%s: %w", op, storage.ErrNoTokensFound)
+	if len(res.Val()) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrTokenNotFound)
 	}
-
-	lastToken := tokens[len(tokens)-1]
-
-	var token RefreshToken
-	err = r.client.HGetAll(ctx, lastToken).Scan(&token)
-	if err != nil {
+	if err := res.Scan(&refToken); err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	return &RefreshTokenFromRedis{
-		UserID: token.UserID,
-		Token:  token.Token,
+		UserID:   refToken.UserID,
+		Token:    refToken.Token,
+		FamilyID: refToken.FamilyID,
 	}, nil
 }
 
@@ -83,16 +85,90 @@ func (r *RedisClient) GetUserIDByToken(ctx context.Context, token string) (primi
 	return userID, nil
 }
 
-func (r *RedisClient) DeleteRefreshToken(ctx context.Context, token string) error {
+func (r *RedisClient) DeleteRefreshToken(ctx context.Context, userID, token string) error {
 	const op = "storage.redis.DeleteRefreshToken"
 
-	_, err := r.client.Del(ctx, token).Result()
-	if err == redis.Nil {
+	key := refreshTokenKey(userID, token)
+	var del *redis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		del = pipe.Del(ctx, key)
+		pipe.SRem(ctx, userRefreshTokensKey(userID), key)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if del.Val() == 0 {
 		return fmt.Errorf("%s: %w", op, storage.ErrTokenNotFound)
 	}
+
+	return nil
+}
+
+// DeleteRefreshTokenFamily deletes all active refresh tokens of the user
+// which belong to the given token family.
+func (r *RedisClient) DeleteRefreshTokenFamily(ctx context.Context, userID, familyID string) error {
+	const op = "storage.redis.DeleteRefreshTokenFamily"
+
+	setKey := userRefreshTokensKey(userID)
+	keys, err := r.client.SMembers(ctx, setKey).Result()
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	for _, key := range keys {
+		keyFamilyID, err := r.client.HGet(ctx, key, "family_id").Result()
+		if err == redis.Nil {
+			// Token has expired, drop it from the index.
+			if err := r.client.SRem(ctx, setKey, key).Err(); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if keyFamilyID != familyID {
+			continue
+		}
+
+		_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Del(ctx, key)
+			pipe.SRem(ctx, setKey, key)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
 	return nil
 }
+
+// DeleteUserRefreshTokens deletes all active refresh tokens of the user.
+func (r *RedisClient) DeleteUserRefreshTokens(ctx context.Context, userID string) error {
+	const op = "storage.redis.DeleteUserRefreshTokens"
+
+	setKey := userRefreshTokensKey(userID)
+	keys, err := r.client.SMembers(ctx, setKey).Result()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := r.client.Del(ctx, append(keys, setKey)...).Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func refreshTokenKey(userID, token string) string {
+	return fmt.Sprintf("%s_%s", token, userID)
+}
+
+// userRefreshTokensKey is the set of refresh token keys issued to the user,
+// so that revocation does not need to scan the keyspace.
+func userRefreshTokensKey(userID string) string {
+	return fmt.Sprintf("refresh_tokens:%s", userID)
+}
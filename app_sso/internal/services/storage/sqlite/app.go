@@ -0,0 +1,67 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (s *SQLiteStorage) SaveApp(ctx context.Context, app *models.DBCreateApp) error {
+	const op = "storage.sqlite.SaveApp"
+
+	scopes, err := json.Marshal(app.Scopes)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	app.ID = primitive.NewObjectID().Hex()
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO apps (id, name, secret_hash, scopes, created_by, created)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		app.ID,
+		app.Name,
+		app.SecretHash,
+		string(scopes),
+		app.CreatedBy,
+		app.Created.UTC(),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrAppExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) FindAppByID(ctx context.Context, appID string) (*models.App, error) {
+	const op = "storage.sqlite.FindAppByID"
+
+	var (
+		app    models.App
+		scopes string
+	)
+	err := s.db.QueryRowContext(ctx,
+		"SELECT id, name, secret_hash, scopes, created_by, created FROM apps WHERE id = ?",
+		appID,
+	).Scan(&app.ID, &app.Name, &app.SecretHash, &scopes, &app.CreatedBy, &app.Created)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrAppNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := json.Unmarshal([]byte(scopes), &app.Scopes); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &app, nil
+}
@@ -0,0 +1,114 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SaveAuditEvent appends the event to audit_log.
+// The table is append-only, triggers reject deletes and updates except
+// removal of personal data on erasure.
+func (s *SQLiteStorage) SaveAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	const op = "storage.sqlite.SaveAuditEvent"
+
+	event.ID = primitive.NewObjectID().Hex()
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO audit_log (id, type, user_id, actor_id, email, method, target_id, details, ip, user_agent, created)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		event.ID,
+		event.Type,
+		event.UserID,
+		event.ActorID,
+		event.Email,
+		event.Method,
+		event.TargetID,
+		event.Details,
+		event.IP,
+		event.UserAgent,
+		event.Created.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// FindAuditEvents returns events ordered by ID descending. IDs are hex
+// of ObjectID, so the newest events go first and ID works as a cursor.
+func (s *SQLiteStorage) FindAuditEvents(ctx context.Context, auditFilter *models.AuditFilter) ([]*models.AuditEvent, error) {
+	const op = "storage.sqlite.FindAuditEvents"
+
+	var (
+		where []string
+		args  []any
+	)
+	if auditFilter.UserID != "" {
+		where = append(where, "(user_id = ? OR actor_id = ?)")
+		args = append(args, auditFilter.UserID, auditFilter.UserID)
+	}
+	if auditFilter.EventType != "" {
+		where = append(where, "type = ?")
+		args = append(args, auditFilter.EventType)
+	}
+	if !auditFilter.From.IsZero() {
+		where = append(where, "created >= ?")
+		args = append(args, auditFilter.From.UTC())
+	}
+	if !auditFilter.To.IsZero() {
+		where = append(where, "created <= ?")
+		args = append(args, auditFilter.To.UTC())
+	}
+	if auditFilter.BeforeID != "" {
+		where = append(where, "id < ?")
+		args = append(args, auditFilter.BeforeID)
+	}
+
+	query := `SELECT id, type, user_id, actor_id, email, method, target_id, details, ip, user_agent, created
+		FROM audit_log`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY id DESC"
+	if auditFilter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, auditFilter.Limit)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var events []*models.AuditEvent
+	for rows.Next() {
+		var event models.AuditEvent
+		err := rows.Scan(
+			&event.ID,
+			&event.Type,
+			&event.UserID,
+			&event.ActorID,
+			&event.Email,
+			&event.Method,
+			&event.TargetID,
+			&event.Details,
+			&event.IP,
+			&event.UserAgent,
+			&event.Created,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		events = append(events, &event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return events, nil
+}
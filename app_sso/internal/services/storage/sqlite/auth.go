@@ -0,0 +1,395 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const userColumns = `id, email, pass_hash, name, is_admin, tg_link, chat_id, status,
+	totp_secret, totp_enabled, created, updated`
+
+const tokenColumns = `token, user_id, family_id, rotated, revoked, expires_at, created,
+	session_created, user_agent, ip, access_jti`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanUser(row scanner) (*models.User, error) {
+	var (
+		user   models.User
+		tgLink sql.NullString
+	)
+	err := row.Scan(
+		&user.ID,
+		&user.Email,
+		&user.PassHash,
+		&user.Name,
+		&user.IsAdmin,
+		&tgLink,
+		&user.ChatID,
+		&user.Status,
+		&user.TOTP.Secret,
+		&user.TOTP.Enabled,
+		&user.Created,
+		&user.Updated,
+	)
+	if err != nil {
+		return nil, err
+	}
+	user.TgLink = tgLink.String
+
+	return &user, nil
+}
+
+func scanToken(row scanner) (*models.RefreshToken, error) {
+	var token models.RefreshToken
+	err := row.Scan(
+		&token.Token,
+		&token.UserID,
+		&token.FamilyID,
+		&token.Rotated,
+		&token.Revoked,
+		&token.ExpiresAt,
+		&token.Created,
+		&token.SessionCreated,
+		&token.UserAgent,
+		&token.IP,
+		&token.AccessJTI,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// findUser returns user with recovery codes by the column value.
+func (s *SQLiteStorage) findUser(ctx context.Context, column string, value any) (*models.User, error) {
+	row := s.db.QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE "+column+" = ?", value)
+	user, err := scanUser(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, "SELECT code_hash FROM user_recovery_codes WHERE user_id = ?", user.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var code []byte
+		if err := rows.Scan(&code); err != nil {
+			return nil, err
+		}
+		user.TOTP.RecoveryCodes = append(user.TOTP.RecoveryCodes, code)
+	}
+
+	return user, rows.Err()
+}
+
+func (s *SQLiteStorage) SaveUser(ctx context.Context, user *models.DBCreateUser) error {
+	const op = "storage.sqlite.SaveUser"
+
+	user.ID = primitive.NewObjectID().Hex()
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO users (id, email, pass_hash, name, tg_link, is_admin, status, created, updated)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		user.ID,
+		user.Email,
+		user.PassHash,
+		user.Name,
+		nullString(user.TgLink),
+		user.IsAdmin,
+		user.Status,
+		user.Created.UTC(),
+		user.Updated.UTC(),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrUserExitsts)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) FindUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	const op = "storage.sqlite.FindUserByEmail"
+
+	user, err := s.findUser(ctx, "email", email)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+func (s *SQLiteStorage) FindUserByID(ctx context.Context, userID string) (*models.User, error) {
+	const op = "storage.sqlite.FindUserByID"
+
+	user, err := s.findUser(ctx, "id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+func (s *SQLiteStorage) FindUserByTgLink(ctx context.Context, tgLink string) (*models.User, error) {
+	const op = "storage.sqlite.FindUserByTgLink"
+
+	user, err := s.findUser(ctx, "tg_link", tgLink)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+func (s *SQLiteStorage) UpdateUserInfo(ctx context.Context, userInfo *models.DBUpdateUserInfo) error {
+	const op = "storage.sqlite.UpdateUserInfo"
+
+	var (
+		sets []string
+		args []any
+	)
+	if userInfo.Email != "" {
+		sets = append(sets, "email = ?")
+		args = append(args, userInfo.Email)
+	}
+	if userInfo.Name != "" {
+		sets = append(sets, "name = ?")
+		args = append(args, userInfo.Name)
+	}
+	if userInfo.TgLink != "" {
+		sets = append(sets, "tg_link = ?")
+		args = append(args, userInfo.TgLink)
+	}
+	if userInfo.ChatID != "" {
+		sets = append(sets, "chat_id = ?")
+		args = append(args, userInfo.ChatID)
+	}
+	if !userInfo.Updated.IsZero() {
+		sets = append(sets, "updated = ?")
+		args = append(args, userInfo.Updated.UTC())
+	}
+
+	if len(sets) == 0 {
+		return nil
+	}
+
+	args = append(args, userInfo.ID)
+	res, err := s.db.ExecContext(ctx, "UPDATE users SET "+strings.Join(sets, ", ")+" WHERE id = ?", args...)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrUserExitsts)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) UpdatePassword(ctx context.Context, userID string, passHash []byte) error {
+	const op = "storage.sqlite.UpdatePassword"
+
+	res, err := s.db.ExecContext(ctx,
+		"UPDATE users SET pass_hash = ?, updated = ? WHERE id = ?",
+		passHash, now(), userID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) UpdateUserStatus(ctx context.Context, userID, status string) error {
+	const op = "storage.sqlite.UpdateUserStatus"
+
+	res, err := s.db.ExecContext(ctx,
+		"UPDATE users SET status = ?, updated = ? WHERE id = ?",
+		status, now(), userID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) GetUserRoles(ctx context.Context, userID string) (*models.UserRoles, error) {
+	const op = "storage.sqlite.GetUserRoles"
+
+	var userRoles models.UserRoles
+	err := s.db.QueryRowContext(ctx, "SELECT is_admin FROM users WHERE id = ?", userID).Scan(&userRoles.IsAdmin)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &userRoles, nil
+}
+
+func (s *SQLiteStorage) SaveRefreshTokenToDB(ctx context.Context, token *models.CreateRefreshToken) error {
+	const op = "storage.sqlite.SaveRefreshTokenToDB"
+
+	_, err := s.db.ExecContext(ctx, "INSERT INTO tokens ("+tokenColumns+") VALUES ("+placeholders(11)+")",
+		token.Token,
+		token.UserID,
+		token.FamilyID,
+		token.Rotated,
+		token.Revoked,
+		token.ExpiresAt.UTC(),
+		token.Created.UTC(),
+		token.SessionCreated.UTC(),
+		token.UserAgent,
+		token.IP,
+		token.AccessJTI,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrTokenExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) FindRefreshTokenInDB(ctx context.Context, token string) (*models.RefreshToken, error) {
+	const op = "storage.sqlite.FindRefreshTokenInDB"
+
+	row := s.db.QueryRowContext(ctx, "SELECT "+tokenColumns+" FROM tokens WHERE token = ?", token)
+	refToken, err := scanToken(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrTokenNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return refToken, nil
+}
+
+// RotateRefreshTokenInDB marks active refresh token as rotated and returns it.
+// Returns storage.ErrTokenNotFound if token is already rotated or revoked.
+func (s *SQLiteStorage) RotateRefreshTokenInDB(ctx context.Context, token string) (*models.RefreshToken, error) {
+	const op = "storage.sqlite.RotateRefreshTokenInDB"
+
+	row := s.db.QueryRowContext(ctx, `
+		UPDATE tokens SET rotated = 1
+		WHERE token = ? AND rotated = 0 AND revoked = 0
+		RETURNING `+tokenColumns,
+		token,
+	)
+	refToken, err := scanToken(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrTokenNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// RETURNING gives updated row, token is returned as it was before rotation
+	refToken.Rotated = false
+
+	return refToken, nil
+}
+
+func (s *SQLiteStorage) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	const op = "storage.sqlite.RevokeRefreshTokenFamily"
+
+	_, err := s.db.ExecContext(ctx, "UPDATE tokens SET revoked = 1 WHERE family_id = ?", familyID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RevokeUserRefreshTokens revokes all refresh tokens of the user.
+func (s *SQLiteStorage) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	const op = "storage.sqlite.RevokeUserRefreshTokens"
+
+	_, err := s.db.ExecContext(ctx, "UPDATE tokens SET revoked = 1 WHERE user_id = ?", userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) GetExistChatID(ctx context.Context, userID string) (string, error) {
+	const op = "storage.sqlite.GetExistChatID"
+
+	var chatID string
+	err := s.db.QueryRowContext(ctx, "SELECT chat_id FROM users WHERE id = ?", userID).Scan(&chatID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return chatID, nil
+}
+
+func (s *SQLiteStorage) GetUsersInfoBatch(ctx context.Context, userIDs []string) ([]models.UserNotification, error) {
+	const op = "storage.sqlite.GetUsersInfoBatch"
+
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT id, email, tg_link, chat_id FROM users WHERE id IN ("+placeholders(len(userIDs))+")",
+		stringArgs(userIDs)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var users []models.UserNotification
+	for rows.Next() {
+		var (
+			user   models.UserNotification
+			tgLink sql.NullString
+		)
+		if err := rows.Scan(&user.UserID, &user.Email, &tgLink, &user.ChatID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		user.TgLink = tgLink.String
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return users, nil
+}
@@ -0,0 +1,243 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (s *SQLiteStorage) SaveGDPRJob(ctx context.Context, job *models.DBCreateGDPRJob) (string, error) {
+	const op = "storage.sqlite.SaveGDPRJob"
+
+	job.ID = primitive.NewObjectID().Hex()
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO gdpr_jobs (id, type, user_id, requested_by, status, created, updated)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		job.ID,
+		job.Type,
+		job.UserID,
+		job.RequestedBy,
+		job.Status,
+		job.Created.UTC(),
+		job.Updated.UTC(),
+	)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return job.ID, nil
+}
+
+func (s *SQLiteStorage) UpdateGDPRJob(ctx context.Context, job *models.DBUpdateGDPRJob) error {
+	const op = "storage.sqlite.UpdateGDPRJob"
+
+	query := "UPDATE gdpr_jobs SET status = ?, error = ?, updated = ?"
+	args := []any{job.Status, job.Error, job.Updated.UTC()}
+	if job.Archive != nil {
+		query += ", archive = ?"
+		args = append(args, job.Archive)
+	}
+	if !job.ExpiresAt.IsZero() {
+		query += ", expires_at = ?"
+		args = append(args, job.ExpiresAt.UTC())
+	}
+	args = append(args, job.ID)
+
+	res, err := s.db.ExecContext(ctx, query+" WHERE id = ?", args...)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrGDPRJobNotFound)
+	}
+
+	return nil
+}
+
+// FindGDPRJob returns the job. SQLite has no TTL indexes,
+// so expired export jobs are hidden instead of deleted.
+func (s *SQLiteStorage) FindGDPRJob(ctx context.Context, jobID string) (*models.GDPRJob, error) {
+	const op = "storage.sqlite.FindGDPRJob"
+
+	var job models.GDPRJob
+	err := s.db.QueryRowContext(ctx, `
+		SELECT id, type, user_id, requested_by, status, error, archive, created, updated
+		FROM gdpr_jobs WHERE id = ? AND (expires_at IS NULL OR expires_at > ?)`,
+		jobID, now(),
+	).Scan(
+		&job.ID,
+		&job.Type,
+		&job.UserID,
+		&job.RequestedBy,
+		&job.Status,
+		&job.Error,
+		&job.Archive,
+		&job.Created,
+		&job.Updated,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrGDPRJobNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &job, nil
+}
+
+// GetUserLearningGroups returns learning groups where the user is learner
+// or group admin.
+func (s *SQLiteStorage) GetUserLearningGroups(ctx context.Context, userID string) ([]models.UserLearningGroup, error) {
+	const op = "storage.sqlite.GetUserLearningGroups"
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT g.id, g.name, MAX(m.role = ?)
+		FROM learning_groups g
+		JOIN learning_group_members m ON m.learning_group_id = g.id
+		WHERE m.user_id = ?
+		GROUP BY g.id, g.name
+		ORDER BY g.id`,
+		models.GroupRoleGroupAdmin, userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var userGroups []models.UserLearningGroup
+	for rows.Next() {
+		var (
+			group   models.UserLearningGroup
+			isAdmin bool
+		)
+		if err := rows.Scan(&group.ID, &group.Name, &isAdmin); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		group.Role = models.GroupRoleLearner
+		if isAdmin {
+			group.Role = models.GroupRoleGroupAdmin
+		}
+		userGroups = append(userGroups, group)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return userGroups, nil
+}
+
+// RemoveUserFromGroups removes the user from all learning groups and their roles.
+// Groups created or modified by the user keep pseudonym instead of user id.
+func (s *SQLiteStorage) RemoveUserFromGroups(ctx context.Context, userID, pseudonym string) error {
+	const op = "storage.sqlite.RemoveUserFromGroups"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "DELETE FROM learning_group_members WHERE user_id = ?", userID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "DELETE FROM lg_role_members WHERE user_id = ?", userID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE lg_roles SET created_by = ? WHERE created_by = ?", pseudonym, userID)
+		if err != nil {
+			return err
+		}
+
+		for _, column := range []string{"created_by", "modified_by"} {
+			_, err := tx.ExecContext(ctx,
+				"UPDATE learning_groups SET "+column+" = ? WHERE "+column+" = ?",
+				pseudonym, userID,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// EraseUserProfile removes personal data of the user. The row is kept
+// with erased email, so the user id stays reserved. Sessions of the user
+// (they keep IP and user agent) and export jobs with archives of the user
+// data are deleted. Audit events are kept with ids, but email, IP
+// and user agent of the user are removed from them.
+func (s *SQLiteStorage) EraseUserProfile(ctx context.Context, userID string) error {
+	const op = "storage.sqlite.EraseUserProfile"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		var email string
+		err := tx.QueryRowContext(ctx, "SELECT email FROM users WHERE id = ?", userID).Scan(&email)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storage.ErrUserNotFound
+			}
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE audit_log SET email = '', ip = '', user_agent = ''
+			WHERE user_id = ? OR email = ?`,
+			userID, email,
+		)
+		if err != nil {
+			return err
+		}
+		// Events of the user acting on others keep email of the subject
+		_, err = tx.ExecContext(ctx,
+			"UPDATE audit_log SET ip = '', user_agent = '' WHERE actor_id = ?",
+			userID,
+		)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM tokens WHERE user_id = ?", userID); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"DELETE FROM gdpr_jobs WHERE user_id = ? AND type = ?",
+			userID, models.GDPRJobTypeExport,
+		)
+		if err != nil {
+			return err
+		}
+
+		res, err := tx.ExecContext(ctx, `
+			UPDATE users SET
+				email = ?, name = '', tg_link = NULL, chat_id = '', pass_hash = x'',
+				is_admin = 0, totp_secret = '', totp_enabled = 0, totp_last_counter = 0, updated = ?
+			WHERE id = ?`,
+			fmt.Sprintf("erased_%s@erased.invalid", userID), now(), userID,
+		)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return storage.ErrUserNotFound
+		}
+
+		_, err = tx.ExecContext(ctx, "DELETE FROM user_recovery_codes WHERE user_id = ?", userID)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+func TestEraseUserProfileDeletesExports(t *testing.T) {
+	db := newTestStorage(t)
+	ctx := context.Background()
+
+	user := &models.DBCreateUser{Email: "user@example.com", Status: models.UserStatusActive, Created: now(), Updated: now()}
+	if err := db.SaveUser(ctx, user); err != nil {
+		t.Fatalf("SaveUser() error = %v", err)
+	}
+
+	exportID := saveTestGDPRJob(t, db, models.GDPRJobTypeExport, user.ID)
+	err := db.UpdateGDPRJob(ctx, &models.DBUpdateGDPRJob{
+		ID:        exportID,
+		Status:    models.GDPRJobStatusCompleted,
+		Archive:   []byte(`{"email":"user@example.com"}`),
+		ExpiresAt: time.Now().Add(time.Hour),
+		Updated:   time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("UpdateGDPRJob() error = %v", err)
+	}
+	eraseID := saveTestGDPRJob(t, db, models.GDPRJobTypeErase, user.ID)
+
+	if err := db.EraseUserProfile(ctx, user.ID); err != nil {
+		t.Fatalf("EraseUserProfile() error = %v", err)
+	}
+
+	if _, err := db.FindGDPRJob(ctx, exportID); !errors.Is(err, storage.ErrGDPRJobNotFound) {
+		t.Errorf("FindGDPRJob() of export error = %v, want %v", err, storage.ErrGDPRJobNotFound)
+	}
+	// Erasure job is finished after the profile is erased
+	if _, err := db.FindGDPRJob(ctx, eraseID); err != nil {
+		t.Errorf("FindGDPRJob() of erasure error = %v", err)
+	}
+}
+
+func TestFindGDPRJobExpired(t *testing.T) {
+	db := newTestStorage(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		wantErr   error
+	}{
+		{name: "without expiration", expiresAt: time.Time{}},
+		{name: "not expired", expiresAt: time.Now().Add(time.Hour)},
+		{name: "expired", expiresAt: time.Now().Add(-time.Second), wantErr: storage.ErrGDPRJobNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobID := saveTestGDPRJob(t, db, models.GDPRJobTypeExport, "user")
+			err := db.UpdateGDPRJob(ctx, &models.DBUpdateGDPRJob{
+				ID:        jobID,
+				Status:    models.GDPRJobStatusCompleted,
+				Archive:   []byte("{}"),
+				ExpiresAt: tt.expiresAt,
+				Updated:   time.Now(),
+			})
+			if err != nil {
+				t.Fatalf("UpdateGDPRJob() error = %v", err)
+			}
+
+			if _, err := db.FindGDPRJob(ctx, jobID); !errors.Is(err, tt.wantErr) {
+				t.Errorf("FindGDPRJob() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func saveTestGDPRJob(t *testing.T, db *SQLiteStorage, jobType, userID string) string {
+	t.Helper()
+
+	jobID, err := db.SaveGDPRJob(context.Background(), &models.DBCreateGDPRJob{
+		Type:        jobType,
+		UserID:      userID,
+		RequestedBy: "admin",
+		Status:      models.GDPRJobStatusPending,
+		Created:     time.Now(),
+		Updated:     time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("SaveGDPRJob() error = %v", err)
+	}
+	return jobID
+}
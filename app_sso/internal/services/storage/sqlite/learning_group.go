@@ -0,0 +1,432 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// addMembers adds users with the role to the learning group, existing members are skipped.
+func addMembers(ctx context.Context, tx *sql.Tx, lgID, role string, userIDs []string) error {
+	for _, userID := range userIDs {
+		_, err := tx.ExecContext(ctx, `
+			INSERT OR IGNORE INTO learning_group_members (learning_group_id, user_id, role)
+			VALUES (?, ?, ?)`,
+			lgID, userID, role,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) SaveLg(ctx context.Context, lg *models.DBCreateLearningGroup) error {
+	const op = "storage.sqlite.SaveLg"
+
+	lg.ID = primitive.NewObjectID().Hex()
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO learning_groups (id, name, parent_id, created_by, modified_by, created, updated, version)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			lg.ID,
+			lg.Name,
+			nullString(lg.ParentID),
+			lg.CreatedBy,
+			lg.ModifiedBy,
+			lg.Created.UTC(),
+			lg.Updated.UTC(),
+			max(lg.Version, 1),
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := addMembers(ctx, tx, lg.ID, models.GroupRoleGroupAdmin, lg.GroupAdmins); err != nil {
+			return err
+		}
+		return addMembers(ctx, tx, lg.ID, models.GroupRoleLearner, lg.Learners)
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrLgExitsts)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// groupUsers returns users with the role in the learning group.
+// Members without user account are skipped.
+func (s *SQLiteStorage) groupUsers(ctx context.Context, lgID, role string) ([]models.GroupUser, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT u.id, u.email, u.name
+		FROM learning_group_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.learning_group_id = ? AND m.role = ?
+		ORDER BY u.id`,
+		lgID, role,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []models.GroupUser{}
+	for rows.Next() {
+		var user models.GroupUser
+		if err := rows.Scan(&user.ID, &user.Email, &user.Name); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+func (s *SQLiteStorage) GetLgByID(ctx context.Context, userLG *models.GetLgByID) (*models.LearningGroup, error) {
+	const op = "storage.sqlite.GetLgByID"
+
+	var lg models.LearningGroup
+	err := s.db.QueryRowContext(ctx, `
+		SELECT g.id, g.name, COALESCE(g.parent_id, ''), g.created_by, g.modified_by, g.created, g.updated, g.version
+		FROM learning_groups g
+		JOIN learning_group_members m ON m.learning_group_id = g.id
+		WHERE g.id = ? AND m.user_id = ? AND m.role = ?`,
+		userLG.LgId, userLG.UserID, models.GroupRoleLearner,
+	).Scan(&lg.ID, &lg.Name, &lg.ParentID, &lg.CreatedBy, &lg.ModifiedBy, &lg.Created, &lg.Updated, &lg.Version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrLgNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	lg.Learners, err = s.groupUsers(ctx, lg.ID, models.GroupRoleLearner)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	lg.GroupAdmins, err = s.groupUsers(ctx, lg.ID, models.GroupRoleGroupAdmin)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &lg, nil
+}
+
+func (s *SQLiteStorage) GetLGroupsByUserID(ctx context.Context, userID string) ([]*models.LearningGroupShort, error) {
+	const op = "storage.sqlite.GetLGroupsByUserID"
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT g.id, g.name, COALESCE(g.parent_id, ''), g.created_by, g.modified_by, g.created, g.updated
+		FROM learning_groups g
+		JOIN learning_group_members m ON m.learning_group_id = g.id
+		WHERE m.user_id = ? AND m.role = ?
+		ORDER BY g.id`,
+		userID, models.GroupRoleLearner,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var learningGroups []*models.LearningGroupShort
+	for rows.Next() {
+		var lg models.LearningGroupShort
+		if err := rows.Scan(&lg.ID, &lg.Name, &lg.ParentID, &lg.CreatedBy, &lg.ModifiedBy, &lg.Created, &lg.Updated); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		learningGroups = append(learningGroups, &lg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return learningGroups, nil
+}
+
+// UpdateLgByID updates the learning group and increments its version.
+// If lg.Version is set, the group is updated only if it has this version,
+// otherwise storage.ErrLgVersionConflict is returned.
+func (s *SQLiteStorage) UpdateLgByID(ctx context.Context, lg *models.DBUpdateLearningGroup) error {
+	const op = "storage.sqlite.UpdateLgByID"
+
+	sets := []string{"version = version + 1"}
+	var args []any
+	if lg.Name != "" {
+		sets = append(sets, "name = ?")
+		args = append(args, lg.Name)
+	}
+	if lg.ModifiedBy != "" {
+		sets = append(sets, "modified_by = ?")
+		args = append(args, lg.ModifiedBy)
+	}
+	if !lg.Updated.IsZero() {
+		sets = append(sets, "updated = ?")
+		args = append(args, lg.Updated.UTC())
+	}
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		var version int64
+		err := tx.QueryRowContext(ctx,
+			"SELECT version FROM learning_groups WHERE id = ?", lg.ID,
+		).Scan(&version)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storage.ErrLgNotFound
+			}
+			return err
+		}
+		if lg.Version > 0 && lg.Version != version {
+			return storage.ErrLgVersionConflict
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"UPDATE learning_groups SET "+strings.Join(sets, ", ")+" WHERE id = ?",
+			append(args, lg.ID)...,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := addMembers(ctx, tx, lg.ID, models.GroupRoleGroupAdmin, lg.GroupAdmins); err != nil {
+			return err
+		}
+		return addMembers(ctx, tx, lg.ID, models.GroupRoleLearner, lg.Learners)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// AddLgMembers adds users with the role to the learning group, existing members are skipped.
+func (s *SQLiteStorage) AddLgMembers(ctx context.Context, change *models.DBChangeLgMembers) error {
+	const op = "storage.sqlite.AddLgMembers"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		if err := touchLg(ctx, tx, change); err != nil {
+			return err
+		}
+		return addMembers(ctx, tx, change.LgID, change.Role, change.UserIDs)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RemoveLgMembers removes users with the role from the learning group, missing users are skipped.
+// It returns ErrLgLastAdmin if no group admins would be left.
+func (s *SQLiteStorage) RemoveLgMembers(ctx context.Context, change *models.DBChangeLgMembers) error {
+	const op = "storage.sqlite.RemoveLgMembers"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		if err := touchLg(ctx, tx, change); err != nil {
+			return err
+		}
+
+		args := append([]any{change.LgID, change.Role}, stringArgs(change.UserIDs)...)
+		_, err := tx.ExecContext(ctx, `
+			DELETE FROM learning_group_members
+			WHERE learning_group_id = ? AND role = ? AND user_id IN (`+placeholders(len(change.UserIDs))+`)`,
+			args...,
+		)
+		if err != nil || change.Role != models.GroupRoleGroupAdmin {
+			return err
+		}
+
+		// Writes are serialized by the transaction, so concurrent removals
+		// can't leave the group without admins
+		var admins int
+		err = tx.QueryRowContext(ctx, `
+			SELECT COUNT(*) FROM learning_group_members
+			WHERE learning_group_id = ? AND role = ?`,
+			change.LgID, models.GroupRoleGroupAdmin,
+		).Scan(&admins)
+		if err != nil {
+			return err
+		}
+		if admins == 0 {
+			return storage.ErrLgLastAdmin
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// touchLg sets modifier of the learning group and increments its version.
+func touchLg(ctx context.Context, tx *sql.Tx, change *models.DBChangeLgMembers) error {
+	res, err := tx.ExecContext(ctx, `
+		UPDATE learning_groups SET modified_by = ?, updated = ?, version = version + 1
+		WHERE id = ?`,
+		change.ModifiedBy, change.Updated.UTC(), change.LgID,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrLgNotFound
+	}
+
+	return nil
+}
+
+// DeleteLgByID deletes the learning group, its child groups are moved
+// to the parent of the deleted group.
+func (s *SQLiteStorage) DeleteLgByID(ctx context.Context, delG *models.DelGroup) error {
+	const op = "storage.sqlite.DeleteLgByID"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE learning_groups
+			SET parent_id = (SELECT parent_id FROM learning_groups WHERE id = ?)
+			WHERE parent_id = ?`,
+			delG.LgId, delG.LgId,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Members and invites are deleted by foreign key cascade
+		_, err = tx.ExecContext(ctx, "DELETE FROM learning_groups WHERE id = ?", delG.LgId)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// isMember reports whether the user has the role in the learning group.
+func (s *SQLiteStorage) isMember(ctx context.Context, lgID, userID, role string) (bool, error) {
+	var isMember bool
+	err := s.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM learning_group_members
+			WHERE learning_group_id = ? AND user_id = ? AND role = ?
+		)`,
+		lgID, userID, role,
+	).Scan(&isMember)
+
+	return isMember, err
+}
+
+func (s *SQLiteStorage) IsGroupAdmin(ctx context.Context, lgUser *models.IsGroupAdmin) (bool, error) {
+	const op = "storage.sqlite.IsGroupAdmin"
+
+	isAdmin, err := s.isMember(ctx, lgUser.LgId, lgUser.UserID, models.GroupRoleGroupAdmin)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return isAdmin, nil
+}
+
+func (s *SQLiteStorage) IsLearner(ctx context.Context, lgUser *models.GetLgByID) (bool, error) {
+	const op = "storage.sqlite.IsLearner"
+
+	isLearner, err := s.isMember(ctx, lgUser.LgId, lgUser.UserID, models.GroupRoleLearner)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return isLearner, nil
+}
+
+// memberGroupIDs returns IDs of learning groups where the user has the role.
+func (s *SQLiteStorage) memberGroupIDs(ctx context.Context, userID, role string) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT learning_group_id FROM learning_group_members
+		WHERE user_id = ? AND role = ?
+		ORDER BY learning_group_id`,
+		userID, role,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var groupIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		groupIDs = append(groupIDs, id)
+	}
+
+	return groupIDs, rows.Err()
+}
+
+func (s *SQLiteStorage) GetUserIsGroupAdminIn(ctx context.Context, user *models.UserIsGroupAdminIn) ([]string, error) {
+	const op = "storage.sqlite.GetUserIsGroupAdminIn"
+
+	groupIDs, err := s.memberGroupIDs(ctx, user.UserID, models.GroupRoleGroupAdmin)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return groupIDs, nil
+}
+
+func (s *SQLiteStorage) GetUserIsLearnerIn(ctx context.Context, user *models.UserIsLearnerIn) ([]string, error) {
+	const op = "storage.sqlite.GetUserIsLearnerIn"
+
+	groupIDs, err := s.memberGroupIDs(ctx, user.UserID, models.GroupRoleLearner)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return groupIDs, nil
+}
+
+func (s *SQLiteStorage) GetLearners(ctx context.Context, lgID *models.GetLearners) ([]string, error) {
+	const op = "storage.sqlite.GetLearners"
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT user_id FROM learning_group_members
+		WHERE learning_group_id = ? AND role = ?
+		ORDER BY user_id`,
+		lgID.LgId, models.GroupRoleLearner,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var learners []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		learners = append(learners, userID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return learners, nil
+}
@@ -0,0 +1,139 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+const inviteColumns = "code, learning_group_id, created_by, max_uses, uses, expires_at, created"
+
+// activeInviteWhere matches invites which are not expired and have uses left.
+const activeInviteWhere = "expires_at > ? AND uses < max_uses"
+
+func scanInvite(row scanner) (*models.LgInvite, error) {
+	var invite models.LgInvite
+	err := row.Scan(
+		&invite.Code,
+		&invite.LgID,
+		&invite.CreatedBy,
+		&invite.MaxUses,
+		&invite.Uses,
+		&invite.ExpiresAt,
+		&invite.Created,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &invite, nil
+}
+
+func (s *SQLiteStorage) SaveLgInvite(ctx context.Context, invite *models.LgInvite) error {
+	const op = "storage.sqlite.SaveLgInvite"
+
+	_, err := s.db.ExecContext(ctx,
+		"INSERT INTO lg_invites ("+inviteColumns+") VALUES ("+placeholders(7)+")",
+		invite.Code,
+		invite.LgID,
+		invite.CreatedBy,
+		invite.MaxUses,
+		invite.Uses,
+		invite.ExpiresAt.UTC(),
+		invite.Created.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) GetLgInvite(ctx context.Context, code string) (*models.LgInvite, error) {
+	const op = "storage.sqlite.GetLgInvite"
+
+	invite, err := scanInvite(s.db.QueryRowContext(ctx,
+		"SELECT "+inviteColumns+" FROM lg_invites WHERE code = ? AND "+activeInviteWhere,
+		code, now(),
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrLgInviteNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return invite, nil
+}
+
+// GetActiveLgInvites returns invites of the learning group from the newest.
+func (s *SQLiteStorage) GetActiveLgInvites(ctx context.Context, lgID string) ([]*models.LgInvite, error) {
+	const op = "storage.sqlite.GetActiveLgInvites"
+
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT "+inviteColumns+" FROM lg_invites WHERE learning_group_id = ? AND "+activeInviteWhere+" ORDER BY created DESC",
+		lgID, now(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var invites []*models.LgInvite
+	for rows.Next() {
+		invite, err := scanInvite(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		invites = append(invites, invite)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return invites, nil
+}
+
+// UseLgInvite spends one use of the active invite. Concurrent requests
+// can't spend more than max_uses, the check and increment are one statement.
+func (s *SQLiteStorage) UseLgInvite(ctx context.Context, code string) (*models.LgInvite, error) {
+	const op = "storage.sqlite.UseLgInvite"
+
+	invite, err := scanInvite(s.db.QueryRowContext(ctx,
+		"UPDATE lg_invites SET uses = uses + 1 WHERE code = ? AND "+activeInviteWhere+" RETURNING "+inviteColumns,
+		code, now(),
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrLgInviteNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return invite, nil
+}
+
+func (s *SQLiteStorage) DeleteLgInvite(ctx context.Context, lgID, code string) error {
+	const op = "storage.sqlite.DeleteLgInvite"
+
+	res, err := s.db.ExecContext(ctx,
+		"DELETE FROM lg_invites WHERE code = ? AND learning_group_id = ?",
+		code, lgID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrLgInviteNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const roleColumns = "id, learning_group_id, name, permissions, created_by, created, updated"
+
+func scanRole(row scanner) (*models.LgRole, error) {
+	var (
+		role        models.LgRole
+		permissions string
+	)
+	err := row.Scan(
+		&role.ID,
+		&role.LgID,
+		&role.Name,
+		&permissions,
+		&role.CreatedBy,
+		&role.Created,
+		&role.Updated,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(permissions), &role.Permissions); err != nil {
+		return nil, err
+	}
+	role.Members = []string{}
+
+	return &role, nil
+}
+
+func (s *SQLiteStorage) SaveLgRole(ctx context.Context, role *models.LgRole) error {
+	const op = "storage.sqlite.SaveLgRole"
+
+	permissions, err := json.Marshal(role.Permissions)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	role.ID = primitive.NewObjectID().Hex()
+	err = s.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO lg_roles ("+roleColumns+") VALUES ("+placeholders(7)+")",
+			role.ID,
+			role.LgID,
+			role.Name,
+			string(permissions),
+			role.CreatedBy,
+			role.Created.UTC(),
+			role.Updated.UTC(),
+		)
+		if err != nil {
+			return err
+		}
+
+		return addRoleMembers(ctx, tx, role.ID, role.Members)
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrLgRoleExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetLgRoles returns roles of the learning group sorted by name.
+func (s *SQLiteStorage) GetLgRoles(ctx context.Context, lgID string) ([]*models.LgRole, error) {
+	const op = "storage.sqlite.GetLgRoles"
+
+	roles, err := s.findRoles(ctx,
+		"SELECT "+roleColumns+" FROM lg_roles WHERE learning_group_id = ? ORDER BY name",
+		lgID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return roles, nil
+}
+
+// GetUserLgRoles returns roles of all learning groups assigned to the user.
+func (s *SQLiteStorage) GetUserLgRoles(ctx context.Context, userID string) ([]*models.LgRole, error) {
+	const op = "storage.sqlite.GetUserLgRoles"
+
+	roles, err := s.findRoles(ctx, `
+		SELECT `+roleColumns+` FROM lg_roles
+		WHERE id IN (SELECT role_id FROM lg_role_members WHERE user_id = ?)
+		ORDER BY name`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return roles, nil
+}
+
+// findRoles returns roles of the query with their members.
+func (s *SQLiteStorage) findRoles(ctx context.Context, query string, args ...any) ([]*models.LgRole, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		roles   []*models.LgRole
+		roleIDs []string
+	)
+	byID := make(map[string]*models.LgRole)
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+		roleIDs = append(roleIDs, role.ID)
+		byID[role.ID] = role
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return roles, nil
+	}
+
+	memberRows, err := s.db.QueryContext(ctx,
+		"SELECT role_id, user_id FROM lg_role_members WHERE role_id IN ("+placeholders(len(roleIDs))+") ORDER BY user_id",
+		stringArgs(roleIDs)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer memberRows.Close()
+
+	for memberRows.Next() {
+		var roleID, userID string
+		if err := memberRows.Scan(&roleID, &userID); err != nil {
+			return nil, err
+		}
+		byID[roleID].Members = append(byID[roleID].Members, userID)
+	}
+
+	return roles, memberRows.Err()
+}
+
+func (s *SQLiteStorage) UpdateLgRole(ctx context.Context, upd *models.DBUpdateLgRole) error {
+	const op = "storage.sqlite.UpdateLgRole"
+
+	permissions, err := json.Marshal(upd.Permissions)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := s.db.ExecContext(ctx,
+		"UPDATE lg_roles SET name = ?, permissions = ?, updated = ? WHERE id = ? AND learning_group_id = ?",
+		upd.Name, string(permissions), upd.Updated.UTC(), upd.ID, upd.LgID,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrLgRoleExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if updated == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrLgRoleNotFound)
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) DeleteLgRole(ctx context.Context, lgID, roleID string) error {
+	const op = "storage.sqlite.DeleteLgRole"
+
+	// Members are deleted by foreign key cascade
+	res, err := s.db.ExecContext(ctx,
+		"DELETE FROM lg_roles WHERE id = ? AND learning_group_id = ?",
+		roleID, lgID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrLgRoleNotFound)
+	}
+
+	return nil
+}
+
+// AddLgRoleMembers assigns the role to the users, existing members are skipped.
+func (s *SQLiteStorage) AddLgRoleMembers(ctx context.Context, change *models.DBChangeLgRoleMembers) error {
+	const op = "storage.sqlite.AddLgRoleMembers"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		if err := touchRole(ctx, tx, change); err != nil {
+			return err
+		}
+		return addRoleMembers(ctx, tx, change.ID, change.UserIDs)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RemoveLgRoleMembers takes the role away from the users, missing users are skipped.
+func (s *SQLiteStorage) RemoveLgRoleMembers(ctx context.Context, change *models.DBChangeLgRoleMembers) error {
+	const op = "storage.sqlite.RemoveLgRoleMembers"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		if err := touchRole(ctx, tx, change); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx,
+			"DELETE FROM lg_role_members WHERE role_id = ? AND user_id IN ("+placeholders(len(change.UserIDs))+")",
+			append([]any{change.ID}, stringArgs(change.UserIDs)...)...,
+		)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// touchRole updates time of the role change, it fails if the role
+// doesn't belong to the learning group.
+func touchRole(ctx context.Context, tx *sql.Tx, change *models.DBChangeLgRoleMembers) error {
+	res, err := tx.ExecContext(ctx,
+		"UPDATE lg_roles SET updated = ? WHERE id = ? AND learning_group_id = ?",
+		change.Updated.UTC(), change.ID, change.LgID,
+	)
+	if err != nil {
+		return err
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return storage.ErrLgRoleNotFound
+	}
+
+	return nil
+}
+
+func addRoleMembers(ctx context.Context, tx *sql.Tx, roleID string, userIDs []string) error {
+	for _, userID := range userIDs {
+		_, err := tx.ExecContext(ctx,
+			"INSERT OR IGNORE INTO lg_role_members (role_id, user_id) VALUES (?, ?)",
+			roleID, userID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+// GetLgAncestorIDs returns IDs of all ancestors of the learning groups,
+// the groups themselves aren't included unless they are ancestors of each other.
+func (s *SQLiteStorage) GetLgAncestorIDs(ctx context.Context, lgIDs []string) ([]string, error) {
+	const op = "storage.sqlite.GetLgAncestorIDs"
+
+	if len(lgIDs) == 0 {
+		return nil, nil
+	}
+
+	// UNION drops visited groups, so the query ends even on a cycle
+	rows, err := s.db.QueryContext(ctx, `
+		WITH RECURSIVE ancestors(id) AS (
+			SELECT parent_id FROM learning_groups
+			WHERE id IN (`+placeholders(len(lgIDs))+`) AND parent_id IS NOT NULL
+			UNION
+			SELECT g.parent_id FROM learning_groups g
+			JOIN ancestors a ON g.id = a.id
+			WHERE g.parent_id IS NOT NULL
+		)
+		SELECT g.id FROM ancestors a
+		JOIN learning_groups g ON g.id = a.id
+		ORDER BY g.id`,
+		stringArgs(lgIDs)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var ancestorIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		ancestorIDs = append(ancestorIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ancestorIDs, nil
+}
+
+// GetLgSubtree returns the learning group and all its descendants.
+// The group is the first one, descendants are in no particular order.
+func (s *SQLiteStorage) GetLgSubtree(ctx context.Context, lgID string) ([]*models.LearningGroupShort, error) {
+	const op = "storage.sqlite.GetLgSubtree"
+
+	rows, err := s.db.QueryContext(ctx, `
+		WITH RECURSIVE subtree(id) AS (
+			SELECT id FROM learning_groups WHERE id = ?
+			UNION
+			SELECT g.id FROM learning_groups g
+			JOIN subtree t ON g.parent_id = t.id
+		)
+		SELECT g.id, g.name, COALESCE(g.parent_id, ''), g.created_by, g.modified_by, g.created, g.updated
+		FROM learning_groups g
+		JOIN subtree t ON t.id = g.id
+		ORDER BY g.id = ? DESC, g.id`,
+		lgID, lgID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var groups []*models.LearningGroupShort
+	for rows.Next() {
+		var lg models.LearningGroupShort
+		if err := rows.Scan(&lg.ID, &lg.Name, &lg.ParentID, &lg.CreatedBy, &lg.ModifiedBy, &lg.Created, &lg.Updated); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		groups = append(groups, &lg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, storage.ErrLgNotFound)
+	}
+
+	return groups, nil
+}
+
+// MoveLg sets the parent of the learning group and increments its version.
+// Empty ParentID makes the group a root one. If move.Version is set,
+// the group is moved only if it has this version, otherwise
+// storage.ErrLgVersionConflict is returned. storage.ErrLgCycle is returned
+// if the group is the parent or one of its ancestors. The check and the update
+// are in one transaction, so concurrent moves of groups under each other
+// can't make a cycle: sqlite lets only one of them write.
+func (s *SQLiteStorage) MoveLg(ctx context.Context, move *models.DBMoveLearningGroup) error {
+	const op = "storage.sqlite.MoveLg"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		if move.ParentID != "" {
+			var exists bool
+			err := tx.QueryRowContext(ctx,
+				"SELECT EXISTS (SELECT 1 FROM learning_groups WHERE id = ?)", move.ParentID,
+			).Scan(&exists)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return storage.ErrLgNotFound
+			}
+		}
+
+		var version int64
+		err := tx.QueryRowContext(ctx,
+			"SELECT version FROM learning_groups WHERE id = ?", move.ID,
+		).Scan(&version)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storage.ErrLgNotFound
+			}
+			return err
+		}
+		if move.Version > 0 && move.Version != version {
+			return storage.ErrLgVersionConflict
+		}
+
+		if move.ParentID != "" {
+			var cycle bool
+			err := tx.QueryRowContext(ctx, `
+				WITH RECURSIVE ancestors(id) AS (
+					SELECT ?
+					UNION
+					SELECT g.parent_id FROM learning_groups g
+					JOIN ancestors a ON g.id = a.id
+					WHERE g.parent_id IS NOT NULL
+				)
+				SELECT EXISTS (SELECT 1 FROM ancestors WHERE id = ?)`,
+				move.ParentID, move.ID,
+			).Scan(&cycle)
+			if err != nil {
+				return err
+			}
+			if cycle {
+				return storage.ErrLgCycle
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE learning_groups
+			SET parent_id = ?, modified_by = ?, updated = ?, version = version + 1
+			WHERE id = ?`,
+			nullString(move.ParentID), move.ModifiedBy, move.Updated.UTC(), move.ID,
+		)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
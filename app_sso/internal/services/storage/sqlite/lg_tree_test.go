@@ -0,0 +1,117 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+func TestMoveLg(t *testing.T) {
+	// root <- child <- grandchild
+	tests := []struct {
+		name    string
+		lg      string
+		parent  string
+		wantErr error
+	}{
+		{name: "under sibling subtree", lg: "other", parent: "grandchild"},
+		{name: "to root", lg: "grandchild", parent: ""},
+		{name: "under itself", lg: "child", parent: "child", wantErr: storage.ErrLgCycle},
+		{name: "under child", lg: "root", parent: "child", wantErr: storage.ErrLgCycle},
+		{name: "under grandchild", lg: "root", parent: "grandchild", wantErr: storage.ErrLgCycle},
+		{name: "unknown parent", lg: "child", parent: "unknown", wantErr: storage.ErrLgNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestStorage(t)
+			ids := map[string]string{}
+			for _, lg := range []struct{ name, parent string }{
+				{"root", ""}, {"child", "root"}, {"grandchild", "child"}, {"other", ""},
+			} {
+				ids[lg.name] = saveTestLg(t, db, lg.name, ids[lg.parent])
+			}
+
+			parentID := ids[tt.parent]
+			if tt.parent == "unknown" {
+				parentID = "unknown"
+			}
+
+			err := db.MoveLg(context.Background(), &models.DBMoveLearningGroup{
+				ID:         ids[tt.lg],
+				ParentID:   parentID,
+				ModifiedBy: "admin",
+				Updated:    time.Now(),
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MoveLg() error = %v, want %v", err, tt.wantErr)
+			}
+
+			ancestorIDs, err := db.GetLgAncestorIDs(context.Background(), []string{ids[tt.lg]})
+			if err != nil {
+				t.Fatalf("GetLgAncestorIDs() error = %v", err)
+			}
+			for _, id := range ancestorIDs {
+				if id == ids[tt.lg] {
+					t.Fatalf("group %s is its own ancestor", tt.lg)
+				}
+			}
+		})
+	}
+}
+
+func newTestStorage(t *testing.T) *SQLiteStorage {
+	t.Helper()
+
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "sso.db")
+
+	db, err := New(ctx, dbPath)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { db.Close(ctx) })
+
+	files, err := filepath.Glob(filepath.Join("..", "..", "..", "..", "migrations", "sqlite", "*.up.sql"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("find migrations: %v", err)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		query, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("read migration: %v", err)
+		}
+		if _, err := db.db.ExecContext(ctx, string(query)); err != nil {
+			t.Fatalf("apply migration %s: %v", filepath.Base(file), err)
+		}
+	}
+
+	return db
+}
+
+func saveTestLg(t *testing.T, db *SQLiteStorage, name, parentID string) string {
+	t.Helper()
+
+	lg := &models.DBCreateLearningGroup{
+		Name:       name,
+		ParentID:   parentID,
+		CreatedBy:  "admin",
+		ModifiedBy: "admin",
+		Created:    time.Now(),
+		Updated:    time.Now(),
+	}
+	if err := db.SaveLg(context.Background(), lg); err != nil {
+		t.Fatalf("SaveLg() error = %v", err)
+	}
+
+	return lg.ID
+}
@@ -0,0 +1,67 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (s *SQLiteStorage) SaveRelyingParty(ctx context.Context, rp *models.DBCreateRelyingParty) error {
+	const op = "storage.sqlite.SaveRelyingParty"
+
+	redirectURIs, err := json.Marshal(rp.RedirectURIs)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	rp.ID = primitive.NewObjectID().Hex()
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO relying_parties (id, name, secret_hash, redirect_uris, created_by, created)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		rp.ID,
+		rp.Name,
+		rp.SecretHash,
+		string(redirectURIs),
+		rp.CreatedBy,
+		rp.Created.UTC(),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrRelyingPartyExists)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) FindRelyingPartyByID(ctx context.Context, clientID string) (*models.RelyingParty, error) {
+	const op = "storage.sqlite.FindRelyingPartyByID"
+
+	var (
+		rp           models.RelyingParty
+		redirectURIs string
+	)
+	err := s.db.QueryRowContext(ctx,
+		"SELECT id, name, secret_hash, redirect_uris, created_by, created FROM relying_parties WHERE id = ?",
+		clientID,
+	).Scan(&rp.ID, &rp.Name, &rp.SecretHash, &redirectURIs, &rp.CreatedBy, &rp.Created)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrRelyingPartyNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := json.Unmarshal([]byte(redirectURIs), &rp.RedirectURIs); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &rp, nil
+}
@@ -0,0 +1,148 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+)
+
+// FindUsersPage returns page of users ordered by ID and total number
+// of users matching the filter.
+func (s *SQLiteStorage) FindUsersPage(ctx context.Context, usersFilter *models.ScimUsersFilter) ([]*models.User, int64, error) {
+	const op = "storage.sqlite.FindUsersPage"
+
+	var (
+		where []string
+		args  []any
+	)
+	if usersFilter.Email != "" {
+		where = append(where, "email = ?")
+		args = append(args, usersFilter.Email)
+	}
+	if usersFilter.Deactivated != nil {
+		if *usersFilter.Deactivated {
+			where = append(where, "status = ?")
+		} else {
+			where = append(where, "status != ?")
+		}
+		args = append(args, models.UserStatusDeactivated)
+	}
+
+	cond := ""
+	if len(where) > 0 {
+		cond = " WHERE " + strings.Join(where, " AND ")
+	}
+
+	var total int64
+	if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM users"+cond, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT "+userColumns+" FROM users"+cond+" ORDER BY id LIMIT ? OFFSET ?",
+		append(args, usersFilter.Limit, usersFilter.Offset)...,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	users := []*models.User{}
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", op, err)
+		}
+		user.PassHash = nil
+		user.TOTP = models.UserTOTP{}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return users, total, nil
+}
+
+// FindLgsPage returns page of learning groups with their learners ordered
+// by ID and total number of groups matching the filter.
+func (s *SQLiteStorage) FindLgsPage(ctx context.Context, groupsFilter *models.ScimGroupsFilter) ([]*models.ScimGroup, int64, error) {
+	const op = "storage.sqlite.FindLgsPage"
+
+	var (
+		where []string
+		args  []any
+	)
+	if groupsFilter.ID != "" {
+		where = append(where, "id = ?")
+		args = append(args, groupsFilter.ID)
+	}
+	if groupsFilter.Name != "" {
+		where = append(where, "name = ?")
+		args = append(args, groupsFilter.Name)
+	}
+
+	cond := ""
+	if len(where) > 0 {
+		cond = " WHERE " + strings.Join(where, " AND ")
+	}
+
+	var total int64
+	if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM learning_groups"+cond, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT id, name, created, updated, version FROM learning_groups"+cond+" ORDER BY id LIMIT ? OFFSET ?",
+		append(args, groupsFilter.Limit, groupsFilter.Offset)...,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var (
+		groups []*models.ScimGroup
+		lgIDs  []string
+	)
+	byID := make(map[string]*models.ScimGroup)
+	for rows.Next() {
+		group := &models.ScimGroup{Members: []string{}}
+		if err := rows.Scan(&group.ID, &group.Name, &group.Created, &group.Updated, &group.Version); err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", op, err)
+		}
+		groups = append(groups, group)
+		lgIDs = append(lgIDs, group.ID)
+		byID[group.ID] = group
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(groups) == 0 {
+		return groups, total, nil
+	}
+
+	memberRows, err := s.db.QueryContext(ctx,
+		"SELECT learning_group_id, user_id FROM learning_group_members WHERE role = ? AND learning_group_id IN ("+placeholders(len(lgIDs))+") ORDER BY user_id",
+		append([]any{models.GroupRoleLearner}, stringArgs(lgIDs)...)...,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer memberRows.Close()
+
+	for memberRows.Next() {
+		var lgID, userID string
+		if err := memberRows.Scan(&lgID, &userID); err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", op, err)
+		}
+		byID[lgID].Members = append(byID[lgID].Members, userID)
+	}
+	if err := memberRows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return groups, total, nil
+}
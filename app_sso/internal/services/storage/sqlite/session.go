@@ -0,0 +1,78 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+// activeTokensWhere matches refresh tokens of the user which can still be used.
+// Every session has exactly one such token.
+const activeTokensWhere = "user_id = ? AND rotated = 0 AND revoked = 0 AND expires_at > ?"
+
+// FindUserSessions returns active sessions of the user, most recently used first.
+func (s *SQLiteStorage) FindUserSessions(ctx context.Context, userID string) ([]*models.Session, error) {
+	const op = "storage.sqlite.FindUserSessions"
+
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT "+tokenColumns+" FROM tokens WHERE "+activeTokensWhere+" ORDER BY created DESC",
+		userID, now(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var sessions []*models.Session
+	for rows.Next() {
+		token, err := scanToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		created := token.SessionCreated
+		// Tokens issued before sessions were introduced
+		if created.IsZero() {
+			created = token.Created
+		}
+
+		sessions = append(sessions, &models.Session{
+			ID:        token.FamilyID,
+			UserAgent: token.UserAgent,
+			IP:        token.IP,
+			Created:   created,
+			LastUsed:  token.Created,
+			ExpiresAt: token.ExpiresAt,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sessions, nil
+}
+
+// FindSessionToken returns active refresh token of the user's session.
+// Returns storage.ErrTokenNotFound if the session doesn't exist, belongs
+// to another user or is already finished.
+func (s *SQLiteStorage) FindSessionToken(ctx context.Context, userID, sessionID string) (*models.RefreshToken, error) {
+	const op = "storage.sqlite.FindSessionToken"
+
+	row := s.db.QueryRowContext(ctx,
+		"SELECT "+tokenColumns+" FROM tokens WHERE "+activeTokensWhere+" AND family_id = ?",
+		userID, now(), sessionID,
+	)
+	refToken, err := scanToken(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrTokenNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return refToken, nil
+}
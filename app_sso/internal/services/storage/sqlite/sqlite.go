@@ -1,245 +1,104 @@
 package sqlite
 
-// import (
-// 	"context"
-// 	"database/sql"
-// 	"errors"
-// 	"fmt"
-// 	"time"
-
-// 	"github.com/DimTur/lp_auth/internal/domain/models"
-// 	"github.com/DimTur/lp_auth/internal/services/storage"
-// 	"github.com/mattn/go-sqlite3"
-// )
-
-// type SQLLiteStorage struct {
-// 	db *sql.DB
-// }
-
-// // New creates a new instance of the SQLite storage
-// func New(storagePath string) (SQLLiteStorage, error) {
-// 	const op = "storage.sqlite.New"
-
-// 	db, err := sql.Open("sqlite3", storagePath)
-// 	if err != nil {
-// 		return SQLLiteStorage{}, fmt.Errorf("%s: %w", op, err)
-// 	}
-
-// 	return SQLLiteStorage{db: db}, nil
-// }
-
-// func (s *SQLLiteStorage) Close() error {
-// 	return s.db.Close()
-// }
-
-// // SaveUser saves user to db.
-// func (s *SQLLiteStorage) SaveUser(ctx context.Context, email string, passHash []byte) (int64, error) {
-// 	const op = "storage.sqlite.SaveUser"
-
-// 	stmt, err := s.db.Prepare("INSERT INTO auth_users(email, pass_hash) VALUES(?, ?)")
-// 	if err != nil {
-// 		return 0, fmt.Errorf("%s: %w", op, err)
-// 	}
-// 	defer stmt.Close()
-
-// 	res, err := stmt.ExecContext(ctx, email, passHash)
-// 	if err != nil {
-// 		var sqliteErr sqlite3.Error
-// 		if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique {
-// 			return 0, fmt.Errorf("%s: %w", op, storage.ErrUserExitsts)
-// 		}
-
-// 		return 0, fmt.Errorf("%s: %w", op, err)
-// 	}
-
-// 	userID, err := res.LastInsertId()
-// 	if err != nil {
-// 		return 0, fmt.Errorf("%s: failed to get last insert id: %w", op, err)
-// 	}
-
-// 	return userID, nil
-// }
-
-// // FindUserByEmail returns user by email.
-// func (s *SQLLiteStorage) FindUserByEmail(ctx context.Context, email string) (models.User, error) {
-// 	const op = "storage.sqlite.FindUserByEmail"
-
-// 	stmt, err := s.db.Prepare("SELECT id, email, pass_hash FROM auth_users WHERE email = ?")
-// 	if err != nil {
-// 		return models.User{}, fmt.Errorf("%s: %w", op, err)
-// 	}
-// 	defer stmt.Close()
-
-// 	row := stmt.QueryRowContext(ctx, email)
-
-// 	var user models.User
-// 	err = row.Scan(&user.ID, &user.Email, &user.PassHash)
-// 	if err != nil {
-// 		if errors.Is(err, sql.ErrNoRows) {
-// 			return models.User{}, fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
-// 		}
-
-// 		return models.User{}, fmt.Errorf("%s: %w", op, err)
-// 	}
-
-// 	return user, nil
-// }
-
-// // IsAdmin checks if user is admin.
-// func (s *SQLLiteStorage) IsAdmin(ctx context.Context, userID int64) (bool, error) {
-// 	const op = "storage.sqlite.IsAdmin"
-
-// 	stmt, err := s.db.Prepare("SELECT is_admin FROM auth_users WHERE id = ?")
-// 	if err != nil {
-// 		return false, fmt.Errorf("%s: %w", op, err)
-// 	}
-// 	defer stmt.Close()
-
-// 	row := stmt.QueryRowContext(ctx, userID)
-
-// 	var isAdmin bool
-// 	err = row.Scan(&isAdmin)
-// 	if err != nil {
-// 		if errors.Is(err, sql.ErrNoRows) {
-// 			return false, fmt.Errorf("%s: %w", op, storage.ErrAppNotFound)
-// 		}
-
-// 		return false, fmt.Errorf("%s: %w", op, err)
-// 	}
-
-// 	return isAdmin, nil
-// }
-
-// // FindAppByID returns user by id.
-// func (s *SQLLiteStorage) FindAppByID(ctx context.Context, appID int64) (models.App, error) {
-// 	const op = "storage.sqlite.App"
-
-// 	stmt, err := s.db.Prepare("SELECT id, name, secret FROM auth_apps WHERE id = ?")
-// 	if err != nil {
-// 		return models.App{}, fmt.Errorf("%s: %w", op, err)
-// 	}
-// 	defer stmt.Close()
-
-// 	row := stmt.QueryRowContext(ctx, appID)
-
-// 	var app models.App
-// 	err = row.Scan(&app.ID, &app.Name, &app.Secret)
-// 	if err != nil {
-// 		if errors.Is(err, sql.ErrNoRows) {
-// 			return models.App{}, fmt.Errorf("%s: %w", op, storage.ErrAppNotFound)
-// 		}
-
-// 		return models.App{}, fmt.Errorf("%s: %w", op, err)
-// 	}
-
-// 	return app, nil
-// }
-
-// // AddApp saves app to db.
-// //
-// // TODO: deprecated
-// func (s *SQLLiteStorage) AddApp(ctx context.Context, name string, secret string) (int64, error) {
-// 	const op = "storage.sqlite.AddApp"
-
-// 	stmt, err := s.db.Prepare("INSERT INTO auth_apps(name, secret) VALUES(?, ?)")
-// 	if err != nil {
-// 		return 0, fmt.Errorf("%s: %w", op, err)
-// 	}
-// 	defer stmt.Close()
-
-// 	res, err := stmt.ExecContext(ctx, name, secret)
-// 	if err != nil {
-// 		var sqliteErr sqlite3.Error
-// 		if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique {
-// 			return 0, fmt.Errorf("%s: %w", op, storage.ErrAppExists)
-// 		}
-
-// 		return 0, fmt.Errorf("%s: %w", op, err)
-// 	}
-
-// 	appID, err := res.LastInsertId()
-// 	if err != nil {
-// 		return 0, fmt.Errorf("%s: failed to get last insert id: %w", op, err)
-// 	}
-
-// 	return appID, nil
-// }
-
-// // SaveRefreshToken saves refresh_token to db.
-// func (s *SQLLiteStorage) SaveRefreshToken(ctx context.Context, userID int64, token string, expiresAt time.Time) error {
-// 	const op = "storage.sqlite.SaveRefreshToken"
-
-// 	stmt, err := s.db.PrepareContext(ctx, `INSERT INTO auth_refresh_tokens(user_id, token, expires_at) VALUES(?,?,?)`)
-// 	if err != nil {
-// 		return fmt.Errorf("%s: %w", op, err)
-// 	}
-// 	defer stmt.Close()
-
-// 	if _, err := stmt.ExecContext(ctx, userID, token, expiresAt); err != nil {
-// 		var sqliteErr sqlite3.Error
-// 		if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique {
-// 			return fmt.Errorf("%s: %w", op, storage.ErrTokenExists)
-// 		}
-
-// 		return fmt.Errorf("%s: %w", op, err)
-// 	}
-
-// 	return nil
-// }
-
-// // DeleteRefreshToken deletes refresh_token from db.
-// func (s *SQLLiteStorage) DeleteRefreshToken(ctx context.Context, token string) error {
-// 	const op = "storage.sqlite.DeleteRefreshToken"
-
-// 	stmt, err := s.db.PrepareContext(ctx, `DELETE FROM auth_refresh_tokens WHERE token = ?`)
-// 	if err != nil {
-// 		return fmt.Errorf("%s: %w", op, err)
-// 	}
-// 	defer stmt.Close()
-
-// 	res, err := stmt.ExecContext(ctx, token)
-// 	if err != nil {
-// 		return fmt.Errorf("%s: %w", op, err)
-// 	}
-
-// 	rowsAffected, err := res.RowsAffected()
-// 	if err != nil {
-// 		return fmt.Errorf("%s: %w", op, err)
-// 	}
-
-// 	if rowsAffected == 0 {
-// 		return fmt.Errorf("%s: %w", op, storage.ErrTokenNotFound)
-// 	}
-
-// 	return nil
-// }
-
-// // FindRefreshToken finds refresh_token in db.
-// func (s *SQLLiteStorage) FindRefreshToken(ctx context.Context, userID int64) (models.RefreshToken, error) {
-// 	const op = "storage.sqlite.FindRefreshToken"
-
-// 	stmt, err := s.db.PrepareContext(ctx, `SELECT token FROM auth_refresh_tokens WHERE user_id = ?`)
-// 	if err != nil {
-// 		return models.RefreshToken{}, fmt.Errorf("%s: %w", op, err)
-// 	}
-
-// 	var tokenFromDB string
-
-// 	err = stmt.QueryRowContext(ctx, userID).Scan(&tokenFromDB)
-// 	if err != nil {
-// 		var sqliteErr sqlite3.Error
-// 		if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sql.ErrNoRows {
-// 			return models.RefreshToken{
-// 				Token:  "",
-// 				UserID: userID,
-// 			}, fmt.Errorf("%s: %w", op, storage.ErrTokenNotFound)
-// 		}
-
-// 		return models.RefreshToken{}, fmt.Errorf("%s: %w", op, err)
-// 	}
-
-// 	return models.RefreshToken{
-// 		Token:  tokenFromDB,
-// 		UserID: userID,
-// 	}, nil
-// }
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+var (
+	ErrOpenSQLite  = errors.New("error opening sqlite db")
+	ErrCloseSQLite = errors.New("smth went wrong with close db conn")
+)
+
+// SQLiteStorage is storage for local development and tests,
+// it's used instead of MongoDB with storage_type: sqlite.
+// Schema is created by migrations/sqlite.
+type SQLiteStorage struct {
+	db *sql.DB
+}
+
+func New(ctx context.Context, storagePath string) (*SQLiteStorage, error) {
+	const op = "storage.sqlite.New"
+
+	// Transactions take the write lock when they begin, so transactions which read
+	// before writing wait for each other instead of failing with "database is locked"
+	db, err := sql.Open("sqlite3", storagePath+"?_foreign_keys=on&_busy_timeout=5000&_txlock=immediate")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, ErrOpenSQLite)
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("%s: %w", op, ErrOpenSQLite)
+	}
+
+	return &SQLiteStorage{db: db}, nil
+}
+
+func (s *SQLiteStorage) Close(_ context.Context) error {
+	const op = "storage.sqlite.Close"
+
+	if err := s.db.Close(); err != nil {
+		return fmt.Errorf("%s: %w", op, ErrCloseSQLite)
+	}
+	return nil
+}
+
+// isUniqueViolation reports whether err is unique or primary key constraint error.
+func isUniqueViolation(err error) bool {
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+
+	return sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique ||
+		sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey
+}
+
+// nullString stores empty strings as NULL, so unique columns
+// behave like sparse indexes in MongoDB.
+func nullString(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// now is stored in UTC, so stored times are compared as strings.
+func now() time.Time {
+	return time.Now().UTC()
+}
+
+// placeholders returns "?, ?, ?" for n arguments.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func stringArgs(values []string) []any {
+	args := make([]any, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+	return args
+}
+
+// withTx runs fn in transaction and commits it if fn succeeds.
+func (s *SQLiteStorage) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,70 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+func (s *SQLiteStorage) FindUserByChatID(ctx context.Context, chatID string) (*models.User, error) {
+	const op = "storage.sqlite.FindUserByChatID"
+
+	user, err := s.findUser(ctx, "chat_id", chatID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// SetUserChatID links telegram chat to the user. The chat is unlinked
+// from other users, so OTPs of one account are sent only to its own chat.
+func (s *SQLiteStorage) SetUserChatID(ctx context.Context, userID, chatID string) error {
+	const op = "storage.sqlite.SetUserChatID"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		updated := now()
+
+		res, err := tx.ExecContext(ctx,
+			"UPDATE users SET chat_id = ?, updated = ? WHERE id = ?",
+			chatID, updated, userID,
+		)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return storage.ErrUserNotFound
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"UPDATE users SET chat_id = '', updated = ? WHERE chat_id = ? AND id != ?",
+			updated, chatID, userID,
+		)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) UnsetUserChatID(ctx context.Context, userID string) error {
+	const op = "storage.sqlite.UnsetUserChatID"
+
+	res, err := s.db.ExecContext(ctx,
+		"UPDATE users SET chat_id = '', updated = ? WHERE id = ?",
+		now(), userID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+func TestSetUserChatID(t *testing.T) {
+	db := newTestStorage(t)
+	ctx := context.Background()
+
+	var userIDs []string
+	for _, email := range []string{"first@example.com", "second@example.com"} {
+		user := &models.DBCreateUser{Email: email, Status: models.UserStatusActive, Created: now(), Updated: now()}
+		if err := db.SaveUser(ctx, user); err != nil {
+			t.Fatalf("SaveUser() error = %v", err)
+		}
+		userIDs = append(userIDs, user.ID)
+	}
+	first, second := userIDs[0], userIDs[1]
+
+	if err := db.SetUserChatID(ctx, first, "chat"); err != nil {
+		t.Fatalf("SetUserChatID() error = %v", err)
+	}
+	// The chat is linked to other account by its owner
+	if err := db.SetUserChatID(ctx, second, "chat"); err != nil {
+		t.Fatalf("SetUserChatID() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		userID string
+		want   string
+	}{
+		{name: "previous account", userID: first, want: ""},
+		{name: "new account", userID: second, want: "chat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatID, err := db.GetExistChatID(ctx, tt.userID)
+			if err != nil {
+				t.Fatalf("GetExistChatID() error = %v", err)
+			}
+			if chatID != tt.want {
+				t.Errorf("GetExistChatID() = %q, want %q", chatID, tt.want)
+			}
+		})
+	}
+
+	user, err := db.FindUserByChatID(ctx, "chat")
+	if err != nil {
+		t.Fatalf("FindUserByChatID() error = %v", err)
+	}
+	if user.ID != second {
+		t.Errorf("FindUserByChatID() = %s, want %s", user.ID, second)
+	}
+
+	if err := db.SetUserChatID(ctx, "unknown", "other chat"); !errors.Is(err, storage.ErrUserNotFound) {
+		t.Errorf("SetUserChatID() of unknown user error = %v, want %v", err, storage.ErrUserNotFound)
+	}
+}
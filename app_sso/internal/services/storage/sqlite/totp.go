@@ -0,0 +1,136 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+// SaveTOTPSecret saves not yet enabled TOTP secret of the user.
+func (s *SQLiteStorage) SaveTOTPSecret(ctx context.Context, userID, secret string) error {
+	const op = "storage.sqlite.SaveTOTPSecret"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			"UPDATE users SET totp_secret = ?, totp_enabled = 0, totp_last_counter = 0, updated = ? WHERE id = ?",
+			secret, now(), userID,
+		)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return storage.ErrUserNotFound
+		}
+
+		_, err = tx.ExecContext(ctx, "DELETE FROM user_recovery_codes WHERE user_id = ?", userID)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) EnableTOTP(ctx context.Context, userID string, recoveryCodes [][]byte) error {
+	const op = "storage.sqlite.EnableTOTP"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			"UPDATE users SET totp_enabled = 1, updated = ? WHERE id = ?",
+			now(), userID,
+		)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return storage.ErrUserNotFound
+		}
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM user_recovery_codes WHERE user_id = ?", userID); err != nil {
+			return err
+		}
+		for _, code := range recoveryCodes {
+			_, err := tx.ExecContext(ctx,
+				"INSERT INTO user_recovery_codes (user_id, code_hash) VALUES (?, ?)",
+				userID, code,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *SQLiteStorage) DisableTOTP(ctx context.Context, userID string) error {
+	const op = "storage.sqlite.DisableTOTP"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			"UPDATE users SET totp_secret = '', totp_enabled = 0, totp_last_counter = 0, updated = ? WHERE id = ?",
+			now(), userID,
+		)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return storage.ErrUserNotFound
+		}
+
+		_, err = tx.ExecContext(ctx, "DELETE FROM user_recovery_codes WHERE user_id = ?", userID)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteRecoveryCode removes used recovery code of the user.
+// Returns storage.ErrInvalidCredentials if the code was already used.
+func (s *SQLiteStorage) DeleteRecoveryCode(ctx context.Context, userID string, codeHash []byte) error {
+	const op = "storage.sqlite.DeleteRecoveryCode"
+
+	res, err := s.db.ExecContext(ctx,
+		"DELETE FROM user_recovery_codes WHERE user_id = ? AND code_hash = ?",
+		userID, codeHash,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+	}
+
+	return nil
+}
+
+// UseTOTPCounter saves time step of the accepted TOTP code of the user.
+// Returns storage.ErrInvalidCredentials if the same or a later step was
+// already used.
+func (s *SQLiteStorage) UseTOTPCounter(ctx context.Context, userID string, counter int64) error {
+	const op = "storage.sqlite.UseTOTPCounter"
+
+	res, err := s.db.ExecContext(ctx,
+		"UPDATE users SET totp_last_counter = ? WHERE id = ? AND totp_last_counter < ?",
+		counter, userID, counter,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrInvalidCredentials)
+	}
+
+	return nil
+}
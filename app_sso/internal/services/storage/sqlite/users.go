@@ -0,0 +1,228 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ListUsers returns users ordered by ID. IDs are hex of ObjectID,
+// so the order is the registration order and ID works as a cursor.
+func (s *SQLiteStorage) ListUsers(ctx context.Context, usersFilter *models.UsersFilter) ([]*models.User, error) {
+	const op = "storage.sqlite.ListUsers"
+
+	var (
+		where []string
+		args  []any
+	)
+	if usersFilter.Query != "" {
+		// LIKE is case-insensitive for ASCII only
+		pattern := "%" + likeEscaper.Replace(usersFilter.Query) + "%"
+		where = append(where, `(email LIKE ? ESCAPE '\' OR name LIKE ? ESCAPE '\' OR tg_link LIKE ? ESCAPE '\')`)
+		args = append(args, pattern, pattern, pattern)
+	}
+	if usersFilter.Status != "" {
+		where = append(where, "status = ?")
+		args = append(args, usersFilter.Status)
+	}
+	if usersFilter.AfterID != "" {
+		where = append(where, "id > ?")
+		args = append(args, usersFilter.AfterID)
+	}
+
+	query := "SELECT " + userColumns + " FROM users"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY id"
+	if usersFilter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, usersFilter.Limit)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var users []*models.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		user.PassHash = nil
+		user.TOTP = models.UserTOTP{}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return users, nil
+}
+
+func (s *SQLiteStorage) SetUserAdmin(ctx context.Context, userID string, isAdmin bool) error {
+	const op = "storage.sqlite.SetUserAdmin"
+
+	res, err := s.db.ExecContext(ctx,
+		"UPDATE users SET is_admin = ?, updated = ? WHERE id = ?",
+		isAdmin, now(), userID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+// DeactivateUser sets deactivated status and keeps the current one
+// in prev_status, so ReactivateUser can restore it. Status of the user
+// who is already deactivated isn't overwritten.
+func (s *SQLiteStorage) DeactivateUser(ctx context.Context, userID string) error {
+	const op = "storage.sqlite.DeactivateUser"
+
+	res, err := s.db.ExecContext(ctx, `
+		UPDATE users
+		SET prev_status = CASE WHEN status = ? THEN prev_status ELSE status END,
+			status = ?, updated = ?
+		WHERE id = ?`,
+		models.UserStatusDeactivated, models.UserStatusDeactivated, now(), userID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	}
+
+	return nil
+}
+
+// ReactivateUser restores status the user had before deactivation
+// and returns it. Users deactivated before prev_status was kept become active.
+func (s *SQLiteStorage) ReactivateUser(ctx context.Context, userID string) (string, error) {
+	const op = "storage.sqlite.ReactivateUser"
+
+	var status string
+	err := s.db.QueryRowContext(ctx, `
+		UPDATE users
+		SET status = CASE WHEN prev_status = '' THEN ? ELSE prev_status END,
+			prev_status = '', updated = ?
+		WHERE id = ? AND status = ?
+		RETURNING status`,
+		models.UserStatusActive, now(), userID, models.UserStatusDeactivated,
+	).Scan(&status)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", op, err)
+		}
+
+		var exists bool
+		err := s.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM users WHERE id = ?)", userID).Scan(&exists)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", op, err)
+		}
+		if !exists {
+			return "", fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+		}
+		return "", fmt.Errorf("%s: %w", op, storage.ErrUserNotDeactivated)
+	}
+
+	return status, nil
+}
+
+// FindUsersByEmails returns users with given emails. Emails without users
+// are skipped, so the result can be shorter than emails.
+func (s *SQLiteStorage) FindUsersByEmails(ctx context.Context, emails []string) ([]*models.User, error) {
+	const op = "storage.sqlite.FindUsersByEmails"
+
+	if len(emails) == 0 {
+		return nil, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT "+userColumns+" FROM users WHERE email IN ("+placeholders(len(emails))+")",
+		stringArgs(emails)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var users []*models.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		user.PassHash = nil
+		user.TOTP = models.UserTOTP{}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return users, nil
+}
+
+// SaveUsers inserts users in one transaction and sets their IDs.
+//
+// Users which break unique email or tg_link aren't saved, their indexes
+// in users are returned. Other users of the batch are saved anyway.
+func (s *SQLiteStorage) SaveUsers(ctx context.Context, users []*models.DBCreateUser) ([]int, error) {
+	const op = "storage.sqlite.SaveUsers"
+
+	var duplicates []int
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		stmt, err := tx.PrepareContext(ctx, `
+			INSERT INTO users (id, email, pass_hash, name, tg_link, is_admin, status, created, updated)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for i, user := range users {
+			user.ID = primitive.NewObjectID().Hex()
+			_, err := stmt.ExecContext(ctx,
+				user.ID,
+				user.Email,
+				user.PassHash,
+				user.Name,
+				nullString(user.TgLink),
+				user.IsAdmin,
+				user.Status,
+				user.Created.UTC(),
+				user.Updated.UTC(),
+			)
+			if err != nil {
+				if isUniqueViolation(err) {
+					duplicates = append(duplicates, i)
+					continue
+				}
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return duplicates, nil
+}
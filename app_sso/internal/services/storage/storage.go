@@ -7,13 +7,19 @@ import (
 )
 
 var (
-	ErrUserExitsts      = errors.New("user already exists")
-	ErrUserNotFound     = errors.New("user not found")
-	ErrUserIdConversion = errors.New("user conversion failed")
+	ErrUserExitsts        = errors.New("user already exists")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrUserIdConversion   = errors.New("user conversion failed")
+	ErrUserNotDeactivated = errors.New("user is not deactivated")
 
 	ErrAppNotFound = errors.New("app not found")
 	ErrAppExists   = errors.New("app already exists")
 
+	ErrRelyingPartyNotFound = errors.New("relying party not found")
+	ErrRelyingPartyExists   = errors.New("relying party already exists")
+	ErrAuthCodeNotFound     = errors.New("authorization code not found")
+	ErrTgLinkTokenNotFound  = errors.New("telegram link token not found")
+
 	ErrTokenExists   = errors.New("refresh token already exists")
 	ErrTokenNotFound = errors.New("refresh token not found")
 	ErrTokenExpired  = errors.New("token is already expired")
@@ -25,8 +31,18 @@ var (
 
 	ErrNoTokensFound = errors.New("no tokens found for user")
 
-	ErrLgNotFound = errors.New("learning group not found")
-	ErrLgExitsts  = errors.New("learning group already exists")
+	ErrLgNotFound        = errors.New("learning group not found")
+	ErrLgExitsts         = errors.New("learning group already exists")
+	ErrLgVersionConflict = errors.New("learning group version conflict")
+	ErrLgCycle           = errors.New("learning group is ancestor of its parent")
+	ErrLgLastAdmin       = errors.New("learning group must keep a group admin")
+
+	ErrLgInviteNotFound = errors.New("learning group invite not found")
+
+	ErrLgRoleNotFound = errors.New("learning group role not found")
+	ErrLgRoleExists   = errors.New("learning group role already exists")
+
+	ErrGDPRJobNotFound = errors.New("gdpr job not found")
 
 	ErrObjectID = errors.New("invalid ObjectID format")
 
@@ -0,0 +1,82 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+)
+
+// ImpersonateUser issues short-lived access token of the user for the admin,
+// so the admin sees the platform as the user does. The admin is kept in the
+// "act" claim of the token. Admins and deactivated users can't be impersonated.
+// Every session is written to the audit log.
+func (uh *UserHandlers) ImpersonateUser(ctx context.Context, imp *models.ImpersonateUser) (*models.ImpersonationToken, error) {
+	const op = "users.ImpersonateUser"
+
+	log := uh.log.With(
+		slog.String("op", op),
+		slog.String("admin_id", imp.AdminID),
+		slog.String("user_id", imp.UserID),
+	)
+
+	// Validation
+	err := uh.validator.Struct(imp)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	if imp.AdminID == imp.UserID {
+		log.Warn("self impersonation")
+		return nil, fmt.Errorf("%s: %w", op, ErrCantImpersonate)
+	}
+
+	if err := uh.checkAdmin(ctx, imp.AdminID); err != nil {
+		log.Warn("failed to check admin", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	user, err := uh.findUser(ctx, imp.UserID)
+	if err != nil {
+		log.Warn("failed to get user", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if user.IsAdmin || user.Status == models.UserStatusDeactivated {
+		log.Warn("user can't be impersonated",
+			slog.Bool("is_admin", user.IsAdmin),
+			slog.String("status", user.Status),
+		)
+		return nil, fmt.Errorf("%s: %w", op, ErrCantImpersonate)
+	}
+
+	log.Info("impersonating user")
+
+	expiresAt := time.Now().Add(impersonationExpiresIn)
+	token, jti, err := uh.impIssuer.IssueImpersonationToken(user.ID, imp.AdminID, impersonationExpiresIn)
+	if err != nil {
+		log.Error("failed to issue impersonation token", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("impersonation session started", slog.String("jti", jti))
+
+	details := fmt.Sprintf("expires_at=%s", expiresAt.UTC().Format(time.RFC3339))
+	if reason := strings.TrimSpace(imp.Reason); reason != "" {
+		details += fmt.Sprintf(" reason=%q", reason)
+	}
+	uh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:     models.AuditEventImpersonation,
+		UserID:   user.ID,
+		ActorID:  imp.AdminID,
+		TargetID: jti,
+		Details:  details,
+	})
+
+	return &models.ImpersonationToken{
+		AccessToken: token,
+		ExpiresAt:   expiresAt,
+	}, nil
+}
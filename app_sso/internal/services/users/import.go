@@ -0,0 +1,311 @@
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	learninggroup "github.com/DimTur/lp_auth/internal/services/learning_group"
+	"github.com/DimTur/lp_auth/internal/services/rabbitmq"
+	"github.com/go-playground/validator/v10"
+)
+
+// ImportUsers creates users from rows of the file uploaded by platform admin
+// and returns report for every row.
+//
+// Rows are validated separately, invalid rows and rows with emails
+// of existing users are skipped. Created users have no password,
+// they get invite token to set it and activate the account.
+// Existing users who haven't set the password yet get the new invite,
+// so the invite which wasn't sent or expired can be recovered.
+// If import fails in the middle, created users stay, so the same file
+// can be imported again.
+func (uh *UserHandlers) ImportUsers(ctx context.Context, imp *models.ImportUsers) (*models.ImportReport, error) {
+	const op = "users.ImportUsers"
+
+	log := uh.log.With(
+		slog.String("op", op),
+		slog.String("admin_id", imp.AdminID),
+		slog.Int("rows", len(imp.Rows)),
+	)
+
+	// Validation
+	err := uh.validator.Struct(imp)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	if len(imp.Rows) > maxImportRows {
+		log.Warn("too many rows")
+		return nil, fmt.Errorf("%s: %w", op, ErrTooManyImportRows)
+	}
+
+	if err := uh.checkAdmin(ctx, imp.AdminID); err != nil {
+		log.Warn("failed to check admin", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("importing users")
+
+	report := &models.ImportReport{
+		Results: make([]*models.ImportRowResult, len(imp.Rows)),
+	}
+
+	// Indexes of valid rows which are unique in the file
+	candidates := make([]int, 0, len(imp.Rows))
+	seen := make(map[string]struct{}, len(imp.Rows))
+	for i, row := range imp.Rows {
+		res := &models.ImportRowResult{
+			Row:   row.Row,
+			Email: row.Email,
+		}
+		report.Results[i] = res
+
+		if err := uh.validator.Struct(row); err != nil {
+			res.Status = models.ImportStatusInvalid
+			res.Error = rowError(err)
+			continue
+		}
+		if _, ok := seen[row.Email]; ok {
+			res.Status = models.ImportStatusSkippedDuplicate
+			res.Error = "duplicate email in file"
+			continue
+		}
+
+		seen[row.Email] = struct{}{}
+		candidates = append(candidates, i)
+	}
+
+	emails := make([]string, 0, len(candidates))
+	for _, i := range candidates {
+		emails = append(emails, imp.Rows[i].Email)
+	}
+
+	existing := make(map[string]*models.User, len(candidates))
+	if len(emails) > 0 {
+		users, err := uh.usrProvider.FindUsersByEmails(ctx, emails)
+		if err != nil {
+			log.Error("failed to find existing users", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		for _, user := range users {
+			existing[user.Email] = user
+		}
+	}
+
+	toCreate := make([]int, 0, len(candidates))
+	for _, i := range candidates {
+		user, ok := existing[imp.Rows[i].Email]
+		if !ok {
+			toCreate = append(toCreate, i)
+			continue
+		}
+
+		res := report.Results[i]
+		res.UserID = user.ID
+		pending, err := uh.invitePending(ctx, user)
+		if err != nil {
+			log.Error("failed to check invite of existing user", slog.String("user_id", user.ID), slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if !pending {
+			res.Status = models.ImportStatusSkippedDuplicate
+			res.Error = "user already exists"
+			continue
+		}
+
+		res.Status = models.ImportStatusReinvited
+		if err := uh.resendInvite(ctx, user); err != nil {
+			log.Error("failed to send invite", slog.String("user_id", user.ID), slog.String("err", err.Error()))
+			res.Error = "invite is not sent"
+		}
+	}
+
+	for start := 0; start < len(toCreate); start += importBatchSize {
+		batch := toCreate[start:min(start+importBatchSize, len(toCreate))]
+		if err := uh.importBatch(ctx, log, imp.Rows, batch, report.Results); err != nil {
+			log.Error("failed to import users batch", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if imp.LearningGroupID != "" || imp.LearningGroupName != "" {
+		if err := uh.addToLearningGroup(ctx, imp, report.Results); err != nil {
+			log.Warn("failed to add users to learning group", slog.String("err", err.Error()))
+			report.LearningGroupError = learningGroupError(err)
+		}
+	}
+
+	log.Info("users imported")
+
+	return report, nil
+}
+
+// importBatch saves rows with given indexes and sends invites to created users.
+// Rows which collide with users saved concurrently are marked as duplicates.
+func (uh *UserHandlers) importBatch(
+	ctx context.Context,
+	log *slog.Logger,
+	rows []*models.ImportUserRow,
+	batch []int,
+	results []*models.ImportRowResult,
+) error {
+	now := time.Now()
+
+	dbUsers := make([]*models.DBCreateUser, 0, len(batch))
+	for _, i := range batch {
+		dbUsers = append(dbUsers, &models.DBCreateUser{
+			Email:   rows[i].Email,
+			Name:    rows[i].Name,
+			TgLink:  rows[i].TgLink,
+			IsAdmin: false,
+			Status:  models.UserStatusPendingVerification,
+			Created: now,
+			Updated: now,
+		})
+	}
+
+	duplicates, err := uh.usrSaver.SaveUsers(ctx, dbUsers)
+	if err != nil {
+		return err
+	}
+
+	skipped := make(map[int]struct{}, len(duplicates))
+	for _, j := range duplicates {
+		skipped[j] = struct{}{}
+	}
+
+	for j, i := range batch {
+		res := results[i]
+		if _, ok := skipped[j]; ok {
+			res.Status = models.ImportStatusSkippedDuplicate
+			res.Error = "email or tg_link is already used"
+			continue
+		}
+
+		res.Status = models.ImportStatusCreated
+		res.UserID = dbUsers[j].ID
+
+		// User is created anyway, admin sees in report that invite isn't sent
+		if err := uh.sendInvite(ctx, dbUsers[j]); err != nil {
+			log.Error("failed to send invite", slog.String("user_id", res.UserID), slog.String("err", err.Error()))
+			res.Error = "invite is not sent"
+		}
+	}
+
+	return nil
+}
+
+// sendInvite issues invite token and sends it to notification service.
+func (uh *UserHandlers) sendInvite(ctx context.Context, user *models.DBCreateUser) error {
+	token, err := uh.inviteIssuer.IssueInviteToken(user.ID, user.Email, inviteExpiresIn)
+	if err != nil {
+		return fmt.Errorf("issue invite token: %w", err)
+	}
+
+	msgBody, err := json.Marshal(&rabbitmq.MsgUserInvite{
+		UserID: user.ID,
+		Email:  user.Email,
+		Name:   user.Name,
+		TgLink: user.TgLink,
+		Token:  token,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal invite: %w", err)
+	}
+
+	if err := uh.rabbitMQQueues.Publish(ctx, exchangeShare, userInviteRoutingKey, msgBody); err != nil {
+		return fmt.Errorf("publish invite: %w", err)
+	}
+
+	return nil
+}
+
+// resendInvite sends the new invite to the existing user.
+// Previous invite tokens stay valid until they expire.
+func (uh *UserHandlers) resendInvite(ctx context.Context, user *models.User) error {
+	return uh.sendInvite(ctx, &models.DBCreateUser{
+		ID:     user.ID,
+		Email:  user.Email,
+		Name:   user.Name,
+		TgLink: user.TgLink,
+	})
+}
+
+// invitePending reports whether the user was invited by admin
+// and hasn't set the password yet. Users found by email come without
+// password hash, so pending users are read again.
+func (uh *UserHandlers) invitePending(ctx context.Context, user *models.User) (bool, error) {
+	if user.Status != models.UserStatusPendingVerification {
+		return false, nil
+	}
+
+	user, err := uh.usrProvider.FindUserByID(ctx, user.ID)
+	if err != nil {
+		return false, err
+	}
+
+	return len(user.PassHash) == 0, nil
+}
+
+// addToLearningGroup adds created and already existing users of the import
+// as learners to the existing learning group or creates the new one.
+// Admin becomes group admin of the new group.
+func (uh *UserHandlers) addToLearningGroup(ctx context.Context, imp *models.ImportUsers, results []*models.ImportRowResult) error {
+	learners := make([]string, 0, len(results))
+	for _, res := range results {
+		if res.UserID != "" {
+			learners = append(learners, res.UserID)
+		}
+	}
+	if len(learners) == 0 {
+		return nil
+	}
+
+	if imp.LearningGroupID != "" {
+		return uh.groupManager.UpdateLearningGroup(ctx, &models.UpdateLearningGroup{
+			UserID:     imp.AdminID,
+			LgId:       imp.LearningGroupID,
+			ModifiedBy: imp.AdminID,
+			Learners:   learners,
+		})
+	}
+
+	return uh.groupManager.CreateLearningGroup(ctx, &models.CreateLearningGroup{
+		Name:        imp.LearningGroupName,
+		CreatedBy:   imp.AdminID,
+		ModifiedBy:  imp.AdminID,
+		GroupAdmins: []string{imp.AdminID},
+		Learners:    learners,
+	})
+}
+
+// rowError describes the first invalid field of the row.
+func rowError(err error) string {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) && len(validationErrs) > 0 {
+		return "invalid " + strings.ToLower(validationErrs[0].Field())
+	}
+
+	return "invalid row"
+}
+
+func learningGroupError(err error) string {
+	switch {
+	case errors.Is(err, learninggroup.ErrPermissionDenied):
+		return "you aren't group admin of the learning group"
+	case errors.Is(err, learninggroup.ErrGroupNotFound):
+		return "learning group not found"
+	case errors.Is(err, learninggroup.ErrGroupExists):
+		return "learning group already exists"
+	case errors.Is(err, learninggroup.ErrInvalidCredentials):
+		return "invalid learning group"
+	}
+
+	return "users weren't added to learning group"
+}
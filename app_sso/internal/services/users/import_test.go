@@ -0,0 +1,70 @@
+package users
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+)
+
+func TestImportUsersReinvite(t *testing.T) {
+	uh, db, publisher := newScimTestHandlers(t)
+	ctx := context.Background()
+
+	admin := saveTestUser(t, db, "admin@example.com", models.UserStatusActive)
+	if err := db.SetUserAdmin(ctx, admin, true); err != nil {
+		t.Fatalf("SetUserAdmin() error = %v", err)
+	}
+	active := saveTestUser(t, db, "active@example.com", models.UserStatusActive)
+	// Registered, but hasn't verified the email, so the password is set
+	unverified := saveTestUser(t, db, "unverified@example.com", models.UserStatusPendingVerification)
+
+	invited := &models.DBCreateUser{
+		Email:   "invited@example.com",
+		Status:  models.UserStatusPendingVerification,
+		Created: time.Now(),
+		Updated: time.Now(),
+	}
+	if err := db.SaveUser(ctx, invited); err != nil {
+		t.Fatalf("SaveUser() error = %v", err)
+	}
+
+	report, err := uh.ImportUsers(ctx, &models.ImportUsers{
+		AdminID: admin,
+		Rows: []*models.ImportUserRow{
+			{Row: 1, Email: "new@example.com"},
+			{Row: 2, Email: "invited@example.com"},
+			{Row: 3, Email: "active@example.com"},
+			{Row: 4, Email: "unverified@example.com"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ImportUsers() error = %v", err)
+	}
+
+	want := []struct {
+		status string
+		userID string
+	}{
+		{status: models.ImportStatusCreated},
+		{status: models.ImportStatusReinvited, userID: invited.ID},
+		{status: models.ImportStatusSkippedDuplicate, userID: active},
+		{status: models.ImportStatusSkippedDuplicate, userID: unverified},
+	}
+	for i, res := range report.Results {
+		if res.Status != want[i].status {
+			t.Errorf("row %d status = %s, want %s", res.Row, res.Status, want[i].status)
+		}
+		if want[i].userID != "" && res.UserID != want[i].userID {
+			t.Errorf("row %d user id = %s, want %s", res.Row, res.UserID, want[i].userID)
+		}
+		if res.Error != "" && res.Status != models.ImportStatusSkippedDuplicate {
+			t.Errorf("row %d error = %s", res.Row, res.Error)
+		}
+	}
+
+	if sent := slicesCount(publisher.routingKeys, userInviteRoutingKey); sent != 2 {
+		t.Errorf("invites sent = %d, want 2", sent)
+	}
+}
@@ -0,0 +1,359 @@
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/rabbitmq"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+)
+
+// ScimListUsers returns page of users for SCIM client.
+// Users are ordered by registration, StartIndex is one-based.
+func (uh *UserHandlers) ScimListUsers(ctx context.Context, list *models.ScimListUsers) (*models.ScimUsersPage, error) {
+	const op = "users.ScimListUsers"
+
+	log := uh.log.With(
+		slog.String("op", op),
+		slog.String("client_id", list.ClientID),
+	)
+
+	// Validation
+	err := uh.validator.Struct(list)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	filter := &models.ScimUsersFilter{
+		Email:  list.Email,
+		Offset: max(list.StartIndex-1, 0),
+		Limit:  scimPageLimit(list.Count),
+	}
+	if list.Active != "" {
+		deactivated := list.Active == "false"
+		filter.Deactivated = &deactivated
+	}
+
+	log.Info("listing users")
+
+	users, total, err := uh.usrProvider.FindUsersPage(ctx, filter)
+	if err != nil {
+		log.Error("failed to list users", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &models.ScimUsersPage{
+		Users: users,
+		Total: total,
+	}, nil
+}
+
+func (uh *UserHandlers) ScimGetUser(ctx context.Context, get *models.ScimManageUser) (*models.User, error) {
+	const op = "users.ScimGetUser"
+
+	log := uh.log.With(
+		slog.String("op", op),
+		slog.String("client_id", get.ClientID),
+		slog.String("user_id", get.UserID),
+	)
+
+	// Validation
+	err := uh.validator.Struct(get)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	user, err := uh.findUser(ctx, get.UserID)
+	if err != nil {
+		log.Warn("failed to get user", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return user, nil
+}
+
+// ScimCreateUser creates the user provisioned by SCIM client.
+// Active user gets invite to set the password like imported users.
+func (uh *UserHandlers) ScimCreateUser(ctx context.Context, create *models.ScimCreateUser) (*models.User, error) {
+	const op = "users.ScimCreateUser"
+
+	log := uh.log.With(
+		slog.String("op", op),
+		slog.String("client_id", create.ClientID),
+	)
+
+	// Validation
+	err := uh.validator.Struct(create)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	status := models.UserStatusPendingVerification
+	if !create.Active {
+		status = models.UserStatusDeactivated
+	}
+
+	now := time.Now()
+	dbUser := &models.DBCreateUser{
+		Email:   create.Email,
+		Name:    create.Name,
+		IsAdmin: false,
+		Status:  status,
+		Created: now,
+		Updated: now,
+	}
+
+	log.Info("creating user")
+
+	if err := uh.usrSaver.SaveUser(ctx, dbUser); err != nil {
+		if errors.Is(err, storage.ErrUserExitsts) {
+			log.Warn("user already exists", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, ErrUserExists)
+		}
+
+		log.Error("failed to save user", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log = log.With(slog.String("user_id", dbUser.ID))
+
+	// User is created anyway, the invite can be sent again by reactivation
+	if create.Active {
+		if err := uh.sendInvite(ctx, dbUser); err != nil {
+			log.Error("failed to send invite", slog.String("err", err.Error()))
+		}
+	}
+
+	uh.recordProvision(ctx, create.ClientID, dbUser.ID, "created")
+
+	log.Info("user created")
+
+	return &models.User{
+		ID:      dbUser.ID,
+		Email:   dbUser.Email,
+		Name:    dbUser.Name,
+		Status:  dbUser.Status,
+		Created: dbUser.Created,
+		Updated: dbUser.Updated,
+	}, nil
+}
+
+// ScimUpdateUser replaces email and name of the user and changes its
+// active state. Deactivation revokes tokens like DeactivateUser.
+//
+// Changed email must be verified again, active user gets pending
+// verification status and the verification email. Users who haven't
+// accepted the invite get it again instead, on email change and on
+// reactivation. Platform admins can't be changed by SCIM clients.
+func (uh *UserHandlers) ScimUpdateUser(ctx context.Context, upd *models.ScimUpdateUser) (*models.User, error) {
+	const op = "users.ScimUpdateUser"
+
+	log := uh.log.With(
+		slog.String("op", op),
+		slog.String("client_id", upd.ClientID),
+		slog.String("user_id", upd.UserID),
+	)
+
+	// Validation
+	err := uh.validator.Struct(upd)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	user, err := uh.findUser(ctx, upd.UserID)
+	if err != nil {
+		log.Warn("failed to get user", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if user.IsAdmin {
+		log.Warn("admin can't be changed by scim client")
+		return nil, fmt.Errorf("%s: %w", op, ErrAdminNotProvisioned)
+	}
+
+	log.Info("updating user")
+
+	emailChanged := upd.Email != user.Email
+	if emailChanged || (upd.Name != "" && upd.Name != user.Name) {
+		err := uh.usrSaver.UpdateUserInfo(ctx, &models.DBUpdateUserInfo{
+			ID:      user.ID,
+			Email:   upd.Email,
+			Name:    upd.Name,
+			Updated: time.Now(),
+		})
+		if err != nil {
+			if errors.Is(err, storage.ErrUserExitsts) {
+				log.Warn("email is already used", slog.String("err", err.Error()))
+				return nil, fmt.Errorf("%s: %w", op, ErrUserExists)
+			}
+
+			log.Error("failed to update user info", slog.String("err", err.Error()))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		uh.recordProvision(ctx, upd.ClientID, user.ID, "updated")
+	}
+
+	deactivated := user.Status == models.UserStatusDeactivated
+	reactivated := false
+	switch {
+	case upd.Active && deactivated:
+		if _, err := uh.reactivate(ctx, log, user.ID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		reactivated = true
+		uh.recordProvision(ctx, upd.ClientID, user.ID, "reactivated")
+	case !upd.Active && !deactivated:
+		if err := uh.deactivate(ctx, log, user.ID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		uh.recordProvision(ctx, upd.ClientID, user.ID, "deactivated")
+	}
+
+	// Users without password haven't accepted the invite, so the invite
+	// is sent again, it verifies the email as well
+	invitePending := len(user.PassHash) == 0
+	switch {
+	case upd.Active && invitePending && (emailChanged || reactivated):
+		name := upd.Name
+		if name == "" {
+			name = user.Name
+		}
+		if err := uh.reissueInvite(ctx, log, &models.DBCreateUser{
+			ID:     user.ID,
+			Email:  upd.Email,
+			Name:   name,
+			TgLink: user.TgLink,
+		}); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	case upd.Active && emailChanged:
+		if err := uh.requestEmailVerification(ctx, log, user.ID, upd.Email); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	user, err = uh.findUser(ctx, user.ID)
+	if err != nil {
+		log.Error("failed to get updated user", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("user updated")
+
+	return user, nil
+}
+
+// ScimDeleteUser deactivates the user. Accounts aren't deleted by SCIM
+// clients, the data is erased by GDPR erasure only. Platform admins
+// can't be deactivated by SCIM clients.
+func (uh *UserHandlers) ScimDeleteUser(ctx context.Context, del *models.ScimManageUser) error {
+	const op = "users.ScimDeleteUser"
+
+	log := uh.log.With(
+		slog.String("op", op),
+		slog.String("client_id", del.ClientID),
+		slog.String("user_id", del.UserID),
+	)
+
+	// Validation
+	err := uh.validator.Struct(del)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	user, err := uh.findUser(ctx, del.UserID)
+	if err != nil {
+		log.Warn("failed to get user", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if user.IsAdmin {
+		log.Warn("admin can't be changed by scim client")
+		return fmt.Errorf("%s: %w", op, ErrAdminNotProvisioned)
+	}
+	if user.Status == models.UserStatusDeactivated {
+		log.Info("user is already deactivated")
+		return nil
+	}
+
+	if err := uh.deactivate(ctx, log, user.ID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	uh.recordProvision(ctx, del.ClientID, user.ID, "deactivated")
+
+	return nil
+}
+
+// reissueInvite keeps the invited user pending verification, reactivation
+// restores active status of users created inactive, and sends the invite
+// to the current email. Failed sending is only logged like on create.
+func (uh *UserHandlers) reissueInvite(ctx context.Context, log *slog.Logger, user *models.DBCreateUser) error {
+	if err := uh.usrSaver.UpdateUserStatus(ctx, user.ID, models.UserStatusPendingVerification); err != nil {
+		log.Error("failed to reset verification status", slog.String("err", err.Error()))
+		return err
+	}
+
+	if err := uh.sendInvite(ctx, user); err != nil {
+		log.Error("failed to send invite", slog.String("err", err.Error()))
+	}
+
+	return nil
+}
+
+// requestEmailVerification resets the user to pending verification and
+// sends the verification email to the new address. The user is already
+// updated, so failed sending is only logged like the invite on create.
+func (uh *UserHandlers) requestEmailVerification(ctx context.Context, log *slog.Logger, userID, email string) error {
+	if err := uh.usrSaver.UpdateUserStatus(ctx, userID, models.UserStatusPendingVerification); err != nil {
+		log.Error("failed to reset verification status", slog.String("err", err.Error()))
+		return err
+	}
+
+	token, err := uh.verifyIssuer.IssueVerificationToken(userID, email, emailVerificationExpiresIn)
+	if err != nil {
+		log.Error("failed to issue verification token", slog.String("err", err.Error()))
+		return nil
+	}
+
+	msgBody, err := json.Marshal(&rabbitmq.MsgEmailVerification{
+		UserID: userID,
+		Email:  email,
+		Token:  token,
+	})
+	if err != nil {
+		log.Error("failed to marshal verification token", slog.String("err", err.Error()))
+		return nil
+	}
+
+	if err := uh.rabbitMQQueues.Publish(ctx, exchangeShare, emailVerificationRoutingKey, msgBody); err != nil {
+		log.Error("failed to publish verification token", slog.String("err", err.Error()))
+	}
+
+	return nil
+}
+
+func (uh *UserHandlers) recordProvision(ctx context.Context, clientID, userID, action string) {
+	uh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:    models.AuditEventUserProvision,
+		UserID:  userID,
+		ActorID: clientID,
+		Details: action,
+	})
+}
+
+// scimPageLimit returns page size for SCIM count, zero count means default size.
+func scimPageLimit(count int64) int64 {
+	if count == 0 {
+		return defaultUsersLimit
+	}
+	return min(count, maxUsersLimit)
+}
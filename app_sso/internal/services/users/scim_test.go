@@ -0,0 +1,283 @@
+package users
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/rabbitmq"
+	"github.com/DimTur/lp_auth/internal/services/storage/sqlite"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestScimChangesOfAdmin(t *testing.T) {
+	uh, db, _ := newScimTestHandlers(t)
+	ctx := context.Background()
+
+	admin := saveTestUser(t, db, "admin@example.com", models.UserStatusActive)
+	if err := db.SetUserAdmin(ctx, admin, true); err != nil {
+		t.Fatalf("SetUserAdmin() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "update",
+			call: func() error {
+				_, err := uh.ScimUpdateUser(ctx, &models.ScimUpdateUser{ClientID: "idp", UserID: admin, Email: "attacker@example.com", Active: true})
+				return err
+			},
+		},
+		{
+			name: "deactivate by update",
+			call: func() error {
+				_, err := uh.ScimUpdateUser(ctx, &models.ScimUpdateUser{ClientID: "idp", UserID: admin, Email: "admin@example.com", Active: false})
+				return err
+			},
+		},
+		{
+			name: "delete",
+			call: func() error {
+				return uh.ScimDeleteUser(ctx, &models.ScimManageUser{ClientID: "idp", UserID: admin})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, ErrAdminNotProvisioned) {
+				t.Errorf("error = %v, want %v", err, ErrAdminNotProvisioned)
+			}
+		})
+	}
+
+	user, err := db.FindUserByID(ctx, admin)
+	if err != nil {
+		t.Fatalf("FindUserByID() error = %v", err)
+	}
+	if user.Email != "admin@example.com" || user.Status != models.UserStatusActive {
+		t.Errorf("admin is changed: email %s, status %s", user.Email, user.Status)
+	}
+}
+
+func TestScimUpdateUserEmail(t *testing.T) {
+	tests := []struct {
+		name             string
+		email            string
+		active           bool
+		wantStatus       string
+		wantVerification bool
+	}{
+		{name: "email changed", email: "new@example.com", active: true, wantStatus: models.UserStatusPendingVerification, wantVerification: true},
+		{name: "email unchanged", email: "user@example.com", active: true, wantStatus: models.UserStatusActive},
+		{name: "email changed on deactivation", email: "new@example.com", active: false, wantStatus: models.UserStatusDeactivated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uh, db, publisher := newScimTestHandlers(t)
+			ctx := context.Background()
+
+			userID := saveTestUser(t, db, "user@example.com", models.UserStatusActive)
+
+			user, err := uh.ScimUpdateUser(ctx, &models.ScimUpdateUser{ClientID: "idp", UserID: userID, Email: tt.email, Active: tt.active})
+			if err != nil {
+				t.Fatalf("ScimUpdateUser() error = %v", err)
+			}
+			if user.Email != tt.email || user.Status != tt.wantStatus {
+				t.Errorf("user email %s, status %s, want %s, %s", user.Email, user.Status, tt.email, tt.wantStatus)
+			}
+
+			sent := slicesCount(publisher.routingKeys, emailVerificationRoutingKey)
+			if (sent > 0) != tt.wantVerification {
+				t.Errorf("verification emails sent = %d, want sent %v", sent, tt.wantVerification)
+			}
+		})
+	}
+}
+
+func TestScimUpdateUserInvitePending(t *testing.T) {
+	tests := []struct {
+		name          string
+		createdActive bool
+		email         string
+		active        bool
+		wantStatus    string
+		wantInvites   int
+	}{
+		{name: "created inactive is reactivated", createdActive: false, email: "user@example.com", active: true, wantStatus: models.UserStatusPendingVerification, wantInvites: 1},
+		{name: "created inactive stays inactive", createdActive: false, email: "new@example.com", active: false, wantStatus: models.UserStatusDeactivated},
+		{name: "email changed", createdActive: true, email: "new@example.com", active: true, wantStatus: models.UserStatusPendingVerification, wantInvites: 2},
+		{name: "email unchanged", createdActive: true, email: "user@example.com", active: true, wantStatus: models.UserStatusPendingVerification, wantInvites: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uh, _, publisher := newScimTestHandlers(t)
+			ctx := context.Background()
+
+			created, err := uh.ScimCreateUser(ctx, &models.ScimCreateUser{ClientID: "idp", Email: "user@example.com", Name: "Test", Active: tt.createdActive})
+			if err != nil {
+				t.Fatalf("ScimCreateUser() error = %v", err)
+			}
+
+			user, err := uh.ScimUpdateUser(ctx, &models.ScimUpdateUser{ClientID: "idp", UserID: created.ID, Email: tt.email, Active: tt.active})
+			if err != nil {
+				t.Fatalf("ScimUpdateUser() error = %v", err)
+			}
+			if user.Status != tt.wantStatus {
+				t.Errorf("user status %s, want %s", user.Status, tt.wantStatus)
+			}
+
+			if sent := slicesCount(publisher.routingKeys, emailVerificationRoutingKey); sent != 0 {
+				t.Errorf("verification emails sent = %d, want invites only", sent)
+			}
+			if sent := slicesCount(publisher.routingKeys, userInviteRoutingKey); sent != tt.wantInvites {
+				t.Fatalf("invites sent = %d, want %d", sent, tt.wantInvites)
+			}
+			if tt.wantInvites == 0 {
+				return
+			}
+
+			var invite rabbitmq.MsgUserInvite
+			if err := json.Unmarshal(publisher.bodies[len(publisher.bodies)-1], &invite); err != nil {
+				t.Fatalf("unmarshal invite: %v", err)
+			}
+			if invite.Email != tt.email || invite.UserID != created.ID || invite.Name != "Test" {
+				t.Errorf("invite = %+v, want invite of %s to %s", invite, created.ID, tt.email)
+			}
+		})
+	}
+}
+
+func newScimTestHandlers(t *testing.T) (*UserHandlers, *sqlite.SQLiteStorage, *memPublisher) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	dbPath := filepath.Join(t.TempDir(), "sso.db")
+	applyMigrations(t, dbPath)
+
+	db, err := sqlite.New(ctx, dbPath)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	t.Cleanup(func() { db.Close(ctx) })
+
+	publisher := &memPublisher{}
+	uh := New(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		validator.New(),
+		db,
+		db,
+		db,
+		nopTokenStore{},
+		fakeTokenIssuer{},
+		fakeTokenIssuer{},
+		nil,
+		publisher,
+		nil,
+		nopAudit{},
+		time.Minute,
+	)
+
+	return uh, db, publisher
+}
+
+// applyMigrations creates the schema like cmd/migrator does.
+func applyMigrations(t *testing.T, dbPath string) {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join("..", "..", "..", "migrations", "sqlite", "*.up.sql"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("find migrations: %v", err)
+	}
+	sort.Strings(files)
+
+	// driver is registered by the sqlite storage package
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	defer db.Close()
+
+	for _, file := range files {
+		query, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("read migration: %v", err)
+		}
+		if _, err := db.Exec(string(query)); err != nil {
+			t.Fatalf("apply migration %s: %v", filepath.Base(file), err)
+		}
+	}
+}
+
+func saveTestUser(t *testing.T, db *sqlite.SQLiteStorage, email, status string) string {
+	t.Helper()
+
+	user := &models.DBCreateUser{
+		Email:    email,
+		PassHash: []byte("hash"),
+		Name:     "Test",
+		Status:   status,
+		Created:  time.Now(),
+		Updated:  time.Now(),
+	}
+	if err := db.SaveUser(context.Background(), user); err != nil {
+		t.Fatalf("SaveUser() error = %v", err)
+	}
+
+	return user.ID
+}
+
+func slicesCount(values []string, value string) int {
+	n := 0
+	for _, v := range values {
+		if v == value {
+			n++
+		}
+	}
+	return n
+}
+
+type nopTokenStore struct{}
+
+func (nopTokenStore) DeleteUserRefreshTokens(context.Context, string) error { return nil }
+func (nopTokenStore) DenyUser(context.Context, string, time.Duration) error { return nil }
+func (nopTokenStore) AllowUser(context.Context, string) error               { return nil }
+
+type fakeTokenIssuer struct{}
+
+func (fakeTokenIssuer) IssueVerificationToken(userID, email string, _ time.Duration) (string, error) {
+	return userID + ":" + email, nil
+}
+
+func (fakeTokenIssuer) IssueInviteToken(userID, email string, _ time.Duration) (string, error) {
+	return "invite:" + userID + ":" + email, nil
+}
+
+type memPublisher struct {
+	routingKeys []string
+	bodies      [][]byte
+}
+
+func (p *memPublisher) Publish(_ context.Context, _, routingKey string, body []byte) error {
+	p.routingKeys = append(p.routingKeys, routingKey)
+	p.bodies = append(p.bodies, body)
+	return nil
+}
+
+type nopAudit struct{}
+
+func (nopAudit) Record(context.Context, *models.AuditEvent) {}
@@ -0,0 +1,433 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+	"github.com/DimTur/lp_auth/internal/services/storage"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	defaultUsersLimit = 20 // TODO: transfer to config
+	maxUsersLimit     = 100
+
+	exchangeShare        = "share"
+	userInviteRoutingKey = "user_invite"
+	inviteExpiresIn      = 7 * 24 * time.Hour // TODO: transfer to config
+	maxImportRows        = 1000               // TODO: transfer to config
+	importBatchSize      = 100
+
+	emailVerificationRoutingKey = "email_verification"
+	emailVerificationExpiresIn  = 24 * time.Hour // TODO: transfer to config
+
+	impersonationExpiresIn = 15 * time.Minute // TODO: transfer to config
+)
+
+type UserSaver interface {
+	SetUserAdmin(ctx context.Context, userID string, isAdmin bool) error
+	UpdateUserStatus(ctx context.Context, userID, status string) error
+	DeactivateUser(ctx context.Context, userID string) error
+	ReactivateUser(ctx context.Context, userID string) (string, error)
+	SaveUsers(ctx context.Context, users []*models.DBCreateUser) ([]int, error)
+	SaveUser(ctx context.Context, user *models.DBCreateUser) error
+	UpdateUserInfo(ctx context.Context, userInfo *models.DBUpdateUserInfo) error
+}
+
+type UserProvider interface {
+	FindUserByID(ctx context.Context, userID string) (*models.User, error)
+	GetUserRoles(ctx context.Context, userID string) (*models.UserRoles, error)
+	ListUsers(ctx context.Context, usersFilter *models.UsersFilter) ([]*models.User, error)
+	FindUsersByEmails(ctx context.Context, emails []string) ([]*models.User, error)
+	FindUsersPage(ctx context.Context, usersFilter *models.ScimUsersFilter) ([]*models.User, int64, error)
+}
+
+type TokenRevoker interface {
+	RevokeUserRefreshTokens(ctx context.Context, userID string) error
+}
+
+type TokenRedisStore interface {
+	DeleteUserRefreshTokens(ctx context.Context, userID string) error
+	DenyUser(ctx context.Context, userID string, ttl time.Duration) error
+	AllowUser(ctx context.Context, userID string) error
+}
+
+type InviteIssuer interface {
+	IssueInviteToken(userID, email string, expiresIn time.Duration) (string, error)
+}
+
+// VerificationIssuer issues email verification tokens
+type VerificationIssuer interface {
+	IssueVerificationToken(userID, email string, expiresIn time.Duration) (string, error)
+}
+
+// ImpersonationIssuer issues access tokens of the user on behalf of the admin
+type ImpersonationIssuer interface {
+	IssueImpersonationToken(userID, actorID string, expiresIn time.Duration) (string, string, error)
+}
+
+type RabbitMQQueues interface {
+	Publish(ctx context.Context, exchange, routingKey string, body []byte) error
+}
+
+// GroupManager adds imported users to learning groups
+type GroupManager interface {
+	CreateLearningGroup(ctx context.Context, lg *models.CreateLearningGroup) error
+	UpdateLearningGroup(ctx context.Context, lg *models.UpdateLearningGroup) error
+}
+
+// AuditRecorder writes admin actions to the audit log
+type AuditRecorder interface {
+	Record(ctx context.Context, event *models.AuditEvent)
+}
+
+var (
+	ErrInvalidCredentials  = errors.New("invalid credentials")
+	ErrPermissionDenied    = errors.New("you don't have permissions")
+	ErrUserNotFound        = errors.New("user not found")
+	ErrSelfModification    = errors.New("admin can't change own account")
+	ErrUserNotDeactivated  = errors.New("user is not deactivated")
+	ErrUserAlreadyDisabled = errors.New("user is already deactivated")
+	ErrTooManyImportRows   = errors.New("too many rows to import")
+	ErrUserExists          = errors.New("user already exists")
+	ErrCantImpersonate     = errors.New("user can't be impersonated")
+	ErrAdminNotProvisioned = errors.New("admin can't be changed by scim client")
+)
+
+type UserHandlers struct {
+	log             *slog.Logger
+	validator       *validator.Validate
+	usrSaver        UserSaver
+	usrProvider     UserProvider
+	tokenRevoker    TokenRevoker
+	tokenRedisStore TokenRedisStore
+	inviteIssuer    InviteIssuer
+	verifyIssuer    VerificationIssuer
+	impIssuer       ImpersonationIssuer
+	rabbitMQQueues  RabbitMQQueues
+	groupManager    GroupManager
+	auditRecorder   AuditRecorder
+	accessTTL       time.Duration
+}
+
+func New(
+	log *slog.Logger,
+	validator *validator.Validate,
+	usrSaver UserSaver,
+	usrProvider UserProvider,
+	tokenRevoker TokenRevoker,
+	tokenRedisStore TokenRedisStore,
+	inviteIssuer InviteIssuer,
+	verifyIssuer VerificationIssuer,
+	impIssuer ImpersonationIssuer,
+	rabbitMQQueues RabbitMQQueues,
+	groupManager GroupManager,
+	auditRecorder AuditRecorder,
+	accessTTL time.Duration,
+) *UserHandlers {
+	return &UserHandlers{
+		log:             log,
+		validator:       validator,
+		usrSaver:        usrSaver,
+		usrProvider:     usrProvider,
+		tokenRevoker:    tokenRevoker,
+		tokenRedisStore: tokenRedisStore,
+		inviteIssuer:    inviteIssuer,
+		verifyIssuer:    verifyIssuer,
+		impIssuer:       impIssuer,
+		rabbitMQQueues:  rabbitMQQueues,
+		groupManager:    groupManager,
+		auditRecorder:   auditRecorder,
+		accessTTL:       accessTTL,
+	}
+}
+
+// ListUsers returns page of users directory. Only platform admins can do it.
+// Users are ordered by registration, next page starts after NextCursor.
+func (uh *UserHandlers) ListUsers(ctx context.Context, list *models.ListUsers) (*models.UsersPage, error) {
+	const op = "users.ListUsers"
+
+	log := uh.log.With(
+		slog.String("op", op),
+		slog.String("admin_id", list.AdminID),
+	)
+
+	// Validation
+	err := uh.validator.Struct(list)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := uh.checkAdmin(ctx, list.AdminID); err != nil {
+		log.Warn("failed to check admin", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	limit := list.Limit
+	if limit == 0 {
+		limit = defaultUsersLimit
+	}
+	limit = min(limit, maxUsersLimit)
+
+	log.Info("listing users")
+
+	// One more user is requested to know whether the next page exists
+	users, err := uh.usrProvider.ListUsers(ctx, &models.UsersFilter{
+		Query:   list.Query,
+		Status:  list.Status,
+		AfterID: list.Cursor,
+		Limit:   limit + 1,
+	})
+	if err != nil {
+		log.Error("failed to list users", slog.String("err", err.Error()))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	page := &models.UsersPage{Users: users}
+	if int64(len(users)) > limit {
+		page.Users = users[:limit]
+		page.NextCursor = page.Users[limit-1].ID
+	}
+
+	return page, nil
+}
+
+// SetUserAdmin grants or revokes platform admin role.
+// Admin can't change own role, so there is always at least one admin left.
+func (uh *UserHandlers) SetUserAdmin(ctx context.Context, set *models.SetUserAdmin) error {
+	const op = "users.SetUserAdmin"
+
+	log := uh.log.With(
+		slog.String("op", op),
+		slog.String("admin_id", set.AdminID),
+		slog.String("user_id", set.UserID),
+		slog.Bool("is_admin", set.IsAdmin),
+	)
+
+	// Validation
+	err := uh.validator.Struct(set)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	if set.AdminID == set.UserID {
+		log.Warn("self modification")
+		return fmt.Errorf("%s: %w", op, ErrSelfModification)
+	}
+
+	if err := uh.checkAdmin(ctx, set.AdminID); err != nil {
+		log.Warn("failed to check admin", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("changing admin role")
+
+	if err := uh.usrSaver.SetUserAdmin(ctx, set.UserID, set.IsAdmin); err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			log.Warn("user not found", slog.String("err", err.Error()))
+			return fmt.Errorf("%s: %w", op, ErrUserNotFound)
+		}
+
+		log.Error("failed to change admin role", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("admin role changed")
+
+	uh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:    models.AuditEventAdminFlagChange,
+		UserID:  set.UserID,
+		ActorID: set.AdminID,
+		Details: fmt.Sprintf("is_admin=%t", set.IsAdmin),
+	})
+
+	return nil
+}
+
+// DeactivateUser blocks the user account. The user can't log in,
+// all refresh tokens of the user are revoked and access tokens
+// are rejected by AuthCheck until they expire.
+func (uh *UserHandlers) DeactivateUser(ctx context.Context, manage *models.ManageUser) error {
+	const op = "users.DeactivateUser"
+
+	log := uh.log.With(
+		slog.String("op", op),
+		slog.String("admin_id", manage.AdminID),
+		slog.String("user_id", manage.UserID),
+	)
+
+	// Validation
+	err := uh.validator.Struct(manage)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+	if manage.AdminID == manage.UserID {
+		log.Warn("self modification")
+		return fmt.Errorf("%s: %w", op, ErrSelfModification)
+	}
+
+	if err := uh.checkAdmin(ctx, manage.AdminID); err != nil {
+		log.Warn("failed to check admin", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	user, err := uh.findUser(ctx, manage.UserID)
+	if err != nil {
+		log.Warn("failed to get user", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if user.Status == models.UserStatusDeactivated {
+		log.Warn("user is already deactivated")
+		return fmt.Errorf("%s: %w", op, ErrUserAlreadyDisabled)
+	}
+
+	if err := uh.deactivate(ctx, log, user.ID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	uh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:    models.AuditEventUserDeactivate,
+		UserID:  user.ID,
+		ActorID: manage.AdminID,
+		Details: fmt.Sprintf("status=%s", user.Status),
+	})
+
+	return nil
+}
+
+// ReactivateUser unblocks the user account deactivated by DeactivateUser.
+// The user gets back the status it had before, e.g. the user who hasn't
+// verified the email yet is still pending verification.
+func (uh *UserHandlers) ReactivateUser(ctx context.Context, manage *models.ManageUser) error {
+	const op = "users.ReactivateUser"
+
+	log := uh.log.With(
+		slog.String("op", op),
+		slog.String("admin_id", manage.AdminID),
+		slog.String("user_id", manage.UserID),
+	)
+
+	// Validation
+	err := uh.validator.Struct(manage)
+	if err != nil {
+		log.Warn("invalid parameters", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+	}
+
+	if err := uh.checkAdmin(ctx, manage.AdminID); err != nil {
+		log.Warn("failed to check admin", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	user, err := uh.findUser(ctx, manage.UserID)
+	if err != nil {
+		log.Warn("failed to get user", slog.String("err", err.Error()))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if user.Status != models.UserStatusDeactivated {
+		log.Warn("user is not deactivated")
+		return fmt.Errorf("%s: %w", op, ErrUserNotDeactivated)
+	}
+
+	status, err := uh.reactivate(ctx, log, user.ID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotDeactivated) {
+			return fmt.Errorf("%s: %w", op, ErrUserNotDeactivated)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	uh.auditRecorder.Record(ctx, &models.AuditEvent{
+		Type:    models.AuditEventUserReactivate,
+		UserID:  user.ID,
+		ActorID: manage.AdminID,
+		Details: fmt.Sprintf("status=%s", status),
+	})
+
+	return nil
+}
+
+// deactivate sets deactivated status, revokes refresh tokens of the user
+// and denies access tokens until they expire. The current status is kept
+// by the storage, so reactivate can restore it.
+func (uh *UserHandlers) deactivate(ctx context.Context, log *slog.Logger, userID string) error {
+	log.Info("deactivating user")
+
+	if err := uh.usrSaver.DeactivateUser(ctx, userID); err != nil {
+		log.Error("failed to update user status", slog.String("err", err.Error()))
+		return err
+	}
+
+	if err := uh.tokenRevoker.RevokeUserRefreshTokens(ctx, userID); err != nil {
+		log.Error("failed to revoke refresh tokens", slog.String("err", err.Error()))
+		return err
+	}
+
+	if err := uh.tokenRedisStore.DeleteUserRefreshTokens(ctx, userID); err != nil {
+		log.Error("failed to delete refresh tokens from redis", slog.String("err", err.Error()))
+		return err
+	}
+
+	if err := uh.tokenRedisStore.DenyUser(ctx, userID, uh.accessTTL); err != nil {
+		log.Error("failed to deny user access tokens", slog.String("err", err.Error()))
+		return err
+	}
+
+	log.Info("user deactivated")
+
+	return nil
+}
+
+// reactivate restores the status the user had before deactivation
+// and returns it.
+func (uh *UserHandlers) reactivate(ctx context.Context, log *slog.Logger, userID string) (string, error) {
+	log.Info("reactivating user")
+
+	status, err := uh.usrSaver.ReactivateUser(ctx, userID)
+	if err != nil {
+		log.Error("failed to update user status", slog.String("err", err.Error()))
+		return "", err
+	}
+
+	if err := uh.tokenRedisStore.AllowUser(ctx, userID); err != nil {
+		log.Error("failed to allow user access tokens", slog.String("err", err.Error()))
+		return "", err
+	}
+
+	log.Info("user reactivated", slog.String("status", status))
+
+	return status, nil
+}
+
+func (uh *UserHandlers) checkAdmin(ctx context.Context, userID string) error {
+	roles, err := uh.usrProvider.GetUserRoles(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	if !roles.IsAdmin {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
+
+func (uh *UserHandlers) findUser(ctx context.Context, userID string) (*models.User, error) {
+	user, err := uh.usrProvider.FindUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return user, nil
+}
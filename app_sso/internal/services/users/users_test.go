@@ -0,0 +1,101 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DimTur/lp_auth/internal/domain/models"
+)
+
+func TestDeactivateReactivateUser(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+	}{
+		{name: "active user", status: models.UserStatusActive},
+		{name: "pending verification user", status: models.UserStatusPendingVerification},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uh, db, _ := newScimTestHandlers(t)
+			audit := &memAudit{}
+			uh.auditRecorder = audit
+			ctx := context.Background()
+
+			admin := saveTestUser(t, db, "admin@example.com", models.UserStatusActive)
+			if err := db.SetUserAdmin(ctx, admin, true); err != nil {
+				t.Fatalf("SetUserAdmin() error = %v", err)
+			}
+			userID := saveTestUser(t, db, "user@example.com", tt.status)
+			manage := &models.ManageUser{AdminID: admin, UserID: userID}
+
+			if err := uh.ReactivateUser(ctx, manage); !errors.Is(err, ErrUserNotDeactivated) {
+				t.Fatalf("ReactivateUser() of not deactivated user error = %v, want %v", err, ErrUserNotDeactivated)
+			}
+
+			if err := uh.DeactivateUser(ctx, manage); err != nil {
+				t.Fatalf("DeactivateUser() error = %v", err)
+			}
+			if status := userStatus(t, db, userID); status != models.UserStatusDeactivated {
+				t.Fatalf("status after DeactivateUser() = %s, want %s", status, models.UserStatusDeactivated)
+			}
+			if err := uh.DeactivateUser(ctx, manage); !errors.Is(err, ErrUserAlreadyDisabled) {
+				t.Fatalf("second DeactivateUser() error = %v, want %v", err, ErrUserAlreadyDisabled)
+			}
+
+			if err := uh.ReactivateUser(ctx, manage); err != nil {
+				t.Fatalf("ReactivateUser() error = %v", err)
+			}
+			if status := userStatus(t, db, userID); status != tt.status {
+				t.Errorf("status after ReactivateUser() = %s, want %s", status, tt.status)
+			}
+
+			wantTypes := []string{models.AuditEventUserDeactivate, models.AuditEventUserReactivate}
+			if len(audit.events) != len(wantTypes) {
+				t.Fatalf("audit events = %d, want %d", len(audit.events), len(wantTypes))
+			}
+			for i, event := range audit.events {
+				if event.Type != wantTypes[i] || event.UserID != userID || event.ActorID != admin {
+					t.Errorf("audit event %d = %+v, want %s of %s by %s", i, event, wantTypes[i], userID, admin)
+				}
+			}
+		})
+	}
+}
+
+func TestScimReactivationRestoresStatus(t *testing.T) {
+	uh, db, _ := newScimTestHandlers(t)
+	ctx := context.Background()
+
+	userID := saveTestUser(t, db, "user@example.com", models.UserStatusPendingVerification)
+
+	for _, active := range []bool{false, true} {
+		if _, err := uh.ScimUpdateUser(ctx, &models.ScimUpdateUser{ClientID: "idp", UserID: userID, Email: "user@example.com", Active: active}); err != nil {
+			t.Fatalf("ScimUpdateUser(active=%v) error = %v", active, err)
+		}
+	}
+
+	if status := userStatus(t, db, userID); status != models.UserStatusPendingVerification {
+		t.Errorf("status after reactivation = %s, want %s", status, models.UserStatusPendingVerification)
+	}
+}
+
+func userStatus(t *testing.T, db UserProvider, userID string) string {
+	t.Helper()
+
+	user, err := db.FindUserByID(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("FindUserByID() error = %v", err)
+	}
+	return user.Status
+}
+
+type memAudit struct {
+	events []*models.AuditEvent
+}
+
+func (a *memAudit) Record(_ context.Context, event *models.AuditEvent) {
+	a.events = append(a.events, event)
+}
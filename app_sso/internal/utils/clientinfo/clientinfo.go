@@ -0,0 +1,80 @@
+package clientinfo
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// gRPC metadata keys set by api gateway.
+const (
+	mdClientIP  = "x-client-ip"
+	mdUserAgent = "x-user-agent"
+	mdActorID   = "x-actor-id"
+)
+
+type ctxKey struct{}
+
+// Info describes the end client on whose behalf the request is made.
+// ActorID is the admin who impersonates the user of the request.
+type Info struct {
+	IP        string
+	UserAgent string
+	ActorID   string
+}
+
+// UnaryServerInterceptor puts client info from incoming metadata to the context.
+func UnaryServerInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	ctx = context.WithValue(ctx, ctxKey{}, Info{
+		IP:        first(md.Get(mdClientIP)),
+		UserAgent: first(md.Get(mdUserAgent)),
+		ActorID:   first(md.Get(mdActorID)),
+	})
+
+	return handler(ctx, req)
+}
+
+// HTTPMiddleware puts client info of requests to public http endpoints of sso
+// to the context. Proxy headers aren't trusted, the peer address is used.
+func HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			ip = r.RemoteAddr
+		}
+
+		ctx := context.WithValue(r.Context(), ctxKey{}, Info{
+			IP:        ip,
+			UserAgent: r.UserAgent(),
+		})
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// FromContext returns client info of the request.
+// Zero Info is returned if the caller didn't pass it.
+func FromContext(ctx context.Context) Info {
+	info, _ := ctx.Value(ctxKey{}).(Info)
+	return info
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,135 @@
+package otp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP parameters (RFC 6238). Authenticator apps use these values by default.
+const (
+	totpSecretSize = 20
+	totpDigits     = 6
+	totpPeriod     = 30
+	// totpSkew is the number of periods before and after the current one
+	// which are accepted to tolerate clock drift.
+	totpSkew = 1
+
+	// recoveryCodeSize gives 80 random bits, so codes are stored
+	// as fast SHA-256 hashes and found by the hash.
+	recoveryCodeSize = 10
+)
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewTOTPSecret returns random base32 encoded TOTP secret.
+func NewTOTPSecret() (string, error) {
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return b32.EncodeToString(secret), nil
+}
+
+// TOTPURI returns otpauth:// URI which can be imported to authenticator app.
+func TOTPURI(issuer, account, secret string) string {
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(totpPeriod))
+
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+// TOTPCode returns TOTP code of the secret for the given time.
+func TOTPCode(secret string, t time.Time) (string, error) {
+	key, err := b32.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", err
+	}
+
+	return hotp(key, uint64(t.Unix()/totpPeriod)), nil
+}
+
+// MatchTOTP checks TOTP code of the secret for the given time and returns
+// the time step counter of the matched code. Callers store the counter
+// to reject the same code while it is still inside the accepted window.
+func MatchTOTP(secret, code string, t time.Time) (int64, bool) {
+	key, err := b32.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+
+	counter := t.Unix() / totpPeriod
+	for i := -totpSkew; i <= totpSkew; i++ {
+		expected := hotp(key, uint64(counter+int64(i)))
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return counter + int64(i), true
+		}
+	}
+
+	return 0, false
+}
+
+// NewRecoveryCodes returns n random one-time recovery codes.
+func NewRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		b := make([]byte, recoveryCodeSize)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		codes = append(codes, hex.EncodeToString(b))
+	}
+
+	return codes, nil
+}
+
+// IsRecoveryCode reports whether the code has format of recovery codes.
+func IsRecoveryCode(code string) bool {
+	if len(code) != hex.EncodedLen(recoveryCodeSize) {
+		return false
+	}
+	_, err := hex.DecodeString(code)
+	return err == nil
+}
+
+// HashRecoveryCode returns the stored hash of the recovery code.
+func HashRecoveryCode(code string) []byte {
+	sum := sha256.Sum256([]byte(strings.ToLower(code)))
+	return sum[:]
+}
+
+// hotp implements HOTP (RFC 4226) with dynamic truncation.
+func hotp(key []byte, counter uint64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
@@ -0,0 +1,148 @@
+package otp
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 seed "12345678901234567890" from RFC 6238 appendix B.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 test vectors truncated to 6 digits
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got, err := TOTPCode(rfcSecret, time.Unix(tt.unix, 0))
+			if err != nil {
+				t.Fatalf("TOTPCode() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("TOTPCode() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchTOTP(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	counter := now.Unix() / totpPeriod
+
+	code := func(t *testing.T, at time.Time) string {
+		t.Helper()
+		c, err := TOTPCode(rfcSecret, at)
+		if err != nil {
+			t.Fatalf("TOTPCode() error = %v", err)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name        string
+		secret      string
+		code        string
+		wantCounter int64
+		wantOK      bool
+	}{
+		{name: "current period", secret: rfcSecret, code: code(t, now), wantCounter: counter, wantOK: true},
+		{name: "lowercase secret", secret: strings.ToLower(rfcSecret), code: code(t, now), wantCounter: counter, wantOK: true},
+		{name: "previous period", secret: rfcSecret, code: code(t, now.Add(-totpPeriod*time.Second)), wantCounter: counter - 1, wantOK: true},
+		{name: "next period", secret: rfcSecret, code: code(t, now.Add(totpPeriod*time.Second)), wantCounter: counter + 1, wantOK: true},
+		{name: "outside skew", secret: rfcSecret, code: code(t, now.Add(-2*totpPeriod*time.Second)), wantOK: false},
+		{name: "wrong length", secret: rfcSecret, code: "12345", wantOK: false},
+		{name: "invalid secret", secret: "not base32!", code: "123456", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotCounter, gotOK := MatchTOTP(tt.secret, tt.code, now)
+			if gotOK != tt.wantOK {
+				t.Fatalf("MatchTOTP() ok = %v, want %v", gotOK, tt.wantOK)
+			}
+			if gotOK && gotCounter != tt.wantCounter {
+				t.Errorf("MatchTOTP() counter = %d, want %d", gotCounter, tt.wantCounter)
+			}
+		})
+	}
+}
+
+func TestNewTOTPSecret(t *testing.T) {
+	secret, err := NewTOTPSecret()
+	if err != nil {
+		t.Fatalf("NewTOTPSecret() error = %v", err)
+	}
+
+	key, err := b32.DecodeString(secret)
+	if err != nil {
+		t.Fatalf("secret is not base32: %v", err)
+	}
+	if len(key) != totpSecretSize {
+		t.Errorf("secret size = %d, want %d", len(key), totpSecretSize)
+	}
+}
+
+func TestNewRecoveryCodes(t *testing.T) {
+	codes, err := NewRecoveryCodes(10)
+	if err != nil {
+		t.Fatalf("NewRecoveryCodes() error = %v", err)
+	}
+	if len(codes) != 10 {
+		t.Fatalf("got %d codes, want 10", len(codes))
+	}
+
+	seen := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		if len(code) != recoveryCodeSize*2 {
+			t.Errorf("code %q length = %d, want %d", code, len(code), recoveryCodeSize*2)
+		}
+		if _, ok := seen[code]; ok {
+			t.Errorf("duplicate code %q", code)
+		}
+		seen[code] = struct{}{}
+	}
+}
+
+func TestRecoveryCodeHash(t *testing.T) {
+	codes, err := NewRecoveryCodes(2)
+	if err != nil {
+		t.Fatalf("NewRecoveryCodes() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		code   string
+		wantOK bool
+	}{
+		{name: "generated code", code: codes[0], wantOK: true},
+		{name: "upper case", code: strings.ToUpper(codes[0]), wantOK: true},
+		{name: "totp code", code: "123456", wantOK: false},
+		{name: "not hex", code: strings.Repeat("z", recoveryCodeSize*2), wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRecoveryCode(tt.code); got != tt.wantOK {
+				t.Errorf("IsRecoveryCode() = %v, want %v", got, tt.wantOK)
+			}
+		})
+	}
+
+	if !bytes.Equal(HashRecoveryCode(codes[0]), HashRecoveryCode(strings.ToUpper(codes[0]))) {
+		t.Error("HashRecoveryCode() depends on case")
+	}
+	if bytes.Equal(HashRecoveryCode(codes[0]), HashRecoveryCode(codes[1])) {
+		t.Error("HashRecoveryCode() is equal for different codes")
+	}
+}
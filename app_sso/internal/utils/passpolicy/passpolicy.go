@@ -0,0 +1,102 @@
+package passpolicy
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// minEmailPartLength is the shortest local part of the email
+// which is searched in the password. Shorter ones give false positives.
+const minEmailPartLength = 4
+
+var (
+	ErrTooShort      = errors.New("password is too short")
+	ErrTooLong       = errors.New("password is too long")
+	ErrBreached      = errors.New("password is found in breached passwords list")
+	ErrContainsEmail = errors.New("password contains email")
+)
+
+// Policy checks passwords chosen by users.
+type Policy struct {
+	minLength int
+	maxLength int
+	breached  map[string]struct{}
+}
+
+// New returns policy with the given length limits.
+// Breached list is a text file with one password per line,
+// empty lines and lines starting with "#" are skipped.
+// Empty path disables the breached passwords check.
+func New(minLength, maxLength int, breachedListPath string) (*Policy, error) {
+	const op = "passpolicy.New"
+
+	p := &Policy{
+		minLength: minLength,
+		maxLength: maxLength,
+		breached:  make(map[string]struct{}),
+	}
+
+	if breachedListPath == "" {
+		return p, nil
+	}
+
+	f, err := os.Open(breachedListPath)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		p.breached[line] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return p, nil
+}
+
+// Check returns error describing the first violated rule.
+func (p *Policy) Check(password, email string) error {
+	length := utf8.RuneCountInString(password)
+	if length < p.minLength {
+		return fmt.Errorf("%w: minimum is %d characters", ErrTooShort, p.minLength)
+	}
+	if p.maxLength > 0 && length > p.maxLength {
+		return fmt.Errorf("%w: maximum is %d characters", ErrTooLong, p.maxLength)
+	}
+
+	if containsEmail(password, email) {
+		return ErrContainsEmail
+	}
+
+	if _, ok := p.breached[password]; ok {
+		return ErrBreached
+	}
+
+	return nil
+}
+
+func containsEmail(password, email string) bool {
+	if email == "" {
+		return false
+	}
+
+	password = strings.ToLower(password)
+	email = strings.ToLower(email)
+	if strings.Contains(password, email) {
+		return true
+	}
+
+	local, _, _ := strings.Cut(email, "@")
+	return utf8.RuneCountInString(local) >= minEmailPartLength && strings.Contains(password, local)
+}
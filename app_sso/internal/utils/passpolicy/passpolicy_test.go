@@ -0,0 +1,78 @@
+package passpolicy
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	breachedList := filepath.Join(t.TempDir(), "breached.txt")
+	content := "# top passwords\npassword123\n\n  qwertyuiop  \n"
+	if err := os.WriteFile(breachedList, []byte(content), 0o600); err != nil {
+		t.Fatalf("write breached list: %v", err)
+	}
+
+	policy, err := New(8, 32, breachedList)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		email    string
+		wantErr  error
+	}{
+		{name: "valid", password: "long enough pass", email: "john@example.com"},
+		{name: "too short", password: "short", wantErr: ErrTooShort},
+		{name: "length in runes", password: "пароль12", email: "john@example.com"},
+		{name: "too long", password: "this password is longer than the limit", wantErr: ErrTooLong},
+		{name: "breached", password: "password123", wantErr: ErrBreached},
+		{name: "breached line is trimmed", password: "qwertyuiop", wantErr: ErrBreached},
+		{name: "comment is not breached", password: "# top passwords", wantErr: nil},
+		{name: "contains email", password: "x-john@example.com", email: "John@Example.com", wantErr: ErrContainsEmail},
+		{name: "contains local part", password: "johnny-b-good", email: "johnny@example.com", wantErr: ErrContainsEmail},
+		{name: "local part case insensitive", password: "JOHNNY-b-good", email: "johnny@example.com", wantErr: ErrContainsEmail},
+		{name: "short local part is ignored", password: "bob-the-builder", email: "bob@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Check(tt.password, tt.email)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Check() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Check() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "breached check disabled", path: ""},
+		{name: "missing file", path: filepath.Join(t.TempDir(), "missing.txt"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := New(8, 0, tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && policy.Check("password123", "") != nil {
+				t.Error("password is rejected without breached list")
+			}
+		})
+	}
+}
@@ -53,6 +53,41 @@ func ValidateRefreshToken(req *ssov1.RefreshTokenRequest) error {
 	return nil
 }
 
+// ValidateRequestPasswordReset validates RequestPasswordReset request
+func ValidateRequestPasswordReset(req *ssov1.RequestPasswordResetRequest) error {
+	if err := validateEmail(req.GetEmail()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateResendVerification validates ResendVerification request
+func ValidateResendVerification(req *ssov1.ResendVerificationRequest) error {
+	if err := validateEmail(req.GetEmail()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateConfirmPasswordReset validates ConfirmPasswordReset request
+func ValidateConfirmPasswordReset(req *ssov1.ConfirmPasswordResetRequest) error {
+	if err := validateEmail(req.GetEmail()); err != nil {
+		return err
+	}
+
+	if req.GetCode() == "" {
+		return status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	if err := validatePassword(req.GetNewPassword()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func validateEmail(email string) error {
 	if email == "" {
 		return status.Error(codes.InvalidArgument, "email is required")
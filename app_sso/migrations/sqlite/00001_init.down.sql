@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS gdpr_jobs;
+DROP TABLE IF EXISTS relying_parties;
+DROP TABLE IF EXISTS apps;
+DROP TABLE IF EXISTS learning_group_members;
+DROP TABLE IF EXISTS learning_groups;
+DROP TABLE IF EXISTS tokens;
+DROP TABLE IF EXISTS user_recovery_codes;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,85 @@
+CREATE TABLE IF NOT EXISTS users (
+    id TEXT PRIMARY KEY,
+    email TEXT NOT NULL UNIQUE,
+    pass_hash BLOB,
+    name TEXT NOT NULL DEFAULT '',
+    is_admin INTEGER NOT NULL DEFAULT 0,
+    tg_link TEXT UNIQUE,
+    chat_id TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'active',
+    totp_secret TEXT NOT NULL DEFAULT '',
+    totp_enabled INTEGER NOT NULL DEFAULT 0,
+    created DATETIME NOT NULL,
+    updated DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_users_status ON users(status);
+
+CREATE TABLE IF NOT EXISTS user_recovery_codes (
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash BLOB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_user_recovery_codes_user_id ON user_recovery_codes(user_id);
+
+CREATE TABLE IF NOT EXISTS tokens (
+    token TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    family_id TEXT NOT NULL,
+    rotated INTEGER NOT NULL DEFAULT 0,
+    revoked INTEGER NOT NULL DEFAULT 0,
+    expires_at DATETIME NOT NULL,
+    created DATETIME NOT NULL,
+    session_created DATETIME NOT NULL,
+    user_agent TEXT NOT NULL DEFAULT '',
+    ip TEXT NOT NULL DEFAULT '',
+    access_jti TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_tokens_family_id ON tokens(family_id);
+CREATE INDEX IF NOT EXISTS idx_tokens_user_id ON tokens(user_id, created);
+
+CREATE TABLE IF NOT EXISTS learning_groups (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    created_by TEXT NOT NULL,
+    modified_by TEXT NOT NULL,
+    created DATETIME NOT NULL,
+    updated DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS learning_group_members (
+    learning_group_id TEXT NOT NULL REFERENCES learning_groups(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    role TEXT NOT NULL,
+    PRIMARY KEY (learning_group_id, user_id, role)
+);
+CREATE INDEX IF NOT EXISTS idx_learning_group_members_user_id ON learning_group_members(user_id, role);
+
+CREATE TABLE IF NOT EXISTS apps (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    secret_hash BLOB,
+    scopes TEXT NOT NULL DEFAULT '[]',
+    created_by TEXT NOT NULL,
+    created DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS relying_parties (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    secret_hash BLOB,
+    redirect_uris TEXT NOT NULL DEFAULT '[]',
+    created_by TEXT NOT NULL,
+    created DATETIME NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS gdpr_jobs (
+    id TEXT PRIMARY KEY,
+    type TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    requested_by TEXT NOT NULL,
+    status TEXT NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    archive BLOB,
+    created DATETIME NOT NULL,
+    updated DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_gdpr_jobs_user_id ON gdpr_jobs(user_id);
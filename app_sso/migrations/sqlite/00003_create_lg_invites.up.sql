@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS lg_invites (
+    code TEXT PRIMARY KEY,
+    learning_group_id TEXT NOT NULL REFERENCES learning_groups(id) ON DELETE CASCADE,
+    created_by TEXT NOT NULL,
+    max_uses INTEGER NOT NULL,
+    uses INTEGER NOT NULL DEFAULT 0,
+    expires_at DATETIME NOT NULL,
+    created DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_lg_invites_learning_group_id ON lg_invites(learning_group_id, created);
@@ -0,0 +1 @@
+ALTER TABLE learning_groups DROP COLUMN version;
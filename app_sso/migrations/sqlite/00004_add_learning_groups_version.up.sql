@@ -0,0 +1 @@
+ALTER TABLE learning_groups ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
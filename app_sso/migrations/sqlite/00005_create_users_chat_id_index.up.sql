@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS users_chat_id ON users (chat_id);
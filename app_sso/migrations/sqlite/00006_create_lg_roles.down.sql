@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS lg_role_members;
+DROP TABLE IF EXISTS lg_roles;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS lg_roles (
+    id TEXT PRIMARY KEY,
+    learning_group_id TEXT NOT NULL REFERENCES learning_groups(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    permissions TEXT NOT NULL DEFAULT '[]',
+    created_by TEXT NOT NULL,
+    created DATETIME NOT NULL,
+    updated DATETIME NOT NULL,
+    UNIQUE (learning_group_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS lg_role_members (
+    role_id TEXT NOT NULL REFERENCES lg_roles(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    PRIMARY KEY (role_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_lg_role_members_user_id ON lg_role_members(user_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_learning_groups_parent_id;
+ALTER TABLE learning_groups DROP COLUMN parent_id;
@@ -0,0 +1,2 @@
+ALTER TABLE learning_groups ADD COLUMN parent_id TEXT;
+CREATE INDEX IF NOT EXISTS idx_learning_groups_parent_id ON learning_groups(parent_id);
@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN totp_last_counter INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS audit_log_no_update;
+
+CREATE TRIGGER IF NOT EXISTS audit_log_no_update BEFORE UPDATE ON audit_log
+BEGIN
+    SELECT RAISE(ABORT, 'audit log is append-only');
+END;
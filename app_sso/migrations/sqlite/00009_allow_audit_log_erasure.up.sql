@@ -0,0 +1,16 @@
+-- Audit log stays append-only, the only allowed update is removal
+-- of personal data (email, ip, user_agent) on GDPR erasure.
+DROP TRIGGER IF EXISTS audit_log_no_update;
+
+CREATE TRIGGER IF NOT EXISTS audit_log_no_update BEFORE UPDATE ON audit_log
+WHEN NOT (
+    NEW.id = OLD.id AND NEW.type = OLD.type AND NEW.user_id = OLD.user_id
+    AND NEW.actor_id = OLD.actor_id AND NEW.method = OLD.method
+    AND NEW.target_id = OLD.target_id AND NEW.details = OLD.details
+    AND NEW.created = OLD.created
+    AND NEW.email IN (OLD.email, '') AND NEW.ip IN (OLD.ip, '')
+    AND NEW.user_agent IN (OLD.user_agent, '')
+)
+BEGIN
+    SELECT RAISE(ABORT, 'audit log is append-only');
+END;
@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN prev_status TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE gdpr_jobs DROP COLUMN expires_at;
@@ -0,0 +1 @@
+ALTER TABLE gdpr_jobs ADD COLUMN expires_at DATETIME;
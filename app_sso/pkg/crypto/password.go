@@ -1,25 +1,183 @@
 package crypto
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Hashes are stored in PHC string format, so algorithm and its parameters
+// can be changed later without breaking existing hashes:
+//
+//	$argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>
+//
+// Legacy bcrypt hashes ($2a$, $2b$, $2y$) are still verified.
+const argon2idPrefix = "$argon2id$"
+
+var ErrInvalidHash = errors.New("invalid password hash")
+
+// Argon2Params are argon2id cost parameters.
+// Memory is in KiB.
+type Argon2Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2Params follow OWASP recommendations for argon2id.
+var DefaultArgon2Params = Argon2Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
 
 type PasswordHasher struct {
+	params Argon2Params
 }
 
-func NewPasswordHasher() PasswordHasher {
-	return PasswordHasher{}
+// NewPasswordHasher returns hasher which hashes passwords with argon2id.
+// Zero fields of params are taken from DefaultArgon2Params.
+func NewPasswordHasher(params Argon2Params) PasswordHasher {
+	if params.Memory == 0 {
+		params.Memory = DefaultArgon2Params.Memory
+	}
+	if params.Iterations == 0 {
+		params.Iterations = DefaultArgon2Params.Iterations
+	}
+	if params.Parallelism == 0 {
+		params.Parallelism = DefaultArgon2Params.Parallelism
+	}
+	if params.SaltLength == 0 {
+		params.SaltLength = DefaultArgon2Params.SaltLength
+	}
+	if params.KeyLength == 0 {
+		params.KeyLength = DefaultArgon2Params.KeyLength
+	}
+
+	return PasswordHasher{params: params}
 }
 
 func (ph PasswordHasher) HashPassword(password string) ([]byte, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	if err != nil {
+	salt := make([]byte, ph.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
 		return nil, err
 	}
-	return hashedPassword, nil
+
+	key := argon2.IDKey(
+		[]byte(password),
+		salt,
+		ph.params.Iterations,
+		ph.params.Memory,
+		ph.params.Parallelism,
+		ph.params.KeyLength,
+	)
+
+	return []byte(fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		ph.params.Memory,
+		ph.params.Iterations,
+		ph.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)), nil
 }
 
 func (ph PasswordHasher) ComparePassword(fromDB []byte, fromUser string) bool {
-	if err := bcrypt.CompareHashAndPassword(fromDB, []byte(fromUser)); err != nil {
+	if !bytes.HasPrefix(fromDB, []byte(argon2idPrefix)) {
+		return bcrypt.CompareHashAndPassword(fromDB, []byte(fromUser)) == nil
+	}
+
+	params, salt, key, err := decodeArgon2id(fromDB)
+	if err != nil {
 		return false
 	}
-	return true
+
+	otherKey := argon2.IDKey(
+		[]byte(fromUser),
+		salt,
+		params.Iterations,
+		params.Memory,
+		params.Parallelism,
+		params.KeyLength,
+	)
+
+	return subtle.ConstantTimeCompare(key, otherKey) == 1
+}
+
+// NeedsRehash reports whether the hash was made with another algorithm
+// or weaker parameters than the current ones.
+// It should be called only after the password was compared successfully.
+func (ph PasswordHasher) NeedsRehash(fromDB []byte) bool {
+	if !bytes.HasPrefix(fromDB, []byte(argon2idPrefix)) {
+		return true
+	}
+
+	params, _, _, err := decodeArgon2id(fromDB)
+	if err != nil {
+		return true
+	}
+
+	return params.Memory < ph.params.Memory ||
+		params.Iterations < ph.params.Iterations ||
+		params.Parallelism < ph.params.Parallelism ||
+		params.SaltLength < ph.params.SaltLength ||
+		params.KeyLength < ph.params.KeyLength
+}
+
+func decodeArgon2id(hash []byte) (*Argon2Params, []byte, []byte, error) {
+	// "", "argon2id", "v=19", "m=65536,t=3,p=2", salt, key
+	parts := strings.Split(string(hash), "$")
+	if len(parts) != 6 {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %s", ErrInvalidHash, err)
+	}
+	if version != argon2.Version {
+		return nil, nil, nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidHash, version)
+	}
+
+	params := &Argon2Params{}
+	if _, err := fmt.Sscanf(
+		parts[3],
+		"m=%d,t=%d,p=%d",
+		&params.Memory,
+		&params.Iterations,
+		&params.Parallelism,
+	); err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %s", ErrInvalidHash, err)
+	}
+	// argon2 panics on zero iterations or parallelism
+	if params.Iterations == 0 || params.Parallelism == 0 {
+		return nil, nil, nil, fmt.Errorf("%w: invalid parameters", ErrInvalidHash)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %s", ErrInvalidHash, err)
+	}
+	params.SaltLength = uint32(len(salt))
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %s", ErrInvalidHash, err)
+	}
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
 }
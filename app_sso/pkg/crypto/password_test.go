@@ -0,0 +1,140 @@
+package crypto
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testParams keep hashing fast in tests.
+var testParams = Argon2Params{Memory: 8 * 1024, Iterations: 1, Parallelism: 1}
+
+func TestComparePassword(t *testing.T) {
+	ph := NewPasswordHasher(testParams)
+
+	argonHash, err := ph.HashPassword("correct horse")
+	if err != nil {
+		t.Fatalf("hash password: %v", err)
+	}
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt hash: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		hash     []byte
+		password string
+		want     bool
+	}{
+		{name: "argon2id match", hash: argonHash, password: "correct horse", want: true},
+		{name: "argon2id mismatch", hash: argonHash, password: "battery staple", want: false},
+		{name: "bcrypt match", hash: bcryptHash, password: "correct horse", want: true},
+		{name: "bcrypt mismatch", hash: bcryptHash, password: "battery staple", want: false},
+		{name: "malformed argon2id", hash: []byte("$argon2id$v=19$m=8192"), password: "correct horse", want: false},
+		{name: "zero iterations", hash: []byte("$argon2id$v=19$m=8192,t=0,p=1$c2FsdA$a2V5"), password: "correct horse", want: false},
+		{name: "empty hash", hash: nil, password: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ph.ComparePassword(tt.hash, tt.password); got != tt.want {
+				t.Errorf("ComparePassword() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashPasswordUsesRandomSalt(t *testing.T) {
+	ph := NewPasswordHasher(testParams)
+
+	first, err := ph.HashPassword("password")
+	if err != nil {
+		t.Fatalf("hash password: %v", err)
+	}
+	second, err := ph.HashPassword("password")
+	if err != nil {
+		t.Fatalf("hash password: %v", err)
+	}
+
+	if string(first) == string(second) {
+		t.Error("hashes of the same password are equal")
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	weak := NewPasswordHasher(testParams)
+	current := NewPasswordHasher(Argon2Params{Memory: 16 * 1024, Iterations: 2, Parallelism: 1})
+
+	weakHash, err := weak.HashPassword("password")
+	if err != nil {
+		t.Fatalf("hash password: %v", err)
+	}
+	currentHash, err := current.HashPassword("password")
+	if err != nil {
+		t.Fatalf("hash password: %v", err)
+	}
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt hash: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		hash []byte
+		want bool
+	}{
+		{name: "current parameters", hash: currentHash, want: false},
+		{name: "weaker parameters", hash: weakHash, want: true},
+		{name: "bcrypt", hash: bcryptHash, want: true},
+		{name: "malformed", hash: []byte("$argon2id$broken"), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := current.NeedsRehash(tt.hash); got != tt.want {
+				t.Errorf("NeedsRehash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeArgon2id(t *testing.T) {
+	tests := []struct {
+		name    string
+		hash    string
+		want    Argon2Params
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			hash: "$argon2id$v=19$m=65536,t=3,p=2$c2FsdHNhbHQ$a2V5a2V5",
+			want: Argon2Params{Memory: 65536, Iterations: 3, Parallelism: 2, SaltLength: 8, KeyLength: 6},
+		},
+		{name: "wrong parts count", hash: "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA", wantErr: true},
+		{name: "unsupported version", hash: "$argon2id$v=16$m=65536,t=3,p=2$c2FsdA$a2V5", wantErr: true},
+		{name: "bad parameters", hash: "$argon2id$v=19$memory$c2FsdA$a2V5", wantErr: true},
+		{name: "zero parallelism", hash: "$argon2id$v=19$m=65536,t=3,p=0$c2FsdA$a2V5", wantErr: true},
+		{name: "bad salt", hash: "$argon2id$v=19$m=65536,t=3,p=2$!!!$a2V5", wantErr: true},
+		{name: "bad key", hash: "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$!!!", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, _, _, err := decodeArgon2id([]byte(tt.hash))
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidHash) {
+					t.Fatalf("decodeArgon2id() error = %v, want %v", err, ErrInvalidHash)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decodeArgon2id() error = %v", err)
+			}
+			if *params != tt.want {
+				t.Errorf("decodeArgon2id() = %+v, want %+v", *params, tt.want)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package jwt
 
 import (
-	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -21,68 +24,230 @@ type JWTManager struct {
 	issuer           string
 	accessExpiresIn  time.Duration
 	refreshExpiresIn time.Duration
-	publicKey        interface{}
-	privateKey       interface{}
+	// gracePeriod is how long a key is accepted after it stopped being active.
+	// It shouldn't be shorter than the longest token lifetime.
+	gracePeriod time.Duration
+
+	mu     sync.RWMutex
+	active *signingKey
+	keys   map[string]*signingKey
 }
 
-func NewJWTManager(issuer string, AccessExpiresIn, RefreshExpiresIn time.Duration, publicKey, privateKey []byte) (*JWTManager, error) {
-	pubKey, err := jwt.ParseEdPublicKeyFromPEM(publicKey)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrKeyParsing, err)
+func NewJWTManager(
+	issuer string,
+	AccessExpiresIn, RefreshExpiresIn, gracePeriod time.Duration,
+	keys []Key,
+	activeKID string,
+) (*JWTManager, error) {
+	j := &JWTManager{
+		issuer:           issuer,
+		accessExpiresIn:  AccessExpiresIn,
+		refreshExpiresIn: RefreshExpiresIn,
+		gracePeriod:      gracePeriod,
 	}
 
-	privKey, err := jwt.ParseEdPrivateKeyFromPEM(privateKey)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrKeyParsing, err)
+	if err := j.SetKeys(keys, activeKID); err != nil {
+		return nil, err
 	}
 
-	return &JWTManager{
-		issuer:           issuer,
-		accessExpiresIn:  AccessExpiresIn,
-		refreshExpiresIn: RefreshExpiresIn,
-		publicKey:        pubKey,
-		privateKey:       privKey,
-	}, nil
+	return j, nil
 }
 
 func (j *JWTManager) GetRefreshExpiresIn() time.Duration {
 	return j.refreshExpiresIn
 }
 
-func (j *JWTManager) IssueAccessToken(userID string) (string, error) {
+func (j *JWTManager) GetAccessExpiresIn() time.Duration {
+	return j.accessExpiresIn
+}
+
+// IssueAccessToken issues access token of the user and returns it with its jti,
+// so the token can be put to the denylist without parsing it.
+func (j *JWTManager) IssueAccessToken(userID string) (string, string, error) {
+	jti, err := newJTI()
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %s", ErrTokenGeneration, err)
+	}
+
 	claims := jwt.MapClaims{
 		"iss":  j.issuer,
 		"sub":  userID,
+		"jti":  jti,
 		"iat":  time.Now().Unix(),
 		"exp":  time.Now().Add(j.accessExpiresIn).Unix(),
 		"type": "access",
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token, err := j.sign(claims)
+	if err != nil {
+		return "", "", err
+	}
+	return token, jti, nil
+}
 
-	signed, err := token.SignedString(j.privateKey.(ed25519.PrivateKey))
+// IssueImpersonationToken issues access token of the user on behalf of the admin.
+// The admin is put to the "act" claim (RFC 8693), the token has no refresh token.
+func (j *JWTManager) IssueImpersonationToken(userID, actorID string, expiresIn time.Duration) (string, string, error) {
+	jti, err := newJTI()
 	if err != nil {
-		return "", fmt.Errorf("%w: %s", ErrSigning, err)
+		return "", "", fmt.Errorf("%w: %s", ErrTokenGeneration, err)
 	}
-	return signed, nil
+
+	claims := jwt.MapClaims{
+		"iss":  j.issuer,
+		"sub":  userID,
+		"jti":  jti,
+		"act":  map[string]interface{}{"sub": actorID},
+		"iat":  time.Now().Unix(),
+		"exp":  time.Now().Add(expiresIn).Unix(),
+		"type": "access",
+	}
+
+	token, err := j.sign(claims)
+	if err != nil {
+		return "", "", err
+	}
+	return token, jti, nil
 }
 
-func (j *JWTManager) IssueRefreshToken(userID string) (string, error) {
+// IssueRefreshToken issues refresh token which belongs to the given token family.
+// Every refresh token has uniq jti, so rotated tokens never collide.
+func (j *JWTManager) IssueRefreshToken(userID, familyID string) (string, error) {
+	jti, err := newJTI()
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrTokenGeneration, err)
+	}
+
 	claims := jwt.MapClaims{
 		"iss":  j.issuer,
 		"sub":  userID,
+		"jti":  jti,
+		"fid":  familyID,
 		"iat":  time.Now().Unix(),
 		"exp":  time.Now().Add(j.refreshExpiresIn).Unix(),
 		"type": "refresh",
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	return j.sign(claims)
+}
+
+// IssueVerificationToken issues token which proves that the user owns the email.
+func (j *JWTManager) IssueVerificationToken(userID, email string, expiresIn time.Duration) (string, error) {
+	claims := jwt.MapClaims{
+		"iss":   j.issuer,
+		"sub":   userID,
+		"email": email,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(expiresIn).Unix(),
+		"type":  "email_verification",
+	}
+
+	return j.sign(claims)
+}
+
+// IssueInviteToken issues token which lets the user imported by admin
+// to set the first password.
+func (j *JWTManager) IssueInviteToken(userID, email string, expiresIn time.Duration) (string, error) {
+	claims := jwt.MapClaims{
+		"iss":   j.issuer,
+		"sub":   userID,
+		"email": email,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(expiresIn).Unix(),
+		"type":  "invite",
+	}
+
+	return j.sign(claims)
+}
 
-	signed, err := token.SignedString(j.privateKey.(ed25519.PrivateKey))
+// IssueMFAToken issues short-lived token which confirms that the user passed
+// the first login step and has to pass the second one.
+func (j *JWTManager) IssueMFAToken(userID string, expiresIn time.Duration) (string, error) {
+	jti, err := newJTI()
 	if err != nil {
-		return "", fmt.Errorf("%w: %s", ErrSigning, err)
+		return "", fmt.Errorf("%w: %s", ErrTokenGeneration, err)
 	}
-	return signed, nil
+
+	claims := jwt.MapClaims{
+		"iss":  j.issuer,
+		"sub":  userID,
+		"jti":  jti,
+		"iat":  time.Now().Unix(),
+		"exp":  time.Now().Add(expiresIn).Unix(),
+		"type": "mfa_pending",
+	}
+
+	return j.sign(claims)
+}
+
+// IssueClientToken issues access token of the service client.
+// Granted scopes are put to the "scope" claim separated by spaces (RFC 8693).
+func (j *JWTManager) IssueClientToken(clientID string, scopes []string, expiresIn time.Duration) (string, error) {
+	jti, err := newJTI()
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrTokenGeneration, err)
+	}
+
+	claims := jwt.MapClaims{
+		"iss":   j.issuer,
+		"sub":   clientID,
+		"jti":   jti,
+		"scope": strings.Join(scopes, " "),
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(expiresIn).Unix(),
+		"type":  "client",
+	}
+
+	return j.sign(claims)
+}
+
+// IssueIDToken issues OIDC ID token for the relying party.
+// OIDC issuer is an URL, so it is passed apart from the issuer of the manager.
+// Extra holds user claims granted by scopes, e.g. email or name.
+func (j *JWTManager) IssueIDToken(
+	issuer, clientID, userID, nonce string,
+	authTime time.Time,
+	expiresIn time.Duration,
+	extra map[string]interface{},
+) (string, error) {
+	claims := jwt.MapClaims{}
+	for k, v := range extra {
+		claims[k] = v
+	}
+
+	claims["iss"] = issuer
+	claims["sub"] = userID
+	claims["aud"] = clientID
+	claims["iat"] = time.Now().Unix()
+	claims["exp"] = time.Now().Add(expiresIn).Unix()
+	claims["auth_time"] = authTime.Unix()
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+
+	return j.sign(claims)
+}
+
+// IssueOIDCAccessToken issues access token of the relying party
+// which is accepted only by OIDC userinfo endpoint.
+func (j *JWTManager) IssueOIDCAccessToken(userID, clientID string, scopes []string, expiresIn time.Duration) (string, error) {
+	jti, err := newJTI()
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrTokenGeneration, err)
+	}
+
+	claims := jwt.MapClaims{
+		"iss":   j.issuer,
+		"sub":   userID,
+		"aud":   clientID,
+		"jti":   jti,
+		"scope": strings.Join(scopes, " "),
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(expiresIn).Unix(),
+		"type":  "oidc_access",
+	}
+
+	return j.sign(claims)
 }
 
 func (j *JWTManager) VerifyToken(tokenString string) (*jwt.Token, error) {
@@ -90,7 +255,7 @@ func (j *JWTManager) VerifyToken(tokenString string) (*jwt.Token, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
 			return nil, ErrValidation
 		}
-		return j.publicKey, nil
+		return j.verificationKey(token)
 	},
 		jwt.WithIssuer(j.issuer),
 		jwt.WithExpirationRequired(),
@@ -101,3 +266,27 @@ func (j *JWTManager) VerifyToken(tokenString string) (*jwt.Token, error) {
 
 	return token, nil
 }
+
+// sign signs claims with the active key and puts its kid to the header.
+func (j *JWTManager) sign(claims jwt.MapClaims) (string, error) {
+	j.mu.RLock()
+	key := j.active
+	j.mu.RUnlock()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = key.id
+
+	signed, err := token.SignedString(key.privateKey)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrSigning, err)
+	}
+	return signed, nil
+}
+
+func newJTI() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,258 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	keyFileExt     = ".pem"
+	retiredFileExt = ".retired"
+)
+
+// Key is Ed25519 signing key identified by kid.
+// RetiredAt is zero unless the key was retired before.
+type Key struct {
+	ID         string
+	PrivateKey ed25519.PrivateKey
+	RetiredAt  time.Time
+}
+
+// LoadKeys loads Ed25519 private keys in PEM format from the directory.
+// The file name without extension is used as kid, e.g. 2024-12.pem is "2024-12".
+// Retirement time of the key is read from <kid>.retired (RFC 3339) if it exists.
+// Hidden files are skipped, so a mounted k8s secret can be used as is.
+func LoadKeys(dir string) ([]Key, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrKeyParsing, err)
+	}
+
+	var keys []Key
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || filepath.Ext(name) != keyFileExt {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrKeyParsing, err)
+		}
+
+		privKey, err := jwt.ParseEdPrivateKeyFromPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %s", ErrKeyParsing, name, err)
+		}
+
+		kid := strings.TrimSuffix(name, keyFileExt)
+		retiredAt, err := loadRetiredAt(dir, kid)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %s", ErrKeyParsing, kid+retiredFileExt, err)
+		}
+
+		keys = append(keys, Key{
+			ID:         kid,
+			PrivateKey: privKey.(ed25519.PrivateKey),
+			RetiredAt:  retiredAt,
+		})
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: no keys in %s", ErrKeyParsing, dir)
+	}
+
+	return keys, nil
+}
+
+func loadRetiredAt(dir, kid string) (time.Time, error) {
+	data, err := os.ReadFile(filepath.Join(dir, kid+retiredFileExt))
+	if errors.Is(err, os.ErrNotExist) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Parse(time.RFC3339, strings.TrimSpace(string(data)))
+}
+
+// signingKey is a key known to the manager.
+// Key which isn't active anymore is accepted for verification
+// until the grace period after retiredAt is over.
+// retiredSaved is false while retiredAt is known only in memory.
+type signingKey struct {
+	id           string
+	privateKey   ed25519.PrivateKey
+	publicKey    ed25519.PublicKey
+	retiredAt    time.Time
+	retiredSaved bool
+}
+
+func (k *signingKey) usable(now time.Time, gracePeriod time.Duration) bool {
+	return k.retiredAt.IsZero() || now.Before(k.retiredAt.Add(gracePeriod))
+}
+
+// SetKeys replaces key set of the manager. Tokens are signed with the key activeKID,
+// if it is empty the last key in kid order is used. The rest keys are accepted
+// by VerifyToken during the grace period since they stopped being active.
+// Retirement time loaded with the key wins over the one seen by this process,
+// see SaveRetirements. Keys which are missing in the new set are dropped at once.
+func (j *JWTManager) SetKeys(keys []Key, activeKID string) error {
+	if len(keys) == 0 {
+		return fmt.Errorf("%w: empty key set", ErrKeyParsing)
+	}
+
+	if activeKID == "" {
+		ids := make([]string, 0, len(keys))
+		for _, key := range keys {
+			ids = append(ids, key.ID)
+		}
+		sort.Strings(ids)
+		activeKID = ids[len(ids)-1]
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	now := time.Now()
+	newKeys := make(map[string]*signingKey, len(keys))
+	for _, key := range keys {
+		sk := &signingKey{
+			id:         key.ID,
+			privateKey: key.PrivateKey,
+			publicKey:  key.PrivateKey.Public().(ed25519.PublicKey),
+		}
+
+		if key.ID != activeKID {
+			switch old, ok := j.keys[key.ID]; {
+			case !key.RetiredAt.IsZero():
+				sk.retiredAt = key.RetiredAt
+				sk.retiredSaved = true
+			case ok && !old.retiredAt.IsZero():
+				sk.retiredAt = old.retiredAt
+				sk.retiredSaved = old.retiredSaved
+			default:
+				sk.retiredAt = now
+			}
+		}
+
+		newKeys[key.ID] = sk
+	}
+
+	active, ok := newKeys[activeKID]
+	if !ok {
+		return fmt.Errorf("%w: active key %q not found", ErrKeyParsing, activeKID)
+	}
+
+	j.keys = newKeys
+	j.active = active
+
+	return nil
+}
+
+// SaveRetirements writes retirement time of the keys retired by this process
+// to <kid>.retired in the keys directory. Without it the grace period would
+// start over after restart. If the directory is read-only (e.g. mounted k8s
+// secret) the files have to be added to the key set by the operator.
+func (j *JWTManager) SaveRetirements(dir string) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	var errs []error
+	for _, key := range j.keys {
+		if key.retiredAt.IsZero() || key.retiredSaved {
+			continue
+		}
+
+		path := filepath.Join(dir, key.id+retiredFileExt)
+		data := []byte(key.retiredAt.UTC().Format(time.RFC3339) + "\n")
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		key.retiredSaved = true
+	}
+
+	return errors.Join(errs...)
+}
+
+// JWK is Ed25519 public key in JSON Web Key format (RFC 8037).
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+}
+
+// JWKS is JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns public keys which tokens can be verified with:
+// the active key and keys in the grace period.
+func (j *JWTManager) JWKS() JWKS {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	now := time.Now()
+	set := JWKS{Keys: make([]JWK, 0, len(j.keys))}
+	for _, key := range j.keys {
+		if !key.usable(now, j.gracePeriod) {
+			continue
+		}
+
+		set.Keys = append(set.Keys, JWK{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(key.publicKey),
+			Kid: key.id,
+			Use: "sig",
+			Alg: "EdDSA",
+		})
+	}
+
+	sort.Slice(set.Keys, func(a, b int) bool { return set.Keys[a].Kid < set.Keys[b].Kid })
+
+	return set
+}
+
+// verificationKey returns public key to verify the token with.
+// Tokens issued before key rotation was introduced don't have kid,
+// they are checked against all usable keys.
+func (j *JWTManager) verificationKey(token *jwt.Token) (interface{}, error) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	now := time.Now()
+
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		var set jwt.VerificationKeySet
+		for _, key := range j.keys {
+			if key.usable(now, j.gracePeriod) {
+				set.Keys = append(set.Keys, key.publicKey)
+			}
+		}
+		return set, nil
+	}
+
+	key, ok := j.keys[kid]
+	if !ok || !key.usable(now, j.gracePeriod) {
+		return nil, fmt.Errorf("%w: unknown kid %q", ErrValidation, kid)
+	}
+
+	return key.publicKey, nil
+}
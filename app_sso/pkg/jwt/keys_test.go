@@ -0,0 +1,174 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+)
+
+const (
+	testIssuer      = "sso.test"
+	testGracePeriod = time.Hour
+)
+
+func newTestKey(t *testing.T, kid string) Key {
+	t.Helper()
+
+	_, privKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Key{ID: kid, PrivateKey: privKey}
+}
+
+func newTestManager(t *testing.T, keys []Key, activeKID string) *JWTManager {
+	t.Helper()
+
+	j, err := NewJWTManager(testIssuer, time.Minute, time.Hour, testGracePeriod, keys, activeKID)
+	if err != nil {
+		t.Fatalf("NewJWTManager() error = %v", err)
+	}
+	return j
+}
+
+func TestSignWithActiveKey(t *testing.T) {
+	older, newer := newTestKey(t, "2024-11"), newTestKey(t, "2024-12")
+
+	tests := []struct {
+		name      string
+		activeKID string
+		wantKID   string
+	}{
+		{name: "explicit active key", activeKID: "2024-11", wantKID: "2024-11"},
+		{name: "last kid by default", activeKID: "", wantKID: "2024-12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := newTestManager(t, []Key{newer, older}, tt.activeKID)
+
+			token, _, err := j.IssueAccessToken("user")
+			if err != nil {
+				t.Fatalf("IssueAccessToken() error = %v", err)
+			}
+
+			parsed, err := j.VerifyToken(token)
+			if err != nil {
+				t.Fatalf("VerifyToken() error = %v", err)
+			}
+			if kid := parsed.Header["kid"]; kid != tt.wantKID {
+				t.Errorf("token kid = %v, want %s", kid, tt.wantKID)
+			}
+		})
+	}
+}
+
+func TestSetKeysUnknownActiveKey(t *testing.T) {
+	j := newTestManager(t, []Key{newTestKey(t, "2024-12")}, "")
+
+	err := j.SetKeys([]Key{newTestKey(t, "2025-01")}, "2025-02")
+	if !errors.Is(err, ErrKeyParsing) {
+		t.Fatalf("SetKeys() error = %v, want %v", err, ErrKeyParsing)
+	}
+
+	// Key set is kept on error
+	if _, _, err := j.IssueAccessToken("user"); err != nil {
+		t.Errorf("IssueAccessToken() after failed SetKeys() error = %v", err)
+	}
+}
+
+func TestRetiredKeyGracePeriod(t *testing.T) {
+	oldKey, newKey := newTestKey(t, "2024-11"), newTestKey(t, "2024-12")
+
+	j := newTestManager(t, []Key{oldKey}, "")
+	oldToken, _, err := j.IssueAccessToken("user")
+	if err != nil {
+		t.Fatalf("IssueAccessToken() error = %v", err)
+	}
+
+	// Rotation: the old key is retired now and is still in the grace period
+	if err := j.SetKeys([]Key{oldKey, newKey}, "2024-12"); err != nil {
+		t.Fatalf("SetKeys() error = %v", err)
+	}
+	if _, err := j.VerifyToken(oldToken); err != nil {
+		t.Errorf("VerifyToken() with retired key in grace period error = %v", err)
+	}
+	if kids := jwksKIDs(j.JWKS()); len(kids) != 2 {
+		t.Errorf("JWKS() kids = %v, want both keys", kids)
+	}
+
+	// Retirement loaded with the key set wins, the grace period is over
+	oldKey.RetiredAt = time.Now().Add(-testGracePeriod - time.Minute)
+	if err := j.SetKeys([]Key{oldKey, newKey}, "2024-12"); err != nil {
+		t.Fatalf("SetKeys() error = %v", err)
+	}
+	if _, err := j.VerifyToken(oldToken); !errors.Is(err, ErrValidation) {
+		t.Errorf("VerifyToken() with retired key after grace period error = %v, want %v", err, ErrValidation)
+	}
+	if kids := jwksKIDs(j.JWKS()); len(kids) != 1 || kids[0] != "2024-12" {
+		t.Errorf("JWKS() kids = %v, want [2024-12]", kids)
+	}
+
+	// Key removed from the set is dropped at once
+	newToken, _, err := j.IssueAccessToken("user")
+	if err != nil {
+		t.Fatalf("IssueAccessToken() error = %v", err)
+	}
+	if err := j.SetKeys([]Key{newTestKey(t, "2025-01")}, ""); err != nil {
+		t.Fatalf("SetKeys() error = %v", err)
+	}
+	if _, err := j.VerifyToken(newToken); !errors.Is(err, ErrValidation) {
+		t.Errorf("VerifyToken() with removed key error = %v, want %v", err, ErrValidation)
+	}
+}
+
+func TestRetirementKeptAcrossReload(t *testing.T) {
+	oldKey, newKey := newTestKey(t, "2024-11"), newTestKey(t, "2024-12")
+
+	j := newTestManager(t, []Key{oldKey, newKey}, "")
+	retiredAt := j.keys["2024-11"].retiredAt
+
+	// Reloading the same set mustn't restart the grace period
+	if err := j.SetKeys([]Key{oldKey, newKey}, ""); err != nil {
+		t.Fatalf("SetKeys() error = %v", err)
+	}
+	if got := j.keys["2024-11"].retiredAt; !got.Equal(retiredAt) {
+		t.Errorf("retiredAt after reload = %v, want %v", got, retiredAt)
+	}
+	if got := j.keys["2024-12"].retiredAt; !got.IsZero() {
+		t.Errorf("active key retiredAt = %v, want zero", got)
+	}
+}
+
+func TestJWKS(t *testing.T) {
+	key := newTestKey(t, "2024-12")
+	j := newTestManager(t, []Key{key}, "")
+
+	set := j.JWKS()
+	if len(set.Keys) != 1 {
+		t.Fatalf("JWKS() keys = %d, want 1", len(set.Keys))
+	}
+
+	want := JWK{
+		Kty: "OKP",
+		Crv: "Ed25519",
+		X:   base64.RawURLEncoding.EncodeToString(key.PrivateKey.Public().(ed25519.PublicKey)),
+		Kid: "2024-12",
+		Use: "sig",
+		Alg: "EdDSA",
+	}
+	if set.Keys[0] != want {
+		t.Errorf("JWKS() key = %+v, want %+v", set.Keys[0], want)
+	}
+}
+
+func jwksKIDs(set JWKS) []string {
+	kids := make([]string, 0, len(set.Keys))
+	for _, key := range set.Keys {
+		kids = append(kids, key.Kid)
+	}
+	return kids
+}
@@ -0,0 +1,1641 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: lp.proto
+
+package lp1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LearningPlatform_CreateChannel_FullMethodName                     = "/lp.v1.LearningPlatform/CreateChannel"
+	LearningPlatform_GetChannel_FullMethodName                        = "/lp.v1.LearningPlatform/GetChannel"
+	LearningPlatform_GetChannels_FullMethodName                       = "/lp.v1.LearningPlatform/GetChannels"
+	LearningPlatform_UpdateChannel_FullMethodName                     = "/lp.v1.LearningPlatform/UpdateChannel"
+	LearningPlatform_DeleteChannel_FullMethodName                     = "/lp.v1.LearningPlatform/DeleteChannel"
+	LearningPlatform_ShareChannelToGroup_FullMethodName               = "/lp.v1.LearningPlatform/ShareChannelToGroup"
+	LearningPlatform_IsChannelCreator_FullMethodName                  = "/lp.v1.LearningPlatform/IsChannelCreator"
+	LearningPlatform_GetLearningGroupsShareWithChannel_FullMethodName = "/lp.v1.LearningPlatform/GetLearningGroupsShareWithChannel"
+	LearningPlatform_CreatePlan_FullMethodName                        = "/lp.v1.LearningPlatform/CreatePlan"
+	LearningPlatform_GetPlan_FullMethodName                           = "/lp.v1.LearningPlatform/GetPlan"
+	LearningPlatform_GetPlans_FullMethodName                          = "/lp.v1.LearningPlatform/GetPlans"
+	LearningPlatform_GetPlansAll_FullMethodName                       = "/lp.v1.LearningPlatform/GetPlansAll"
+	LearningPlatform_UpdatePlan_FullMethodName                        = "/lp.v1.LearningPlatform/UpdatePlan"
+	LearningPlatform_DeletePlan_FullMethodName                        = "/lp.v1.LearningPlatform/DeletePlan"
+	LearningPlatform_SharePlanWithUsers_FullMethodName                = "/lp.v1.LearningPlatform/SharePlanWithUsers"
+	LearningPlatform_IsUserShareWithPlan_FullMethodName               = "/lp.v1.LearningPlatform/IsUserShareWithPlan"
+	LearningPlatform_GetPlansForSharing_FullMethodName                = "/lp.v1.LearningPlatform/GetPlansForSharing"
+	LearningPlatform_CreateLesson_FullMethodName                      = "/lp.v1.LearningPlatform/CreateLesson"
+	LearningPlatform_GetLesson_FullMethodName                         = "/lp.v1.LearningPlatform/GetLesson"
+	LearningPlatform_GetLessons_FullMethodName                        = "/lp.v1.LearningPlatform/GetLessons"
+	LearningPlatform_UpdateLesson_FullMethodName                      = "/lp.v1.LearningPlatform/UpdateLesson"
+	LearningPlatform_DeleteLesson_FullMethodName                      = "/lp.v1.LearningPlatform/DeleteLesson"
+	LearningPlatform_CreateImagePage_FullMethodName                   = "/lp.v1.LearningPlatform/CreateImagePage"
+	LearningPlatform_CreatePDFPage_FullMethodName                     = "/lp.v1.LearningPlatform/CreatePDFPage"
+	LearningPlatform_CreateVideoPage_FullMethodName                   = "/lp.v1.LearningPlatform/CreateVideoPage"
+	LearningPlatform_GetImagePage_FullMethodName                      = "/lp.v1.LearningPlatform/GetImagePage"
+	LearningPlatform_GetVideoPage_FullMethodName                      = "/lp.v1.LearningPlatform/GetVideoPage"
+	LearningPlatform_GetPDFPage_FullMethodName                        = "/lp.v1.LearningPlatform/GetPDFPage"
+	LearningPlatform_GetPages_FullMethodName                          = "/lp.v1.LearningPlatform/GetPages"
+	LearningPlatform_UpdateImagePage_FullMethodName                   = "/lp.v1.LearningPlatform/UpdateImagePage"
+	LearningPlatform_UpdatePDFPage_FullMethodName                     = "/lp.v1.LearningPlatform/UpdatePDFPage"
+	LearningPlatform_UpdateVideoPage_FullMethodName                   = "/lp.v1.LearningPlatform/UpdateVideoPage"
+	LearningPlatform_DeletePage_FullMethodName                        = "/lp.v1.LearningPlatform/DeletePage"
+	LearningPlatform_CreateQuestionPage_FullMethodName                = "/lp.v1.LearningPlatform/CreateQuestionPage"
+	LearningPlatform_GetQuestionPage_FullMethodName                   = "/lp.v1.LearningPlatform/GetQuestionPage"
+	LearningPlatform_UpdateQuestionPage_FullMethodName                = "/lp.v1.LearningPlatform/UpdateQuestionPage"
+	LearningPlatform_TryLesson_FullMethodName                         = "/lp.v1.LearningPlatform/TryLesson"
+	LearningPlatform_UpdatePageAttempt_FullMethodName                 = "/lp.v1.LearningPlatform/UpdatePageAttempt"
+	LearningPlatform_CompleteLesson_FullMethodName                    = "/lp.v1.LearningPlatform/CompleteLesson"
+	LearningPlatform_GetLessonAttempts_FullMethodName                 = "/lp.v1.LearningPlatform/GetLessonAttempts"
+	LearningPlatform_CheckPermissionForUser_FullMethodName            = "/lp.v1.LearningPlatform/CheckPermissionForUser"
+)
+
+// LearningPlatformClient is the client API for LearningPlatform service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LearningPlatformClient interface {
+	CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*CreateChannelResponse, error)
+	GetChannel(ctx context.Context, in *GetChannelRequest, opts ...grpc.CallOption) (*GetChannelResponse, error)
+	GetChannels(ctx context.Context, in *GetChannelsRequest, opts ...grpc.CallOption) (*GetChannelsResponse, error)
+	UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*UpdateChannelResponse, error)
+	DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error)
+	ShareChannelToGroup(ctx context.Context, in *ShareChannelToGroupRequest, opts ...grpc.CallOption) (*ShareChannelToGroupResponse, error)
+	IsChannelCreator(ctx context.Context, in *IsChannelCreatorRequest, opts ...grpc.CallOption) (*IsChannelCreatorResponse, error)
+	GetLearningGroupsShareWithChannel(ctx context.Context, in *GetLearningGroupsShareWithChannelRequest, opts ...grpc.CallOption) (*GetLearningGroupsShareWithChannelResponse, error)
+	CreatePlan(ctx context.Context, in *CreatePlanRequest, opts ...grpc.CallOption) (*CreatePlanResponse, error)
+	GetPlan(ctx context.Context, in *GetPlanRequest, opts ...grpc.CallOption) (*GetPlanResponse, error)
+	GetPlans(ctx context.Context, in *GetPlansRequest, opts ...grpc.CallOption) (*GetPlansResponse, error)
+	GetPlansAll(ctx context.Context, in *GetPlansRequest, opts ...grpc.CallOption) (*GetPlansResponse, error)
+	UpdatePlan(ctx context.Context, in *UpdatePlanRequest, opts ...grpc.CallOption) (*UpdatePlanResponse, error)
+	DeletePlan(ctx context.Context, in *DeletePlanRequest, opts ...grpc.CallOption) (*DeletePlanResponse, error)
+	SharePlanWithUsers(ctx context.Context, in *SharePlanWithUsersRequest, opts ...grpc.CallOption) (*SharePlanWithUsersResponse, error)
+	IsUserShareWithPlan(ctx context.Context, in *IsUserShareWithPlanRequest, opts ...grpc.CallOption) (*IsUserShareWithPlanResponse, error)
+	GetPlansForSharing(ctx context.Context, in *GetPlansForSharingRequest, opts ...grpc.CallOption) (*GetPlansForSharingResponse, error)
+	CreateLesson(ctx context.Context, in *CreateLessonRequest, opts ...grpc.CallOption) (*CreateLessonResponse, error)
+	GetLesson(ctx context.Context, in *GetLessonRequest, opts ...grpc.CallOption) (*GetLessonResponse, error)
+	GetLessons(ctx context.Context, in *GetLessonsRequest, opts ...grpc.CallOption) (*GetLessonsResponse, error)
+	UpdateLesson(ctx context.Context, in *UpdateLessonRequest, opts ...grpc.CallOption) (*UpdateLessonResponse, error)
+	DeleteLesson(ctx context.Context, in *DeleteLessonRequest, opts ...grpc.CallOption) (*DeleteLessonResponse, error)
+	CreateImagePage(ctx context.Context, in *CreateImagePageRequest, opts ...grpc.CallOption) (*CreateImagePageResponse, error)
+	CreatePDFPage(ctx context.Context, in *CreatePDFPageRequest, opts ...grpc.CallOption) (*CreatePDFPageResponse, error)
+	CreateVideoPage(ctx context.Context, in *CreateVideoPageRequest, opts ...grpc.CallOption) (*CreateVideoPageResponse, error)
+	GetImagePage(ctx context.Context, in *GetImagePageRequest, opts ...grpc.CallOption) (*GetImagePageResponse, error)
+	GetVideoPage(ctx context.Context, in *GetVideoPageRequest, opts ...grpc.CallOption) (*GetVideoPageResponse, error)
+	GetPDFPage(ctx context.Context, in *GetPDFPageRequest, opts ...grpc.CallOption) (*GetPDFPageResponse, error)
+	GetPages(ctx context.Context, in *GetPagesRequest, opts ...grpc.CallOption) (*GetPagesResponse, error)
+	UpdateImagePage(ctx context.Context, in *UpdateImagePageRequest, opts ...grpc.CallOption) (*UpdateImagePageResponse, error)
+	UpdatePDFPage(ctx context.Context, in *UpdatePDFPageRequest, opts ...grpc.CallOption) (*UpdatePDFPageResponse, error)
+	UpdateVideoPage(ctx context.Context, in *UpdateVideoPageRequest, opts ...grpc.CallOption) (*UpdateVideoPageResponse, error)
+	DeletePage(ctx context.Context, in *DeletePageRequest, opts ...grpc.CallOption) (*DeletePageResponse, error)
+	CreateQuestionPage(ctx context.Context, in *CreateQuestionPageRequest, opts ...grpc.CallOption) (*CreateQuestionPageResponse, error)
+	GetQuestionPage(ctx context.Context, in *GetQuestionPageRequest, opts ...grpc.CallOption) (*GetQuestionPageResponse, error)
+	UpdateQuestionPage(ctx context.Context, in *UpdateQuestionPageRequest, opts ...grpc.CallOption) (*UpdateQuestionPageResponse, error)
+	TryLesson(ctx context.Context, in *TryLessonRequest, opts ...grpc.CallOption) (*TryLessonResponse, error)
+	UpdatePageAttempt(ctx context.Context, in *UpdatePageAttemptRequest, opts ...grpc.CallOption) (*UpdatePageAttemptResponse, error)
+	CompleteLesson(ctx context.Context, in *CompleteLessonRequest, opts ...grpc.CallOption) (*CompleteLessonResponse, error)
+	GetLessonAttempts(ctx context.Context, in *GetLessonAttemptsRequest, opts ...grpc.CallOption) (*GetLessonAttemptsResponse, error)
+	CheckPermissionForUser(ctx context.Context, in *CheckPermissionForUserRequest, opts ...grpc.CallOption) (*CheckPermissionForUserResponse, error)
+}
+
+type learningPlatformClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLearningPlatformClient(cc grpc.ClientConnInterface) LearningPlatformClient {
+	return &learningPlatformClient{cc}
+}
+
+func (c *learningPlatformClient) CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*CreateChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChannelResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_CreateChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetChannel(ctx context.Context, in *GetChannelRequest, opts ...grpc.CallOption) (*GetChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetChannels(ctx context.Context, in *GetChannelsRequest, opts ...grpc.CallOption) (*GetChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelsResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*UpdateChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateChannelResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_UpdateChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChannelResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_DeleteChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) ShareChannelToGroup(ctx context.Context, in *ShareChannelToGroupRequest, opts ...grpc.CallOption) (*ShareChannelToGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareChannelToGroupResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_ShareChannelToGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) IsChannelCreator(ctx context.Context, in *IsChannelCreatorRequest, opts ...grpc.CallOption) (*IsChannelCreatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsChannelCreatorResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_IsChannelCreator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetLearningGroupsShareWithChannel(ctx context.Context, in *GetLearningGroupsShareWithChannelRequest, opts ...grpc.CallOption) (*GetLearningGroupsShareWithChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLearningGroupsShareWithChannelResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetLearningGroupsShareWithChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) CreatePlan(ctx context.Context, in *CreatePlanRequest, opts ...grpc.CallOption) (*CreatePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePlanResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_CreatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetPlan(ctx context.Context, in *GetPlanRequest, opts ...grpc.CallOption) (*GetPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlanResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetPlans(ctx context.Context, in *GetPlansRequest, opts ...grpc.CallOption) (*GetPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlansResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetPlansAll(ctx context.Context, in *GetPlansRequest, opts ...grpc.CallOption) (*GetPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlansResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetPlansAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) UpdatePlan(ctx context.Context, in *UpdatePlanRequest, opts ...grpc.CallOption) (*UpdatePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePlanResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_UpdatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) DeletePlan(ctx context.Context, in *DeletePlanRequest, opts ...grpc.CallOption) (*DeletePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePlanResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_DeletePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) SharePlanWithUsers(ctx context.Context, in *SharePlanWithUsersRequest, opts ...grpc.CallOption) (*SharePlanWithUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharePlanWithUsersResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_SharePlanWithUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) IsUserShareWithPlan(ctx context.Context, in *IsUserShareWithPlanRequest, opts ...grpc.CallOption) (*IsUserShareWithPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsUserShareWithPlanResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_IsUserShareWithPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetPlansForSharing(ctx context.Context, in *GetPlansForSharingRequest, opts ...grpc.CallOption) (*GetPlansForSharingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlansForSharingResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetPlansForSharing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) CreateLesson(ctx context.Context, in *CreateLessonRequest, opts ...grpc.CallOption) (*CreateLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLessonResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_CreateLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetLesson(ctx context.Context, in *GetLessonRequest, opts ...grpc.CallOption) (*GetLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLessonResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetLessons(ctx context.Context, in *GetLessonsRequest, opts ...grpc.CallOption) (*GetLessonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLessonsResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetLessons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) UpdateLesson(ctx context.Context, in *UpdateLessonRequest, opts ...grpc.CallOption) (*UpdateLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLessonResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_UpdateLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) DeleteLesson(ctx context.Context, in *DeleteLessonRequest, opts ...grpc.CallOption) (*DeleteLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLessonResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_DeleteLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) CreateImagePage(ctx context.Context, in *CreateImagePageRequest, opts ...grpc.CallOption) (*CreateImagePageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateImagePageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_CreateImagePage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) CreatePDFPage(ctx context.Context, in *CreatePDFPageRequest, opts ...grpc.CallOption) (*CreatePDFPageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePDFPageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_CreatePDFPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) CreateVideoPage(ctx context.Context, in *CreateVideoPageRequest, opts ...grpc.CallOption) (*CreateVideoPageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVideoPageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_CreateVideoPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetImagePage(ctx context.Context, in *GetImagePageRequest, opts ...grpc.CallOption) (*GetImagePageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetImagePageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetImagePage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetVideoPage(ctx context.Context, in *GetVideoPageRequest, opts ...grpc.CallOption) (*GetVideoPageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVideoPageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetVideoPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetPDFPage(ctx context.Context, in *GetPDFPageRequest, opts ...grpc.CallOption) (*GetPDFPageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPDFPageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetPDFPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetPages(ctx context.Context, in *GetPagesRequest, opts ...grpc.CallOption) (*GetPagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPagesResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetPages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) UpdateImagePage(ctx context.Context, in *UpdateImagePageRequest, opts ...grpc.CallOption) (*UpdateImagePageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateImagePageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_UpdateImagePage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) UpdatePDFPage(ctx context.Context, in *UpdatePDFPageRequest, opts ...grpc.CallOption) (*UpdatePDFPageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePDFPageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_UpdatePDFPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) UpdateVideoPage(ctx context.Context, in *UpdateVideoPageRequest, opts ...grpc.CallOption) (*UpdateVideoPageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVideoPageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_UpdateVideoPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) DeletePage(ctx context.Context, in *DeletePageRequest, opts ...grpc.CallOption) (*DeletePageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_DeletePage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) CreateQuestionPage(ctx context.Context, in *CreateQuestionPageRequest, opts ...grpc.CallOption) (*CreateQuestionPageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateQuestionPageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_CreateQuestionPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetQuestionPage(ctx context.Context, in *GetQuestionPageRequest, opts ...grpc.CallOption) (*GetQuestionPageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuestionPageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetQuestionPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) UpdateQuestionPage(ctx context.Context, in *UpdateQuestionPageRequest, opts ...grpc.CallOption) (*UpdateQuestionPageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateQuestionPageResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_UpdateQuestionPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) TryLesson(ctx context.Context, in *TryLessonRequest, opts ...grpc.CallOption) (*TryLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TryLessonResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_TryLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) UpdatePageAttempt(ctx context.Context, in *UpdatePageAttemptRequest, opts ...grpc.CallOption) (*UpdatePageAttemptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePageAttemptResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_UpdatePageAttempt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) CompleteLesson(ctx context.Context, in *CompleteLessonRequest, opts ...grpc.CallOption) (*CompleteLessonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteLessonResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_CompleteLesson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) GetLessonAttempts(ctx context.Context, in *GetLessonAttemptsRequest, opts ...grpc.CallOption) (*GetLessonAttemptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLessonAttemptsResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_GetLessonAttempts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningPlatformClient) CheckPermissionForUser(ctx context.Context, in *CheckPermissionForUserRequest, opts ...grpc.CallOption) (*CheckPermissionForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPermissionForUserResponse)
+	err := c.cc.Invoke(ctx, LearningPlatform_CheckPermissionForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LearningPlatformServer is the server API for LearningPlatform service.
+// All implementations must embed UnimplementedLearningPlatformServer
+// for forward compatibility.
+type LearningPlatformServer interface {
+	CreateChannel(context.Context, *CreateChannelRequest) (*CreateChannelResponse, error)
+	GetChannel(context.Context, *GetChannelRequest) (*GetChannelResponse, error)
+	GetChannels(context.Context, *GetChannelsRequest) (*GetChannelsResponse, error)
+	UpdateChannel(context.Context, *UpdateChannelRequest) (*UpdateChannelResponse, error)
+	DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error)
+	ShareChannelToGroup(context.Context, *ShareChannelToGroupRequest) (*ShareChannelToGroupResponse, error)
+	IsChannelCreator(context.Context, *IsChannelCreatorRequest) (*IsChannelCreatorResponse, error)
+	GetLearningGroupsShareWithChannel(context.Context, *GetLearningGroupsShareWithChannelRequest) (*GetLearningGroupsShareWithChannelResponse, error)
+	CreatePlan(context.Context, *CreatePlanRequest) (*CreatePlanResponse, error)
+	GetPlan(context.Context, *GetPlanRequest) (*GetPlanResponse, error)
+	GetPlans(context.Context, *GetPlansRequest) (*GetPlansResponse, error)
+	GetPlansAll(context.Context, *GetPlansRequest) (*GetPlansResponse, error)
+	UpdatePlan(context.Context, *UpdatePlanRequest) (*UpdatePlanResponse, error)
+	DeletePlan(context.Context, *DeletePlanRequest) (*DeletePlanResponse, error)
+	SharePlanWithUsers(context.Context, *SharePlanWithUsersRequest) (*SharePlanWithUsersResponse, error)
+	IsUserShareWithPlan(context.Context, *IsUserShareWithPlanRequest) (*IsUserShareWithPlanResponse, error)
+	GetPlansForSharing(context.Context, *GetPlansForSharingRequest) (*GetPlansForSharingResponse, error)
+	CreateLesson(context.Context, *CreateLessonRequest) (*CreateLessonResponse, error)
+	GetLesson(context.Context, *GetLessonRequest) (*GetLessonResponse, error)
+	GetLessons(context.Context, *GetLessonsRequest) (*GetLessonsResponse, error)
+	UpdateLesson(context.Context, *UpdateLessonRequest) (*UpdateLessonResponse, error)
+	DeleteLesson(context.Context, *DeleteLessonRequest) (*DeleteLessonResponse, error)
+	CreateImagePage(context.Context, *CreateImagePageRequest) (*CreateImagePageResponse, error)
+	CreatePDFPage(context.Context, *CreatePDFPageRequest) (*CreatePDFPageResponse, error)
+	CreateVideoPage(context.Context, *CreateVideoPageRequest) (*CreateVideoPageResponse, error)
+	GetImagePage(context.Context, *GetImagePageRequest) (*GetImagePageResponse, error)
+	GetVideoPage(context.Context, *GetVideoPageRequest) (*GetVideoPageResponse, error)
+	GetPDFPage(context.Context, *GetPDFPageRequest) (*GetPDFPageResponse, error)
+	GetPages(context.Context, *GetPagesRequest) (*GetPagesResponse, error)
+	UpdateImagePage(context.Context, *UpdateImagePageRequest) (*UpdateImagePageResponse, error)
+	UpdatePDFPage(context.Context, *UpdatePDFPageRequest) (*UpdatePDFPageResponse, error)
+	UpdateVideoPage(context.Context, *UpdateVideoPageRequest) (*UpdateVideoPageResponse, error)
+	DeletePage(context.Context, *DeletePageRequest) (*DeletePageResponse, error)
+	CreateQuestionPage(context.Context, *CreateQuestionPageRequest) (*CreateQuestionPageResponse, error)
+	GetQuestionPage(context.Context, *GetQuestionPageRequest) (*GetQuestionPageResponse, error)
+	UpdateQuestionPage(context.Context, *UpdateQuestionPageRequest) (*UpdateQuestionPageResponse, error)
+	TryLesson(context.Context, *TryLessonRequest) (*TryLessonResponse, error)
+	UpdatePageAttempt(context.Context, *UpdatePageAttemptRequest) (*UpdatePageAttemptResponse, error)
+	CompleteLesson(context.Context, *CompleteLessonRequest) (*CompleteLessonResponse, error)
+	GetLessonAttempts(context.Context, *GetLessonAttemptsRequest) (*GetLessonAttemptsResponse, error)
+	CheckPermissionForUser(context.Context, *CheckPermissionForUserRequest) (*CheckPermissionForUserResponse, error)
+	mustEmbedUnimplementedLearningPlatformServer()
+}
+
+// UnimplementedLearningPlatformServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLearningPlatformServer struct{}
+
+func (UnimplementedLearningPlatformServer) CreateChannel(context.Context, *CreateChannelRequest) (*CreateChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChannel not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetChannel(context.Context, *GetChannelRequest) (*GetChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannel not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetChannels(context.Context, *GetChannelsRequest) (*GetChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannels not implemented")
+}
+func (UnimplementedLearningPlatformServer) UpdateChannel(context.Context, *UpdateChannelRequest) (*UpdateChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannel not implemented")
+}
+func (UnimplementedLearningPlatformServer) DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannel not implemented")
+}
+func (UnimplementedLearningPlatformServer) ShareChannelToGroup(context.Context, *ShareChannelToGroupRequest) (*ShareChannelToGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareChannelToGroup not implemented")
+}
+func (UnimplementedLearningPlatformServer) IsChannelCreator(context.Context, *IsChannelCreatorRequest) (*IsChannelCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsChannelCreator not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetLearningGroupsShareWithChannel(context.Context, *GetLearningGroupsShareWithChannelRequest) (*GetLearningGroupsShareWithChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLearningGroupsShareWithChannel not implemented")
+}
+func (UnimplementedLearningPlatformServer) CreatePlan(context.Context, *CreatePlanRequest) (*CreatePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlan not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetPlan(context.Context, *GetPlanRequest) (*GetPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlan not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetPlans(context.Context, *GetPlansRequest) (*GetPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlans not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetPlansAll(context.Context, *GetPlansRequest) (*GetPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlansAll not implemented")
+}
+func (UnimplementedLearningPlatformServer) UpdatePlan(context.Context, *UpdatePlanRequest) (*UpdatePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlan not implemented")
+}
+func (UnimplementedLearningPlatformServer) DeletePlan(context.Context, *DeletePlanRequest) (*DeletePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlan not implemented")
+}
+func (UnimplementedLearningPlatformServer) SharePlanWithUsers(context.Context, *SharePlanWithUsersRequest) (*SharePlanWithUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharePlanWithUsers not implemented")
+}
+func (UnimplementedLearningPlatformServer) IsUserShareWithPlan(context.Context, *IsUserShareWithPlanRequest) (*IsUserShareWithPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsUserShareWithPlan not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetPlansForSharing(context.Context, *GetPlansForSharingRequest) (*GetPlansForSharingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlansForSharing not implemented")
+}
+func (UnimplementedLearningPlatformServer) CreateLesson(context.Context, *CreateLessonRequest) (*CreateLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLesson not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetLesson(context.Context, *GetLessonRequest) (*GetLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLesson not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetLessons(context.Context, *GetLessonsRequest) (*GetLessonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessons not implemented")
+}
+func (UnimplementedLearningPlatformServer) UpdateLesson(context.Context, *UpdateLessonRequest) (*UpdateLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLesson not implemented")
+}
+func (UnimplementedLearningPlatformServer) DeleteLesson(context.Context, *DeleteLessonRequest) (*DeleteLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLesson not implemented")
+}
+func (UnimplementedLearningPlatformServer) CreateImagePage(context.Context, *CreateImagePageRequest) (*CreateImagePageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateImagePage not implemented")
+}
+func (UnimplementedLearningPlatformServer) CreatePDFPage(context.Context, *CreatePDFPageRequest) (*CreatePDFPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePDFPage not implemented")
+}
+func (UnimplementedLearningPlatformServer) CreateVideoPage(context.Context, *CreateVideoPageRequest) (*CreateVideoPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVideoPage not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetImagePage(context.Context, *GetImagePageRequest) (*GetImagePageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImagePage not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetVideoPage(context.Context, *GetVideoPageRequest) (*GetVideoPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideoPage not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetPDFPage(context.Context, *GetPDFPageRequest) (*GetPDFPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPDFPage not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetPages(context.Context, *GetPagesRequest) (*GetPagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPages not implemented")
+}
+func (UnimplementedLearningPlatformServer) UpdateImagePage(context.Context, *UpdateImagePageRequest) (*UpdateImagePageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateImagePage not implemented")
+}
+func (UnimplementedLearningPlatformServer) UpdatePDFPage(context.Context, *UpdatePDFPageRequest) (*UpdatePDFPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePDFPage not implemented")
+}
+func (UnimplementedLearningPlatformServer) UpdateVideoPage(context.Context, *UpdateVideoPageRequest) (*UpdateVideoPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVideoPage not implemented")
+}
+func (UnimplementedLearningPlatformServer) DeletePage(context.Context, *DeletePageRequest) (*DeletePageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePage not implemented")
+}
+func (UnimplementedLearningPlatformServer) CreateQuestionPage(context.Context, *CreateQuestionPageRequest) (*CreateQuestionPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuestionPage not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetQuestionPage(context.Context, *GetQuestionPageRequest) (*GetQuestionPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuestionPage not implemented")
+}
+func (UnimplementedLearningPlatformServer) UpdateQuestionPage(context.Context, *UpdateQuestionPageRequest) (*UpdateQuestionPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuestionPage not implemented")
+}
+func (UnimplementedLearningPlatformServer) TryLesson(context.Context, *TryLessonRequest) (*TryLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TryLesson not implemented")
+}
+func (UnimplementedLearningPlatformServer) UpdatePageAttempt(context.Context, *UpdatePageAttemptRequest) (*UpdatePageAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePageAttempt not implemented")
+}
+func (UnimplementedLearningPlatformServer) CompleteLesson(context.Context, *CompleteLessonRequest) (*CompleteLessonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteLesson not implemented")
+}
+func (UnimplementedLearningPlatformServer) GetLessonAttempts(context.Context, *GetLessonAttemptsRequest) (*GetLessonAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonAttempts not implemented")
+}
+func (UnimplementedLearningPlatformServer) CheckPermissionForUser(context.Context, *CheckPermissionForUserRequest) (*CheckPermissionForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPermissionForUser not implemented")
+}
+func (UnimplementedLearningPlatformServer) mustEmbedUnimplementedLearningPlatformServer() {}
+func (UnimplementedLearningPlatformServer) testEmbeddedByValue()                          {}
+
+// UnsafeLearningPlatformServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LearningPlatformServer will
+// result in compilation errors.
+type UnsafeLearningPlatformServer interface {
+	mustEmbedUnimplementedLearningPlatformServer()
+}
+
+func RegisterLearningPlatformServer(s grpc.ServiceRegistrar, srv LearningPlatformServer) {
+	// If the following call pancis, it indicates UnimplementedLearningPlatformServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LearningPlatform_ServiceDesc, srv)
+}
+
+func _LearningPlatform_CreateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).CreateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_CreateChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).CreateChannel(ctx, req.(*CreateChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetChannel(ctx, req.(*GetChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetChannels(ctx, req.(*GetChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_UpdateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).UpdateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_UpdateChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).UpdateChannel(ctx, req.(*UpdateChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_DeleteChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).DeleteChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_DeleteChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).DeleteChannel(ctx, req.(*DeleteChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_ShareChannelToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareChannelToGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).ShareChannelToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_ShareChannelToGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).ShareChannelToGroup(ctx, req.(*ShareChannelToGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_IsChannelCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsChannelCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).IsChannelCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_IsChannelCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).IsChannelCreator(ctx, req.(*IsChannelCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetLearningGroupsShareWithChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLearningGroupsShareWithChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetLearningGroupsShareWithChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetLearningGroupsShareWithChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetLearningGroupsShareWithChannel(ctx, req.(*GetLearningGroupsShareWithChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_CreatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).CreatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_CreatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).CreatePlan(ctx, req.(*CreatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetPlan(ctx, req.(*GetPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetPlans(ctx, req.(*GetPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetPlansAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetPlansAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetPlansAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetPlansAll(ctx, req.(*GetPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_UpdatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).UpdatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_UpdatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).UpdatePlan(ctx, req.(*UpdatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_DeletePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).DeletePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_DeletePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).DeletePlan(ctx, req.(*DeletePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_SharePlanWithUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharePlanWithUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).SharePlanWithUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_SharePlanWithUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).SharePlanWithUsers(ctx, req.(*SharePlanWithUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_IsUserShareWithPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsUserShareWithPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).IsUserShareWithPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_IsUserShareWithPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).IsUserShareWithPlan(ctx, req.(*IsUserShareWithPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetPlansForSharing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlansForSharingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetPlansForSharing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetPlansForSharing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetPlansForSharing(ctx, req.(*GetPlansForSharingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_CreateLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).CreateLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_CreateLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).CreateLesson(ctx, req.(*CreateLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetLesson(ctx, req.(*GetLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetLessons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetLessons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetLessons(ctx, req.(*GetLessonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_UpdateLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).UpdateLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_UpdateLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).UpdateLesson(ctx, req.(*UpdateLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_DeleteLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).DeleteLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_DeleteLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).DeleteLesson(ctx, req.(*DeleteLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_CreateImagePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateImagePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).CreateImagePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_CreateImagePage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).CreateImagePage(ctx, req.(*CreateImagePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_CreatePDFPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePDFPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).CreatePDFPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_CreatePDFPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).CreatePDFPage(ctx, req.(*CreatePDFPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_CreateVideoPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVideoPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).CreateVideoPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_CreateVideoPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).CreateVideoPage(ctx, req.(*CreateVideoPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetImagePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImagePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetImagePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetImagePage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetImagePage(ctx, req.(*GetImagePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetVideoPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVideoPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetVideoPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetVideoPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetVideoPage(ctx, req.(*GetVideoPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetPDFPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPDFPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetPDFPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetPDFPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetPDFPage(ctx, req.(*GetPDFPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetPages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetPages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetPages(ctx, req.(*GetPagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_UpdateImagePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateImagePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).UpdateImagePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_UpdateImagePage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).UpdateImagePage(ctx, req.(*UpdateImagePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_UpdatePDFPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePDFPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).UpdatePDFPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_UpdatePDFPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).UpdatePDFPage(ctx, req.(*UpdatePDFPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_UpdateVideoPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVideoPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).UpdateVideoPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_UpdateVideoPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).UpdateVideoPage(ctx, req.(*UpdateVideoPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_DeletePage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).DeletePage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_DeletePage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).DeletePage(ctx, req.(*DeletePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_CreateQuestionPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuestionPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).CreateQuestionPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_CreateQuestionPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).CreateQuestionPage(ctx, req.(*CreateQuestionPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetQuestionPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestionPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetQuestionPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetQuestionPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetQuestionPage(ctx, req.(*GetQuestionPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_UpdateQuestionPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuestionPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).UpdateQuestionPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_UpdateQuestionPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).UpdateQuestionPage(ctx, req.(*UpdateQuestionPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_TryLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TryLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).TryLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_TryLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).TryLesson(ctx, req.(*TryLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_UpdatePageAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePageAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).UpdatePageAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_UpdatePageAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).UpdatePageAttempt(ctx, req.(*UpdatePageAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_CompleteLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteLessonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).CompleteLesson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_CompleteLesson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).CompleteLesson(ctx, req.(*CompleteLessonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_GetLessonAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).GetLessonAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_GetLessonAttempts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).GetLessonAttempts(ctx, req.(*GetLessonAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningPlatform_CheckPermissionForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningPlatformServer).CheckPermissionForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningPlatform_CheckPermissionForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningPlatformServer).CheckPermissionForUser(ctx, req.(*CheckPermissionForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LearningPlatform_ServiceDesc is the grpc.ServiceDesc for LearningPlatform service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LearningPlatform_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "lp.v1.LearningPlatform",
+	HandlerType: (*LearningPlatformServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateChannel",
+			Handler:    _LearningPlatform_CreateChannel_Handler,
+		},
+		{
+			MethodName: "GetChannel",
+			Handler:    _LearningPlatform_GetChannel_Handler,
+		},
+		{
+			MethodName: "GetChannels",
+			Handler:    _LearningPlatform_GetChannels_Handler,
+		},
+		{
+			MethodName: "UpdateChannel",
+			Handler:    _LearningPlatform_UpdateChannel_Handler,
+		},
+		{
+			MethodName: "DeleteChannel",
+			Handler:    _LearningPlatform_DeleteChannel_Handler,
+		},
+		{
+			MethodName: "ShareChannelToGroup",
+			Handler:    _LearningPlatform_ShareChannelToGroup_Handler,
+		},
+		{
+			MethodName: "IsChannelCreator",
+			Handler:    _LearningPlatform_IsChannelCreator_Handler,
+		},
+		{
+			MethodName: "GetLearningGroupsShareWithChannel",
+			Handler:    _LearningPlatform_GetLearningGroupsShareWithChannel_Handler,
+		},
+		{
+			MethodName: "CreatePlan",
+			Handler:    _LearningPlatform_CreatePlan_Handler,
+		},
+		{
+			MethodName: "GetPlan",
+			Handler:    _LearningPlatform_GetPlan_Handler,
+		},
+		{
+			MethodName: "GetPlans",
+			Handler:    _LearningPlatform_GetPlans_Handler,
+		},
+		{
+			MethodName: "GetPlansAll",
+			Handler:    _LearningPlatform_GetPlansAll_Handler,
+		},
+		{
+			MethodName: "UpdatePlan",
+			Handler:    _LearningPlatform_UpdatePlan_Handler,
+		},
+		{
+			MethodName: "DeletePlan",
+			Handler:    _LearningPlatform_DeletePlan_Handler,
+		},
+		{
+			MethodName: "SharePlanWithUsers",
+			Handler:    _LearningPlatform_SharePlanWithUsers_Handler,
+		},
+		{
+			MethodName: "IsUserShareWithPlan",
+			Handler:    _LearningPlatform_IsUserShareWithPlan_Handler,
+		},
+		{
+			MethodName: "GetPlansForSharing",
+			Handler:    _LearningPlatform_GetPlansForSharing_Handler,
+		},
+		{
+			MethodName: "CreateLesson",
+			Handler:    _LearningPlatform_CreateLesson_Handler,
+		},
+		{
+			MethodName: "GetLesson",
+			Handler:    _LearningPlatform_GetLesson_Handler,
+		},
+		{
+			MethodName: "GetLessons",
+			Handler:    _LearningPlatform_GetLessons_Handler,
+		},
+		{
+			MethodName: "UpdateLesson",
+			Handler:    _LearningPlatform_UpdateLesson_Handler,
+		},
+		{
+			MethodName: "DeleteLesson",
+			Handler:    _LearningPlatform_DeleteLesson_Handler,
+		},
+		{
+			MethodName: "CreateImagePage",
+			Handler:    _LearningPlatform_CreateImagePage_Handler,
+		},
+		{
+			MethodName: "CreatePDFPage",
+			Handler:    _LearningPlatform_CreatePDFPage_Handler,
+		},
+		{
+			MethodName: "CreateVideoPage",
+			Handler:    _LearningPlatform_CreateVideoPage_Handler,
+		},
+		{
+			MethodName: "GetImagePage",
+			Handler:    _LearningPlatform_GetImagePage_Handler,
+		},
+		{
+			MethodName: "GetVideoPage",
+			Handler:    _LearningPlatform_GetVideoPage_Handler,
+		},
+		{
+			MethodName: "GetPDFPage",
+			Handler:    _LearningPlatform_GetPDFPage_Handler,
+		},
+		{
+			MethodName: "GetPages",
+			Handler:    _LearningPlatform_GetPages_Handler,
+		},
+		{
+			MethodName: "UpdateImagePage",
+			Handler:    _LearningPlatform_UpdateImagePage_Handler,
+		},
+		{
+			MethodName: "UpdatePDFPage",
+			Handler:    _LearningPlatform_UpdatePDFPage_Handler,
+		},
+		{
+			MethodName: "UpdateVideoPage",
+			Handler:    _LearningPlatform_UpdateVideoPage_Handler,
+		},
+		{
+			MethodName: "DeletePage",
+			Handler:    _LearningPlatform_DeletePage_Handler,
+		},
+		{
+			MethodName: "CreateQuestionPage",
+			Handler:    _LearningPlatform_CreateQuestionPage_Handler,
+		},
+		{
+			MethodName: "GetQuestionPage",
+			Handler:    _LearningPlatform_GetQuestionPage_Handler,
+		},
+		{
+			MethodName: "UpdateQuestionPage",
+			Handler:    _LearningPlatform_UpdateQuestionPage_Handler,
+		},
+		{
+			MethodName: "TryLesson",
+			Handler:    _LearningPlatform_TryLesson_Handler,
+		},
+		{
+			MethodName: "UpdatePageAttempt",
+			Handler:    _LearningPlatform_UpdatePageAttempt_Handler,
+		},
+		{
+			MethodName: "CompleteLesson",
+			Handler:    _LearningPlatform_CompleteLesson_Handler,
+		},
+		{
+			MethodName: "GetLessonAttempts",
+			Handler:    _LearningPlatform_GetLessonAttempts_Handler,
+		},
+		{
+			MethodName: "CheckPermissionForUser",
+			Handler:    _LearningPlatform_CheckPermissionForUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lp.proto",
+}
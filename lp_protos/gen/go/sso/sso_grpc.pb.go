@@ -0,0 +1,2781 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: sso.proto
+
+package ssov1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Sso_RegisterUser_FullMethodName           = "/auth.v1.Sso/RegisterUser"
+	Sso_LoginUser_FullMethodName              = "/auth.v1.Sso/LoginUser"
+	Sso_LoginViaTg_FullMethodName             = "/auth.v1.Sso/LoginViaTg"
+	Sso_RefreshToken_FullMethodName           = "/auth.v1.Sso/RefreshToken"
+	Sso_IsAdmin_FullMethodName                = "/auth.v1.Sso/IsAdmin"
+	Sso_AuthCheck_FullMethodName              = "/auth.v1.Sso/AuthCheck"
+	Sso_UpdateUserInfo_FullMethodName         = "/auth.v1.Sso/UpdateUserInfo"
+	Sso_CheckOTPAndLogIn_FullMethodName       = "/auth.v1.Sso/CheckOTPAndLogIn"
+	Sso_CreateLearningGroup_FullMethodName    = "/auth.v1.Sso/CreateLearningGroup"
+	Sso_GetLearningGroupByID_FullMethodName   = "/auth.v1.Sso/GetLearningGroupByID"
+	Sso_UpdateLearningGroup_FullMethodName    = "/auth.v1.Sso/UpdateLearningGroup"
+	Sso_DeleteLearningGroup_FullMethodName    = "/auth.v1.Sso/DeleteLearningGroup"
+	Sso_GetLearningGroups_FullMethodName      = "/auth.v1.Sso/GetLearningGroups"
+	Sso_IsGroupAdmin_FullMethodName           = "/auth.v1.Sso/IsGroupAdmin"
+	Sso_IsUserGroupAdminIn_FullMethodName     = "/auth.v1.Sso/IsUserGroupAdminIn"
+	Sso_IsUserLearnerIn_FullMethodName        = "/auth.v1.Sso/IsUserLearnerIn"
+	Sso_GetLearners_FullMethodName            = "/auth.v1.Sso/GetLearners"
+	Sso_Logout_FullMethodName                 = "/auth.v1.Sso/Logout"
+	Sso_GetUserInfo_FullMethodName            = "/auth.v1.Sso/GetUserInfo"
+	Sso_RequestPasswordReset_FullMethodName   = "/auth.v1.Sso/RequestPasswordReset"
+	Sso_ConfirmPasswordReset_FullMethodName   = "/auth.v1.Sso/ConfirmPasswordReset"
+	Sso_VerifyEmail_FullMethodName            = "/auth.v1.Sso/VerifyEmail"
+	Sso_ResendVerification_FullMethodName     = "/auth.v1.Sso/ResendVerification"
+	Sso_LoginMFA_FullMethodName               = "/auth.v1.Sso/LoginMFA"
+	Sso_EnrollTOTP_FullMethodName             = "/auth.v1.Sso/EnrollTOTP"
+	Sso_VerifyTOTP_FullMethodName             = "/auth.v1.Sso/VerifyTOTP"
+	Sso_DisableTOTP_FullMethodName            = "/auth.v1.Sso/DisableTOTP"
+	Sso_RegisterApp_FullMethodName            = "/auth.v1.Sso/RegisterApp"
+	Sso_IssueClientToken_FullMethodName       = "/auth.v1.Sso/IssueClientToken"
+	Sso_RegisterRelyingParty_FullMethodName   = "/auth.v1.Sso/RegisterRelyingParty"
+	Sso_ListSessions_FullMethodName           = "/auth.v1.Sso/ListSessions"
+	Sso_RevokeSession_FullMethodName          = "/auth.v1.Sso/RevokeSession"
+	Sso_ListUsers_FullMethodName              = "/auth.v1.Sso/ListUsers"
+	Sso_SetUserAdmin_FullMethodName           = "/auth.v1.Sso/SetUserAdmin"
+	Sso_DeactivateUser_FullMethodName         = "/auth.v1.Sso/DeactivateUser"
+	Sso_ReactivateUser_FullMethodName         = "/auth.v1.Sso/ReactivateUser"
+	Sso_RequestUserExport_FullMethodName      = "/auth.v1.Sso/RequestUserExport"
+	Sso_RequestUserErasure_FullMethodName     = "/auth.v1.Sso/RequestUserErasure"
+	Sso_GetGDPRJob_FullMethodName             = "/auth.v1.Sso/GetGDPRJob"
+	Sso_ImportUsers_FullMethodName            = "/auth.v1.Sso/ImportUsers"
+	Sso_AcceptInvite_FullMethodName           = "/auth.v1.Sso/AcceptInvite"
+	Sso_ListAuditEvents_FullMethodName        = "/auth.v1.Sso/ListAuditEvents"
+	Sso_CreateLgInvite_FullMethodName         = "/auth.v1.Sso/CreateLgInvite"
+	Sso_ListLgInvites_FullMethodName          = "/auth.v1.Sso/ListLgInvites"
+	Sso_RevokeLgInvite_FullMethodName         = "/auth.v1.Sso/RevokeLgInvite"
+	Sso_RedeemLgInvite_FullMethodName         = "/auth.v1.Sso/RedeemLgInvite"
+	Sso_AddMembers_FullMethodName             = "/auth.v1.Sso/AddMembers"
+	Sso_RemoveMembers_FullMethodName          = "/auth.v1.Sso/RemoveMembers"
+	Sso_CreateTgLinkToken_FullMethodName      = "/auth.v1.Sso/CreateTgLinkToken"
+	Sso_UnlinkTelegram_FullMethodName         = "/auth.v1.Sso/UnlinkTelegram"
+	Sso_CreateLgRole_FullMethodName           = "/auth.v1.Sso/CreateLgRole"
+	Sso_ListLgRoles_FullMethodName            = "/auth.v1.Sso/ListLgRoles"
+	Sso_UpdateLgRole_FullMethodName           = "/auth.v1.Sso/UpdateLgRole"
+	Sso_DeleteLgRole_FullMethodName           = "/auth.v1.Sso/DeleteLgRole"
+	Sso_AssignLgRole_FullMethodName           = "/auth.v1.Sso/AssignLgRole"
+	Sso_UnassignLgRole_FullMethodName         = "/auth.v1.Sso/UnassignLgRole"
+	Sso_GetUserPermissions_FullMethodName     = "/auth.v1.Sso/GetUserPermissions"
+	Sso_ScimListUsers_FullMethodName          = "/auth.v1.Sso/ScimListUsers"
+	Sso_ScimGetUser_FullMethodName            = "/auth.v1.Sso/ScimGetUser"
+	Sso_ScimCreateUser_FullMethodName         = "/auth.v1.Sso/ScimCreateUser"
+	Sso_ScimUpdateUser_FullMethodName         = "/auth.v1.Sso/ScimUpdateUser"
+	Sso_ScimDeleteUser_FullMethodName         = "/auth.v1.Sso/ScimDeleteUser"
+	Sso_ScimListGroups_FullMethodName         = "/auth.v1.Sso/ScimListGroups"
+	Sso_ScimGetGroup_FullMethodName           = "/auth.v1.Sso/ScimGetGroup"
+	Sso_ScimCreateGroup_FullMethodName        = "/auth.v1.Sso/ScimCreateGroup"
+	Sso_ScimUpdateGroup_FullMethodName        = "/auth.v1.Sso/ScimUpdateGroup"
+	Sso_ScimChangeGroupMembers_FullMethodName = "/auth.v1.Sso/ScimChangeGroupMembers"
+	Sso_ScimDeleteGroup_FullMethodName        = "/auth.v1.Sso/ScimDeleteGroup"
+	Sso_ImpersonateUser_FullMethodName        = "/auth.v1.Sso/ImpersonateUser"
+	Sso_GetLearningGroupTree_FullMethodName   = "/auth.v1.Sso/GetLearningGroupTree"
+	Sso_MoveLearningGroup_FullMethodName      = "/auth.v1.Sso/MoveLearningGroup"
+)
+
+// SsoClient is the client API for Sso service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SsoClient interface {
+	RegisterUser(ctx context.Context, in *RegisterUserRequest, opts ...grpc.CallOption) (*RegisterUserResponse, error)
+	LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
+	LoginViaTg(ctx context.Context, in *LoginViaTgRequest, opts ...grpc.CallOption) (*LoginViaTgResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	IsAdmin(ctx context.Context, in *IsAdminRequest, opts ...grpc.CallOption) (*IsAdminResponse, error)
+	AuthCheck(ctx context.Context, in *AuthCheckRequest, opts ...grpc.CallOption) (*AuthCheckResponse, error)
+	UpdateUserInfo(ctx context.Context, in *UpdateUserInfoRequest, opts ...grpc.CallOption) (*UpdateUserInfoResponse, error)
+	CheckOTPAndLogIn(ctx context.Context, in *CheckOTPAndLogInRequest, opts ...grpc.CallOption) (*CheckOTPAndLogInResponse, error)
+	CreateLearningGroup(ctx context.Context, in *CreateLearningGroupRequest, opts ...grpc.CallOption) (*CreateLearningGroupResponse, error)
+	GetLearningGroupByID(ctx context.Context, in *GetLearningGroupByIDRequest, opts ...grpc.CallOption) (*GetLearningGroupByIDResponse, error)
+	UpdateLearningGroup(ctx context.Context, in *UpdateLearningGroupRequest, opts ...grpc.CallOption) (*UpdateLearningGroupResponse, error)
+	DeleteLearningGroup(ctx context.Context, in *DeleteLearningGroupRequest, opts ...grpc.CallOption) (*DeleteLearningGroupResponse, error)
+	GetLearningGroups(ctx context.Context, in *GetLearningGroupsRequest, opts ...grpc.CallOption) (*GetLearningGroupsResponse, error)
+	IsGroupAdmin(ctx context.Context, in *IsGroupAdminRequest, opts ...grpc.CallOption) (*IsGroupAdminResponse, error)
+	IsUserGroupAdminIn(ctx context.Context, in *IsUserGroupAdminInRequest, opts ...grpc.CallOption) (*IsUserGroupAdminInResponse, error)
+	IsUserLearnerIn(ctx context.Context, in *IsUserLearnereInRequest, opts ...grpc.CallOption) (*IsUserLearnereInResponse, error)
+	GetLearners(ctx context.Context, in *GetLearnersRequest, opts ...grpc.CallOption) (*GetLearnersResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*GetUserInfoResponse, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*ConfirmPasswordResetResponse, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	ResendVerification(ctx context.Context, in *ResendVerificationRequest, opts ...grpc.CallOption) (*ResendVerificationResponse, error)
+	LoginMFA(ctx context.Context, in *LoginMFARequest, opts ...grpc.CallOption) (*LoginMFAResponse, error)
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error)
+	VerifyTOTP(ctx context.Context, in *VerifyTOTPRequest, opts ...grpc.CallOption) (*VerifyTOTPResponse, error)
+	DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error)
+	RegisterApp(ctx context.Context, in *RegisterAppRequest, opts ...grpc.CallOption) (*RegisterAppResponse, error)
+	IssueClientToken(ctx context.Context, in *IssueClientTokenRequest, opts ...grpc.CallOption) (*IssueClientTokenResponse, error)
+	RegisterRelyingParty(ctx context.Context, in *RegisterRelyingPartyRequest, opts ...grpc.CallOption) (*RegisterRelyingPartyResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	SetUserAdmin(ctx context.Context, in *SetUserAdminRequest, opts ...grpc.CallOption) (*SetUserAdminResponse, error)
+	DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*DeactivateUserResponse, error)
+	ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*ReactivateUserResponse, error)
+	RequestUserExport(ctx context.Context, in *RequestUserExportRequest, opts ...grpc.CallOption) (*RequestUserExportResponse, error)
+	RequestUserErasure(ctx context.Context, in *RequestUserErasureRequest, opts ...grpc.CallOption) (*RequestUserErasureResponse, error)
+	GetGDPRJob(ctx context.Context, in *GetGDPRJobRequest, opts ...grpc.CallOption) (*GetGDPRJobResponse, error)
+	ImportUsers(ctx context.Context, in *ImportUsersRequest, opts ...grpc.CallOption) (*ImportUsersResponse, error)
+	AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*AcceptInviteResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	CreateLgInvite(ctx context.Context, in *CreateLgInviteRequest, opts ...grpc.CallOption) (*CreateLgInviteResponse, error)
+	ListLgInvites(ctx context.Context, in *ListLgInvitesRequest, opts ...grpc.CallOption) (*ListLgInvitesResponse, error)
+	RevokeLgInvite(ctx context.Context, in *RevokeLgInviteRequest, opts ...grpc.CallOption) (*RevokeLgInviteResponse, error)
+	RedeemLgInvite(ctx context.Context, in *RedeemLgInviteRequest, opts ...grpc.CallOption) (*RedeemLgInviteResponse, error)
+	AddMembers(ctx context.Context, in *AddMembersRequest, opts ...grpc.CallOption) (*AddMembersResponse, error)
+	RemoveMembers(ctx context.Context, in *RemoveMembersRequest, opts ...grpc.CallOption) (*RemoveMembersResponse, error)
+	CreateTgLinkToken(ctx context.Context, in *CreateTgLinkTokenRequest, opts ...grpc.CallOption) (*CreateTgLinkTokenResponse, error)
+	UnlinkTelegram(ctx context.Context, in *UnlinkTelegramRequest, opts ...grpc.CallOption) (*UnlinkTelegramResponse, error)
+	CreateLgRole(ctx context.Context, in *CreateLgRoleRequest, opts ...grpc.CallOption) (*CreateLgRoleResponse, error)
+	ListLgRoles(ctx context.Context, in *ListLgRolesRequest, opts ...grpc.CallOption) (*ListLgRolesResponse, error)
+	UpdateLgRole(ctx context.Context, in *UpdateLgRoleRequest, opts ...grpc.CallOption) (*UpdateLgRoleResponse, error)
+	DeleteLgRole(ctx context.Context, in *DeleteLgRoleRequest, opts ...grpc.CallOption) (*DeleteLgRoleResponse, error)
+	AssignLgRole(ctx context.Context, in *AssignLgRoleRequest, opts ...grpc.CallOption) (*AssignLgRoleResponse, error)
+	UnassignLgRole(ctx context.Context, in *UnassignLgRoleRequest, opts ...grpc.CallOption) (*UnassignLgRoleResponse, error)
+	GetUserPermissions(ctx context.Context, in *GetUserPermissionsRequest, opts ...grpc.CallOption) (*GetUserPermissionsResponse, error)
+	ScimListUsers(ctx context.Context, in *ScimListUsersRequest, opts ...grpc.CallOption) (*ScimListUsersResponse, error)
+	ScimGetUser(ctx context.Context, in *ScimGetUserRequest, opts ...grpc.CallOption) (*ScimGetUserResponse, error)
+	ScimCreateUser(ctx context.Context, in *ScimCreateUserRequest, opts ...grpc.CallOption) (*ScimCreateUserResponse, error)
+	ScimUpdateUser(ctx context.Context, in *ScimUpdateUserRequest, opts ...grpc.CallOption) (*ScimUpdateUserResponse, error)
+	ScimDeleteUser(ctx context.Context, in *ScimDeleteUserRequest, opts ...grpc.CallOption) (*ScimDeleteUserResponse, error)
+	ScimListGroups(ctx context.Context, in *ScimListGroupsRequest, opts ...grpc.CallOption) (*ScimListGroupsResponse, error)
+	ScimGetGroup(ctx context.Context, in *ScimGetGroupRequest, opts ...grpc.CallOption) (*ScimGetGroupResponse, error)
+	ScimCreateGroup(ctx context.Context, in *ScimCreateGroupRequest, opts ...grpc.CallOption) (*ScimCreateGroupResponse, error)
+	ScimUpdateGroup(ctx context.Context, in *ScimUpdateGroupRequest, opts ...grpc.CallOption) (*ScimUpdateGroupResponse, error)
+	ScimChangeGroupMembers(ctx context.Context, in *ScimChangeGroupMembersRequest, opts ...grpc.CallOption) (*ScimChangeGroupMembersResponse, error)
+	ScimDeleteGroup(ctx context.Context, in *ScimDeleteGroupRequest, opts ...grpc.CallOption) (*ScimDeleteGroupResponse, error)
+	ImpersonateUser(ctx context.Context, in *ImpersonateUserRequest, opts ...grpc.CallOption) (*ImpersonateUserResponse, error)
+	GetLearningGroupTree(ctx context.Context, in *GetLearningGroupTreeRequest, opts ...grpc.CallOption) (*GetLearningGroupTreeResponse, error)
+	MoveLearningGroup(ctx context.Context, in *MoveLearningGroupRequest, opts ...grpc.CallOption) (*MoveLearningGroupResponse, error)
+}
+
+type ssoClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSsoClient(cc grpc.ClientConnInterface) SsoClient {
+	return &ssoClient{cc}
+}
+
+func (c *ssoClient) RegisterUser(ctx context.Context, in *RegisterUserRequest, opts ...grpc.CallOption) (*RegisterUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterUserResponse)
+	err := c.cc.Invoke(ctx, Sso_RegisterUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginUserResponse)
+	err := c.cc.Invoke(ctx, Sso_LoginUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) LoginViaTg(ctx context.Context, in *LoginViaTgRequest, opts ...grpc.CallOption) (*LoginViaTgResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginViaTgResponse)
+	err := c.cc.Invoke(ctx, Sso_LoginViaTg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, Sso_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) IsAdmin(ctx context.Context, in *IsAdminRequest, opts ...grpc.CallOption) (*IsAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsAdminResponse)
+	err := c.cc.Invoke(ctx, Sso_IsAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) AuthCheck(ctx context.Context, in *AuthCheckRequest, opts ...grpc.CallOption) (*AuthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthCheckResponse)
+	err := c.cc.Invoke(ctx, Sso_AuthCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) UpdateUserInfo(ctx context.Context, in *UpdateUserInfoRequest, opts ...grpc.CallOption) (*UpdateUserInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserInfoResponse)
+	err := c.cc.Invoke(ctx, Sso_UpdateUserInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) CheckOTPAndLogIn(ctx context.Context, in *CheckOTPAndLogInRequest, opts ...grpc.CallOption) (*CheckOTPAndLogInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckOTPAndLogInResponse)
+	err := c.cc.Invoke(ctx, Sso_CheckOTPAndLogIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) CreateLearningGroup(ctx context.Context, in *CreateLearningGroupRequest, opts ...grpc.CallOption) (*CreateLearningGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLearningGroupResponse)
+	err := c.cc.Invoke(ctx, Sso_CreateLearningGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) GetLearningGroupByID(ctx context.Context, in *GetLearningGroupByIDRequest, opts ...grpc.CallOption) (*GetLearningGroupByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLearningGroupByIDResponse)
+	err := c.cc.Invoke(ctx, Sso_GetLearningGroupByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) UpdateLearningGroup(ctx context.Context, in *UpdateLearningGroupRequest, opts ...grpc.CallOption) (*UpdateLearningGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLearningGroupResponse)
+	err := c.cc.Invoke(ctx, Sso_UpdateLearningGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) DeleteLearningGroup(ctx context.Context, in *DeleteLearningGroupRequest, opts ...grpc.CallOption) (*DeleteLearningGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLearningGroupResponse)
+	err := c.cc.Invoke(ctx, Sso_DeleteLearningGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) GetLearningGroups(ctx context.Context, in *GetLearningGroupsRequest, opts ...grpc.CallOption) (*GetLearningGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLearningGroupsResponse)
+	err := c.cc.Invoke(ctx, Sso_GetLearningGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) IsGroupAdmin(ctx context.Context, in *IsGroupAdminRequest, opts ...grpc.CallOption) (*IsGroupAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsGroupAdminResponse)
+	err := c.cc.Invoke(ctx, Sso_IsGroupAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) IsUserGroupAdminIn(ctx context.Context, in *IsUserGroupAdminInRequest, opts ...grpc.CallOption) (*IsUserGroupAdminInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsUserGroupAdminInResponse)
+	err := c.cc.Invoke(ctx, Sso_IsUserGroupAdminIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) IsUserLearnerIn(ctx context.Context, in *IsUserLearnereInRequest, opts ...grpc.CallOption) (*IsUserLearnereInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsUserLearnereInResponse)
+	err := c.cc.Invoke(ctx, Sso_IsUserLearnerIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) GetLearners(ctx context.Context, in *GetLearnersRequest, opts ...grpc.CallOption) (*GetLearnersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLearnersResponse)
+	err := c.cc.Invoke(ctx, Sso_GetLearners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, Sso_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*GetUserInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserInfoResponse)
+	err := c.cc.Invoke(ctx, Sso_GetUserInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, Sso_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*ConfirmPasswordResetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmPasswordResetResponse)
+	err := c.cc.Invoke(ctx, Sso_ConfirmPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, Sso_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ResendVerification(ctx context.Context, in *ResendVerificationRequest, opts ...grpc.CallOption) (*ResendVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResendVerificationResponse)
+	err := c.cc.Invoke(ctx, Sso_ResendVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) LoginMFA(ctx context.Context, in *LoginMFARequest, opts ...grpc.CallOption) (*LoginMFAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginMFAResponse)
+	err := c.cc.Invoke(ctx, Sso_LoginMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollTOTPResponse)
+	err := c.cc.Invoke(ctx, Sso_EnrollTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) VerifyTOTP(ctx context.Context, in *VerifyTOTPRequest, opts ...grpc.CallOption) (*VerifyTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyTOTPResponse)
+	err := c.cc.Invoke(ctx, Sso_VerifyTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTOTPResponse)
+	err := c.cc.Invoke(ctx, Sso_DisableTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) RegisterApp(ctx context.Context, in *RegisterAppRequest, opts ...grpc.CallOption) (*RegisterAppResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterAppResponse)
+	err := c.cc.Invoke(ctx, Sso_RegisterApp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) IssueClientToken(ctx context.Context, in *IssueClientTokenRequest, opts ...grpc.CallOption) (*IssueClientTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueClientTokenResponse)
+	err := c.cc.Invoke(ctx, Sso_IssueClientToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) RegisterRelyingParty(ctx context.Context, in *RegisterRelyingPartyRequest, opts ...grpc.CallOption) (*RegisterRelyingPartyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterRelyingPartyResponse)
+	err := c.cc.Invoke(ctx, Sso_RegisterRelyingParty_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, Sso_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, Sso_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, Sso_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) SetUserAdmin(ctx context.Context, in *SetUserAdminRequest, opts ...grpc.CallOption) (*SetUserAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserAdminResponse)
+	err := c.cc.Invoke(ctx, Sso_SetUserAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*DeactivateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateUserResponse)
+	err := c.cc.Invoke(ctx, Sso_DeactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*ReactivateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateUserResponse)
+	err := c.cc.Invoke(ctx, Sso_ReactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) RequestUserExport(ctx context.Context, in *RequestUserExportRequest, opts ...grpc.CallOption) (*RequestUserExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestUserExportResponse)
+	err := c.cc.Invoke(ctx, Sso_RequestUserExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) RequestUserErasure(ctx context.Context, in *RequestUserErasureRequest, opts ...grpc.CallOption) (*RequestUserErasureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestUserErasureResponse)
+	err := c.cc.Invoke(ctx, Sso_RequestUserErasure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) GetGDPRJob(ctx context.Context, in *GetGDPRJobRequest, opts ...grpc.CallOption) (*GetGDPRJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGDPRJobResponse)
+	err := c.cc.Invoke(ctx, Sso_GetGDPRJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ImportUsers(ctx context.Context, in *ImportUsersRequest, opts ...grpc.CallOption) (*ImportUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportUsersResponse)
+	err := c.cc.Invoke(ctx, Sso_ImportUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*AcceptInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptInviteResponse)
+	err := c.cc.Invoke(ctx, Sso_AcceptInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, Sso_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) CreateLgInvite(ctx context.Context, in *CreateLgInviteRequest, opts ...grpc.CallOption) (*CreateLgInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLgInviteResponse)
+	err := c.cc.Invoke(ctx, Sso_CreateLgInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ListLgInvites(ctx context.Context, in *ListLgInvitesRequest, opts ...grpc.CallOption) (*ListLgInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLgInvitesResponse)
+	err := c.cc.Invoke(ctx, Sso_ListLgInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) RevokeLgInvite(ctx context.Context, in *RevokeLgInviteRequest, opts ...grpc.CallOption) (*RevokeLgInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeLgInviteResponse)
+	err := c.cc.Invoke(ctx, Sso_RevokeLgInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) RedeemLgInvite(ctx context.Context, in *RedeemLgInviteRequest, opts ...grpc.CallOption) (*RedeemLgInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemLgInviteResponse)
+	err := c.cc.Invoke(ctx, Sso_RedeemLgInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) AddMembers(ctx context.Context, in *AddMembersRequest, opts ...grpc.CallOption) (*AddMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddMembersResponse)
+	err := c.cc.Invoke(ctx, Sso_AddMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) RemoveMembers(ctx context.Context, in *RemoveMembersRequest, opts ...grpc.CallOption) (*RemoveMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveMembersResponse)
+	err := c.cc.Invoke(ctx, Sso_RemoveMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) CreateTgLinkToken(ctx context.Context, in *CreateTgLinkTokenRequest, opts ...grpc.CallOption) (*CreateTgLinkTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTgLinkTokenResponse)
+	err := c.cc.Invoke(ctx, Sso_CreateTgLinkToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) UnlinkTelegram(ctx context.Context, in *UnlinkTelegramRequest, opts ...grpc.CallOption) (*UnlinkTelegramResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkTelegramResponse)
+	err := c.cc.Invoke(ctx, Sso_UnlinkTelegram_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) CreateLgRole(ctx context.Context, in *CreateLgRoleRequest, opts ...grpc.CallOption) (*CreateLgRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLgRoleResponse)
+	err := c.cc.Invoke(ctx, Sso_CreateLgRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ListLgRoles(ctx context.Context, in *ListLgRolesRequest, opts ...grpc.CallOption) (*ListLgRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLgRolesResponse)
+	err := c.cc.Invoke(ctx, Sso_ListLgRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) UpdateLgRole(ctx context.Context, in *UpdateLgRoleRequest, opts ...grpc.CallOption) (*UpdateLgRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLgRoleResponse)
+	err := c.cc.Invoke(ctx, Sso_UpdateLgRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) DeleteLgRole(ctx context.Context, in *DeleteLgRoleRequest, opts ...grpc.CallOption) (*DeleteLgRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLgRoleResponse)
+	err := c.cc.Invoke(ctx, Sso_DeleteLgRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) AssignLgRole(ctx context.Context, in *AssignLgRoleRequest, opts ...grpc.CallOption) (*AssignLgRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignLgRoleResponse)
+	err := c.cc.Invoke(ctx, Sso_AssignLgRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) UnassignLgRole(ctx context.Context, in *UnassignLgRoleRequest, opts ...grpc.CallOption) (*UnassignLgRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnassignLgRoleResponse)
+	err := c.cc.Invoke(ctx, Sso_UnassignLgRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) GetUserPermissions(ctx context.Context, in *GetUserPermissionsRequest, opts ...grpc.CallOption) (*GetUserPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserPermissionsResponse)
+	err := c.cc.Invoke(ctx, Sso_GetUserPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ScimListUsers(ctx context.Context, in *ScimListUsersRequest, opts ...grpc.CallOption) (*ScimListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimListUsersResponse)
+	err := c.cc.Invoke(ctx, Sso_ScimListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ScimGetUser(ctx context.Context, in *ScimGetUserRequest, opts ...grpc.CallOption) (*ScimGetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimGetUserResponse)
+	err := c.cc.Invoke(ctx, Sso_ScimGetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ScimCreateUser(ctx context.Context, in *ScimCreateUserRequest, opts ...grpc.CallOption) (*ScimCreateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimCreateUserResponse)
+	err := c.cc.Invoke(ctx, Sso_ScimCreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ScimUpdateUser(ctx context.Context, in *ScimUpdateUserRequest, opts ...grpc.CallOption) (*ScimUpdateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimUpdateUserResponse)
+	err := c.cc.Invoke(ctx, Sso_ScimUpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ScimDeleteUser(ctx context.Context, in *ScimDeleteUserRequest, opts ...grpc.CallOption) (*ScimDeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimDeleteUserResponse)
+	err := c.cc.Invoke(ctx, Sso_ScimDeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ScimListGroups(ctx context.Context, in *ScimListGroupsRequest, opts ...grpc.CallOption) (*ScimListGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimListGroupsResponse)
+	err := c.cc.Invoke(ctx, Sso_ScimListGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ScimGetGroup(ctx context.Context, in *ScimGetGroupRequest, opts ...grpc.CallOption) (*ScimGetGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimGetGroupResponse)
+	err := c.cc.Invoke(ctx, Sso_ScimGetGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ScimCreateGroup(ctx context.Context, in *ScimCreateGroupRequest, opts ...grpc.CallOption) (*ScimCreateGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimCreateGroupResponse)
+	err := c.cc.Invoke(ctx, Sso_ScimCreateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ScimUpdateGroup(ctx context.Context, in *ScimUpdateGroupRequest, opts ...grpc.CallOption) (*ScimUpdateGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimUpdateGroupResponse)
+	err := c.cc.Invoke(ctx, Sso_ScimUpdateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ScimChangeGroupMembers(ctx context.Context, in *ScimChangeGroupMembersRequest, opts ...grpc.CallOption) (*ScimChangeGroupMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimChangeGroupMembersResponse)
+	err := c.cc.Invoke(ctx, Sso_ScimChangeGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ScimDeleteGroup(ctx context.Context, in *ScimDeleteGroupRequest, opts ...grpc.CallOption) (*ScimDeleteGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScimDeleteGroupResponse)
+	err := c.cc.Invoke(ctx, Sso_ScimDeleteGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) ImpersonateUser(ctx context.Context, in *ImpersonateUserRequest, opts ...grpc.CallOption) (*ImpersonateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonateUserResponse)
+	err := c.cc.Invoke(ctx, Sso_ImpersonateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) GetLearningGroupTree(ctx context.Context, in *GetLearningGroupTreeRequest, opts ...grpc.CallOption) (*GetLearningGroupTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLearningGroupTreeResponse)
+	err := c.cc.Invoke(ctx, Sso_GetLearningGroupTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ssoClient) MoveLearningGroup(ctx context.Context, in *MoveLearningGroupRequest, opts ...grpc.CallOption) (*MoveLearningGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveLearningGroupResponse)
+	err := c.cc.Invoke(ctx, Sso_MoveLearningGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SsoServer is the server API for Sso service.
+// All implementations must embed UnimplementedSsoServer
+// for forward compatibility.
+type SsoServer interface {
+	RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error)
+	LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error)
+	LoginViaTg(context.Context, *LoginViaTgRequest) (*LoginViaTgResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	IsAdmin(context.Context, *IsAdminRequest) (*IsAdminResponse, error)
+	AuthCheck(context.Context, *AuthCheckRequest) (*AuthCheckResponse, error)
+	UpdateUserInfo(context.Context, *UpdateUserInfoRequest) (*UpdateUserInfoResponse, error)
+	CheckOTPAndLogIn(context.Context, *CheckOTPAndLogInRequest) (*CheckOTPAndLogInResponse, error)
+	CreateLearningGroup(context.Context, *CreateLearningGroupRequest) (*CreateLearningGroupResponse, error)
+	GetLearningGroupByID(context.Context, *GetLearningGroupByIDRequest) (*GetLearningGroupByIDResponse, error)
+	UpdateLearningGroup(context.Context, *UpdateLearningGroupRequest) (*UpdateLearningGroupResponse, error)
+	DeleteLearningGroup(context.Context, *DeleteLearningGroupRequest) (*DeleteLearningGroupResponse, error)
+	GetLearningGroups(context.Context, *GetLearningGroupsRequest) (*GetLearningGroupsResponse, error)
+	IsGroupAdmin(context.Context, *IsGroupAdminRequest) (*IsGroupAdminResponse, error)
+	IsUserGroupAdminIn(context.Context, *IsUserGroupAdminInRequest) (*IsUserGroupAdminInResponse, error)
+	IsUserLearnerIn(context.Context, *IsUserLearnereInRequest) (*IsUserLearnereInResponse, error)
+	GetLearners(context.Context, *GetLearnersRequest) (*GetLearnersResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	GetUserInfo(context.Context, *GetUserInfoRequest) (*GetUserInfoResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*ConfirmPasswordResetResponse, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	ResendVerification(context.Context, *ResendVerificationRequest) (*ResendVerificationResponse, error)
+	LoginMFA(context.Context, *LoginMFARequest) (*LoginMFAResponse, error)
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error)
+	VerifyTOTP(context.Context, *VerifyTOTPRequest) (*VerifyTOTPResponse, error)
+	DisableTOTP(context.Context, *DisableTOTPRequest) (*DisableTOTPResponse, error)
+	RegisterApp(context.Context, *RegisterAppRequest) (*RegisterAppResponse, error)
+	IssueClientToken(context.Context, *IssueClientTokenRequest) (*IssueClientTokenResponse, error)
+	RegisterRelyingParty(context.Context, *RegisterRelyingPartyRequest) (*RegisterRelyingPartyResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	SetUserAdmin(context.Context, *SetUserAdminRequest) (*SetUserAdminResponse, error)
+	DeactivateUser(context.Context, *DeactivateUserRequest) (*DeactivateUserResponse, error)
+	ReactivateUser(context.Context, *ReactivateUserRequest) (*ReactivateUserResponse, error)
+	RequestUserExport(context.Context, *RequestUserExportRequest) (*RequestUserExportResponse, error)
+	RequestUserErasure(context.Context, *RequestUserErasureRequest) (*RequestUserErasureResponse, error)
+	GetGDPRJob(context.Context, *GetGDPRJobRequest) (*GetGDPRJobResponse, error)
+	ImportUsers(context.Context, *ImportUsersRequest) (*ImportUsersResponse, error)
+	AcceptInvite(context.Context, *AcceptInviteRequest) (*AcceptInviteResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	CreateLgInvite(context.Context, *CreateLgInviteRequest) (*CreateLgInviteResponse, error)
+	ListLgInvites(context.Context, *ListLgInvitesRequest) (*ListLgInvitesResponse, error)
+	RevokeLgInvite(context.Context, *RevokeLgInviteRequest) (*RevokeLgInviteResponse, error)
+	RedeemLgInvite(context.Context, *RedeemLgInviteRequest) (*RedeemLgInviteResponse, error)
+	AddMembers(context.Context, *AddMembersRequest) (*AddMembersResponse, error)
+	RemoveMembers(context.Context, *RemoveMembersRequest) (*RemoveMembersResponse, error)
+	CreateTgLinkToken(context.Context, *CreateTgLinkTokenRequest) (*CreateTgLinkTokenResponse, error)
+	UnlinkTelegram(context.Context, *UnlinkTelegramRequest) (*UnlinkTelegramResponse, error)
+	CreateLgRole(context.Context, *CreateLgRoleRequest) (*CreateLgRoleResponse, error)
+	ListLgRoles(context.Context, *ListLgRolesRequest) (*ListLgRolesResponse, error)
+	UpdateLgRole(context.Context, *UpdateLgRoleRequest) (*UpdateLgRoleResponse, error)
+	DeleteLgRole(context.Context, *DeleteLgRoleRequest) (*DeleteLgRoleResponse, error)
+	AssignLgRole(context.Context, *AssignLgRoleRequest) (*AssignLgRoleResponse, error)
+	UnassignLgRole(context.Context, *UnassignLgRoleRequest) (*UnassignLgRoleResponse, error)
+	GetUserPermissions(context.Context, *GetUserPermissionsRequest) (*GetUserPermissionsResponse, error)
+	ScimListUsers(context.Context, *ScimListUsersRequest) (*ScimListUsersResponse, error)
+	ScimGetUser(context.Context, *ScimGetUserRequest) (*ScimGetUserResponse, error)
+	ScimCreateUser(context.Context, *ScimCreateUserRequest) (*ScimCreateUserResponse, error)
+	ScimUpdateUser(context.Context, *ScimUpdateUserRequest) (*ScimUpdateUserResponse, error)
+	ScimDeleteUser(context.Context, *ScimDeleteUserRequest) (*ScimDeleteUserResponse, error)
+	ScimListGroups(context.Context, *ScimListGroupsRequest) (*ScimListGroupsResponse, error)
+	ScimGetGroup(context.Context, *ScimGetGroupRequest) (*ScimGetGroupResponse, error)
+	ScimCreateGroup(context.Context, *ScimCreateGroupRequest) (*ScimCreateGroupResponse, error)
+	ScimUpdateGroup(context.Context, *ScimUpdateGroupRequest) (*ScimUpdateGroupResponse, error)
+	ScimChangeGroupMembers(context.Context, *ScimChangeGroupMembersRequest) (*ScimChangeGroupMembersResponse, error)
+	ScimDeleteGroup(context.Context, *ScimDeleteGroupRequest) (*ScimDeleteGroupResponse, error)
+	ImpersonateUser(context.Context, *ImpersonateUserRequest) (*ImpersonateUserResponse, error)
+	GetLearningGroupTree(context.Context, *GetLearningGroupTreeRequest) (*GetLearningGroupTreeResponse, error)
+	MoveLearningGroup(context.Context, *MoveLearningGroupRequest) (*MoveLearningGroupResponse, error)
+	mustEmbedUnimplementedSsoServer()
+}
+
+// UnimplementedSsoServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSsoServer struct{}
+
+func (UnimplementedSsoServer) RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterUser not implemented")
+}
+func (UnimplementedSsoServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+}
+func (UnimplementedSsoServer) LoginViaTg(context.Context, *LoginViaTgRequest) (*LoginViaTgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginViaTg not implemented")
+}
+func (UnimplementedSsoServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedSsoServer) IsAdmin(context.Context, *IsAdminRequest) (*IsAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAdmin not implemented")
+}
+func (UnimplementedSsoServer) AuthCheck(context.Context, *AuthCheckRequest) (*AuthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthCheck not implemented")
+}
+func (UnimplementedSsoServer) UpdateUserInfo(context.Context, *UpdateUserInfoRequest) (*UpdateUserInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserInfo not implemented")
+}
+func (UnimplementedSsoServer) CheckOTPAndLogIn(context.Context, *CheckOTPAndLogInRequest) (*CheckOTPAndLogInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckOTPAndLogIn not implemented")
+}
+func (UnimplementedSsoServer) CreateLearningGroup(context.Context, *CreateLearningGroupRequest) (*CreateLearningGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLearningGroup not implemented")
+}
+func (UnimplementedSsoServer) GetLearningGroupByID(context.Context, *GetLearningGroupByIDRequest) (*GetLearningGroupByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLearningGroupByID not implemented")
+}
+func (UnimplementedSsoServer) UpdateLearningGroup(context.Context, *UpdateLearningGroupRequest) (*UpdateLearningGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLearningGroup not implemented")
+}
+func (UnimplementedSsoServer) DeleteLearningGroup(context.Context, *DeleteLearningGroupRequest) (*DeleteLearningGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLearningGroup not implemented")
+}
+func (UnimplementedSsoServer) GetLearningGroups(context.Context, *GetLearningGroupsRequest) (*GetLearningGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLearningGroups not implemented")
+}
+func (UnimplementedSsoServer) IsGroupAdmin(context.Context, *IsGroupAdminRequest) (*IsGroupAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsGroupAdmin not implemented")
+}
+func (UnimplementedSsoServer) IsUserGroupAdminIn(context.Context, *IsUserGroupAdminInRequest) (*IsUserGroupAdminInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsUserGroupAdminIn not implemented")
+}
+func (UnimplementedSsoServer) IsUserLearnerIn(context.Context, *IsUserLearnereInRequest) (*IsUserLearnereInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsUserLearnerIn not implemented")
+}
+func (UnimplementedSsoServer) GetLearners(context.Context, *GetLearnersRequest) (*GetLearnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLearners not implemented")
+}
+func (UnimplementedSsoServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedSsoServer) GetUserInfo(context.Context, *GetUserInfoRequest) (*GetUserInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserInfo not implemented")
+}
+func (UnimplementedSsoServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedSsoServer) ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*ConfirmPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPasswordReset not implemented")
+}
+func (UnimplementedSsoServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedSsoServer) ResendVerification(context.Context, *ResendVerificationRequest) (*ResendVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendVerification not implemented")
+}
+func (UnimplementedSsoServer) LoginMFA(context.Context, *LoginMFARequest) (*LoginMFAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginMFA not implemented")
+}
+func (UnimplementedSsoServer) EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedSsoServer) VerifyTOTP(context.Context, *VerifyTOTPRequest) (*VerifyTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTOTP not implemented")
+}
+func (UnimplementedSsoServer) DisableTOTP(context.Context, *DisableTOTPRequest) (*DisableTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (UnimplementedSsoServer) RegisterApp(context.Context, *RegisterAppRequest) (*RegisterAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterApp not implemented")
+}
+func (UnimplementedSsoServer) IssueClientToken(context.Context, *IssueClientTokenRequest) (*IssueClientTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueClientToken not implemented")
+}
+func (UnimplementedSsoServer) RegisterRelyingParty(context.Context, *RegisterRelyingPartyRequest) (*RegisterRelyingPartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterRelyingParty not implemented")
+}
+func (UnimplementedSsoServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedSsoServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedSsoServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedSsoServer) SetUserAdmin(context.Context, *SetUserAdminRequest) (*SetUserAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserAdmin not implemented")
+}
+func (UnimplementedSsoServer) DeactivateUser(context.Context, *DeactivateUserRequest) (*DeactivateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateUser not implemented")
+}
+func (UnimplementedSsoServer) ReactivateUser(context.Context, *ReactivateUserRequest) (*ReactivateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateUser not implemented")
+}
+func (UnimplementedSsoServer) RequestUserExport(context.Context, *RequestUserExportRequest) (*RequestUserExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUserExport not implemented")
+}
+func (UnimplementedSsoServer) RequestUserErasure(context.Context, *RequestUserErasureRequest) (*RequestUserErasureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUserErasure not implemented")
+}
+func (UnimplementedSsoServer) GetGDPRJob(context.Context, *GetGDPRJobRequest) (*GetGDPRJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGDPRJob not implemented")
+}
+func (UnimplementedSsoServer) ImportUsers(context.Context, *ImportUsersRequest) (*ImportUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportUsers not implemented")
+}
+func (UnimplementedSsoServer) AcceptInvite(context.Context, *AcceptInviteRequest) (*AcceptInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvite not implemented")
+}
+func (UnimplementedSsoServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedSsoServer) CreateLgInvite(context.Context, *CreateLgInviteRequest) (*CreateLgInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLgInvite not implemented")
+}
+func (UnimplementedSsoServer) ListLgInvites(context.Context, *ListLgInvitesRequest) (*ListLgInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLgInvites not implemented")
+}
+func (UnimplementedSsoServer) RevokeLgInvite(context.Context, *RevokeLgInviteRequest) (*RevokeLgInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeLgInvite not implemented")
+}
+func (UnimplementedSsoServer) RedeemLgInvite(context.Context, *RedeemLgInviteRequest) (*RedeemLgInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemLgInvite not implemented")
+}
+func (UnimplementedSsoServer) AddMembers(context.Context, *AddMembersRequest) (*AddMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMembers not implemented")
+}
+func (UnimplementedSsoServer) RemoveMembers(context.Context, *RemoveMembersRequest) (*RemoveMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMembers not implemented")
+}
+func (UnimplementedSsoServer) CreateTgLinkToken(context.Context, *CreateTgLinkTokenRequest) (*CreateTgLinkTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTgLinkToken not implemented")
+}
+func (UnimplementedSsoServer) UnlinkTelegram(context.Context, *UnlinkTelegramRequest) (*UnlinkTelegramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkTelegram not implemented")
+}
+func (UnimplementedSsoServer) CreateLgRole(context.Context, *CreateLgRoleRequest) (*CreateLgRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLgRole not implemented")
+}
+func (UnimplementedSsoServer) ListLgRoles(context.Context, *ListLgRolesRequest) (*ListLgRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLgRoles not implemented")
+}
+func (UnimplementedSsoServer) UpdateLgRole(context.Context, *UpdateLgRoleRequest) (*UpdateLgRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLgRole not implemented")
+}
+func (UnimplementedSsoServer) DeleteLgRole(context.Context, *DeleteLgRoleRequest) (*DeleteLgRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLgRole not implemented")
+}
+func (UnimplementedSsoServer) AssignLgRole(context.Context, *AssignLgRoleRequest) (*AssignLgRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignLgRole not implemented")
+}
+func (UnimplementedSsoServer) UnassignLgRole(context.Context, *UnassignLgRoleRequest) (*UnassignLgRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignLgRole not implemented")
+}
+func (UnimplementedSsoServer) GetUserPermissions(context.Context, *GetUserPermissionsRequest) (*GetUserPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPermissions not implemented")
+}
+func (UnimplementedSsoServer) ScimListUsers(context.Context, *ScimListUsersRequest) (*ScimListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScimListUsers not implemented")
+}
+func (UnimplementedSsoServer) ScimGetUser(context.Context, *ScimGetUserRequest) (*ScimGetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScimGetUser not implemented")
+}
+func (UnimplementedSsoServer) ScimCreateUser(context.Context, *ScimCreateUserRequest) (*ScimCreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScimCreateUser not implemented")
+}
+func (UnimplementedSsoServer) ScimUpdateUser(context.Context, *ScimUpdateUserRequest) (*ScimUpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScimUpdateUser not implemented")
+}
+func (UnimplementedSsoServer) ScimDeleteUser(context.Context, *ScimDeleteUserRequest) (*ScimDeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScimDeleteUser not implemented")
+}
+func (UnimplementedSsoServer) ScimListGroups(context.Context, *ScimListGroupsRequest) (*ScimListGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScimListGroups not implemented")
+}
+func (UnimplementedSsoServer) ScimGetGroup(context.Context, *ScimGetGroupRequest) (*ScimGetGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScimGetGroup not implemented")
+}
+func (UnimplementedSsoServer) ScimCreateGroup(context.Context, *ScimCreateGroupRequest) (*ScimCreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScimCreateGroup not implemented")
+}
+func (UnimplementedSsoServer) ScimUpdateGroup(context.Context, *ScimUpdateGroupRequest) (*ScimUpdateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScimUpdateGroup not implemented")
+}
+func (UnimplementedSsoServer) ScimChangeGroupMembers(context.Context, *ScimChangeGroupMembersRequest) (*ScimChangeGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScimChangeGroupMembers not implemented")
+}
+func (UnimplementedSsoServer) ScimDeleteGroup(context.Context, *ScimDeleteGroupRequest) (*ScimDeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScimDeleteGroup not implemented")
+}
+func (UnimplementedSsoServer) ImpersonateUser(context.Context, *ImpersonateUserRequest) (*ImpersonateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImpersonateUser not implemented")
+}
+func (UnimplementedSsoServer) GetLearningGroupTree(context.Context, *GetLearningGroupTreeRequest) (*GetLearningGroupTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLearningGroupTree not implemented")
+}
+func (UnimplementedSsoServer) MoveLearningGroup(context.Context, *MoveLearningGroupRequest) (*MoveLearningGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveLearningGroup not implemented")
+}
+func (UnimplementedSsoServer) mustEmbedUnimplementedSsoServer() {}
+func (UnimplementedSsoServer) testEmbeddedByValue()             {}
+
+// UnsafeSsoServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SsoServer will
+// result in compilation errors.
+type UnsafeSsoServer interface {
+	mustEmbedUnimplementedSsoServer()
+}
+
+func RegisterSsoServer(s grpc.ServiceRegistrar, srv SsoServer) {
+	// If the following call pancis, it indicates UnimplementedSsoServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Sso_ServiceDesc, srv)
+}
+
+func _Sso_RegisterUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).RegisterUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_RegisterUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).RegisterUser(ctx, req.(*RegisterUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).LoginUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_LoginUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).LoginUser(ctx, req.(*LoginUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_LoginViaTg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginViaTgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).LoginViaTg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_LoginViaTg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).LoginViaTg(ctx, req.(*LoginViaTgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_IsAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).IsAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_IsAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).IsAdmin(ctx, req.(*IsAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_AuthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).AuthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_AuthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).AuthCheck(ctx, req.(*AuthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_UpdateUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).UpdateUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_UpdateUserInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).UpdateUserInfo(ctx, req.(*UpdateUserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_CheckOTPAndLogIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckOTPAndLogInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).CheckOTPAndLogIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_CheckOTPAndLogIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).CheckOTPAndLogIn(ctx, req.(*CheckOTPAndLogInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_CreateLearningGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLearningGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).CreateLearningGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_CreateLearningGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).CreateLearningGroup(ctx, req.(*CreateLearningGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_GetLearningGroupByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLearningGroupByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).GetLearningGroupByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_GetLearningGroupByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).GetLearningGroupByID(ctx, req.(*GetLearningGroupByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_UpdateLearningGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLearningGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).UpdateLearningGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_UpdateLearningGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).UpdateLearningGroup(ctx, req.(*UpdateLearningGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_DeleteLearningGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLearningGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).DeleteLearningGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_DeleteLearningGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).DeleteLearningGroup(ctx, req.(*DeleteLearningGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_GetLearningGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLearningGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).GetLearningGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_GetLearningGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).GetLearningGroups(ctx, req.(*GetLearningGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_IsGroupAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsGroupAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).IsGroupAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_IsGroupAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).IsGroupAdmin(ctx, req.(*IsGroupAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_IsUserGroupAdminIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsUserGroupAdminInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).IsUserGroupAdminIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_IsUserGroupAdminIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).IsUserGroupAdminIn(ctx, req.(*IsUserGroupAdminInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_IsUserLearnerIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsUserLearnereInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).IsUserLearnerIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_IsUserLearnerIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).IsUserLearnerIn(ctx, req.(*IsUserLearnereInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_GetLearners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLearnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).GetLearners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_GetLearners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).GetLearners(ctx, req.(*GetLearnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_GetUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).GetUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_GetUserInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).GetUserInfo(ctx, req.(*GetUserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ConfirmPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ConfirmPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ConfirmPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ConfirmPasswordReset(ctx, req.(*ConfirmPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ResendVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ResendVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ResendVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ResendVerification(ctx, req.(*ResendVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_LoginMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).LoginMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_LoginMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).LoginMFA(ctx, req.(*LoginMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_EnrollTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_VerifyTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).VerifyTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_VerifyTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).VerifyTOTP(ctx, req.(*VerifyTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_DisableTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).DisableTOTP(ctx, req.(*DisableTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_RegisterApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).RegisterApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_RegisterApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).RegisterApp(ctx, req.(*RegisterAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_IssueClientToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueClientTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).IssueClientToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_IssueClientToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).IssueClientToken(ctx, req.(*IssueClientTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_RegisterRelyingParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRelyingPartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).RegisterRelyingParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_RegisterRelyingParty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).RegisterRelyingParty(ctx, req.(*RegisterRelyingPartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_SetUserAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).SetUserAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_SetUserAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).SetUserAdmin(ctx, req.(*SetUserAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_DeactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).DeactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_DeactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).DeactivateUser(ctx, req.(*DeactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ReactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ReactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ReactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ReactivateUser(ctx, req.(*ReactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_RequestUserExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestUserExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).RequestUserExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_RequestUserExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).RequestUserExport(ctx, req.(*RequestUserExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_RequestUserErasure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestUserErasureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).RequestUserErasure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_RequestUserErasure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).RequestUserErasure(ctx, req.(*RequestUserErasureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_GetGDPRJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGDPRJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).GetGDPRJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_GetGDPRJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).GetGDPRJob(ctx, req.(*GetGDPRJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ImportUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ImportUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ImportUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ImportUsers(ctx, req.(*ImportUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_AcceptInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).AcceptInvite(ctx, req.(*AcceptInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_CreateLgInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLgInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).CreateLgInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_CreateLgInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).CreateLgInvite(ctx, req.(*CreateLgInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ListLgInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLgInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ListLgInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ListLgInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ListLgInvites(ctx, req.(*ListLgInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_RevokeLgInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeLgInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).RevokeLgInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_RevokeLgInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).RevokeLgInvite(ctx, req.(*RevokeLgInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_RedeemLgInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemLgInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).RedeemLgInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_RedeemLgInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).RedeemLgInvite(ctx, req.(*RedeemLgInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_AddMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).AddMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_AddMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).AddMembers(ctx, req.(*AddMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_RemoveMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).RemoveMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_RemoveMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).RemoveMembers(ctx, req.(*RemoveMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_CreateTgLinkToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTgLinkTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).CreateTgLinkToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_CreateTgLinkToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).CreateTgLinkToken(ctx, req.(*CreateTgLinkTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_UnlinkTelegram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkTelegramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).UnlinkTelegram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_UnlinkTelegram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).UnlinkTelegram(ctx, req.(*UnlinkTelegramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_CreateLgRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLgRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).CreateLgRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_CreateLgRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).CreateLgRole(ctx, req.(*CreateLgRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ListLgRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLgRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ListLgRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ListLgRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ListLgRoles(ctx, req.(*ListLgRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_UpdateLgRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLgRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).UpdateLgRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_UpdateLgRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).UpdateLgRole(ctx, req.(*UpdateLgRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_DeleteLgRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLgRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).DeleteLgRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_DeleteLgRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).DeleteLgRole(ctx, req.(*DeleteLgRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_AssignLgRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignLgRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).AssignLgRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_AssignLgRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).AssignLgRole(ctx, req.(*AssignLgRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_UnassignLgRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignLgRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).UnassignLgRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_UnassignLgRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).UnassignLgRole(ctx, req.(*UnassignLgRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_GetUserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).GetUserPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_GetUserPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).GetUserPermissions(ctx, req.(*GetUserPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ScimListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ScimListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ScimListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ScimListUsers(ctx, req.(*ScimListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ScimGetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimGetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ScimGetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ScimGetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ScimGetUser(ctx, req.(*ScimGetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ScimCreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimCreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ScimCreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ScimCreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ScimCreateUser(ctx, req.(*ScimCreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ScimUpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimUpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ScimUpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ScimUpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ScimUpdateUser(ctx, req.(*ScimUpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ScimDeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimDeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ScimDeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ScimDeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ScimDeleteUser(ctx, req.(*ScimDeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ScimListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ScimListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ScimListGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ScimListGroups(ctx, req.(*ScimListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ScimGetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimGetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ScimGetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ScimGetGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ScimGetGroup(ctx, req.(*ScimGetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ScimCreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimCreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ScimCreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ScimCreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ScimCreateGroup(ctx, req.(*ScimCreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ScimUpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimUpdateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ScimUpdateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ScimUpdateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ScimUpdateGroup(ctx, req.(*ScimUpdateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ScimChangeGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimChangeGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ScimChangeGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ScimChangeGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ScimChangeGroupMembers(ctx, req.(*ScimChangeGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ScimDeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScimDeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ScimDeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ScimDeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ScimDeleteGroup(ctx, req.(*ScimDeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_ImpersonateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).ImpersonateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_ImpersonateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).ImpersonateUser(ctx, req.(*ImpersonateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_GetLearningGroupTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLearningGroupTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).GetLearningGroupTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_GetLearningGroupTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).GetLearningGroupTree(ctx, req.(*GetLearningGroupTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sso_MoveLearningGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveLearningGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SsoServer).MoveLearningGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sso_MoveLearningGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SsoServer).MoveLearningGroup(ctx, req.(*MoveLearningGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Sso_ServiceDesc is the grpc.ServiceDesc for Sso service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Sso_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.Sso",
+	HandlerType: (*SsoServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterUser",
+			Handler:    _Sso_RegisterUser_Handler,
+		},
+		{
+			MethodName: "LoginUser",
+			Handler:    _Sso_LoginUser_Handler,
+		},
+		{
+			MethodName: "LoginViaTg",
+			Handler:    _Sso_LoginViaTg_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _Sso_RefreshToken_Handler,
+		},
+		{
+			MethodName: "IsAdmin",
+			Handler:    _Sso_IsAdmin_Handler,
+		},
+		{
+			MethodName: "AuthCheck",
+			Handler:    _Sso_AuthCheck_Handler,
+		},
+		{
+			MethodName: "UpdateUserInfo",
+			Handler:    _Sso_UpdateUserInfo_Handler,
+		},
+		{
+			MethodName: "CheckOTPAndLogIn",
+			Handler:    _Sso_CheckOTPAndLogIn_Handler,
+		},
+		{
+			MethodName: "CreateLearningGroup",
+			Handler:    _Sso_CreateLearningGroup_Handler,
+		},
+		{
+			MethodName: "GetLearningGroupByID",
+			Handler:    _Sso_GetLearningGroupByID_Handler,
+		},
+		{
+			MethodName: "UpdateLearningGroup",
+			Handler:    _Sso_UpdateLearningGroup_Handler,
+		},
+		{
+			MethodName: "DeleteLearningGroup",
+			Handler:    _Sso_DeleteLearningGroup_Handler,
+		},
+		{
+			MethodName: "GetLearningGroups",
+			Handler:    _Sso_GetLearningGroups_Handler,
+		},
+		{
+			MethodName: "IsGroupAdmin",
+			Handler:    _Sso_IsGroupAdmin_Handler,
+		},
+		{
+			MethodName: "IsUserGroupAdminIn",
+			Handler:    _Sso_IsUserGroupAdminIn_Handler,
+		},
+		{
+			MethodName: "IsUserLearnerIn",
+			Handler:    _Sso_IsUserLearnerIn_Handler,
+		},
+		{
+			MethodName: "GetLearners",
+			Handler:    _Sso_GetLearners_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Sso_Logout_Handler,
+		},
+		{
+			MethodName: "GetUserInfo",
+			Handler:    _Sso_GetUserInfo_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _Sso_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ConfirmPasswordReset",
+			Handler:    _Sso_ConfirmPasswordReset_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _Sso_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "ResendVerification",
+			Handler:    _Sso_ResendVerification_Handler,
+		},
+		{
+			MethodName: "LoginMFA",
+			Handler:    _Sso_LoginMFA_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _Sso_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "VerifyTOTP",
+			Handler:    _Sso_VerifyTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _Sso_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "RegisterApp",
+			Handler:    _Sso_RegisterApp_Handler,
+		},
+		{
+			MethodName: "IssueClientToken",
+			Handler:    _Sso_IssueClientToken_Handler,
+		},
+		{
+			MethodName: "RegisterRelyingParty",
+			Handler:    _Sso_RegisterRelyingParty_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Sso_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _Sso_RevokeSession_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _Sso_ListUsers_Handler,
+		},
+		{
+			MethodName: "SetUserAdmin",
+			Handler:    _Sso_SetUserAdmin_Handler,
+		},
+		{
+			MethodName: "DeactivateUser",
+			Handler:    _Sso_DeactivateUser_Handler,
+		},
+		{
+			MethodName: "ReactivateUser",
+			Handler:    _Sso_ReactivateUser_Handler,
+		},
+		{
+			MethodName: "RequestUserExport",
+			Handler:    _Sso_RequestUserExport_Handler,
+		},
+		{
+			MethodName: "RequestUserErasure",
+			Handler:    _Sso_RequestUserErasure_Handler,
+		},
+		{
+			MethodName: "GetGDPRJob",
+			Handler:    _Sso_GetGDPRJob_Handler,
+		},
+		{
+			MethodName: "ImportUsers",
+			Handler:    _Sso_ImportUsers_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _Sso_AcceptInvite_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Sso_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "CreateLgInvite",
+			Handler:    _Sso_CreateLgInvite_Handler,
+		},
+		{
+			MethodName: "ListLgInvites",
+			Handler:    _Sso_ListLgInvites_Handler,
+		},
+		{
+			MethodName: "RevokeLgInvite",
+			Handler:    _Sso_RevokeLgInvite_Handler,
+		},
+		{
+			MethodName: "RedeemLgInvite",
+			Handler:    _Sso_RedeemLgInvite_Handler,
+		},
+		{
+			MethodName: "AddMembers",
+			Handler:    _Sso_AddMembers_Handler,
+		},
+		{
+			MethodName: "RemoveMembers",
+			Handler:    _Sso_RemoveMembers_Handler,
+		},
+		{
+			MethodName: "CreateTgLinkToken",
+			Handler:    _Sso_CreateTgLinkToken_Handler,
+		},
+		{
+			MethodName: "UnlinkTelegram",
+			Handler:    _Sso_UnlinkTelegram_Handler,
+		},
+		{
+			MethodName: "CreateLgRole",
+			Handler:    _Sso_CreateLgRole_Handler,
+		},
+		{
+			MethodName: "ListLgRoles",
+			Handler:    _Sso_ListLgRoles_Handler,
+		},
+		{
+			MethodName: "UpdateLgRole",
+			Handler:    _Sso_UpdateLgRole_Handler,
+		},
+		{
+			MethodName: "DeleteLgRole",
+			Handler:    _Sso_DeleteLgRole_Handler,
+		},
+		{
+			MethodName: "AssignLgRole",
+			Handler:    _Sso_AssignLgRole_Handler,
+		},
+		{
+			MethodName: "UnassignLgRole",
+			Handler:    _Sso_UnassignLgRole_Handler,
+		},
+		{
+			MethodName: "GetUserPermissions",
+			Handler:    _Sso_GetUserPermissions_Handler,
+		},
+		{
+			MethodName: "ScimListUsers",
+			Handler:    _Sso_ScimListUsers_Handler,
+		},
+		{
+			MethodName: "ScimGetUser",
+			Handler:    _Sso_ScimGetUser_Handler,
+		},
+		{
+			MethodName: "ScimCreateUser",
+			Handler:    _Sso_ScimCreateUser_Handler,
+		},
+		{
+			MethodName: "ScimUpdateUser",
+			Handler:    _Sso_ScimUpdateUser_Handler,
+		},
+		{
+			MethodName: "ScimDeleteUser",
+			Handler:    _Sso_ScimDeleteUser_Handler,
+		},
+		{
+			MethodName: "ScimListGroups",
+			Handler:    _Sso_ScimListGroups_Handler,
+		},
+		{
+			MethodName: "ScimGetGroup",
+			Handler:    _Sso_ScimGetGroup_Handler,
+		},
+		{
+			MethodName: "ScimCreateGroup",
+			Handler:    _Sso_ScimCreateGroup_Handler,
+		},
+		{
+			MethodName: "ScimUpdateGroup",
+			Handler:    _Sso_ScimUpdateGroup_Handler,
+		},
+		{
+			MethodName: "ScimChangeGroupMembers",
+			Handler:    _Sso_ScimChangeGroupMembers_Handler,
+		},
+		{
+			MethodName: "ScimDeleteGroup",
+			Handler:    _Sso_ScimDeleteGroup_Handler,
+		},
+		{
+			MethodName: "ImpersonateUser",
+			Handler:    _Sso_ImpersonateUser_Handler,
+		},
+		{
+			MethodName: "GetLearningGroupTree",
+			Handler:    _Sso_GetLearningGroupTree_Handler,
+		},
+		{
+			MethodName: "MoveLearningGroup",
+			Handler:    _Sso_MoveLearningGroup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sso.proto",
+}
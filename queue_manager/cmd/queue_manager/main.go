@@ -116,6 +116,56 @@ func NewInitCmd() *cobra.Command {
 				log.Error("failed to declare and bind Channel Queue", slog.Any("err", err))
 			}
 
+			// Declare and bind EmailVerification queue
+			if err := declareQueueAndBind(
+				rmq,
+				cfg.RabbitMQ.EmailVerification.EmailVerificationQueue,
+				cfg.RabbitMQ.Share.ShareExchange.Name,
+				cfg.RabbitMQ.EmailVerification.EmailVerificationRoutingKey,
+			); err != nil {
+				log.Error("failed to declare and bind EmailVerification Queue", slog.Any("err", err))
+			}
+
+			// Declare and bind GDPR queue
+			if err := declareQueueAndBind(
+				rmq,
+				cfg.RabbitMQ.GDPR.GDPRQueue,
+				cfg.RabbitMQ.Share.ShareExchange.Name,
+				cfg.RabbitMQ.GDPR.GDPRRoutingKey,
+			); err != nil {
+				log.Error("failed to declare and bind GDPR Queue", slog.Any("err", err))
+			}
+
+			// Declare and bind GDPRResult queue
+			if err := declareQueueAndBind(
+				rmq,
+				cfg.RabbitMQ.GDPR.GDPRResultQueue,
+				cfg.RabbitMQ.Share.ShareExchange.Name,
+				cfg.RabbitMQ.GDPR.GDPRResultRoutingKey,
+			); err != nil {
+				log.Error("failed to declare and bind GDPRResult Queue", slog.Any("err", err))
+			}
+
+			// Declare and bind UserInvite queue
+			if err := declareQueueAndBind(
+				rmq,
+				cfg.RabbitMQ.UserInvite.UserInviteQueue,
+				cfg.RabbitMQ.Share.ShareExchange.Name,
+				cfg.RabbitMQ.UserInvite.UserInviteRoutingKey,
+			); err != nil {
+				log.Error("failed to declare and bind UserInvite Queue", slog.Any("err", err))
+			}
+
+			// Declare and bind ChatResult queue
+			if err := declareQueueAndBind(
+				rmq,
+				cfg.RabbitMQ.ChatResult.ChatResultQueue,
+				cfg.RabbitMQ.Share.ShareExchange.Name,
+				cfg.RabbitMQ.ChatResult.ChatResultRoutingKey,
+			); err != nil {
+				log.Error("failed to declare and bind ChatResult Queue", slog.Any("err", err))
+			}
+
 			return nil
 		},
 	}
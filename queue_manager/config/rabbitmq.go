@@ -1,17 +1,21 @@
 package config
 
 type RabbitMQ struct {
-	UserName     string       `yaml:"username"`
-	Password     string       `yaml:"password"`
-	Host         string       `yaml:"host"`
-	Port         int          `yaml:"port"`
-	Share        Share        `yaml:"share"`
-	Otp          Otp          `yaml:"otp"`
-	Chat         Chat         `yaml:"chat"`
-	Notification Notification `yaml:"notification"`
-	Spfu         Spfu         `yaml:"spfu"`
-	Plan         Plan         `yaml:"plan"`
-	Channel      Channel      `yaml:"channel"`
+	UserName          string            `yaml:"username"`
+	Password          string            `yaml:"password"`
+	Host              string            `yaml:"host"`
+	Port              int               `yaml:"port"`
+	Share             Share             `yaml:"share"`
+	Otp               Otp               `yaml:"otp"`
+	Chat              Chat              `yaml:"chat"`
+	Notification      Notification      `yaml:"notification"`
+	Spfu              Spfu              `yaml:"spfu"`
+	Plan              Plan              `yaml:"plan"`
+	Channel           Channel           `yaml:"channel"`
+	EmailVerification EmailVerification `yaml:"email_verification"`
+	GDPR              GDPR              `yaml:"gdpr"`
+	UserInvite        UserInvite        `yaml:"user_invite"`
+	ChatResult        ChatResult        `yaml:"chat_result"`
 }
 
 type QueueConfig struct {
@@ -0,0 +1,8 @@
+package config
+
+// ChatResult queue carries results of telegram link and unlink
+// requests from sso to notification service.
+type ChatResult struct {
+	ChatResultQueue      QueueConfig `yaml:"chat_result_queue"`
+	ChatResultRoutingKey string      `yaml:"chat_result_routing_key"`
+}
@@ -0,0 +1,6 @@
+package config
+
+type EmailVerification struct {
+	EmailVerificationQueue      QueueConfig `yaml:"email_verification_queue"`
+	EmailVerificationRoutingKey string      `yaml:"email_verification_routing_key"`
+}
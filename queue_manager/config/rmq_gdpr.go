@@ -0,0 +1,10 @@
+package config
+
+// GDPR queues carry user data export and erasure jobs
+// from sso to learning platform and results back.
+type GDPR struct {
+	GDPRQueue            QueueConfig `yaml:"gdpr_queue"`
+	GDPRRoutingKey       string      `yaml:"gdpr_routing_key"`
+	GDPRResultQueue      QueueConfig `yaml:"gdpr_result_queue"`
+	GDPRResultRoutingKey string      `yaml:"gdpr_result_routing_key"`
+}
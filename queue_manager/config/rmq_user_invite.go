@@ -0,0 +1,6 @@
+package config
+
+type UserInvite struct {
+	UserInviteQueue      QueueConfig `yaml:"user_invite_queue"`
+	UserInviteRoutingKey string      `yaml:"user_invite_routing_key"`
+}